@@ -0,0 +1,147 @@
+package parquet
+
+import (
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The schema elements have two annotations: the ConvertedType of the first
+// versions of the format, and the LogicalType that replaces it. The files
+// written before the LogicalType have only the ConvertedType, and the spec
+// requires the writers to write the ConvertedType of the logical types that
+// have one for the readers that do not know the LogicalType. The schemas,
+// read or built, are normalized to have both: the LogicalType of the
+// elements with only a ConvertedType, and the ConvertedType of the elements
+// with only a LogicalType, if it has an equivalent. The INTERVAL and the
+// MAP_KEY_VALUE have no LogicalType, the UUID, the FLOAT16, the VARIANT,
+// the GEOMETRY, the GEOGRAPHY, the TIME and the TIMESTAMP in NANOS or not
+// adjusted to UTC and the UNKNOWN have no ConvertedType.
+
+// normalizeAnnotations sets the LogicalType of element from its
+// ConvertedType, or the ConvertedType from the LogicalType, if it has only
+// one of them. An element with both is left as it is.
+func normalizeAnnotations(element *thrift.SchemaElement) {
+	switch {
+	case element.LogicalType == nil && element.ConvertedType != nil:
+		element.LogicalType = logicalTypeOf(element)
+	case element.LogicalType != nil && element.ConvertedType == nil:
+		element.ConvertedType = convertedTypeOf(element.LogicalType)
+		if d := element.LogicalType.DECIMAL; d != nil && element.ConvertedType != nil {
+			scale, precision := d.Scale, d.Precision
+			element.Scale, element.Precision = &scale, &precision
+		}
+	}
+}
+
+// logicalTypeOf returns the LogicalType equivalent to the ConvertedType of
+// element, nil if there is none.
+func logicalTypeOf(element *thrift.SchemaElement) *thrift.LogicalType {
+	integer := func(bitWidth int8, signed bool) *thrift.LogicalType {
+		return &thrift.LogicalType{INTEGER: &thrift.IntType{BitWidth: bitWidth, IsSigned: signed}}
+	}
+	timeUnit := func(micros bool) *thrift.TimeUnit {
+		if micros {
+			return &thrift.TimeUnit{MICROS: &thrift.MicroSeconds{}}
+		}
+		return &thrift.TimeUnit{MILLIS: &thrift.MilliSeconds{}}
+	}
+	switch ct := element.GetConvertedType(); ct {
+	case thrift.ConvertedType_UTF8:
+		return &thrift.LogicalType{STRING: &thrift.StringType{}}
+	case thrift.ConvertedType_MAP:
+		return &thrift.LogicalType{MAP: &thrift.MapType{}}
+	case thrift.ConvertedType_LIST:
+		return &thrift.LogicalType{LIST: &thrift.ListType{}}
+	case thrift.ConvertedType_ENUM:
+		return &thrift.LogicalType{ENUM: &thrift.EnumType{}}
+	case thrift.ConvertedType_DECIMAL:
+		return &thrift.LogicalType{DECIMAL: &thrift.DecimalType{Scale: element.GetScale(), Precision: element.GetPrecision()}}
+	case thrift.ConvertedType_DATE:
+		return &thrift.LogicalType{DATE: &thrift.DateType{}}
+	case thrift.ConvertedType_TIME_MILLIS, thrift.ConvertedType_TIME_MICROS:
+		unit := timeUnit(ct == thrift.ConvertedType_TIME_MICROS)
+		return &thrift.LogicalType{TIME: &thrift.TimeType{IsAdjustedToUTC: true, Unit: unit}}
+	case thrift.ConvertedType_TIMESTAMP_MILLIS, thrift.ConvertedType_TIMESTAMP_MICROS:
+		unit := timeUnit(ct == thrift.ConvertedType_TIMESTAMP_MICROS)
+		return &thrift.LogicalType{TIMESTAMP: &thrift.TimestampType{IsAdjustedToUTC: true, Unit: unit}}
+	case thrift.ConvertedType_INT_8:
+		return integer(8, true)
+	case thrift.ConvertedType_INT_16:
+		return integer(16, true)
+	case thrift.ConvertedType_INT_32:
+		return integer(32, true)
+	case thrift.ConvertedType_INT_64:
+		return integer(64, true)
+	case thrift.ConvertedType_UINT_8:
+		return integer(8, false)
+	case thrift.ConvertedType_UINT_16:
+		return integer(16, false)
+	case thrift.ConvertedType_UINT_32:
+		return integer(32, false)
+	case thrift.ConvertedType_UINT_64:
+		return integer(64, false)
+	case thrift.ConvertedType_JSON:
+		return &thrift.LogicalType{JSON: &thrift.JsonType{}}
+	case thrift.ConvertedType_BSON:
+		return &thrift.LogicalType{BSON: &thrift.BsonType{}}
+	}
+	return nil
+}
+
+// convertedTypeOf returns the ConvertedType equivalent to the logical type
+// lt, nil if there is none.
+func convertedTypeOf(lt *thrift.LogicalType) *thrift.ConvertedType {
+	var ct thrift.ConvertedType
+	switch {
+	case lt.IsSetSTRING():
+		ct = thrift.ConvertedType_UTF8
+	case lt.IsSetMAP():
+		ct = thrift.ConvertedType_MAP
+	case lt.IsSetLIST():
+		ct = thrift.ConvertedType_LIST
+	case lt.IsSetENUM():
+		ct = thrift.ConvertedType_ENUM
+	case lt.IsSetDECIMAL():
+		ct = thrift.ConvertedType_DECIMAL
+	case lt.IsSetDATE():
+		ct = thrift.ConvertedType_DATE
+	case lt.IsSetTIME() && lt.TIME.IsAdjustedToUTC && lt.TIME.GetUnit().IsSetMILLIS():
+		ct = thrift.ConvertedType_TIME_MILLIS
+	case lt.IsSetTIME() && lt.TIME.IsAdjustedToUTC && lt.TIME.GetUnit().IsSetMICROS():
+		ct = thrift.ConvertedType_TIME_MICROS
+	case lt.IsSetTIMESTAMP() && lt.TIMESTAMP.IsAdjustedToUTC && lt.TIMESTAMP.GetUnit().IsSetMILLIS():
+		ct = thrift.ConvertedType_TIMESTAMP_MILLIS
+	case lt.IsSetTIMESTAMP() && lt.TIMESTAMP.IsAdjustedToUTC && lt.TIMESTAMP.GetUnit().IsSetMICROS():
+		ct = thrift.ConvertedType_TIMESTAMP_MICROS
+	case lt.IsSetINTEGER():
+		var ok bool
+		if ct, ok = integerConvertedType(lt.INTEGER); !ok {
+			return nil
+		}
+	case lt.IsSetJSON():
+		ct = thrift.ConvertedType_JSON
+	case lt.IsSetBSON():
+		ct = thrift.ConvertedType_BSON
+	default:
+		return nil
+	}
+	return &ct
+}
+
+// integerConvertedType returns the INT_N or UINT_N of the INTEGER t, ok
+// false if its bit width is not 8, 16, 32 or 64.
+func integerConvertedType(t *thrift.IntType) (ct thrift.ConvertedType, ok bool) {
+	signed := map[int8]thrift.ConvertedType{
+		8: thrift.ConvertedType_INT_8, 16: thrift.ConvertedType_INT_16,
+		32: thrift.ConvertedType_INT_32, 64: thrift.ConvertedType_INT_64,
+	}
+	unsigned := map[int8]thrift.ConvertedType{
+		8: thrift.ConvertedType_UINT_8, 16: thrift.ConvertedType_UINT_16,
+		32: thrift.ConvertedType_UINT_32, 64: thrift.ConvertedType_UINT_64,
+	}
+	if t.IsSigned {
+		ct, ok = signed[t.BitWidth]
+	} else {
+		ct, ok = unsigned[t.BitWidth]
+	}
+	return ct, ok
+}
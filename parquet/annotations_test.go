@@ -0,0 +1,117 @@
+package parquet
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestNormalizeAnnotations(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	millis := &thrift.TimeUnit{MILLIS: &thrift.MilliSeconds{}}
+	for _, test := range []struct {
+		element, want thrift.SchemaElement
+	}{
+		{
+			element: thrift.SchemaElement{ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_UTF8)},
+			want: thrift.SchemaElement{
+				ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_UTF8),
+				LogicalType:   &thrift.LogicalType{STRING: &thrift.StringType{}},
+			},
+		},
+		{
+			element: thrift.SchemaElement{ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_UINT_16)},
+			want: thrift.SchemaElement{
+				ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_UINT_16),
+				LogicalType:   &thrift.LogicalType{INTEGER: &thrift.IntType{BitWidth: 16}},
+			},
+		},
+		{
+			element: thrift.SchemaElement{ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_TIMESTAMP_MILLIS)},
+			want: thrift.SchemaElement{
+				ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_TIMESTAMP_MILLIS),
+				LogicalType:   &thrift.LogicalType{TIMESTAMP: &thrift.TimestampType{IsAdjustedToUTC: true, Unit: millis}},
+			},
+		},
+		{
+			element: thrift.SchemaElement{LogicalType: &thrift.LogicalType{DECIMAL: &thrift.DecimalType{Scale: 2, Precision: 9}}},
+			want: thrift.SchemaElement{
+				ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_DECIMAL),
+				LogicalType:   &thrift.LogicalType{DECIMAL: &thrift.DecimalType{Scale: 2, Precision: 9}},
+				Scale:         int32Ptr(2),
+				Precision:     int32Ptr(9),
+			},
+		},
+		{
+			element: thrift.SchemaElement{LogicalType: &thrift.LogicalType{TIME: &thrift.TimeType{Unit: millis}}},
+			want:    thrift.SchemaElement{LogicalType: &thrift.LogicalType{TIME: &thrift.TimeType{Unit: millis}}},
+		},
+		{
+			element: thrift.SchemaElement{ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_INTERVAL)},
+			want:    thrift.SchemaElement{ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_INTERVAL)},
+		},
+		{
+			// both annotations, left as they are
+			element: thrift.SchemaElement{
+				ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_INT_32),
+				LogicalType:   &thrift.LogicalType{INTEGER: &thrift.IntType{BitWidth: 32, IsSigned: true}},
+			},
+			want: thrift.SchemaElement{
+				ConvertedType: thrift.ConvertedTypePtr(thrift.ConvertedType_INT_32),
+				LogicalType:   &thrift.LogicalType{INTEGER: &thrift.IntType{BitWidth: 32, IsSigned: true}},
+			},
+		},
+	} {
+		element := test.element
+		normalizeAnnotations(&element)
+		if !reflect.DeepEqual(element, test.want) {
+			t.Errorf("got %v, want %v", element, test.want)
+		}
+	}
+}
+
+func TestNormalizedSchemas(t *testing.T) {
+	// a file with only the converted types
+	utf8, list := thrift.ConvertedType_UTF8, thrift.ConvertedType_LIST
+	required, repeated := thrift.FieldRepetitionType_REQUIRED, thrift.FieldRepetitionType_REPEATED
+	two, one := int32(2), int32(1)
+	s, err := schemaFromFileMetaData(&thrift.FileMetaData{Schema: []*thrift.SchemaElement{
+		{Name: "root", NumChildren: &two},
+		{Name: "name", Type: thrift.TypePtr(thrift.Type_BYTE_ARRAY), RepetitionType: &required, ConvertedType: &utf8},
+		{Name: "tags", RepetitionType: &required, ConvertedType: &list, NumChildren: &one},
+		{Name: "list", RepetitionType: &repeated, NumChildren: &one},
+		{Name: "element", Type: thrift.TypePtr(thrift.Type_INT32), RepetitionType: &required},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lt := s.ColumnByName("name").SchemaElement.GetLogicalType(); lt == nil || !lt.IsSetSTRING() {
+		t.Errorf("logical type of name: %v", lt)
+	}
+	if lt := s.root.children[1].(*group).schemaElement.GetLogicalType(); lt == nil || !lt.IsSetLIST() {
+		t.Errorf("logical type of tags: %v", lt)
+	}
+
+	// the written elements have both annotations
+	s, err = ParseSchema(`message root {
+  required binary name (STRING);
+  required int64 ts (TIMESTAMP(MICROS,true));
+  required int64 local (TIMESTAMP(MICROS,false));
+  required fixed_len_byte_array(16) id (UUID);
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]*thrift.ConvertedType{
+		"name":  thrift.ConvertedTypePtr(thrift.ConvertedType_UTF8),
+		"ts":    thrift.ConvertedTypePtr(thrift.ConvertedType_TIMESTAMP_MICROS),
+		"local": nil,
+		"id":    nil,
+	} {
+		e := s.ColumnByName(name).SchemaElement
+		if !reflect.DeepEqual(e.ConvertedType, want) || e.LogicalType == nil {
+			t.Errorf("%s: converted type %v, logical type %v", name, e.ConvertedType, e.LogicalType)
+		}
+	}
+}
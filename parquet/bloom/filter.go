@@ -0,0 +1,234 @@
+// Package bloom implements the split block Bloom filters of the column chunks
+// of parquet files.
+//
+// A filter is a set of blocks of 256 bits. A value is hashed with the 64
+// bits xxHash of its PLAIN encoding, the upper 32 bits of the hash select a
+// block and the lower 32 bits set one bit in each of the 8 words of the
+// block. The format is described here:
+// https://github.com/apache/parquet-format/blob/master/BloomFilter.md
+package bloom
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+const (
+	// BlockSize is the size in bytes of a block of a filter.
+	BlockSize = 32
+	// MaxBytes is the maximum size in bytes of the bitset of a filter.
+	MaxBytes = 128 * 1024 * 1024
+
+	// DefaultNDV and DefaultFPP are the number of distinct values and the
+	// false positive probability a filter is sized for by default, the same
+	// as Arrow: the bitset is 1MB.
+	DefaultNDV = 1024 * 1024
+	DefaultFPP = 0.05
+)
+
+// salt are the odd constants the key of a value is multiplied with to set a
+// bit in each word of its block.
+var salt = [8]uint32{
+	0x47b6137b, 0x44974d91, 0x8824ad5b, 0xa2b7289d,
+	0x705495c7, 0x2df1424b, 0x9efc4947, 0x5c6bfb31,
+}
+
+type block [8]uint32
+
+// mask returns the bits set in a block for key.
+func mask(key uint32) block {
+	var m block
+	for i := range m {
+		m[i] = 1 << ((key * salt[i]) >> 27)
+	}
+	return m
+}
+
+// Filter is a split block Bloom filter. Check never returns false for a
+// value that was inserted, it may return true for a value that was not.
+type Filter struct {
+	blocks []block
+}
+
+// New returns an empty filter with a bitset of numBytes bytes, rounded up to
+// a multiple of BlockSize.
+func New(numBytes int) *Filter {
+	if numBytes > MaxBytes {
+		numBytes = MaxBytes
+	}
+	n := (numBytes + BlockSize - 1) / BlockSize
+	if n < 1 {
+		n = 1
+	}
+	return &Filter{blocks: make([]block, n)}
+}
+
+// OptimalNumBytes returns the size in bytes of the bitset of a filter of
+// ndv distinct values with a false positive probability of fpp: the power
+// of 2 above the size computed in the spec, from BlockSize to MaxBytes. The
+// defaults are used when ndv or fpp are 0 or out of range.
+func OptimalNumBytes(ndv int64, fpp float64) int {
+	if ndv <= 0 {
+		ndv = DefaultNDV
+	}
+	if fpp <= 0 || fpp >= 1 {
+		fpp = DefaultFPP
+	}
+	bits := -8 * float64(ndv) / math.Log(1-math.Pow(fpp, 1.0/8))
+	n := BlockSize
+	for n < MaxBytes && float64(n)*8 < bits {
+		n *= 2
+	}
+	return n
+}
+
+// NumBytes returns the size in bytes of the bitset of f.
+func (f *Filter) NumBytes() int {
+	return len(f.blocks) * BlockSize
+}
+
+// block returns the block of f for hash.
+func (f *Filter) block(hash uint64) *block {
+	return &f.blocks[((hash>>32)*uint64(len(f.blocks)))>>32]
+}
+
+// InsertHash inserts the value with the given hash in f.
+func (f *Filter) InsertHash(hash uint64) {
+	b, m := f.block(hash), mask(uint32(hash))
+	for i := range b {
+		b[i] |= m[i]
+	}
+}
+
+// CheckHash returns whether the value with the given hash may have been
+// inserted in f.
+func (f *Filter) CheckHash(hash uint64) bool {
+	b, m := f.block(hash), mask(uint32(hash))
+	for i := range b {
+		if b[i]&m[i] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Insert inserts value in f. See Hash for the types of value.
+func (f *Filter) Insert(value interface{}) {
+	f.InsertHash(Hash(value))
+}
+
+// Check returns whether value may have been inserted in f, false if it is
+// definitely not in the column chunk of the filter. See Hash for the types
+// of value.
+func (f *Filter) Check(value interface{}) bool {
+	return f.CheckHash(Hash(value))
+}
+
+// Hash returns the hash of value, the xxHash of its PLAIN encoding. value is
+// an int32, an int64, a float32, a float64, a string or a []byte, the latter
+// two for the BYTE_ARRAY and FIXED_LEN_BYTE_ARRAY columns. Hash panics for
+// the other types: the BOOLEAN columns have no Bloom filter.
+func Hash(value interface{}) uint64 {
+	var b [8]byte
+	switch v := value.(type) {
+	case int32:
+		binary.LittleEndian.PutUint32(b[:], uint32(v))
+		return xxhash.Sum64(b[:4])
+	case int64:
+		binary.LittleEndian.PutUint64(b[:], uint64(v))
+		return xxhash.Sum64(b[:])
+	case float32:
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(v))
+		return xxhash.Sum64(b[:4])
+	case float64:
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+		return xxhash.Sum64(b[:])
+	case string:
+		return xxhash.Sum64String(v)
+	case []byte:
+		return HashBytes(v)
+	default:
+		panic(fmt.Sprintf("bloom: unsupported value type %T", value))
+	}
+}
+
+// HashBytes returns the hash of a PLAIN encoded value, without the length of
+// a byte array.
+func HashBytes(b []byte) uint64 {
+	return xxhash.Sum64(b)
+}
+
+// Read reads a filter from r: its header followed by its bitset.
+func Read(r io.Reader) (*Filter, error) {
+	var header thrift.BloomFilterHeader
+	if _, err := header.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("could not read bloom filter header: %s", err)
+	}
+	switch {
+	case !header.GetAlgorithm().IsSetBLOCK():
+		return nil, fmt.Errorf("unsupported bloom filter algorithm %s", header.GetAlgorithm())
+	case !header.GetHash().IsSetXXHASH():
+		return nil, fmt.Errorf("unsupported bloom filter hash %s", header.GetHash())
+	case !header.GetCompression().IsSetUNCOMPRESSED():
+		return nil, fmt.Errorf("unsupported bloom filter compression %s", header.GetCompression())
+	}
+	n := header.GetNumBytes()
+	if n <= 0 || n%BlockSize != 0 || n > MaxBytes {
+		return nil, fmt.Errorf("invalid bloom filter size %d", n)
+	}
+
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, fmt.Errorf("could not read bloom filter bitset: %s", err)
+	}
+	f := &Filter{blocks: make([]block, int(n)/BlockSize)}
+	for i := range f.blocks {
+		for j := range f.blocks[i] {
+			f.blocks[i][j] = binary.LittleEndian.Uint32(data[i*BlockSize+j*4:])
+		}
+	}
+	return f, nil
+}
+
+// Header returns the header of f, written before its bitset.
+func (f *Filter) Header() *thrift.BloomFilterHeader {
+	header := thrift.NewBloomFilterHeader()
+	header.NumBytes = int32(f.NumBytes())
+	header.Algorithm = &thrift.BloomFilterAlgorithm{BLOCK: thrift.NewSplitBlockAlgorithm()}
+	header.Hash = &thrift.BloomFilterHash{XXHASH: thrift.NewXxHash()}
+	header.Compression = &thrift.BloomFilterCompression{UNCOMPRESSED: thrift.NewUncompressed()}
+	return header
+}
+
+// Bitset returns the bitset of f as it is written, its blocks of 32-bit
+// little-endian words.
+func (f *Filter) Bitset() []byte {
+	data := make([]byte, f.NumBytes())
+	for i := range f.blocks {
+		for j := range f.blocks[i] {
+			binary.LittleEndian.PutUint32(data[i*BlockSize+j*4:], f.blocks[i][j])
+		}
+	}
+	return data
+}
+
+// Write writes f to w, its header followed by its bitset, and returns the
+// number of bytes written.
+func (f *Filter) Write(w io.Writer) (int, error) {
+	n, err := f.Header().Write(w)
+	if err != nil {
+		return n, fmt.Errorf("could not write bloom filter header: %s", err)
+	}
+
+	dn, err := w.Write(f.Bitset())
+	n += dn
+	if err != nil {
+		return n, fmt.Errorf("could not write bloom filter bitset: %s", err)
+	}
+	return n, nil
+}
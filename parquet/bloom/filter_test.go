@@ -0,0 +1,151 @@
+package bloom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestFilterCheck(t *testing.T) {
+	values := []interface{}{
+		int32(-1), int32(0), int32(42),
+		int64(-1), int64(1) << 40,
+		float32(1.5), float64(-2.25),
+		"hello", []byte("world"), []byte{},
+	}
+	f := New(1024)
+	for _, v := range values {
+		f.Insert(v)
+	}
+	for _, v := range values {
+		if !f.Check(v) {
+			t.Errorf("Check(%#v) = false after Insert", v)
+		}
+	}
+
+	// the string and the byte array of the same bytes are the same value
+	if !f.Check([]byte("hello")) || !f.Check("world") {
+		t.Errorf("byte arrays and strings are hashed differently")
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if f.Check(fmt.Sprintf("missing-%d", i)) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 100 {
+		t.Errorf("%d false positives for 10000 values", falsePositives)
+	}
+}
+
+func TestFilterBitset(t *testing.T) {
+	f := New(40)
+	if got := f.NumBytes(); got != 2*BlockSize {
+		t.Fatalf("NumBytes() = %d, want %d", got, 2*BlockSize)
+	}
+
+	// the upper half of the hash selects the second block, the key 1 sets
+	// the bit salt[i] >> 27 of each word
+	f.InsertHash(0x80000000<<32 | 1)
+	var b bytes.Buffer
+	n, err := f.Write(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != b.Len() {
+		t.Errorf("Write returned %d for %d bytes", n, b.Len())
+	}
+	bitset := b.Bytes()[b.Len()-f.NumBytes():]
+	for i := 0; i < 8; i++ {
+		if w := binary.LittleEndian.Uint32(bitset[i*4:]); w != 0 {
+			t.Errorf("word %d of the first block is %#x", i, w)
+		}
+		want := uint32(1) << (salt[i] >> 27)
+		if w := binary.LittleEndian.Uint32(bitset[BlockSize+i*4:]); w != want {
+			t.Errorf("word %d of the second block is %#x, want %#x", i, w, want)
+		}
+	}
+}
+
+func TestFilterReadWrite(t *testing.T) {
+	f := New(256)
+	for i := int64(0); i < 100; i++ {
+		f.Insert(i)
+	}
+	var b bytes.Buffer
+	if _, err := f.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	// the bitset is followed by other data
+	b.WriteString("PAR1")
+
+	read, err := Read(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.NumBytes() != f.NumBytes() {
+		t.Errorf("read %d bytes, want %d", read.NumBytes(), f.NumBytes())
+	}
+	for i := int64(0); i < 100; i++ {
+		if !read.Check(i) {
+			t.Errorf("Check(%d) = false", i)
+		}
+	}
+	if b.String() != "PAR1" {
+		t.Errorf("%q left after the filter", b.String())
+	}
+}
+
+func TestReadInvalidFilter(t *testing.T) {
+	var b bytes.Buffer
+	if _, err := New(64).Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	data := b.Bytes()
+
+	if _, err := Read(bytes.NewReader(data[:len(data)-1])); err == nil {
+		t.Errorf("no error for a truncated bitset")
+	}
+	if _, err := Read(bytes.NewReader(data[:3])); err == nil {
+		t.Errorf("no error for a truncated header")
+	}
+
+	// a bitset of 33 bytes is not made of blocks
+	header := thrift.NewBloomFilterHeader()
+	header.NumBytes = 33
+	header.Algorithm = &thrift.BloomFilterAlgorithm{BLOCK: thrift.NewSplitBlockAlgorithm()}
+	header.Hash = &thrift.BloomFilterHash{XXHASH: thrift.NewXxHash()}
+	header.Compression = &thrift.BloomFilterCompression{UNCOMPRESSED: thrift.NewUncompressed()}
+	b.Reset()
+	if _, err := header.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	b.Write(make([]byte, 33))
+	if _, err := Read(&b); err == nil {
+		t.Errorf("no error for a bitset of 33 bytes")
+	}
+}
+
+func TestOptimalNumBytes(t *testing.T) {
+	tests := []struct {
+		ndv  int64
+		fpp  float64
+		want int
+	}{
+		{0, 0, 1024 * 1024},
+		{1, 0.01, BlockSize},
+		{1000, 0.01, 2048},
+		{1000000, 0.01, 2 * 1024 * 1024},
+		{1000000, 0.1, 1024 * 1024},
+		{1 << 40, 0.01, MaxBytes},
+	}
+	for _, test := range tests {
+		if got := OptimalNumBytes(test.ndv, test.fpp); got != test.want {
+			t.Errorf("OptimalNumBytes(%d, %v) = %d, want %d", test.ndv, test.fpp, got, test.want)
+		}
+	}
+}
@@ -76,6 +76,15 @@ func (s *Scanner) Scan() bool {
 	r := io.LimitReader(s.rs, meta.TotalCompressedSize)
 
 	pageScanner := page.NewScanner(s.schema, meta.GetCodec(), r)
+	if dict, err := page.ZstdDictionary(meta); err != nil {
+		s.setErr(err)
+		return false
+	} else if dict != nil {
+		if err := pageScanner.UseZstdDictionary(dict); err != nil {
+			s.setErr(err)
+			return false
+		}
+	}
 
 	currentChunk := new(Chunk)
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
 	"github.com/kostya-sh/parquet-go/parquet/datatypes"
 	"github.com/kostya-sh/parquet-go/parquet/page"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
@@ -18,10 +19,32 @@ type Encoder struct {
 	pageEncoder  page.PageEncoder
 	currentChunk *Chunk
 	buffer       []byte
+	bloomFilter  *bloom.Filter
 }
 
 type Preferences struct {
 	MemorySize int
+	// DataPageVersion is the format of the data pages: 1, the default, or 2.
+	DataPageVersion int
+	// Compression is the name of the compression codec of the pages, see
+	// page.CodecByName, and CompressionLevel its level, 0 for the default.
+	Compression      string
+	CompressionLevel int
+	// ZstdDictionarySize is the size of the zstd dictionary trained on the
+	// pages of a chunk, see page.EncodingPreferences.
+	ZstdDictionarySize int
+	// StatisticsTruncateLength is the maximum length of the min and max
+	// statistics of byte arrays, 0 for no limit.
+	StatisticsTruncateLength int
+	// BloomFilter builds a Bloom filter of the values of the chunk, sized
+	// for BloomFilterNDV distinct values and a false positive probability
+	// of BloomFilterFPP, see bloom.OptimalNumBytes. It is ignored for
+	// BOOLEAN columns.
+	BloomFilter    bool
+	BloomFilterNDV int64
+	BloomFilterFPP float64
+	// KeyValueMetadata is the key/value metadata of the chunk.
+	KeyValueMetadata map[string]string
 }
 
 func DefaultPreferences() *Preferences {
@@ -33,7 +56,29 @@ func DefaultPreferences() *Preferences {
 // NewEncoder
 func NewEncoder(schema *thrift.SchemaElement, p *Preferences) *Encoder {
 	enc := &Encoder{Schema: schema, Metadata: thrift.NewColumnMetaData()}
-	preferences := page.EncodingPreferences{CompressionCodec: "", Strategy: "default"}
+	codec, err := page.CodecByName(p.Compression)
+	if err != nil {
+		panic(err)
+	}
+	enc.Metadata.Codec = codec
+	enc.Metadata.KeyValueMetadata = thrift.KeyValues(p.KeyValueMetadata)
+
+	preferences := page.EncodingPreferences{
+		CompressionCodec: p.Compression,
+		CompressionLevel: p.CompressionLevel,
+		Strategy:         "default",
+		Type:             schema.Type,
+		DataPageVersion:  p.DataPageVersion,
+
+		ZstdDictionarySize: p.ZstdDictionarySize,
+		SortOrder:          page.SortOrderOf(schema),
+
+		StatisticsTruncateLength: p.StatisticsTruncateLength,
+	}
+	if p.BloomFilter && schema.GetType() != thrift.Type_BOOLEAN {
+		enc.bloomFilter = bloom.New(bloom.OptimalNumBytes(p.BloomFilterNDV, p.BloomFilterFPP))
+		preferences.BloomFilter = enc.bloomFilter
+	}
 	enc.pageEncoder = page.NewPageEncoder(preferences)
 
 	enc.buffer = make([]byte, 0, p.MemorySize)
@@ -43,6 +88,12 @@ func NewEncoder(schema *thrift.SchemaElement, p *Preferences) *Encoder {
 	return enc
 }
 
+// BloomFilter returns the Bloom filter of the values written, nil if the
+// encoder builds none.
+func (e *Encoder) BloomFilter() *bloom.Filter {
+	return e.bloomFilter
+}
+
 func (e *Encoder) CompressedSize() int64 {
 	return 0
 }
@@ -55,6 +106,22 @@ func (e *Encoder) WriteBuffer(b *datatypes.Buffer) error {
 
 // WriteBuffer writes the contents of b in the current ColumnChunk
 func (e *Encoder) WriteChunk(w io.Writer) (*Chunk, error) {
+	pages, err := e.pageEncoder.Pages()
+	if err != nil {
+		return nil, err
+	}
+	encodingStats, err := page.EncodingStats(pages)
+	if err != nil {
+		return nil, err
+	}
+	e.Metadata.EncodingStats = encodingStats
+	e.Metadata.Statistics = e.pageEncoder.Statistics()
+	e.Metadata.SizeStatistics = e.pageEncoder.SizeStatistics()
+	if e.Metadata.SizeStatistics != nil && e.Schema.GetType() != thrift.Type_BYTE_ARRAY {
+		// the FIXED_LEN_BYTE_ARRAY values are written as byte arrays but
+		// their size is known from the schema
+		e.Metadata.SizeStatistics.UnencodedByteArrayDataBytes = nil
+	}
 	return nil, nil
 }
 
@@ -0,0 +1,273 @@
+package parquet
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// SchemaChangeKind is the kind of a difference between two schemas, see
+// CompatibleWith.
+type SchemaChangeKind int
+
+const (
+	// FieldAdded is a field of the new schema that is not in the old one.
+	// The data of the old schema is read with the nulls of the field if it
+	// is optional, it cannot be read if it is required.
+	FieldAdded SchemaChangeKind = iota
+	// FieldRemoved is a field of the old schema that is not in the new one,
+	// not read.
+	FieldRemoved
+	// FieldRenamed is a field with the field_id of a field of the old schema
+	// and another name, read by field ID, see ReaderOptions.FieldIDSchema.
+	FieldRenamed
+	// TypeWidened is a column whose values are the values of the old type,
+	// and more: INT32 to INT64, the integers of fewer bits to the integers of
+	// more bits, FLOAT to DOUBLE and the DECIMAL of a greater precision and
+	// the same scale. The values are promoted to the new type when they are
+	// read with ReaderOptions.FieldIDSchema only.
+	TypeWidened
+	// TypeChanged is any other change of the type of a field, whether a group
+	// or a column, its physical type or its logical type. The data of the old
+	// schema cannot be read.
+	TypeChanged
+	// RepetitionChanged is a field whose repetition changed: the required
+	// fields made optional are read, the optional fields made required and
+	// the changes from or to repeated are not.
+	RepetitionChanged
+)
+
+// String returns the name of k, e.g. "field added".
+func (k SchemaChangeKind) String() string {
+	switch k {
+	case FieldAdded:
+		return "field added"
+	case FieldRemoved:
+		return "field removed"
+	case FieldRenamed:
+		return "field renamed"
+	case TypeWidened:
+		return "type widened"
+	case TypeChanged:
+		return "type changed"
+	case RepetitionChanged:
+		return "repetition changed"
+	}
+	return fmt.Sprintf("SchemaChangeKind(%d)", int(k))
+}
+
+// SchemaChange is a difference between an old and a new schema.
+type SchemaChange struct {
+	Kind SchemaChangeKind
+	// Path is the path of the field in the new schema, in the old schema for
+	// the fields removed, e.g. "address.zip".
+	Path string
+	// Old and New describe the field in the old and the new schema, e.g.
+	// "int32" and "int64" for a type widened, "" for a field added or
+	// removed.
+	Old, New string
+	// Compatible is whether the data written with the old schema is read
+	// with the new schema: the rows of the files of the old schema read with
+	// the new schema as ReaderOptions.FieldIDSchema, its fields all having a
+	// field_id, have the fields and the values of the new schema. The rows
+	// read by name have the fields and the values of the file, e.g. int32
+	// for a column widened to INT64: the callers convert them.
+	Compatible bool
+}
+
+// String returns the description of c, e.g. "address.zip: type widened
+// (int32 to int64)".
+func (c SchemaChange) String() string {
+	s := fmt.Sprintf("%s: %s", c.Path, c.Kind)
+	if c.Old != "" || c.New != "" {
+		s += fmt.Sprintf(" (%s to %s)", c.Old, c.New)
+	}
+	if !c.Compatible {
+		s += ", incompatible"
+	}
+	return s
+}
+
+// CompatibleWith returns the differences between the schemas old and new,
+// and whether the data written with old can be read with new: whether all
+// the changes are compatible. The fields are matched by field_id when both
+// have one, by name otherwise, group by group. The changes are in the order
+// of the fields of new, the fields removed after the fields of their group.
+func CompatibleWith(old, new *Schema) (changes []SchemaChange, compatible bool) {
+	changes = compareFields("", schemaNodes(old), schemaNodes(new))
+	compatible = true
+	for _, c := range changes {
+		compatible = compatible && c.Compatible
+	}
+	return changes, compatible
+}
+
+// schemaNodes returns the fields of the top level of s, the columns of a
+// schema without groups.
+func schemaNodes(s *Schema) []schemaElement {
+	if s.root.schemaElement != nil {
+		return s.root.children
+	}
+	nodes := make([]schemaElement, len(s.columnsSequence))
+	for i, name := range s.columnsSequence {
+		nodes[i] = &primitive{schemaElement: s.columns[name].SchemaElement}
+	}
+	return nodes
+}
+
+// compareFields returns the changes from the fields old to the fields new
+// of the group of path prefix, followed by ".".
+func compareFields(prefix string, old, new []schemaElement) []SchemaChange {
+	var changes []SchemaChange
+	matched := make(map[schemaElement]bool)
+	for _, n := range new {
+		ne := elementOf(n)
+		path := prefix + ne.Name
+		o := matchField(ne, old)
+		if o == nil {
+			optional := ne.GetRepetitionType() != thrift.FieldRepetitionType_REQUIRED
+			changes = append(changes, SchemaChange{Kind: FieldAdded, Path: path, Compatible: optional})
+			continue
+		}
+		matched[o] = true
+		oe := elementOf(o)
+		if oe.Name != ne.Name {
+			changes = append(changes, SchemaChange{Kind: FieldRenamed, Path: path, Old: oe.Name, New: ne.Name, Compatible: true})
+		}
+		if oe.GetRepetitionType() != ne.GetRepetitionType() {
+			compatible := oe.GetRepetitionType() == thrift.FieldRepetitionType_REQUIRED &&
+				ne.GetRepetitionType() == thrift.FieldRepetitionType_OPTIONAL
+			changes = append(changes, SchemaChange{
+				Kind:       RepetitionChanged,
+				Path:       path,
+				Old:        strings.ToLower(oe.GetRepetitionType().String()),
+				New:        strings.ToLower(ne.GetRepetitionType().String()),
+				Compatible: compatible,
+			})
+		}
+
+		og, oldGroup := o.(*group)
+		ng, newGroup := n.(*group)
+		switch {
+		case oldGroup != newGroup:
+			changes = append(changes, SchemaChange{Kind: TypeChanged, Path: path, Old: fieldTypeName(o), New: fieldTypeName(n)})
+		case newGroup:
+			if !sameAnnotation(oe, ne) {
+				changes = append(changes, SchemaChange{Kind: TypeChanged, Path: path, Old: fieldTypeName(o), New: fieldTypeName(n)})
+			}
+			changes = append(changes, compareFields(path+".", og.children, ng.children)...)
+		case !sameColumnType(oe, ne):
+			kind := TypeChanged
+			if widens(oe, ne) {
+				kind = TypeWidened
+			}
+			changes = append(changes, SchemaChange{Kind: kind, Path: path, Old: fieldTypeName(o), New: fieldTypeName(n), Compatible: kind == TypeWidened})
+		}
+	}
+	for _, o := range old {
+		if !matched[o] {
+			changes = append(changes, SchemaChange{Kind: FieldRemoved, Path: prefix + elementOf(o).Name, Compatible: true})
+		}
+	}
+	return changes
+}
+
+// matchField returns the field of old with the field_id of e if both have
+// one, with the name of e otherwise, nil if there is none.
+func matchField(e *thrift.SchemaElement, old []schemaElement) schemaElement {
+	for _, o := range old {
+		oe := elementOf(o)
+		if e.FieldID != nil && oe.FieldID != nil {
+			if *e.FieldID == *oe.FieldID {
+				return o
+			}
+			continue
+		}
+		if oe.Name == e.Name {
+			return o
+		}
+	}
+	return nil
+}
+
+// fieldTypeName returns the type of the field node as in the schemas, e.g.
+// "int32", "binary (STRING)" or "group (LIST)".
+func fieldTypeName(node schemaElement) string {
+	e := elementOf(node)
+	name := "group"
+	if _, ok := node.(*primitive); ok {
+		name = strings.ToLower(e.GetType().String())
+		switch e.GetType() {
+		case thrift.Type_BYTE_ARRAY:
+			name = "binary"
+		case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+			name = fmt.Sprintf("%s(%d)", name, e.GetTypeLength())
+		}
+	}
+	if e.ConvertedType != nil || e.LogicalType != nil {
+		name += fmt.Sprintf(" (%s)", logicalTypeAnnotation(e))
+	}
+	return name
+}
+
+// sameAnnotation returns whether the elements a and b have the same
+// annotation.
+func sameAnnotation(a, b *thrift.SchemaElement) bool {
+	if a.LogicalType != nil && b.LogicalType != nil {
+		return reflect.DeepEqual(a.LogicalType, b.LogicalType)
+	}
+	return logicalTypeAnnotation(a) == logicalTypeAnnotation(b)
+}
+
+// sameColumnType returns whether the columns a and b have the same type.
+func sameColumnType(a, b *thrift.SchemaElement) bool {
+	return a.GetType() == b.GetType() && a.GetTypeLength() == b.GetTypeLength() &&
+		a.GetScale() == b.GetScale() && a.GetPrecision() == b.GetPrecision() && sameAnnotation(a, b)
+}
+
+// widens returns whether the values of the column old are values of the
+// column new, of another type, see TypeWidened.
+func widens(old, new *thrift.SchemaElement) bool {
+	switch {
+	case isDecimal(old) && isDecimal(new):
+		return old.GetScale() == new.GetScale() && old.GetPrecision() <= new.GetPrecision()
+	case old.GetType() == thrift.Type_FLOAT && new.GetType() == thrift.Type_DOUBLE:
+		return old.ConvertedType == nil && old.LogicalType == nil && new.ConvertedType == nil && new.LogicalType == nil
+	}
+	o, n := signedWidth(old), signedWidth(new)
+	return o > 0 && n >= o
+}
+
+// signedWidth returns the bit width of the signed integer column element,
+// 0 if it is not one.
+func signedWidth(element *thrift.SchemaElement) int {
+	width := 0
+	switch element.GetType() {
+	case thrift.Type_INT32:
+		width = 32
+	case thrift.Type_INT64:
+		width = 64
+	default:
+		return 0
+	}
+	if lt := element.GetLogicalType(); lt != nil {
+		if !lt.IsSetINTEGER() || !lt.INTEGER.IsSigned {
+			return 0
+		}
+		return int(lt.INTEGER.BitWidth)
+	}
+	if element.ConvertedType == nil {
+		return width
+	}
+	switch element.GetConvertedType() {
+	case thrift.ConvertedType_INT_8:
+		return 8
+	case thrift.ConvertedType_INT_16:
+		return 16
+	case thrift.ConvertedType_INT_32, thrift.ConvertedType_INT_64:
+		return width
+	}
+	return 0
+}
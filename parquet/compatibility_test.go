@@ -0,0 +1,95 @@
+package parquet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompatibleWith(t *testing.T) {
+	old, err := ParseSchema(`message root {
+  required int32 id;
+  required int32 count (INT_16);
+  required float ratio;
+  optional binary name (STRING) = 1;
+  required group address {
+    required binary zip (STRING);
+    optional binary street (STRING);
+  }
+  optional fixed_len_byte_array(8) price (DECIMAL(10,2));
+  optional binary dropped;
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		schema     string
+		changes    []string
+		compatible bool
+	}{
+		{
+			schema: `message root {
+  required int64 id;
+  required int32 count;
+  required double ratio;
+  optional binary full_name (STRING) = 1;
+  optional group address {
+    required binary zip (STRING);
+    optional binary street (STRING);
+    optional binary city (STRING);
+  }
+  optional fixed_len_byte_array(8) price (DECIMAL(12,2));
+}`,
+			changes: []string{
+				"id: type widened (int32 to int64)",
+				"count: type widened (int32 (INTEGER(16,true)) to int32)",
+				"ratio: type widened (float to double)",
+				"full_name: field renamed (name to full_name)",
+				"address: repetition changed (required to optional)",
+				"address.city: field added",
+				"price: type widened (fixed_len_byte_array(8) (DECIMAL(10,2)) to fixed_len_byte_array(8) (DECIMAL(12,2)))",
+				"dropped: field removed",
+			},
+			compatible: true,
+		},
+		{
+			schema: `message root {
+  required int32 id (INT_8);
+  required int32 count (UINT_16);
+  required int32 ratio;
+  required binary name (STRING) = 1;
+  required binary address (STRING);
+  optional fixed_len_byte_array(8) price (DECIMAL(10,3));
+  repeated binary dropped;
+  required binary added;
+}`,
+			changes: []string{
+				"id: type changed (int32 to int32 (INTEGER(8,true))), incompatible",
+				"count: type changed (int32 (INTEGER(16,true)) to int32 (INTEGER(16,false))), incompatible",
+				"ratio: type changed (float to int32), incompatible",
+				"name: repetition changed (optional to required), incompatible",
+				"address: type changed (group to binary (STRING)), incompatible",
+				"price: type changed (fixed_len_byte_array(8) (DECIMAL(10,2)) to fixed_len_byte_array(8) (DECIMAL(10,3))), incompatible",
+				"dropped: repetition changed (optional to repeated), incompatible",
+				"added: field added, incompatible",
+			},
+		},
+	} {
+		s, err := ParseSchema(test.schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		changes, compatible := CompatibleWith(old, s)
+		got := make([]string, len(changes))
+		for i, c := range changes {
+			got[i] = c.String()
+		}
+		if !reflect.DeepEqual(got, test.changes) || compatible != test.compatible {
+			t.Errorf("%s: got %q, %t, want %q, %t", test.schema, got, compatible, test.changes, test.compatible)
+		}
+	}
+
+	if changes, compatible := CompatibleWith(old, old); len(changes) != 0 || !compatible {
+		t.Errorf("changes of the same schema: %v, %t", changes, compatible)
+	}
+}
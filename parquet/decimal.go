@@ -0,0 +1,104 @@
+package parquet
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The values of the DECIMAL columns are read as *big.Rat: the unscaled
+// integer stored in the column, an INT32, an INT64 or the big-endian two's
+// complement bytes of a BYTE_ARRAY or of a FIXED_LEN_BYTE_ARRAY, divided by
+// 10^scale, the scale of the column. The Writer writes them back.
+
+// isDecimal returns whether element is a DECIMAL column.
+func isDecimal(element *thrift.SchemaElement) bool {
+	return element.GetConvertedType() == thrift.ConvertedType_DECIMAL
+}
+
+// checkDecimal returns an error if the precision and the scale of the
+// DECIMAL column element are not valid for its type.
+func checkDecimal(element *thrift.SchemaElement) error {
+	precision, scale := element.GetPrecision(), element.GetScale()
+	if precision <= 0 || scale < 0 || scale > precision {
+		return fmt.Errorf("invalid DECIMAL(%d,%d)", precision, scale)
+	}
+	var max int32
+	switch t := element.GetType(); t {
+	case thrift.Type_INT32:
+		max = 9
+	case thrift.Type_INT64:
+		max = 18
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		// the largest integer of TypeLength bytes has one more digit
+		n := new(big.Int).Lsh(big.NewInt(1), uint(8*element.GetTypeLength()-1))
+		max = int32(len(n.Sub(n, big.NewInt(1)).String())) - 1
+	case thrift.Type_BYTE_ARRAY:
+		return nil
+	default:
+		return fmt.Errorf("DECIMAL of type %s", t)
+	}
+	if precision > max {
+		return fmt.Errorf("DECIMAL(%d,%d) of type %s has at most %d digits", precision, scale, element.GetType(), max)
+	}
+	return nil
+}
+
+// decimalValue returns the DECIMAL value of the value v read from the
+// column element, v itself if it is not an unscaled value.
+func decimalValue(element *thrift.SchemaElement, v interface{}) interface{} {
+	n := new(big.Int)
+	switch v := v.(type) {
+	case int32:
+		n.SetInt64(int64(v))
+	case int64:
+		n.SetInt64(v)
+	case []byte:
+		n.SetBytes(v)
+		if len(v) > 0 && v[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(8*len(v))))
+		}
+	default:
+		return v
+	}
+	return new(big.Rat).SetFrac(n, pow10(element.GetScale()))
+}
+
+// unscaledValue returns the value of the column element storing the DECIMAL
+// r, an error if r has more digits than the precision or the scale of the
+// column.
+func unscaledValue(element *thrift.SchemaElement, r *big.Rat) (interface{}, error) {
+	n, m := new(big.Int).QuoRem(new(big.Int).Mul(r.Num(), pow10(element.GetScale())), r.Denom(), new(big.Int))
+	if m.Sign() != 0 {
+		return nil, fmt.Errorf("%s has more than %d decimals", r.RatString(), element.GetScale())
+	}
+	if new(big.Int).Abs(n).Cmp(pow10(element.GetPrecision())) >= 0 {
+		return nil, fmt.Errorf("%s has more than %d digits", r.FloatString(int(element.GetScale())), element.GetPrecision())
+	}
+
+	switch element.GetType() {
+	case thrift.Type_INT32:
+		return int32(n.Int64()), nil
+	case thrift.Type_INT64:
+		return n.Int64(), nil
+	}
+	size := int(element.GetTypeLength())
+	if element.GetType() == thrift.Type_BYTE_ARRAY {
+		// the shortest two's complement, with a sign bit
+		bits := n.BitLen()
+		if n.Sign() < 0 {
+			bits = new(big.Int).Not(n).BitLen()
+		}
+		size = bits/8 + 1
+	}
+	if n.Sign() < 0 {
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), uint(8*size)))
+	}
+	return n.FillBytes(make([]byte, size)), nil
+}
+
+// pow10 returns 10^n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,144 @@
+package parquet
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+)
+
+func decimalTestSchema(t *testing.T) *Schema {
+	s, err := ParseSchema(`message decimals {
+  required int32 d32 (DECIMAL(9,2));
+  required int64 d64 (DECIMAL(18,4));
+  optional fixed_len_byte_array(9) fixed (DECIMAL(20,3));
+  required binary bytes (DECIMAL(40,10));
+  required fixed_len_byte_array(4) uuid;
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func decimalTestRat(t *testing.T, s string) *big.Rat {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		t.Fatalf("invalid rational %s", s)
+	}
+	return r
+}
+
+func TestDecimalRoundTrip(t *testing.T) {
+	values := []string{"0", "1.5", "-1.5", "1234567.89", "-9999999.99", "0.01", "-0.01", "127", "-128"}
+	for _, disableDictionary := range []bool{false, true} {
+		var b bytes.Buffer
+		w, err := NewWriter(&b, decimalTestSchema(t), WriterOptions{ColumnOptions: ColumnOptions{DisableDictionary: disableDictionary}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, s := range values {
+			row := map[string]interface{}{
+				"d32":   decimalTestRat(t, s),
+				"d64":   decimalTestRat(t, s),
+				"bytes": decimalTestRat(t, s),
+				"uuid":  []byte{byte(i), 1, 2, 3},
+			}
+			if i%2 == 0 {
+				row["fixed"] = decimalTestRat(t, s)
+			}
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// the unscaled values are written as they are
+		if err := w.WriteRow(map[string]interface{}{"d32": int32(-150), "d64": int64(-15000), "bytes": []byte{0xff}, "uuid": "abcd"}); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows := readTestRows(t, f)
+		if len(rows) != len(values)+1 {
+			t.Fatalf("got %d rows", len(rows))
+		}
+		for i, s := range values {
+			want := decimalTestRat(t, s)
+			for _, name := range []string{"d32", "d64", "fixed", "bytes"} {
+				got, ok := rows[i][name].(*big.Rat)
+				if name == "fixed" && i%2 == 1 {
+					if rows[i][name] != nil {
+						t.Errorf("row %d: got %v for a null %s", i, rows[i][name], name)
+					}
+					continue
+				}
+				if !ok || got.Cmp(want) != 0 {
+					t.Errorf("dictionary %t, row %d: got %v for %s, want %s", !disableDictionary, i, rows[i][name], name, s)
+				}
+			}
+			if uuid, ok := rows[i]["uuid"].([]byte); !ok || !bytes.Equal(uuid, []byte{byte(i), 1, 2, 3}) {
+				t.Errorf("row %d: got uuid %v", i, rows[i]["uuid"])
+			}
+		}
+		last := rows[len(values)]
+		for name, want := range map[string]string{"d32": "-1.5", "d64": "-1.5", "bytes": "-0.0000000001"} {
+			if got, ok := last[name].(*big.Rat); !ok || got.Cmp(decimalTestRat(t, want)) != 0 {
+				t.Errorf("got %v for the unscaled %s, want %s", last[name], name, want)
+			}
+		}
+	}
+}
+
+func TestDecimalErrors(t *testing.T) {
+	var b bytes.Buffer
+	w, err := NewWriter(&b, decimalTestSchema(t), WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{"d32": big.NewRat(1, 2), "d64": big.NewRat(1, 2), "bytes": big.NewRat(1, 2), "uuid": "abcd"}
+	}
+	for _, invalid := range []map[string]interface{}{
+		{"d32": decimalTestRat(t, "0.001")},
+		{"d32": decimalTestRat(t, "10000000")},
+		{"d64": big.NewRat(1, 3)},
+		{"fixed": decimalTestRat(t, "100000000000000000")},
+		{"bytes": decimalTestRat(t, "1e30")},
+		{"d32": 1.5},
+		{"uuid": "abc"},
+		{"uuid": big.NewRat(1, 1)},
+	} {
+		row := valid()
+		for k, v := range invalid {
+			row[k] = v
+		}
+		if err := w.WriteRow(row); err == nil {
+			t.Errorf("no error for %v", invalid)
+		} else {
+			t.Log(err)
+		}
+	}
+	if err := w.WriteRow(valid()); err != nil {
+		t.Errorf("got %s for a valid row", err)
+	}
+
+	for _, text := range []string{
+		"message m { required int32 d (DECIMAL(10,2)); }",
+		"message m { required int64 d (DECIMAL(19,2)); }",
+		"message m { required fixed_len_byte_array(4) d (DECIMAL(10,2)); }",
+		"message m { required int32 d (DECIMAL(4,5)); }",
+		"message m { required double d (DECIMAL(4,2)); }",
+	} {
+		s, err := ParseSchema(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewWriter(&b, s, WriterOptions{}); err == nil {
+			t.Errorf("no error for %s", text)
+		}
+	}
+}
@@ -10,7 +10,7 @@ type cell struct {
 
 func checkColumnValues(t *testing.T, path string, columnIdx int, expected []cell) {
 
-	fd, err := OpenFile(path)
+	fd, err := Open(path)
 	if err != nil {
 		t.Errorf("failed to read %s: %s", path, err)
 		return
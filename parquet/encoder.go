@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
@@ -100,11 +101,6 @@ type Encoder interface {
 	// WriteBool(name string, values []bool) error
 }
 
-// RowGroup
-type RowGroup struct {
-	thrift.RowGroup
-}
-
 type defaultEncoder struct {
 	io.WriteCloser
 	schema          *Schema
@@ -113,19 +109,44 @@ type defaultEncoder struct {
 	rowGroupEncoder *rowGroupEncoder
 	headerWritten   bool
 	recordBuffer    *datatypes.RecordBuffer
+	sorting         *sortingVerifier          // nil unless the sorting is verified
+	encryptor       *encryption.FileEncryptor // nil unless the file is encrypted
 }
 
 // NewEncoder
-// TODO add ability to configure encoder behavior
 func NewEncoder(schema *Schema, w io.WriteCloser) Encoder {
+	return NewEncoderWithOptions(schema, w, WriterOptions{})
+}
+
+// NewEncoderWithOptions is like NewEncoder with options to configure how the
+// columns are written. It panics if the compression of a column is not
+// supported, or a sorting column or an encrypted column is not in the
+// schema.
+func NewEncoderWithOptions(schema *Schema, w io.WriteCloser, options WriterOptions) Encoder {
 	enc := &defaultEncoder{
 		WriteCloser:     w,
 		schema:          schema,
 		version:         "parquet-go", // FIXME
 		filemetadata:    thrift.NewFileMetaData(),
-		rowGroupEncoder: newRowGroupEncoder(schema),
+		rowGroupEncoder: newRowGroupEncoder(schema, options),
 		recordBuffer:    datatypes.NewRecordbuffer(schema.Elements()),
 	}
+	enc.filemetadata.KeyValueMetadata = thrift.KeyValues(options.KeyValueMetadata)
+	if options.VerifySorting && len(options.SortingColumns) > 0 {
+		enc.sorting = newSortingVerifier(schema, options.SortingColumns)
+	}
+	if options.Encryption != nil {
+		for _, path := range options.Encryption.EncryptedColumns() {
+			if schema.ColumnByName(path) == nil {
+				panic(fmt.Sprintf("encrypted column %s is not in the schema", path))
+			}
+		}
+		encryptor, err := encryption.NewFileEncryptor(options.Encryption)
+		if err != nil {
+			panic(err)
+		}
+		enc.encryptor = encryptor
+	}
 
 	return enc
 }
@@ -141,6 +162,11 @@ func (e *defaultEncoder) WriteRecords(records []map[string]interface{}) error {
 	}
 
 	for _, r := range records {
+		if e.sorting != nil {
+			if err := e.sorting.verify(r); err != nil {
+				return err
+			}
+		}
 		e.recordBuffer.Append(r)
 	}
 
@@ -166,7 +192,12 @@ func (e *defaultEncoder) Close() error {
 	}
 
 	// Write Metadata
-	err := writeFileMetadata(e, e.filemetadata)
+	var err error
+	if e.encryptor != nil {
+		err = writeEncryptedFileMetadata(e, e.filemetadata, e.encryptor)
+	} else {
+		err = writeFileMetadata(e, e.filemetadata)
+	}
 	if err != nil {
 		return err
 	}
@@ -175,7 +206,11 @@ func (e *defaultEncoder) Close() error {
 
 func (e *defaultEncoder) writeHeader() error {
 	if !e.headerWritten {
-		if err := writeHeader(e); err != nil {
+		magic := parquetMagic
+		if e.encryptor != nil && e.encryptor.FooterEncrypted() {
+			magic = encryptedMagic
+		}
+		if err := writeMagic(e, magic); err != nil {
 			return fmt.Errorf("could not write header: %s", err)
 		}
 		e.headerWritten = true
@@ -3,7 +3,13 @@ package parquet
 import (
 	"bytes"
 	"math/rand"
+	"reflect"
 	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/column"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
 var schema = `
@@ -96,3 +102,255 @@ func TestCodec(t *testing.T) {
 	}
 
 }
+
+func TestWriterOptionsColumn(t *testing.T) {
+	options := WriterOptions{
+		ColumnOptions: ColumnOptions{DataPageVersion: 2},
+		Columns: map[string]ColumnOptions{
+			"a": {DataPageVersion: 1},
+			"b": {},
+		},
+	}
+	for name, want := range map[string]int{"a": 1, "b": 2, "c": 2} {
+		if got := options.column(name).DataPageVersion; got != want {
+			t.Errorf("%s: DataPageVersion was %d, expected %d", name, got, want)
+		}
+	}
+}
+
+func TestWriterOptionsCompression(t *testing.T) {
+	options := WriterOptions{
+		ColumnOptions: ColumnOptions{Compression: "zstd", CompressionLevel: 9},
+		Columns: map[string]ColumnOptions{
+			"strings": {Compression: "gzip"},
+			"random":  {Compression: "uncompressed"},
+			"level":   {CompressionLevel: 3},
+		},
+	}
+	for name, want := range map[string]ColumnOptions{
+		"strings": {Compression: "gzip"},
+		"random":  {Compression: "uncompressed"},
+		"level":   {Compression: "zstd", CompressionLevel: 3},
+		"other":   {Compression: "zstd", CompressionLevel: 9},
+	} {
+		if got := options.column(name); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %+v, expected %+v", name, got, want)
+		}
+	}
+
+	for name, want := range map[string]thrift.CompressionCodec{
+		"strings": thrift.CompressionCodec_GZIP,
+		"random":  thrift.CompressionCodec_UNCOMPRESSED,
+		"level":   thrift.CompressionCodec_ZSTD,
+	} {
+		c := options.column(name)
+		enc := column.NewEncoder(thrift.NewSchemaElement(), &column.Preferences{Compression: c.Compression, CompressionLevel: c.CompressionLevel})
+		if got := enc.Metadata.GetCodec(); got != want {
+			t.Errorf("%s: codec was %s, expected %s", name, got, want)
+		}
+	}
+}
+
+func TestWriterOptionsBloomFilter(t *testing.T) {
+	options := WriterOptions{
+		ColumnOptions: ColumnOptions{BloomFilterFPP: 0.01},
+		Columns: map[string]ColumnOptions{
+			"id":   {BloomFilter: true, BloomFilterNDV: 1000},
+			"flag": {BloomFilter: true},
+		},
+	}
+
+	c := options.column("id")
+	if !c.BloomFilter || c.BloomFilterNDV != 1000 || c.BloomFilterFPP != 0.01 {
+		t.Errorf("id: got %+v", c)
+	}
+	if options.column("other").BloomFilter {
+		t.Errorf("other: bloom filter enabled")
+	}
+
+	preferences := &column.Preferences{BloomFilter: c.BloomFilter, BloomFilterNDV: c.BloomFilterNDV, BloomFilterFPP: c.BloomFilterFPP}
+	enc := column.NewEncoder(&thrift.SchemaElement{Type: thrift.TypePtr(thrift.Type_INT64)}, preferences)
+	if enc.BloomFilter() == nil || enc.BloomFilter().NumBytes() != 2048 {
+		t.Errorf("id: got bloom filter %v", enc.BloomFilter())
+	}
+	enc = column.NewEncoder(&thrift.SchemaElement{Type: thrift.TypePtr(thrift.Type_BOOLEAN)}, preferences)
+	if enc.BloomFilter() != nil {
+		t.Errorf("flag: got a bloom filter for a BOOLEAN column")
+	}
+}
+
+func TestWriterSortingColumns(t *testing.T) {
+	schema := NewSchema()
+	for _, spec := range []string{"station: BYTE_ARRAY UTF8 REQUIRED", "time: INT64 REQUIRED"} {
+		if err := schema.AddColumnFromSpec(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	options := WriterOptions{
+		SortingColumns: []SortingColumn{{Column: "station"}, {Column: "time", Descending: true}},
+		VerifySorting:  true,
+	}
+
+	var b bytes.Buffer
+	enc := NewEncoderWithOptions(schema, NopCloser(&b), options)
+	sorted := []map[string]interface{}{
+		{"station": "a", "time": int64(3)},
+		{"station": "a", "time": int64(1)},
+		{"station": "b", "time": int64(5)},
+	}
+	if err := enc.WriteRecords(sorted); err != nil {
+		t.Fatal(err)
+	}
+	if err := enc.WriteRecords([]map[string]interface{}{{"station": "a", "time": int64(9)}}); err == nil {
+		t.Errorf("no error for a station out of order")
+	}
+
+	enc = NewEncoderWithOptions(schema, NopCloser(&b), options)
+	unsorted := []map[string]interface{}{
+		{"station": "a", "time": int64(1)},
+		{"station": "a", "time": int64(2)},
+	}
+	if err := enc.WriteRecords(unsorted); err == nil {
+		t.Errorf("no error for ascending times")
+	}
+
+	want := []*thrift.SortingColumn{{ColumnIdx: 0}, {ColumnIdx: 1, Descending: true}}
+	got := enc.(*defaultEncoder).rowGroupEncoder.currentRowGroup.SortingColumns
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got sorting columns %v, want %v", got, want)
+	}
+
+	// without verification the order is only declared
+	options.VerifySorting = false
+	enc = NewEncoderWithOptions(schema, NopCloser(&b), options)
+	if err := enc.WriteRecords(unsorted); err != nil {
+		t.Errorf("unexpected error without verification: %s", err)
+	}
+}
+
+func TestWriterKeyValueMetadata(t *testing.T) {
+	schema := NewSchema()
+	for _, spec := range []string{"id: INT64 REQUIRED", "name: BYTE_ARRAY UTF8 REQUIRED"} {
+		if err := schema.AddColumnFromSpec(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	options := WriterOptions{
+		KeyValueMetadata: map[string]string{"writer.model": "avro", "created.for": "test"},
+		ColumnOptions:    ColumnOptions{ChunkKeyValueMetadata: map[string]string{"origin": "default"}},
+		Columns: map[string]ColumnOptions{
+			"name": {ChunkKeyValueMetadata: map[string]string{"origin": "name"}},
+		},
+	}
+
+	var b bytes.Buffer
+	enc := NewEncoderWithOptions(schema, NopCloser(&b), options)
+	encoders := enc.(*defaultEncoder).rowGroupEncoder.encoders
+	for name, want := range map[string]string{"id": "default", "name": "name"} {
+		got := thrift.KeyValueMap(encoders[name].Metadata.KeyValueMetadata)
+		if !reflect.DeepEqual(got, map[string]string{"origin": want}) {
+			t.Errorf("%s: got chunk metadata %v", name, got)
+		}
+	}
+
+	if err := enc.Close(); err != nil {
+		t.Fatal(err)
+	}
+	meta, err := ReadMetadata(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kvs := meta.GetKeyValueMetadata(); len(kvs) != 2 || kvs[0].Key != "created.for" || kvs[1].Key != "writer.model" {
+		t.Errorf("key value metadata is not sorted by key: %v", kvs)
+	}
+	f := &File{meta: meta}
+	if got := f.KeyValueMetadata(); !reflect.DeepEqual(got, options.KeyValueMetadata) {
+		t.Errorf("got file metadata %v, want %v", got, options.KeyValueMetadata)
+	}
+}
+
+func TestWriterEncryption(t *testing.T) {
+	schema := NewSchema()
+	if err := schema.AddColumnFromSpec("id: INT64 REQUIRED"); err != nil {
+		t.Fatal(err)
+	}
+	key := []byte("0123456789012345")
+	for _, plaintextFooter := range []bool{false, true} {
+		builder := encryption.NewFileEncryptionPropertiesBuilder(key).WithFooterKeyID("kf")
+		if plaintextFooter {
+			builder.WithPlaintextFooter()
+		}
+		properties, err := builder.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var b bytes.Buffer
+		enc := NewEncoderWithOptions(schema, NopCloser(&b), WriterOptions{Encryption: properties})
+		if err := enc.Close(); err != nil {
+			t.Fatal(err)
+		}
+		data := b.Bytes()
+		magic := encryptedMagic
+		if plaintextFooter {
+			magic = parquetMagic
+		}
+		if !bytes.Equal(data[:magicSize], magic) || !bytes.Equal(data[len(data)-magicSize:], magic) {
+			t.Errorf("plaintext footer %t: file starts with %q and ends with %q", plaintextFooter, data[:magicSize], data[len(data)-magicSize:])
+		}
+
+		options := ReaderOptions{Decryption: &encryption.DecryptionProperties{FooterKey: key}}
+		if _, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err != nil {
+			t.Errorf("plaintext footer %t: %s", plaintextFooter, err)
+		}
+		options.Decryption.FooterKey = []byte("abcdefghijklmnop")
+		if _, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err == nil {
+			t.Errorf("plaintext footer %t: no error with another footer key", plaintextFooter)
+		}
+	}
+
+	column, err := encryption.NewColumnEncryptionPropertiesBuilder("missing").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	properties, err := encryption.NewFileEncryptionPropertiesBuilder(key).WithEncryptedColumns(column).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("no panic for an encrypted column not in the schema")
+		}
+	}()
+	NewEncoderWithOptions(schema, NopCloser(&bytes.Buffer{}), WriterOptions{Encryption: properties})
+}
+
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		a, b       interface{}
+		order      page.SortOrder
+		nullsFirst bool
+		want       int
+	}{
+		{int32(-1), int32(2), page.SortOrderSigned, false, -1},
+		{int32(-1), int32(2), page.SortOrderUnsigned, false, 1},
+		{2, int64(2), page.SortOrderSigned, false, 0},
+		{float32(1.5), 0.5, page.SortOrderSigned, false, 1},
+		{"abc", []byte("abd"), page.SortOrderSigned, false, -1},
+		{nil, "a", page.SortOrderSigned, false, 1},
+		{nil, "a", page.SortOrderSigned, true, -1},
+		{nil, nil, page.SortOrderSigned, false, 0},
+	}
+	for _, test := range tests {
+		got, err := compareValues(test.a, test.b, test.order, test.nullsFirst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("compareValues(%#v, %#v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+	if _, err := compareValues("a", 1, page.SortOrderSigned, false); err == nil {
+		t.Errorf("no error comparing a string and an int")
+	}
+}
@@ -0,0 +1,120 @@
+package bitpacking
+
+import "fmt"
+
+// The unpackers of the 8 values of the bit widths of the benchmarks before
+// they were regenerated as batched uint32 kernels, to measure the speedup.
+// Some of them are wrong, for the bit widths 3, 12 and 20: they are not
+// used by the tests.
+
+func baselineUnpack1(b []byte, out []int32) error {
+
+	if len(b) != 1 {
+		panic(fmt.Sprint("expected: ", 1, " got ", len(b)))
+	}
+	out[0] = int32((b[0] & 0x1))
+	out[1] = int32((b[0] & 0x2) >> 1)
+	out[2] = int32((b[0] & 0x4) >> 2)
+	out[3] = int32((b[0] & 0x8) >> 3)
+	out[4] = int32((b[0] & 0x10) >> 4)
+	out[5] = int32((b[0] & 0x20) >> 5)
+	out[6] = int32((b[0] & 0x40) >> 6)
+	out[7] = int32((b[0] & 0x80) >> 7)
+
+	return nil
+}
+
+func baselineUnpack3(b []byte, out []int32) error {
+
+	if len(b) != 3 {
+		panic(fmt.Sprint("expected: ", 3, " got ", len(b)))
+	}
+	out[0] = int32((b[0] & 0x7))
+	out[1] = int32((b[0] & 0x38) >> 3)
+	out[2] = int32((b[0]&0xc0)>>6 | (b[1] & 0x1))
+	out[3] = int32((b[1] & 0xe) >> 1)
+	out[4] = int32((b[1] & 0x70) >> 4)
+	out[5] = int32((b[1]&0x80)>>7 | (b[2]&0x3)<<1)
+	out[6] = int32((b[2] & 0x1c) >> 2)
+	out[7] = int32((b[2] & 0xe0) >> 5)
+
+	return nil
+}
+
+func baselineUnpack8(b []byte, out []int32) error {
+
+	if len(b) != 8 {
+		panic(fmt.Sprint("expected: ", 8, " got ", len(b)))
+	}
+	out[0] = int32(int32(b[0]))
+	out[1] = int32(int32(b[1]))
+	out[2] = int32(int32(b[2]))
+	out[3] = int32(int32(b[3]))
+	out[4] = int32(int32(b[4]))
+	out[5] = int32(int32(b[5]))
+	out[6] = int32(int32(b[6]))
+	out[7] = int32(int32(b[7]))
+
+	return nil
+}
+
+func baselineUnpack12(b []byte, out []int32) error {
+
+	if len(b) != 12 {
+		panic(fmt.Sprint("expected: ", 12, " got ", len(b)))
+	}
+	out[0] = int32(int32(b[0]) + (int32((b[1] & 0xf)) << 8))
+	out[1] = int32(int32((b[1]&0xf0)>>4) + (int32((b[2])<<4) << 8))
+	out[2] = int32(int32(b[3]) + (int32((b[4] & 0xf)) << 8))
+	out[3] = int32(int32((b[4]&0xf0)>>4) + (int32((b[5])<<4) << 8))
+	out[4] = int32(int32(b[6]) + (int32((b[7] & 0xf)) << 8))
+	out[5] = int32(int32((b[7]&0xf0)>>4) + (int32((b[8])<<4) << 8))
+	out[6] = int32(int32(b[9]) + (int32((b[10] & 0xf)) << 8))
+	out[7] = int32(int32((b[10]&0xf0)>>4) + (int32((b[11])<<4) << 8))
+
+	return nil
+}
+
+func baselineUnpack20(b []byte, out []int32) error {
+
+	if len(b) != 20 {
+		panic(fmt.Sprint("expected: ", 20, " got ", len(b)))
+	}
+	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0xf)) << 16))
+	out[1] = int32(int32((b[2]&0xf0)>>4) + (int32((b[3])<<4) << 8) + (int32(b[4]) << 16))
+	out[2] = int32(int32(b[5]) + (int32(b[6]) << 8) + (int32((b[7] & 0xf)) << 16))
+	out[3] = int32(int32((b[7]&0xf0)>>4) + (int32((b[8])<<4) << 8) + (int32(b[9]) << 16))
+	out[4] = int32(int32(b[10]) + (int32(b[11]) << 8) + (int32((b[12] & 0xf)) << 16))
+	out[5] = int32(int32((b[12]&0xf0)>>4) + (int32((b[13])<<4) << 8) + (int32(b[14]) << 16))
+	out[6] = int32(int32(b[15]) + (int32(b[16]) << 8) + (int32((b[17] & 0xf)) << 16))
+	out[7] = int32(int32((b[17]&0xf0)>>4) + (int32((b[18])<<4) << 8) + (int32(b[19]) << 16))
+
+	return nil
+}
+
+func baselineUnpack32(b []byte, out []int32) error {
+
+	if len(b) != 32 {
+		panic(fmt.Sprint("expected: ", 32, " got ", len(b)))
+	}
+	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32(b[3]) << 24))
+	out[1] = int32(int32(b[4]) + (int32(b[5]) << 8) + (int32(b[6]) << 16) + (int32(b[7]) << 24))
+	out[2] = int32(int32(b[8]) + (int32(b[9]) << 8) + (int32(b[10]) << 16) + (int32(b[11]) << 24))
+	out[3] = int32(int32(b[12]) + (int32(b[13]) << 8) + (int32(b[14]) << 16) + (int32(b[15]) << 24))
+	out[4] = int32(int32(b[16]) + (int32(b[17]) << 8) + (int32(b[18]) << 16) + (int32(b[19]) << 24))
+	out[5] = int32(int32(b[20]) + (int32(b[21]) << 8) + (int32(b[22]) << 16) + (int32(b[23]) << 24))
+	out[6] = int32(int32(b[24]) + (int32(b[25]) << 8) + (int32(b[26]) << 16) + (int32(b[27]) << 24))
+	out[7] = int32(int32(b[28]) + (int32(b[29]) << 8) + (int32(b[30]) << 16) + (int32(b[31]) << 24))
+
+	return nil
+}
+
+// baselineUnpackers are the baseline unpackers by bit width.
+var baselineUnpackers = map[uint]func(b []byte, out []int32) error{
+	1:  baselineUnpack1,
+	3:  baselineUnpack3,
+	8:  baselineUnpack8,
+	12: baselineUnpack12,
+	20: baselineUnpack20,
+	32: baselineUnpack32,
+}
@@ -3,6 +3,7 @@ package bitpacking
 import (
 	"bytes"
 	"math"
+	"math/rand"
 	"reflect"
 	"strconv"
 	"strings"
@@ -92,7 +93,7 @@ func TestDecoding(t *testing.T) {
 		}
 
 		if !reflect.DeepEqual(out, tc.input) {
-			t.Logf("%v != %v", out, tc.input)
+			t.Errorf("%d: %v != %v", idx, out, tc.input)
 		}
 	}
 }
@@ -151,6 +152,168 @@ func TestUnpack8int32(t *testing.T) {
 	}
 }
 
+func TestUnpack8int64(t *testing.T) {
+	// values up to 32 bits must match the output of the 32 bit encoder
+	for _, test := range unpack8int32Tests {
+		out := make([]int64, 8)
+		if err := NewDecoder64(test.width).Read(bytes.NewReader(test.data), out); err != nil {
+			t.Errorf("%s", err)
+		}
+		for i, v := range test.values {
+			if out[i] != int64(v) {
+				t.Errorf("width %d: got %v, want %v", test.width, out, test.values)
+				break
+			}
+		}
+	}
+
+	// 8 x 0x0123456789ABCDEF, 64 bits wide
+	data := bytes.Repeat([]byte{0xEF, 0xCD, 0xAB, 0x89, 0x67, 0x45, 0x23, 0x01}, 8)
+	out := make([]int64, 8)
+	if err := NewDecoder64(64).Read(bytes.NewReader(data), out); err != nil {
+		t.Fatalf("%s", err)
+	}
+	for _, v := range out {
+		if v != 0x0123456789ABCDEF {
+			t.Fatalf("got %x, want 0x0123456789ABCDEF", out)
+		}
+	}
+
+	// 0..7 shifted left by 32 bits, 36 bits wide:
+	// every value is 4 bytes of zeros followed by a 4 bits nibble
+	data = []byte{
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10,
+		0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x30,
+		0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x50,
+		0x00, 0x00, 0x00, 0x00, 0x06, 0x00, 0x00, 0x00, 0x70,
+	}
+	if err := NewDecoder64(36).Read(bytes.NewReader(data), out); err != nil {
+		t.Fatalf("%s", err)
+	}
+	for i, v := range out {
+		if v != int64(i)<<32 {
+			t.Fatalf("got %x, want %x at %d", v, int64(i)<<32, i)
+		}
+	}
+}
+
+// unpackReference decodes 8 values one bit at a time.
+func unpackReference(b []byte, out []int32, bitWidth uint) {
+	for i := uint(0); i < 8; i++ {
+		var v uint32
+		for j := uint(0); j < bitWidth; j++ {
+			bit := i*bitWidth + j
+			v |= uint32(b[bit/8]>>(bit%8)&1) << j
+		}
+		out[i] = int32(v)
+	}
+}
+
+func TestUnpackAllWidths(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for width := uint(1); width <= 32; width++ {
+		values := make([]int32, 64)
+		for i := range values {
+			values[i] = int32(r.Uint32() >> (32 - width))
+		}
+
+		var b bytes.Buffer
+		if _, err := NewEncoder(width, RLE).Write(&b, values); err != nil {
+			t.Fatalf("width %d: %s", width, err)
+		}
+
+		want := make([]int32, 64)
+		for i := 0; i < 64; i += 8 {
+			unpackReference(b.Bytes()[i/8*int(width):], want[i:], width)
+		}
+		if !reflect.DeepEqual(want, values) {
+			t.Fatalf("width %d: encoder returned %v, want %v", width, want, values)
+		}
+
+		got := make([]int32, 64)
+		if err := NewDecoder(width).UnpackGroups(b.Bytes(), got); err != nil {
+			t.Fatalf("width %d: %s", width, err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("width %d: got %v, want %v", width, got, values)
+		}
+		if err := NewDecoder(width).UnpackGroups(b.Bytes()[:len(b.Bytes())-1], got); err == nil {
+			t.Errorf("width %d: no error for a missing byte", width)
+		}
+	}
+}
+
+// packReference64 packs values one bit at a time.
+func packReference64(values []int64, bitWidth uint) []byte {
+	b := make([]byte, (uint(len(values))*bitWidth+7)/8)
+	for i, v := range values {
+		for j := uint(0); j < bitWidth; j++ {
+			bit := uint(i)*bitWidth + j
+			b[bit/8] |= byte(uint64(v)>>j&1) << (bit % 8)
+		}
+	}
+	return b
+}
+
+func TestUnpack8int64AllWidths(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for width := uint(1); width <= 64; width++ {
+		values := make([]int64, 64)
+		for i := range values {
+			values[i] = int64(uint64(r.Int63())<<1>>(64-width) | 1)
+		}
+		values[0] = int64(^uint64(0) >> (64 - width)) // all bits set
+
+		got := make([]int64, 64)
+		NewDecoder64(width).UnpackGroups(packReference64(values, width), got)
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("width %d: got %x, want %x", width, got, values)
+		}
+	}
+}
+
+// benchmarkUnpack benchmarks the unpacking of 1024 values of width bits
+// with unpack, UnpackGroups if it is nil.
+func benchmarkUnpack(b *testing.B, width uint, unpack func(b []byte, out []int32) error) {
+	const n = 1024
+	data := make([]byte, n/8*int(width))
+	rand.New(rand.NewSource(1)).Read(data)
+	out := make([]int32, n)
+	dec := NewDecoder(width)
+
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if unpack == nil {
+			if err := dec.UnpackGroups(data, out); err != nil {
+				b.Fatal(err)
+			}
+			continue
+		}
+		for j := 0; j < n; j += 8 {
+			if err := unpack(data[j/8*int(width):(j/8+1)*int(width)], out[j:j+8]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkUnpack compares UnpackGroups with the baseline unpackers and
+// with unpackReference.
+func BenchmarkUnpack(b *testing.B) {
+	for _, width := range []uint{1, 3, 8, 12, 20, 32} {
+		width := width
+		b.Run(strconv.Itoa(int(width)), func(b *testing.B) { benchmarkUnpack(b, width, nil) })
+		b.Run(strconv.Itoa(int(width))+"/baseline", func(b *testing.B) { benchmarkUnpack(b, width, baselineUnpackers[width]) })
+		b.Run(strconv.Itoa(int(width))+"/reference", func(b *testing.B) {
+			benchmarkUnpack(b, width, func(b []byte, out []int32) error {
+				unpackReference(b, out, width)
+				return nil
+			})
+		})
+	}
+}
+
 func TestBitWidth(t *testing.T) {
 	tests := []struct {
 		max   uint32
@@ -195,3 +358,29 @@ func TestBitWidth(t *testing.T) {
 // 		}
 // 	}
 // }
+
+func TestReadBitPacked(t *testing.T) {
+	// example from the parquet specification: 0 to 7 with a bit width of 3
+	got, err := ReadBitPacked(bytes.NewReader([]byte{0x05, 0x39, 0x77}), 3, 8)
+	check(t, err)
+	if want := []int32{0, 1, 2, 3, 4, 5, 6, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// 3 values of width 1 use only the most significant bits of one byte
+	got, err = ReadBitPacked(bytes.NewReader([]byte{0xA0}), 1, 3)
+	check(t, err)
+	if want := []int32{1, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got, err = ReadBitPacked(bytes.NewReader([]byte{0xFF, 0xFF, 0xFF, 0xFE, 0x00, 0x00, 0x00, 0x01}), 32, 2)
+	check(t, err)
+	if want := []int32{-2, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if _, err := ReadBitPacked(bytes.NewReader([]byte{0x05, 0x39}), 3, 8); err == nil {
+		t.Errorf("expected an error for truncated data")
+	}
+}
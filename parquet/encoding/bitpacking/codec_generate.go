@@ -1232,14 +1232,15 @@ func (d *Decoder) decode1RLE(b []byte, out []int32) error {
 	if len(b) != 1 {
 		panic(fmt.Sprint("expected: ", 1, " got ", len(b)))
 	}
-	out[0] = int32((b[0] & 0x1))
-	out[1] = int32((b[0] & 0x2) >> 1)
-	out[2] = int32((b[0] & 0x4) >> 2)
-	out[3] = int32((b[0] & 0x8) >> 3)
-	out[4] = int32((b[0] & 0x10) >> 4)
-	out[5] = int32((b[0] & 0x20) >> 5)
-	out[6] = int32((b[0] & 0x40) >> 6)
-	out[7] = int32((b[0] & 0x80) >> 7)
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) & 0x1)
+	out[1] = int32((uint32(b[0]) >> 1) & 0x1)
+	out[2] = int32((uint32(b[0]) >> 2) & 0x1)
+	out[3] = int32((uint32(b[0]) >> 3) & 0x1)
+	out[4] = int32((uint32(b[0]) >> 4) & 0x1)
+	out[5] = int32((uint32(b[0]) >> 5) & 0x1)
+	out[6] = int32((uint32(b[0]) >> 6) & 0x1)
+	out[7] = int32((uint32(b[0]) >> 7) & 0x1)
 
 	return nil
 }
@@ -1249,14 +1250,15 @@ func (d *Decoder) decode2RLE(b []byte, out []int32) error {
 	if len(b) != 2 {
 		panic(fmt.Sprint("expected: ", 2, " got ", len(b)))
 	}
-	out[0] = int32((b[0] & 0x3))
-	out[1] = int32((b[0] & 0xc) >> 2)
-	out[2] = int32((b[0] & 0x30) >> 4)
-	out[3] = int32((b[0] & 0xc0) >> 6)
-	out[4] = int32((b[1] & 0x3))
-	out[5] = int32((b[1] & 0xc) >> 2)
-	out[6] = int32((b[1] & 0x30) >> 4)
-	out[7] = int32((b[1] & 0xc0) >> 6)
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) & 0x3)
+	out[1] = int32((uint32(b[0]) >> 2) & 0x3)
+	out[2] = int32((uint32(b[0]) >> 4) & 0x3)
+	out[3] = int32((uint32(b[0]) >> 6) & 0x3)
+	out[4] = int32(uint32(b[1]) & 0x3)
+	out[5] = int32((uint32(b[1]) >> 2) & 0x3)
+	out[6] = int32((uint32(b[1]) >> 4) & 0x3)
+	out[7] = int32((uint32(b[1]) >> 6) & 0x3)
 
 	return nil
 }
@@ -1266,14 +1268,15 @@ func (d *Decoder) decode3RLE(b []byte, out []int32) error {
 	if len(b) != 3 {
 		panic(fmt.Sprint("expected: ", 3, " got ", len(b)))
 	}
-	out[0] = int32((b[0] & 0x7))
-	out[1] = int32((b[0] & 0x38) >> 3)
-	out[2] = int32((b[0]&0xc0)>>6 | (b[1] & 0x1))
-	out[3] = int32((b[1] & 0xe) >> 1)
-	out[4] = int32((b[1] & 0x70) >> 4)
-	out[5] = int32((b[1]&0x80)>>7 | (b[2]&0x3)<<1)
-	out[6] = int32((b[2] & 0x1c) >> 2)
-	out[7] = int32((b[2] & 0xe0) >> 5)
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) & 0x7)
+	out[1] = int32((uint32(b[0]) >> 3) & 0x7)
+	out[2] = int32((uint32(b[0])>>6 | uint32(b[1])<<2) & 0x7)
+	out[3] = int32((uint32(b[1]) >> 1) & 0x7)
+	out[4] = int32((uint32(b[1]) >> 4) & 0x7)
+	out[5] = int32((uint32(b[1])>>7 | uint32(b[2])<<1) & 0x7)
+	out[6] = int32((uint32(b[2]) >> 2) & 0x7)
+	out[7] = int32((uint32(b[2]) >> 5) & 0x7)
 
 	return nil
 }
@@ -1283,14 +1286,15 @@ func (d *Decoder) decode4RLE(b []byte, out []int32) error {
 	if len(b) != 4 {
 		panic(fmt.Sprint("expected: ", 4, " got ", len(b)))
 	}
-	out[0] = int32((b[0] & 0xf))
-	out[1] = int32((b[0] & 0xf0) >> 4)
-	out[2] = int32((b[1] & 0xf))
-	out[3] = int32((b[1] & 0xf0) >> 4)
-	out[4] = int32((b[2] & 0xf))
-	out[5] = int32((b[2] & 0xf0) >> 4)
-	out[6] = int32((b[3] & 0xf))
-	out[7] = int32((b[3] & 0xf0) >> 4)
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) & 0xF)
+	out[1] = int32((uint32(b[0]) >> 4) & 0xF)
+	out[2] = int32(uint32(b[1]) & 0xF)
+	out[3] = int32((uint32(b[1]) >> 4) & 0xF)
+	out[4] = int32(uint32(b[2]) & 0xF)
+	out[5] = int32((uint32(b[2]) >> 4) & 0xF)
+	out[6] = int32(uint32(b[3]) & 0xF)
+	out[7] = int32((uint32(b[3]) >> 4) & 0xF)
 
 	return nil
 }
@@ -1300,14 +1304,15 @@ func (d *Decoder) decode5RLE(b []byte, out []int32) error {
 	if len(b) != 5 {
 		panic(fmt.Sprint("expected: ", 5, " got ", len(b)))
 	}
-	out[0] = int32((b[0] & 0x1f))
-	out[1] = int32((b[0]&0xe0)>>5 | (b[1]&0x3)<<3)
-	out[2] = int32((b[1] & 0x7c) >> 2)
-	out[3] = int32((b[1]&0x80)>>7 | (b[2]&0xf)<<1)
-	out[4] = int32((b[2]&0xf0)>>4 | (b[3] & 0x1))
-	out[5] = int32((b[3] & 0x3e) >> 1)
-	out[6] = int32((b[3]&0xc0)>>6 | (b[4]&0x7)<<2)
-	out[7] = int32((b[4] & 0xf8) >> 3)
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) & 0x1F)
+	out[1] = int32((uint32(b[0])>>5 | uint32(b[1])<<3) & 0x1F)
+	out[2] = int32((uint32(b[1]) >> 2) & 0x1F)
+	out[3] = int32((uint32(b[1])>>7 | uint32(b[2])<<1) & 0x1F)
+	out[4] = int32((uint32(b[2])>>4 | uint32(b[3])<<4) & 0x1F)
+	out[5] = int32((uint32(b[3]) >> 1) & 0x1F)
+	out[6] = int32((uint32(b[3])>>6 | uint32(b[4])<<2) & 0x1F)
+	out[7] = int32((uint32(b[4]) >> 3) & 0x1F)
 
 	return nil
 }
@@ -1317,14 +1322,15 @@ func (d *Decoder) decode6RLE(b []byte, out []int32) error {
 	if len(b) != 6 {
 		panic(fmt.Sprint("expected: ", 6, " got ", len(b)))
 	}
-	out[0] = int32((b[0] & 0x3f))
-	out[1] = int32((b[0]&0xc0)>>6 | (b[1]&0xf)<<2)
-	out[2] = int32((b[1]&0xf0)>>4 | (b[2]&0x3)<<4)
-	out[3] = int32((b[2] & 0xfc) >> 2)
-	out[4] = int32((b[3] & 0x3f))
-	out[5] = int32((b[3]&0xc0)>>6 | (b[4]&0xf)<<2)
-	out[6] = int32((b[4]&0xf0)>>4 | (b[5]&0x3)<<4)
-	out[7] = int32((b[5] & 0xfc) >> 2)
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) & 0x3F)
+	out[1] = int32((uint32(b[0])>>6 | uint32(b[1])<<2) & 0x3F)
+	out[2] = int32((uint32(b[1])>>4 | uint32(b[2])<<4) & 0x3F)
+	out[3] = int32((uint32(b[2]) >> 2) & 0x3F)
+	out[4] = int32(uint32(b[3]) & 0x3F)
+	out[5] = int32((uint32(b[3])>>6 | uint32(b[4])<<2) & 0x3F)
+	out[6] = int32((uint32(b[4])>>4 | uint32(b[5])<<4) & 0x3F)
+	out[7] = int32((uint32(b[5]) >> 2) & 0x3F)
 
 	return nil
 }
@@ -1334,14 +1340,15 @@ func (d *Decoder) decode7RLE(b []byte, out []int32) error {
 	if len(b) != 7 {
 		panic(fmt.Sprint("expected: ", 7, " got ", len(b)))
 	}
-	out[0] = int32((b[0] & 0x7f))
-	out[1] = int32((b[0]&0x80)>>7 | (b[1]&0x3f)<<1)
-	out[2] = int32((b[1]&0xc0)>>6 | (b[2]&0x1f)<<2)
-	out[3] = int32((b[2]&0xe0)>>5 | (b[3]&0xf)<<3)
-	out[4] = int32((b[3]&0xf0)>>4 | (b[4]&0x7)<<4)
-	out[5] = int32((b[4]&0xf8)>>3 | (b[5]&0x3)<<5)
-	out[6] = int32((b[5]&0xfc)>>2 | (b[6] & 0x1))
-	out[7] = int32((b[6] & 0xfe) >> 1)
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) & 0x7F)
+	out[1] = int32((uint32(b[0])>>7 | uint32(b[1])<<1) & 0x7F)
+	out[2] = int32((uint32(b[1])>>6 | uint32(b[2])<<2) & 0x7F)
+	out[3] = int32((uint32(b[2])>>5 | uint32(b[3])<<3) & 0x7F)
+	out[4] = int32((uint32(b[3])>>4 | uint32(b[4])<<4) & 0x7F)
+	out[5] = int32((uint32(b[4])>>3 | uint32(b[5])<<5) & 0x7F)
+	out[6] = int32((uint32(b[5])>>2 | uint32(b[6])<<6) & 0x7F)
+	out[7] = int32((uint32(b[6]) >> 1) & 0x7F)
 
 	return nil
 }
@@ -1351,14 +1358,15 @@ func (d *Decoder) decode8RLE(b []byte, out []int32) error {
 	if len(b) != 8 {
 		panic(fmt.Sprint("expected: ", 8, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]))
-	out[1] = int32(int32(b[1]))
-	out[2] = int32(int32(b[2]))
-	out[3] = int32(int32(b[3]))
-	out[4] = int32(int32(b[4]))
-	out[5] = int32(int32(b[5]))
-	out[6] = int32(int32(b[6]))
-	out[7] = int32(int32(b[7]))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]))
+	out[1] = int32(uint32(b[1]))
+	out[2] = int32(uint32(b[2]))
+	out[3] = int32(uint32(b[3]))
+	out[4] = int32(uint32(b[4]))
+	out[5] = int32(uint32(b[5]))
+	out[6] = int32(uint32(b[6]))
+	out[7] = int32(uint32(b[7]))
 
 	return nil
 }
@@ -1368,14 +1376,15 @@ func (d *Decoder) decode9RLE(b []byte, out []int32) error {
 	if len(b) != 9 {
 		panic(fmt.Sprint("expected: ", 9, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32((b[1] & 0x1)) << 8))
-	out[1] = int32(int32((b[1]&0xfe)>>1) + (int32((b[2]&0x3)<<7) << 8))
-	out[2] = int32(int32((b[2]&0xfc)>>2) + (int32((b[3]&0x7)<<6) << 8))
-	out[3] = int32(int32((b[3]&0xf8)>>3) + (int32((b[4]&0xf)<<5) << 8))
-	out[4] = int32(int32((b[4]&0xf0)>>4) + (int32((b[5]&0x1f)<<4) << 8))
-	out[5] = int32(int32((b[5]&0xe0)>>5) + (int32((b[6]&0x3f)<<3) << 8))
-	out[6] = int32(int32((b[6]&0xc0)>>6) + (int32((b[7]&0x7f)<<2) << 8))
-	out[7] = int32(int32((b[7]&0x80)>>7) + (int32((b[8])<<1) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x1FF)
+	out[1] = int32((uint32(b[1])>>1 | uint32(b[2])<<7) & 0x1FF)
+	out[2] = int32((uint32(b[2])>>2 | uint32(b[3])<<6) & 0x1FF)
+	out[3] = int32((uint32(b[3])>>3 | uint32(b[4])<<5) & 0x1FF)
+	out[4] = int32((uint32(b[4])>>4 | uint32(b[5])<<4) & 0x1FF)
+	out[5] = int32((uint32(b[5])>>5 | uint32(b[6])<<3) & 0x1FF)
+	out[6] = int32((uint32(b[6])>>6 | uint32(b[7])<<2) & 0x1FF)
+	out[7] = int32((uint32(b[7])>>7 | uint32(b[8])<<1) & 0x1FF)
 
 	return nil
 }
@@ -1385,14 +1394,15 @@ func (d *Decoder) decode10RLE(b []byte, out []int32) error {
 	if len(b) != 10 {
 		panic(fmt.Sprint("expected: ", 10, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32((b[1] & 0x3)) << 8))
-	out[1] = int32(int32((b[1]&0xfc)>>2) + (int32((b[2]&0xf)<<6) << 8))
-	out[2] = int32(int32((b[2]&0xf0)>>4) + (int32((b[3]&0x3f)<<4) << 8))
-	out[3] = int32(int32((b[3]&0xc0)>>6) + (int32((b[4])<<2) << 8))
-	out[4] = int32(int32(b[5]) + (int32((b[6] & 0x3)) << 8))
-	out[5] = int32(int32((b[6]&0xfc)>>2) + (int32((b[7]&0xf)<<6) << 8))
-	out[6] = int32(int32((b[7]&0xf0)>>4) + (int32((b[8]&0x3f)<<4) << 8))
-	out[7] = int32(int32((b[8]&0xc0)>>6) + (int32((b[9])<<2) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x3FF)
+	out[1] = int32((uint32(b[1])>>2 | uint32(b[2])<<6) & 0x3FF)
+	out[2] = int32((uint32(b[2])>>4 | uint32(b[3])<<4) & 0x3FF)
+	out[3] = int32((uint32(b[3])>>6 | uint32(b[4])<<2) & 0x3FF)
+	out[4] = int32((uint32(b[5]) | uint32(b[6])<<8) & 0x3FF)
+	out[5] = int32((uint32(b[6])>>2 | uint32(b[7])<<6) & 0x3FF)
+	out[6] = int32((uint32(b[7])>>4 | uint32(b[8])<<4) & 0x3FF)
+	out[7] = int32((uint32(b[8])>>6 | uint32(b[9])<<2) & 0x3FF)
 
 	return nil
 }
@@ -1402,14 +1412,15 @@ func (d *Decoder) decode11RLE(b []byte, out []int32) error {
 	if len(b) != 11 {
 		panic(fmt.Sprint("expected: ", 11, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32((b[1] & 0x7)) << 8))
-	out[1] = int32(int32((b[1]&0xf8)>>3) + (int32((b[2]&0x3f)<<5) << 8))
-	out[2] = int32(int32((b[2]&0xc0)>>6) + (int32((b[3])<<2) << 8) + (int32((b[4] & 0x1)) << 16))
-	out[3] = int32(int32((b[4]&0xfe)>>1) + (int32((b[5]&0xf)<<7) << 8))
-	out[4] = int32(int32((b[5]&0xf0)>>4) + (int32((b[6]&0x7f)<<4) << 8))
-	out[5] = int32(int32((b[6]&0x80)>>7) + (int32((b[7])<<1) << 8) + (int32((b[8] & 0x3)) << 16))
-	out[6] = int32(int32((b[8]&0xfc)>>2) + (int32((b[9]&0x1f)<<6) << 8))
-	out[7] = int32(int32((b[9]&0xe0)>>5) + (int32((b[10])<<3) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x7FF)
+	out[1] = int32((uint32(b[1])>>3 | uint32(b[2])<<5) & 0x7FF)
+	out[2] = int32((uint32(b[2])>>6 | uint32(b[3])<<2 | uint32(b[4])<<10) & 0x7FF)
+	out[3] = int32((uint32(b[4])>>1 | uint32(b[5])<<7) & 0x7FF)
+	out[4] = int32((uint32(b[5])>>4 | uint32(b[6])<<4) & 0x7FF)
+	out[5] = int32((uint32(b[6])>>7 | uint32(b[7])<<1 | uint32(b[8])<<9) & 0x7FF)
+	out[6] = int32((uint32(b[8])>>2 | uint32(b[9])<<6) & 0x7FF)
+	out[7] = int32((uint32(b[9])>>5 | uint32(b[10])<<3) & 0x7FF)
 
 	return nil
 }
@@ -1419,14 +1430,15 @@ func (d *Decoder) decode12RLE(b []byte, out []int32) error {
 	if len(b) != 12 {
 		panic(fmt.Sprint("expected: ", 12, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32((b[1] & 0xf)) << 8))
-	out[1] = int32(int32((b[1]&0xf0)>>4) + (int32((b[2])<<4) << 8))
-	out[2] = int32(int32(b[3]) + (int32((b[4] & 0xf)) << 8))
-	out[3] = int32(int32((b[4]&0xf0)>>4) + (int32((b[5])<<4) << 8))
-	out[4] = int32(int32(b[6]) + (int32((b[7] & 0xf)) << 8))
-	out[5] = int32(int32((b[7]&0xf0)>>4) + (int32((b[8])<<4) << 8))
-	out[6] = int32(int32(b[9]) + (int32((b[10] & 0xf)) << 8))
-	out[7] = int32(int32((b[10]&0xf0)>>4) + (int32((b[11])<<4) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0xFFF)
+	out[1] = int32((uint32(b[1])>>4 | uint32(b[2])<<4) & 0xFFF)
+	out[2] = int32((uint32(b[3]) | uint32(b[4])<<8) & 0xFFF)
+	out[3] = int32((uint32(b[4])>>4 | uint32(b[5])<<4) & 0xFFF)
+	out[4] = int32((uint32(b[6]) | uint32(b[7])<<8) & 0xFFF)
+	out[5] = int32((uint32(b[7])>>4 | uint32(b[8])<<4) & 0xFFF)
+	out[6] = int32((uint32(b[9]) | uint32(b[10])<<8) & 0xFFF)
+	out[7] = int32((uint32(b[10])>>4 | uint32(b[11])<<4) & 0xFFF)
 
 	return nil
 }
@@ -1436,14 +1448,15 @@ func (d *Decoder) decode13RLE(b []byte, out []int32) error {
 	if len(b) != 13 {
 		panic(fmt.Sprint("expected: ", 13, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32((b[1] & 0x1f)) << 8))
-	out[1] = int32(int32((b[1]&0xe0)>>5) + (int32((b[2])<<3) << 8) + (int32((b[3] & 0x3)) << 16))
-	out[2] = int32(int32((b[3]&0xfc)>>2) + (int32((b[4]&0x7f)<<6) << 8))
-	out[3] = int32(int32((b[4]&0x80)>>7) + (int32((b[5])<<1) << 8) + (int32((b[6] & 0xf)) << 16))
-	out[4] = int32(int32((b[6]&0xf0)>>4) + (int32((b[7])<<4) << 8) + (int32((b[8] & 0x1)) << 16))
-	out[5] = int32(int32((b[8]&0xfe)>>1) + (int32((b[9]&0x3f)<<7) << 8))
-	out[6] = int32(int32((b[9]&0xc0)>>6) + (int32((b[10])<<2) << 8) + (int32((b[11] & 0x7)) << 16))
-	out[7] = int32(int32((b[11]&0xf8)>>3) + (int32((b[12])<<5) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x1FFF)
+	out[1] = int32((uint32(b[1])>>5 | uint32(b[2])<<3 | uint32(b[3])<<11) & 0x1FFF)
+	out[2] = int32((uint32(b[3])>>2 | uint32(b[4])<<6) & 0x1FFF)
+	out[3] = int32((uint32(b[4])>>7 | uint32(b[5])<<1 | uint32(b[6])<<9) & 0x1FFF)
+	out[4] = int32((uint32(b[6])>>4 | uint32(b[7])<<4 | uint32(b[8])<<12) & 0x1FFF)
+	out[5] = int32((uint32(b[8])>>1 | uint32(b[9])<<7) & 0x1FFF)
+	out[6] = int32((uint32(b[9])>>6 | uint32(b[10])<<2 | uint32(b[11])<<10) & 0x1FFF)
+	out[7] = int32((uint32(b[11])>>3 | uint32(b[12])<<5) & 0x1FFF)
 
 	return nil
 }
@@ -1453,14 +1466,15 @@ func (d *Decoder) decode14RLE(b []byte, out []int32) error {
 	if len(b) != 14 {
 		panic(fmt.Sprint("expected: ", 14, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32((b[1] & 0x3f)) << 8))
-	out[1] = int32(int32((b[1]&0xc0)>>6) + (int32((b[2])<<2) << 8) + (int32((b[3] & 0xf)) << 16))
-	out[2] = int32(int32((b[3]&0xf0)>>4) + (int32((b[4])<<4) << 8) + (int32((b[5] & 0x3)) << 16))
-	out[3] = int32(int32((b[5]&0xfc)>>2) + (int32((b[6])<<6) << 8))
-	out[4] = int32(int32(b[7]) + (int32((b[8] & 0x3f)) << 8))
-	out[5] = int32(int32((b[8]&0xc0)>>6) + (int32((b[9])<<2) << 8) + (int32((b[10] & 0xf)) << 16))
-	out[6] = int32(int32((b[10]&0xf0)>>4) + (int32((b[11])<<4) << 8) + (int32((b[12] & 0x3)) << 16))
-	out[7] = int32(int32((b[12]&0xfc)>>2) + (int32((b[13])<<6) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x3FFF)
+	out[1] = int32((uint32(b[1])>>6 | uint32(b[2])<<2 | uint32(b[3])<<10) & 0x3FFF)
+	out[2] = int32((uint32(b[3])>>4 | uint32(b[4])<<4 | uint32(b[5])<<12) & 0x3FFF)
+	out[3] = int32((uint32(b[5])>>2 | uint32(b[6])<<6) & 0x3FFF)
+	out[4] = int32((uint32(b[7]) | uint32(b[8])<<8) & 0x3FFF)
+	out[5] = int32((uint32(b[8])>>6 | uint32(b[9])<<2 | uint32(b[10])<<10) & 0x3FFF)
+	out[6] = int32((uint32(b[10])>>4 | uint32(b[11])<<4 | uint32(b[12])<<12) & 0x3FFF)
+	out[7] = int32((uint32(b[12])>>2 | uint32(b[13])<<6) & 0x3FFF)
 
 	return nil
 }
@@ -1470,14 +1484,15 @@ func (d *Decoder) decode15RLE(b []byte, out []int32) error {
 	if len(b) != 15 {
 		panic(fmt.Sprint("expected: ", 15, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32((b[1] & 0x7f)) << 8))
-	out[1] = int32(int32((b[1]&0x80)>>7) + (int32((b[2])<<1) << 8) + (int32((b[3] & 0x3f)) << 16))
-	out[2] = int32(int32((b[3]&0xc0)>>6) + (int32((b[4])<<2) << 8) + (int32((b[5] & 0x1f)) << 16))
-	out[3] = int32(int32((b[5]&0xe0)>>5) + (int32((b[6])<<3) << 8) + (int32((b[7] & 0xf)) << 16))
-	out[4] = int32(int32((b[7]&0xf0)>>4) + (int32((b[8])<<4) << 8) + (int32((b[9] & 0x7)) << 16))
-	out[5] = int32(int32((b[9]&0xf8)>>3) + (int32((b[10])<<5) << 8) + (int32((b[11] & 0x3)) << 16))
-	out[6] = int32(int32((b[11]&0xfc)>>2) + (int32((b[12])<<6) << 8) + (int32((b[13] & 0x1)) << 16))
-	out[7] = int32(int32((b[13]&0xfe)>>1) + (int32((b[14])<<7) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x7FFF)
+	out[1] = int32((uint32(b[1])>>7 | uint32(b[2])<<1 | uint32(b[3])<<9) & 0x7FFF)
+	out[2] = int32((uint32(b[3])>>6 | uint32(b[4])<<2 | uint32(b[5])<<10) & 0x7FFF)
+	out[3] = int32((uint32(b[5])>>5 | uint32(b[6])<<3 | uint32(b[7])<<11) & 0x7FFF)
+	out[4] = int32((uint32(b[7])>>4 | uint32(b[8])<<4 | uint32(b[9])<<12) & 0x7FFF)
+	out[5] = int32((uint32(b[9])>>3 | uint32(b[10])<<5 | uint32(b[11])<<13) & 0x7FFF)
+	out[6] = int32((uint32(b[11])>>2 | uint32(b[12])<<6 | uint32(b[13])<<14) & 0x7FFF)
+	out[7] = int32((uint32(b[13])>>1 | uint32(b[14])<<7) & 0x7FFF)
 
 	return nil
 }
@@ -1487,14 +1502,15 @@ func (d *Decoder) decode16RLE(b []byte, out []int32) error {
 	if len(b) != 16 {
 		panic(fmt.Sprint("expected: ", 16, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8))
-	out[1] = int32(int32(b[2]) + (int32(b[3]) << 8))
-	out[2] = int32(int32(b[4]) + (int32(b[5]) << 8))
-	out[3] = int32(int32(b[6]) + (int32(b[7]) << 8))
-	out[4] = int32(int32(b[8]) + (int32(b[9]) << 8))
-	out[5] = int32(int32(b[10]) + (int32(b[11]) << 8))
-	out[6] = int32(int32(b[12]) + (int32(b[13]) << 8))
-	out[7] = int32(int32(b[14]) + (int32(b[15]) << 8))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) | uint32(b[1])<<8)
+	out[1] = int32(uint32(b[2]) | uint32(b[3])<<8)
+	out[2] = int32(uint32(b[4]) | uint32(b[5])<<8)
+	out[3] = int32(uint32(b[6]) | uint32(b[7])<<8)
+	out[4] = int32(uint32(b[8]) | uint32(b[9])<<8)
+	out[5] = int32(uint32(b[10]) | uint32(b[11])<<8)
+	out[6] = int32(uint32(b[12]) | uint32(b[13])<<8)
+	out[7] = int32(uint32(b[14]) | uint32(b[15])<<8)
 
 	return nil
 }
@@ -1504,14 +1520,15 @@ func (d *Decoder) decode17RLE(b []byte, out []int32) error {
 	if len(b) != 17 {
 		panic(fmt.Sprint("expected: ", 17, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0x1)) << 16))
-	out[1] = int32(int32((b[2]&0xfe)>>1) + (int32((b[3])<<7) << 8) + (int32((b[4] & 0x3)) << 16))
-	out[2] = int32(int32((b[4]&0xfc)>>2) + (int32((b[5])<<6) << 8) + (int32((b[6] & 0x7)) << 16))
-	out[3] = int32(int32((b[6]&0xf8)>>3) + (int32((b[7])<<5) << 8) + (int32((b[8] & 0xf)) << 16))
-	out[4] = int32(int32((b[8]&0xf0)>>4) + (int32((b[9])<<4) << 8) + (int32((b[10] & 0x1f)) << 16))
-	out[5] = int32(int32((b[10]&0xe0)>>5) + (int32((b[11])<<3) << 8) + (int32((b[12] & 0x3f)) << 16))
-	out[6] = int32(int32((b[12]&0xc0)>>6) + (int32((b[13])<<2) << 8) + (int32((b[14] & 0x7f)) << 16))
-	out[7] = int32(int32((b[14]&0x80)>>7) + (int32((b[15])<<1) << 8) + (int32(b[16]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x1FFFF)
+	out[1] = int32((uint32(b[2])>>1 | uint32(b[3])<<7 | uint32(b[4])<<15) & 0x1FFFF)
+	out[2] = int32((uint32(b[4])>>2 | uint32(b[5])<<6 | uint32(b[6])<<14) & 0x1FFFF)
+	out[3] = int32((uint32(b[6])>>3 | uint32(b[7])<<5 | uint32(b[8])<<13) & 0x1FFFF)
+	out[4] = int32((uint32(b[8])>>4 | uint32(b[9])<<4 | uint32(b[10])<<12) & 0x1FFFF)
+	out[5] = int32((uint32(b[10])>>5 | uint32(b[11])<<3 | uint32(b[12])<<11) & 0x1FFFF)
+	out[6] = int32((uint32(b[12])>>6 | uint32(b[13])<<2 | uint32(b[14])<<10) & 0x1FFFF)
+	out[7] = int32((uint32(b[14])>>7 | uint32(b[15])<<1 | uint32(b[16])<<9) & 0x1FFFF)
 
 	return nil
 }
@@ -1521,14 +1538,15 @@ func (d *Decoder) decode18RLE(b []byte, out []int32) error {
 	if len(b) != 18 {
 		panic(fmt.Sprint("expected: ", 18, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0x3)) << 16))
-	out[1] = int32(int32((b[2]&0xfc)>>2) + (int32((b[3])<<6) << 8) + (int32((b[4] & 0xf)) << 16))
-	out[2] = int32(int32((b[4]&0xf0)>>4) + (int32((b[5])<<4) << 8) + (int32((b[6] & 0x3f)) << 16))
-	out[3] = int32(int32((b[6]&0xc0)>>6) + (int32((b[7])<<2) << 8) + (int32(b[8]) << 16))
-	out[4] = int32(int32(b[9]) + (int32(b[10]) << 8) + (int32((b[11] & 0x3)) << 16))
-	out[5] = int32(int32((b[11]&0xfc)>>2) + (int32((b[12])<<6) << 8) + (int32((b[13] & 0xf)) << 16))
-	out[6] = int32(int32((b[13]&0xf0)>>4) + (int32((b[14])<<4) << 8) + (int32((b[15] & 0x3f)) << 16))
-	out[7] = int32(int32((b[15]&0xc0)>>6) + (int32((b[16])<<2) << 8) + (int32(b[17]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x3FFFF)
+	out[1] = int32((uint32(b[2])>>2 | uint32(b[3])<<6 | uint32(b[4])<<14) & 0x3FFFF)
+	out[2] = int32((uint32(b[4])>>4 | uint32(b[5])<<4 | uint32(b[6])<<12) & 0x3FFFF)
+	out[3] = int32((uint32(b[6])>>6 | uint32(b[7])<<2 | uint32(b[8])<<10) & 0x3FFFF)
+	out[4] = int32((uint32(b[9]) | uint32(b[10])<<8 | uint32(b[11])<<16) & 0x3FFFF)
+	out[5] = int32((uint32(b[11])>>2 | uint32(b[12])<<6 | uint32(b[13])<<14) & 0x3FFFF)
+	out[6] = int32((uint32(b[13])>>4 | uint32(b[14])<<4 | uint32(b[15])<<12) & 0x3FFFF)
+	out[7] = int32((uint32(b[15])>>6 | uint32(b[16])<<2 | uint32(b[17])<<10) & 0x3FFFF)
 
 	return nil
 }
@@ -1538,14 +1556,15 @@ func (d *Decoder) decode19RLE(b []byte, out []int32) error {
 	if len(b) != 19 {
 		panic(fmt.Sprint("expected: ", 19, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0x7)) << 16))
-	out[1] = int32(int32((b[2]&0xf8)>>3) + (int32((b[3])<<5) << 8) + (int32((b[4] & 0x3f)) << 16))
-	out[2] = int32(int32((b[4]&0xc0)>>6) + (int32((b[5])<<2) << 8) + (int32(b[6]) << 16) + (int32((b[7] & 0x1)) << 24))
-	out[3] = int32(int32((b[7]&0xfe)>>1) + (int32((b[8])<<7) << 8) + (int32((b[9] & 0xf)) << 16))
-	out[4] = int32(int32((b[9]&0xf0)>>4) + (int32((b[10])<<4) << 8) + (int32((b[11] & 0x7f)) << 16))
-	out[5] = int32(int32((b[11]&0x80)>>7) + (int32((b[12])<<1) << 8) + (int32(b[13]) << 16) + (int32((b[14] & 0x3)) << 24))
-	out[6] = int32(int32((b[14]&0xfc)>>2) + (int32((b[15])<<6) << 8) + (int32((b[16] & 0x1f)) << 16))
-	out[7] = int32(int32((b[16]&0xe0)>>5) + (int32((b[17])<<3) << 8) + (int32(b[18]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x7FFFF)
+	out[1] = int32((uint32(b[2])>>3 | uint32(b[3])<<5 | uint32(b[4])<<13) & 0x7FFFF)
+	out[2] = int32((uint32(b[4])>>6 | uint32(b[5])<<2 | uint32(b[6])<<10 | uint32(b[7])<<18) & 0x7FFFF)
+	out[3] = int32((uint32(b[7])>>1 | uint32(b[8])<<7 | uint32(b[9])<<15) & 0x7FFFF)
+	out[4] = int32((uint32(b[9])>>4 | uint32(b[10])<<4 | uint32(b[11])<<12) & 0x7FFFF)
+	out[5] = int32((uint32(b[11])>>7 | uint32(b[12])<<1 | uint32(b[13])<<9 | uint32(b[14])<<17) & 0x7FFFF)
+	out[6] = int32((uint32(b[14])>>2 | uint32(b[15])<<6 | uint32(b[16])<<14) & 0x7FFFF)
+	out[7] = int32((uint32(b[16])>>5 | uint32(b[17])<<3 | uint32(b[18])<<11) & 0x7FFFF)
 
 	return nil
 }
@@ -1555,14 +1574,15 @@ func (d *Decoder) decode20RLE(b []byte, out []int32) error {
 	if len(b) != 20 {
 		panic(fmt.Sprint("expected: ", 20, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0xf)) << 16))
-	out[1] = int32(int32((b[2]&0xf0)>>4) + (int32((b[3])<<4) << 8) + (int32(b[4]) << 16))
-	out[2] = int32(int32(b[5]) + (int32(b[6]) << 8) + (int32((b[7] & 0xf)) << 16))
-	out[3] = int32(int32((b[7]&0xf0)>>4) + (int32((b[8])<<4) << 8) + (int32(b[9]) << 16))
-	out[4] = int32(int32(b[10]) + (int32(b[11]) << 8) + (int32((b[12] & 0xf)) << 16))
-	out[5] = int32(int32((b[12]&0xf0)>>4) + (int32((b[13])<<4) << 8) + (int32(b[14]) << 16))
-	out[6] = int32(int32(b[15]) + (int32(b[16]) << 8) + (int32((b[17] & 0xf)) << 16))
-	out[7] = int32(int32((b[17]&0xf0)>>4) + (int32((b[18])<<4) << 8) + (int32(b[19]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0xFFFFF)
+	out[1] = int32((uint32(b[2])>>4 | uint32(b[3])<<4 | uint32(b[4])<<12) & 0xFFFFF)
+	out[2] = int32((uint32(b[5]) | uint32(b[6])<<8 | uint32(b[7])<<16) & 0xFFFFF)
+	out[3] = int32((uint32(b[7])>>4 | uint32(b[8])<<4 | uint32(b[9])<<12) & 0xFFFFF)
+	out[4] = int32((uint32(b[10]) | uint32(b[11])<<8 | uint32(b[12])<<16) & 0xFFFFF)
+	out[5] = int32((uint32(b[12])>>4 | uint32(b[13])<<4 | uint32(b[14])<<12) & 0xFFFFF)
+	out[6] = int32((uint32(b[15]) | uint32(b[16])<<8 | uint32(b[17])<<16) & 0xFFFFF)
+	out[7] = int32((uint32(b[17])>>4 | uint32(b[18])<<4 | uint32(b[19])<<12) & 0xFFFFF)
 
 	return nil
 }
@@ -1572,14 +1592,15 @@ func (d *Decoder) decode21RLE(b []byte, out []int32) error {
 	if len(b) != 21 {
 		panic(fmt.Sprint("expected: ", 21, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0x1f)) << 16))
-	out[1] = int32(int32((b[2]&0xe0)>>5) + (int32((b[3])<<3) << 8) + (int32(b[4]) << 16) + (int32((b[5] & 0x3)) << 24))
-	out[2] = int32(int32((b[5]&0xfc)>>2) + (int32((b[6])<<6) << 8) + (int32((b[7] & 0x7f)) << 16))
-	out[3] = int32(int32((b[7]&0x80)>>7) + (int32((b[8])<<1) << 8) + (int32(b[9]) << 16) + (int32((b[10] & 0xf)) << 24))
-	out[4] = int32(int32((b[10]&0xf0)>>4) + (int32((b[11])<<4) << 8) + (int32(b[12]) << 16) + (int32((b[13] & 0x1)) << 24))
-	out[5] = int32(int32((b[13]&0xfe)>>1) + (int32((b[14])<<7) << 8) + (int32((b[15] & 0x3f)) << 16))
-	out[6] = int32(int32((b[15]&0xc0)>>6) + (int32((b[16])<<2) << 8) + (int32(b[17]) << 16) + (int32((b[18] & 0x7)) << 24))
-	out[7] = int32(int32((b[18]&0xf8)>>3) + (int32((b[19])<<5) << 8) + (int32(b[20]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x1FFFFF)
+	out[1] = int32((uint32(b[2])>>5 | uint32(b[3])<<3 | uint32(b[4])<<11 | uint32(b[5])<<19) & 0x1FFFFF)
+	out[2] = int32((uint32(b[5])>>2 | uint32(b[6])<<6 | uint32(b[7])<<14) & 0x1FFFFF)
+	out[3] = int32((uint32(b[7])>>7 | uint32(b[8])<<1 | uint32(b[9])<<9 | uint32(b[10])<<17) & 0x1FFFFF)
+	out[4] = int32((uint32(b[10])>>4 | uint32(b[11])<<4 | uint32(b[12])<<12 | uint32(b[13])<<20) & 0x1FFFFF)
+	out[5] = int32((uint32(b[13])>>1 | uint32(b[14])<<7 | uint32(b[15])<<15) & 0x1FFFFF)
+	out[6] = int32((uint32(b[15])>>6 | uint32(b[16])<<2 | uint32(b[17])<<10 | uint32(b[18])<<18) & 0x1FFFFF)
+	out[7] = int32((uint32(b[18])>>3 | uint32(b[19])<<5 | uint32(b[20])<<13) & 0x1FFFFF)
 
 	return nil
 }
@@ -1589,14 +1610,15 @@ func (d *Decoder) decode22RLE(b []byte, out []int32) error {
 	if len(b) != 22 {
 		panic(fmt.Sprint("expected: ", 22, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0x3f)) << 16))
-	out[1] = int32(int32((b[2]&0xc0)>>6) + (int32((b[3])<<2) << 8) + (int32(b[4]) << 16) + (int32((b[5] & 0xf)) << 24))
-	out[2] = int32(int32((b[5]&0xf0)>>4) + (int32((b[6])<<4) << 8) + (int32(b[7]) << 16) + (int32((b[8] & 0x3)) << 24))
-	out[3] = int32(int32((b[8]&0xfc)>>2) + (int32((b[9])<<6) << 8) + (int32(b[10]) << 16))
-	out[4] = int32(int32(b[11]) + (int32(b[12]) << 8) + (int32((b[13] & 0x3f)) << 16))
-	out[5] = int32(int32((b[13]&0xc0)>>6) + (int32((b[14])<<2) << 8) + (int32(b[15]) << 16) + (int32((b[16] & 0xf)) << 24))
-	out[6] = int32(int32((b[16]&0xf0)>>4) + (int32((b[17])<<4) << 8) + (int32(b[18]) << 16) + (int32((b[19] & 0x3)) << 24))
-	out[7] = int32(int32((b[19]&0xfc)>>2) + (int32((b[20])<<6) << 8) + (int32(b[21]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x3FFFFF)
+	out[1] = int32((uint32(b[2])>>6 | uint32(b[3])<<2 | uint32(b[4])<<10 | uint32(b[5])<<18) & 0x3FFFFF)
+	out[2] = int32((uint32(b[5])>>4 | uint32(b[6])<<4 | uint32(b[7])<<12 | uint32(b[8])<<20) & 0x3FFFFF)
+	out[3] = int32((uint32(b[8])>>2 | uint32(b[9])<<6 | uint32(b[10])<<14) & 0x3FFFFF)
+	out[4] = int32((uint32(b[11]) | uint32(b[12])<<8 | uint32(b[13])<<16) & 0x3FFFFF)
+	out[5] = int32((uint32(b[13])>>6 | uint32(b[14])<<2 | uint32(b[15])<<10 | uint32(b[16])<<18) & 0x3FFFFF)
+	out[6] = int32((uint32(b[16])>>4 | uint32(b[17])<<4 | uint32(b[18])<<12 | uint32(b[19])<<20) & 0x3FFFFF)
+	out[7] = int32((uint32(b[19])>>2 | uint32(b[20])<<6 | uint32(b[21])<<14) & 0x3FFFFF)
 
 	return nil
 }
@@ -1606,14 +1628,15 @@ func (d *Decoder) decode23RLE(b []byte, out []int32) error {
 	if len(b) != 23 {
 		panic(fmt.Sprint("expected: ", 23, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32((b[2] & 0x7f)) << 16))
-	out[1] = int32(int32((b[2]&0x80)>>7) + (int32((b[3])<<1) << 8) + (int32(b[4]) << 16) + (int32((b[5] & 0x3f)) << 24))
-	out[2] = int32(int32((b[5]&0xc0)>>6) + (int32((b[6])<<2) << 8) + (int32(b[7]) << 16) + (int32((b[8] & 0x1f)) << 24))
-	out[3] = int32(int32((b[8]&0xe0)>>5) + (int32((b[9])<<3) << 8) + (int32(b[10]) << 16) + (int32((b[11] & 0xf)) << 24))
-	out[4] = int32(int32((b[11]&0xf0)>>4) + (int32((b[12])<<4) << 8) + (int32(b[13]) << 16) + (int32((b[14] & 0x7)) << 24))
-	out[5] = int32(int32((b[14]&0xf8)>>3) + (int32((b[15])<<5) << 8) + (int32(b[16]) << 16) + (int32((b[17] & 0x3)) << 24))
-	out[6] = int32(int32((b[17]&0xfc)>>2) + (int32((b[18])<<6) << 8) + (int32(b[19]) << 16) + (int32((b[20] & 0x1)) << 24))
-	out[7] = int32(int32((b[20]&0xfe)>>1) + (int32((b[21])<<7) << 8) + (int32(b[22]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x7FFFFF)
+	out[1] = int32((uint32(b[2])>>7 | uint32(b[3])<<1 | uint32(b[4])<<9 | uint32(b[5])<<17) & 0x7FFFFF)
+	out[2] = int32((uint32(b[5])>>6 | uint32(b[6])<<2 | uint32(b[7])<<10 | uint32(b[8])<<18) & 0x7FFFFF)
+	out[3] = int32((uint32(b[8])>>5 | uint32(b[9])<<3 | uint32(b[10])<<11 | uint32(b[11])<<19) & 0x7FFFFF)
+	out[4] = int32((uint32(b[11])>>4 | uint32(b[12])<<4 | uint32(b[13])<<12 | uint32(b[14])<<20) & 0x7FFFFF)
+	out[5] = int32((uint32(b[14])>>3 | uint32(b[15])<<5 | uint32(b[16])<<13 | uint32(b[17])<<21) & 0x7FFFFF)
+	out[6] = int32((uint32(b[17])>>2 | uint32(b[18])<<6 | uint32(b[19])<<14 | uint32(b[20])<<22) & 0x7FFFFF)
+	out[7] = int32((uint32(b[20])>>1 | uint32(b[21])<<7 | uint32(b[22])<<15) & 0x7FFFFF)
 
 	return nil
 }
@@ -1623,14 +1646,15 @@ func (d *Decoder) decode24RLE(b []byte, out []int32) error {
 	if len(b) != 24 {
 		panic(fmt.Sprint("expected: ", 24, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16))
-	out[1] = int32(int32(b[3]) + (int32(b[4]) << 8) + (int32(b[5]) << 16))
-	out[2] = int32(int32(b[6]) + (int32(b[7]) << 8) + (int32(b[8]) << 16))
-	out[3] = int32(int32(b[9]) + (int32(b[10]) << 8) + (int32(b[11]) << 16))
-	out[4] = int32(int32(b[12]) + (int32(b[13]) << 8) + (int32(b[14]) << 16))
-	out[5] = int32(int32(b[15]) + (int32(b[16]) << 8) + (int32(b[17]) << 16))
-	out[6] = int32(int32(b[18]) + (int32(b[19]) << 8) + (int32(b[20]) << 16))
-	out[7] = int32(int32(b[21]) + (int32(b[22]) << 8) + (int32(b[23]) << 16))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16)
+	out[1] = int32(uint32(b[3]) | uint32(b[4])<<8 | uint32(b[5])<<16)
+	out[2] = int32(uint32(b[6]) | uint32(b[7])<<8 | uint32(b[8])<<16)
+	out[3] = int32(uint32(b[9]) | uint32(b[10])<<8 | uint32(b[11])<<16)
+	out[4] = int32(uint32(b[12]) | uint32(b[13])<<8 | uint32(b[14])<<16)
+	out[5] = int32(uint32(b[15]) | uint32(b[16])<<8 | uint32(b[17])<<16)
+	out[6] = int32(uint32(b[18]) | uint32(b[19])<<8 | uint32(b[20])<<16)
+	out[7] = int32(uint32(b[21]) | uint32(b[22])<<8 | uint32(b[23])<<16)
 
 	return nil
 }
@@ -1640,14 +1664,15 @@ func (d *Decoder) decode25RLE(b []byte, out []int32) error {
 	if len(b) != 25 {
 		panic(fmt.Sprint("expected: ", 25, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32((b[3] & 0x1)) << 24))
-	out[1] = int32(int32((b[3]&0xfe)>>1) + (int32((b[4])<<7) << 8) + (int32(b[5]) << 16) + (int32((b[6] & 0x3)) << 24))
-	out[2] = int32(int32((b[6]&0xfc)>>2) + (int32((b[7])<<6) << 8) + (int32(b[8]) << 16) + (int32((b[9] & 0x7)) << 24))
-	out[3] = int32(int32((b[9]&0xf8)>>3) + (int32((b[10])<<5) << 8) + (int32(b[11]) << 16) + (int32((b[12] & 0xf)) << 24))
-	out[4] = int32(int32((b[12]&0xf0)>>4) + (int32((b[13])<<4) << 8) + (int32(b[14]) << 16) + (int32((b[15] & 0x1f)) << 24))
-	out[5] = int32(int32((b[15]&0xe0)>>5) + (int32((b[16])<<3) << 8) + (int32(b[17]) << 16) + (int32((b[18] & 0x3f)) << 24))
-	out[6] = int32(int32((b[18]&0xc0)>>6) + (int32((b[19])<<2) << 8) + (int32(b[20]) << 16) + (int32((b[21] & 0x7f)) << 24))
-	out[7] = int32(int32((b[21]&0x80)>>7) + (int32((b[22])<<1) << 8) + (int32(b[23]) << 16) + (int32(b[24]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x1FFFFFF)
+	out[1] = int32((uint32(b[3])>>1 | uint32(b[4])<<7 | uint32(b[5])<<15 | uint32(b[6])<<23) & 0x1FFFFFF)
+	out[2] = int32((uint32(b[6])>>2 | uint32(b[7])<<6 | uint32(b[8])<<14 | uint32(b[9])<<22) & 0x1FFFFFF)
+	out[3] = int32((uint32(b[9])>>3 | uint32(b[10])<<5 | uint32(b[11])<<13 | uint32(b[12])<<21) & 0x1FFFFFF)
+	out[4] = int32((uint32(b[12])>>4 | uint32(b[13])<<4 | uint32(b[14])<<12 | uint32(b[15])<<20) & 0x1FFFFFF)
+	out[5] = int32((uint32(b[15])>>5 | uint32(b[16])<<3 | uint32(b[17])<<11 | uint32(b[18])<<19) & 0x1FFFFFF)
+	out[6] = int32((uint32(b[18])>>6 | uint32(b[19])<<2 | uint32(b[20])<<10 | uint32(b[21])<<18) & 0x1FFFFFF)
+	out[7] = int32((uint32(b[21])>>7 | uint32(b[22])<<1 | uint32(b[23])<<9 | uint32(b[24])<<17) & 0x1FFFFFF)
 
 	return nil
 }
@@ -1657,14 +1682,15 @@ func (d *Decoder) decode26RLE(b []byte, out []int32) error {
 	if len(b) != 26 {
 		panic(fmt.Sprint("expected: ", 26, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32((b[3] & 0x3)) << 24))
-	out[1] = int32(int32((b[3]&0xfc)>>2) + (int32((b[4])<<6) << 8) + (int32(b[5]) << 16) + (int32((b[6] & 0xf)) << 24))
-	out[2] = int32(int32((b[6]&0xf0)>>4) + (int32((b[7])<<4) << 8) + (int32(b[8]) << 16) + (int32((b[9] & 0x3f)) << 24))
-	out[3] = int32(int32((b[9]&0xc0)>>6) + (int32((b[10])<<2) << 8) + (int32(b[11]) << 16) + (int32(b[12]) << 24))
-	out[4] = int32(int32(b[13]) + (int32(b[14]) << 8) + (int32(b[15]) << 16) + (int32((b[16] & 0x3)) << 24))
-	out[5] = int32(int32((b[16]&0xfc)>>2) + (int32((b[17])<<6) << 8) + (int32(b[18]) << 16) + (int32((b[19] & 0xf)) << 24))
-	out[6] = int32(int32((b[19]&0xf0)>>4) + (int32((b[20])<<4) << 8) + (int32(b[21]) << 16) + (int32((b[22] & 0x3f)) << 24))
-	out[7] = int32(int32((b[22]&0xc0)>>6) + (int32((b[23])<<2) << 8) + (int32(b[24]) << 16) + (int32(b[25]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x3FFFFFF)
+	out[1] = int32((uint32(b[3])>>2 | uint32(b[4])<<6 | uint32(b[5])<<14 | uint32(b[6])<<22) & 0x3FFFFFF)
+	out[2] = int32((uint32(b[6])>>4 | uint32(b[7])<<4 | uint32(b[8])<<12 | uint32(b[9])<<20) & 0x3FFFFFF)
+	out[3] = int32((uint32(b[9])>>6 | uint32(b[10])<<2 | uint32(b[11])<<10 | uint32(b[12])<<18) & 0x3FFFFFF)
+	out[4] = int32((uint32(b[13]) | uint32(b[14])<<8 | uint32(b[15])<<16 | uint32(b[16])<<24) & 0x3FFFFFF)
+	out[5] = int32((uint32(b[16])>>2 | uint32(b[17])<<6 | uint32(b[18])<<14 | uint32(b[19])<<22) & 0x3FFFFFF)
+	out[6] = int32((uint32(b[19])>>4 | uint32(b[20])<<4 | uint32(b[21])<<12 | uint32(b[22])<<20) & 0x3FFFFFF)
+	out[7] = int32((uint32(b[22])>>6 | uint32(b[23])<<2 | uint32(b[24])<<10 | uint32(b[25])<<18) & 0x3FFFFFF)
 
 	return nil
 }
@@ -1674,14 +1700,15 @@ func (d *Decoder) decode27RLE(b []byte, out []int32) error {
 	if len(b) != 27 {
 		panic(fmt.Sprint("expected: ", 27, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32((b[3] & 0x7)) << 24))
-	out[1] = int32(int32((b[3]&0xf8)>>3) + (int32((b[4])<<5) << 8) + (int32(b[5]) << 16) + (int32((b[6] & 0x3f)) << 24))
-	out[2] = int32(int32((b[6]&0xc0)>>6) + (int32((b[7])<<2) << 8) + (int32(b[8]) << 16) + (int32(b[9]) << 24) + (int32((b[10] & 0x1)) << 32))
-	out[3] = int32(int32((b[10]&0xfe)>>1) + (int32((b[11])<<7) << 8) + (int32(b[12]) << 16) + (int32((b[13] & 0xf)) << 24))
-	out[4] = int32(int32((b[13]&0xf0)>>4) + (int32((b[14])<<4) << 8) + (int32(b[15]) << 16) + (int32((b[16] & 0x7f)) << 24))
-	out[5] = int32(int32((b[16]&0x80)>>7) + (int32((b[17])<<1) << 8) + (int32(b[18]) << 16) + (int32(b[19]) << 24) + (int32((b[20] & 0x3)) << 32))
-	out[6] = int32(int32((b[20]&0xfc)>>2) + (int32((b[21])<<6) << 8) + (int32(b[22]) << 16) + (int32((b[23] & 0x1f)) << 24))
-	out[7] = int32(int32((b[23]&0xe0)>>5) + (int32((b[24])<<3) << 8) + (int32(b[25]) << 16) + (int32(b[26]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x7FFFFFF)
+	out[1] = int32((uint32(b[3])>>3 | uint32(b[4])<<5 | uint32(b[5])<<13 | uint32(b[6])<<21) & 0x7FFFFFF)
+	out[2] = int32((uint32(b[6])>>6 | uint32(b[7])<<2 | uint32(b[8])<<10 | uint32(b[9])<<18 | uint32(b[10])<<26) & 0x7FFFFFF)
+	out[3] = int32((uint32(b[10])>>1 | uint32(b[11])<<7 | uint32(b[12])<<15 | uint32(b[13])<<23) & 0x7FFFFFF)
+	out[4] = int32((uint32(b[13])>>4 | uint32(b[14])<<4 | uint32(b[15])<<12 | uint32(b[16])<<20) & 0x7FFFFFF)
+	out[5] = int32((uint32(b[16])>>7 | uint32(b[17])<<1 | uint32(b[18])<<9 | uint32(b[19])<<17 | uint32(b[20])<<25) & 0x7FFFFFF)
+	out[6] = int32((uint32(b[20])>>2 | uint32(b[21])<<6 | uint32(b[22])<<14 | uint32(b[23])<<22) & 0x7FFFFFF)
+	out[7] = int32((uint32(b[23])>>5 | uint32(b[24])<<3 | uint32(b[25])<<11 | uint32(b[26])<<19) & 0x7FFFFFF)
 
 	return nil
 }
@@ -1691,14 +1718,15 @@ func (d *Decoder) decode28RLE(b []byte, out []int32) error {
 	if len(b) != 28 {
 		panic(fmt.Sprint("expected: ", 28, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32((b[3] & 0xf)) << 24))
-	out[1] = int32(int32((b[3]&0xf0)>>4) + (int32((b[4])<<4) << 8) + (int32(b[5]) << 16) + (int32(b[6]) << 24))
-	out[2] = int32(int32(b[7]) + (int32(b[8]) << 8) + (int32(b[9]) << 16) + (int32((b[10] & 0xf)) << 24))
-	out[3] = int32(int32((b[10]&0xf0)>>4) + (int32((b[11])<<4) << 8) + (int32(b[12]) << 16) + (int32(b[13]) << 24))
-	out[4] = int32(int32(b[14]) + (int32(b[15]) << 8) + (int32(b[16]) << 16) + (int32((b[17] & 0xf)) << 24))
-	out[5] = int32(int32((b[17]&0xf0)>>4) + (int32((b[18])<<4) << 8) + (int32(b[19]) << 16) + (int32(b[20]) << 24))
-	out[6] = int32(int32(b[21]) + (int32(b[22]) << 8) + (int32(b[23]) << 16) + (int32((b[24] & 0xf)) << 24))
-	out[7] = int32(int32((b[24]&0xf0)>>4) + (int32((b[25])<<4) << 8) + (int32(b[26]) << 16) + (int32(b[27]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0xFFFFFFF)
+	out[1] = int32((uint32(b[3])>>4 | uint32(b[4])<<4 | uint32(b[5])<<12 | uint32(b[6])<<20) & 0xFFFFFFF)
+	out[2] = int32((uint32(b[7]) | uint32(b[8])<<8 | uint32(b[9])<<16 | uint32(b[10])<<24) & 0xFFFFFFF)
+	out[3] = int32((uint32(b[10])>>4 | uint32(b[11])<<4 | uint32(b[12])<<12 | uint32(b[13])<<20) & 0xFFFFFFF)
+	out[4] = int32((uint32(b[14]) | uint32(b[15])<<8 | uint32(b[16])<<16 | uint32(b[17])<<24) & 0xFFFFFFF)
+	out[5] = int32((uint32(b[17])>>4 | uint32(b[18])<<4 | uint32(b[19])<<12 | uint32(b[20])<<20) & 0xFFFFFFF)
+	out[6] = int32((uint32(b[21]) | uint32(b[22])<<8 | uint32(b[23])<<16 | uint32(b[24])<<24) & 0xFFFFFFF)
+	out[7] = int32((uint32(b[24])>>4 | uint32(b[25])<<4 | uint32(b[26])<<12 | uint32(b[27])<<20) & 0xFFFFFFF)
 
 	return nil
 }
@@ -1708,14 +1736,15 @@ func (d *Decoder) decode29RLE(b []byte, out []int32) error {
 	if len(b) != 29 {
 		panic(fmt.Sprint("expected: ", 29, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32((b[3] & 0x1f)) << 24))
-	out[1] = int32(int32((b[3]&0xe0)>>5) + (int32((b[4])<<3) << 8) + (int32(b[5]) << 16) + (int32(b[6]) << 24) + (int32((b[7] & 0x3)) << 32))
-	out[2] = int32(int32((b[7]&0xfc)>>2) + (int32((b[8])<<6) << 8) + (int32(b[9]) << 16) + (int32((b[10] & 0x7f)) << 24))
-	out[3] = int32(int32((b[10]&0x80)>>7) + (int32((b[11])<<1) << 8) + (int32(b[12]) << 16) + (int32(b[13]) << 24) + (int32((b[14] & 0xf)) << 32))
-	out[4] = int32(int32((b[14]&0xf0)>>4) + (int32((b[15])<<4) << 8) + (int32(b[16]) << 16) + (int32(b[17]) << 24) + (int32((b[18] & 0x1)) << 32))
-	out[5] = int32(int32((b[18]&0xfe)>>1) + (int32((b[19])<<7) << 8) + (int32(b[20]) << 16) + (int32((b[21] & 0x3f)) << 24))
-	out[6] = int32(int32((b[21]&0xc0)>>6) + (int32((b[22])<<2) << 8) + (int32(b[23]) << 16) + (int32(b[24]) << 24) + (int32((b[25] & 0x7)) << 32))
-	out[7] = int32(int32((b[25]&0xf8)>>3) + (int32((b[26])<<5) << 8) + (int32(b[27]) << 16) + (int32(b[28]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x1FFFFFFF)
+	out[1] = int32((uint32(b[3])>>5 | uint32(b[4])<<3 | uint32(b[5])<<11 | uint32(b[6])<<19 | uint32(b[7])<<27) & 0x1FFFFFFF)
+	out[2] = int32((uint32(b[7])>>2 | uint32(b[8])<<6 | uint32(b[9])<<14 | uint32(b[10])<<22) & 0x1FFFFFFF)
+	out[3] = int32((uint32(b[10])>>7 | uint32(b[11])<<1 | uint32(b[12])<<9 | uint32(b[13])<<17 | uint32(b[14])<<25) & 0x1FFFFFFF)
+	out[4] = int32((uint32(b[14])>>4 | uint32(b[15])<<4 | uint32(b[16])<<12 | uint32(b[17])<<20 | uint32(b[18])<<28) & 0x1FFFFFFF)
+	out[5] = int32((uint32(b[18])>>1 | uint32(b[19])<<7 | uint32(b[20])<<15 | uint32(b[21])<<23) & 0x1FFFFFFF)
+	out[6] = int32((uint32(b[21])>>6 | uint32(b[22])<<2 | uint32(b[23])<<10 | uint32(b[24])<<18 | uint32(b[25])<<26) & 0x1FFFFFFF)
+	out[7] = int32((uint32(b[25])>>3 | uint32(b[26])<<5 | uint32(b[27])<<13 | uint32(b[28])<<21) & 0x1FFFFFFF)
 
 	return nil
 }
@@ -1725,14 +1754,15 @@ func (d *Decoder) decode30RLE(b []byte, out []int32) error {
 	if len(b) != 30 {
 		panic(fmt.Sprint("expected: ", 30, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32((b[3] & 0x3f)) << 24))
-	out[1] = int32(int32((b[3]&0xc0)>>6) + (int32((b[4])<<2) << 8) + (int32(b[5]) << 16) + (int32(b[6]) << 24) + (int32((b[7] & 0xf)) << 32))
-	out[2] = int32(int32((b[7]&0xf0)>>4) + (int32((b[8])<<4) << 8) + (int32(b[9]) << 16) + (int32(b[10]) << 24) + (int32((b[11] & 0x3)) << 32))
-	out[3] = int32(int32((b[11]&0xfc)>>2) + (int32((b[12])<<6) << 8) + (int32(b[13]) << 16) + (int32(b[14]) << 24))
-	out[4] = int32(int32(b[15]) + (int32(b[16]) << 8) + (int32(b[17]) << 16) + (int32((b[18] & 0x3f)) << 24))
-	out[5] = int32(int32((b[18]&0xc0)>>6) + (int32((b[19])<<2) << 8) + (int32(b[20]) << 16) + (int32(b[21]) << 24) + (int32((b[22] & 0xf)) << 32))
-	out[6] = int32(int32((b[22]&0xf0)>>4) + (int32((b[23])<<4) << 8) + (int32(b[24]) << 16) + (int32(b[25]) << 24) + (int32((b[26] & 0x3)) << 32))
-	out[7] = int32(int32((b[26]&0xfc)>>2) + (int32((b[27])<<6) << 8) + (int32(b[28]) << 16) + (int32(b[29]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x3FFFFFFF)
+	out[1] = int32((uint32(b[3])>>6 | uint32(b[4])<<2 | uint32(b[5])<<10 | uint32(b[6])<<18 | uint32(b[7])<<26) & 0x3FFFFFFF)
+	out[2] = int32((uint32(b[7])>>4 | uint32(b[8])<<4 | uint32(b[9])<<12 | uint32(b[10])<<20 | uint32(b[11])<<28) & 0x3FFFFFFF)
+	out[3] = int32((uint32(b[11])>>2 | uint32(b[12])<<6 | uint32(b[13])<<14 | uint32(b[14])<<22) & 0x3FFFFFFF)
+	out[4] = int32((uint32(b[15]) | uint32(b[16])<<8 | uint32(b[17])<<16 | uint32(b[18])<<24) & 0x3FFFFFFF)
+	out[5] = int32((uint32(b[18])>>6 | uint32(b[19])<<2 | uint32(b[20])<<10 | uint32(b[21])<<18 | uint32(b[22])<<26) & 0x3FFFFFFF)
+	out[6] = int32((uint32(b[22])>>4 | uint32(b[23])<<4 | uint32(b[24])<<12 | uint32(b[25])<<20 | uint32(b[26])<<28) & 0x3FFFFFFF)
+	out[7] = int32((uint32(b[26])>>2 | uint32(b[27])<<6 | uint32(b[28])<<14 | uint32(b[29])<<22) & 0x3FFFFFFF)
 
 	return nil
 }
@@ -1742,14 +1772,15 @@ func (d *Decoder) decode31RLE(b []byte, out []int32) error {
 	if len(b) != 31 {
 		panic(fmt.Sprint("expected: ", 31, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32((b[3] & 0x7f)) << 24))
-	out[1] = int32(int32((b[3]&0x80)>>7) + (int32((b[4])<<1) << 8) + (int32(b[5]) << 16) + (int32(b[6]) << 24) + (int32((b[7] & 0x3f)) << 32))
-	out[2] = int32(int32((b[7]&0xc0)>>6) + (int32((b[8])<<2) << 8) + (int32(b[9]) << 16) + (int32(b[10]) << 24) + (int32((b[11] & 0x1f)) << 32))
-	out[3] = int32(int32((b[11]&0xe0)>>5) + (int32((b[12])<<3) << 8) + (int32(b[13]) << 16) + (int32(b[14]) << 24) + (int32((b[15] & 0xf)) << 32))
-	out[4] = int32(int32((b[15]&0xf0)>>4) + (int32((b[16])<<4) << 8) + (int32(b[17]) << 16) + (int32(b[18]) << 24) + (int32((b[19] & 0x7)) << 32))
-	out[5] = int32(int32((b[19]&0xf8)>>3) + (int32((b[20])<<5) << 8) + (int32(b[21]) << 16) + (int32(b[22]) << 24) + (int32((b[23] & 0x3)) << 32))
-	out[6] = int32(int32((b[23]&0xfc)>>2) + (int32((b[24])<<6) << 8) + (int32(b[25]) << 16) + (int32(b[26]) << 24) + (int32((b[27] & 0x1)) << 32))
-	out[7] = int32(int32((b[27]&0xfe)>>1) + (int32((b[28])<<7) << 8) + (int32(b[29]) << 16) + (int32(b[30]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x7FFFFFFF)
+	out[1] = int32((uint32(b[3])>>7 | uint32(b[4])<<1 | uint32(b[5])<<9 | uint32(b[6])<<17 | uint32(b[7])<<25) & 0x7FFFFFFF)
+	out[2] = int32((uint32(b[7])>>6 | uint32(b[8])<<2 | uint32(b[9])<<10 | uint32(b[10])<<18 | uint32(b[11])<<26) & 0x7FFFFFFF)
+	out[3] = int32((uint32(b[11])>>5 | uint32(b[12])<<3 | uint32(b[13])<<11 | uint32(b[14])<<19 | uint32(b[15])<<27) & 0x7FFFFFFF)
+	out[4] = int32((uint32(b[15])>>4 | uint32(b[16])<<4 | uint32(b[17])<<12 | uint32(b[18])<<20 | uint32(b[19])<<28) & 0x7FFFFFFF)
+	out[5] = int32((uint32(b[19])>>3 | uint32(b[20])<<5 | uint32(b[21])<<13 | uint32(b[22])<<21 | uint32(b[23])<<29) & 0x7FFFFFFF)
+	out[6] = int32((uint32(b[23])>>2 | uint32(b[24])<<6 | uint32(b[25])<<14 | uint32(b[26])<<22 | uint32(b[27])<<30) & 0x7FFFFFFF)
+	out[7] = int32((uint32(b[27])>>1 | uint32(b[28])<<7 | uint32(b[29])<<15 | uint32(b[30])<<23) & 0x7FFFFFFF)
 
 	return nil
 }
@@ -1759,14 +1790,1153 @@ func (d *Decoder) decode32RLE(b []byte, out []int32) error {
 	if len(b) != 32 {
 		panic(fmt.Sprint("expected: ", 32, " got ", len(b)))
 	}
-	out[0] = int32(int32(b[0]) + (int32(b[1]) << 8) + (int32(b[2]) << 16) + (int32(b[3]) << 24))
-	out[1] = int32(int32(b[4]) + (int32(b[5]) << 8) + (int32(b[6]) << 16) + (int32(b[7]) << 24))
-	out[2] = int32(int32(b[8]) + (int32(b[9]) << 8) + (int32(b[10]) << 16) + (int32(b[11]) << 24))
-	out[3] = int32(int32(b[12]) + (int32(b[13]) << 8) + (int32(b[14]) << 16) + (int32(b[15]) << 24))
-	out[4] = int32(int32(b[16]) + (int32(b[17]) << 8) + (int32(b[18]) << 16) + (int32(b[19]) << 24))
-	out[5] = int32(int32(b[20]) + (int32(b[21]) << 8) + (int32(b[22]) << 16) + (int32(b[23]) << 24))
-	out[6] = int32(int32(b[24]) + (int32(b[25]) << 8) + (int32(b[26]) << 16) + (int32(b[27]) << 24))
-	out[7] = int32(int32(b[28]) + (int32(b[29]) << 8) + (int32(b[30]) << 16) + (int32(b[31]) << 24))
+	_ = out[7] // eliminate bounds checks
+	out[0] = int32(uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24)
+	out[1] = int32(uint32(b[4]) | uint32(b[5])<<8 | uint32(b[6])<<16 | uint32(b[7])<<24)
+	out[2] = int32(uint32(b[8]) | uint32(b[9])<<8 | uint32(b[10])<<16 | uint32(b[11])<<24)
+	out[3] = int32(uint32(b[12]) | uint32(b[13])<<8 | uint32(b[14])<<16 | uint32(b[15])<<24)
+	out[4] = int32(uint32(b[16]) | uint32(b[17])<<8 | uint32(b[18])<<16 | uint32(b[19])<<24)
+	out[5] = int32(uint32(b[20]) | uint32(b[21])<<8 | uint32(b[22])<<16 | uint32(b[23])<<24)
+	out[6] = int32(uint32(b[24]) | uint32(b[25])<<8 | uint32(b[26])<<16 | uint32(b[27])<<24)
+	out[7] = int32(uint32(b[28]) | uint32(b[29])<<8 | uint32(b[30])<<16 | uint32(b[31])<<24)
 
 	return nil
 }
+
+func unpackGroups1(b []byte, out []int32) {
+
+	for len(b) >= 1 && len(out) >= 8 {
+		_ = b[0]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) & 0x1)
+		out[1] = int32((uint32(b[0]) >> 1) & 0x1)
+		out[2] = int32((uint32(b[0]) >> 2) & 0x1)
+		out[3] = int32((uint32(b[0]) >> 3) & 0x1)
+		out[4] = int32((uint32(b[0]) >> 4) & 0x1)
+		out[5] = int32((uint32(b[0]) >> 5) & 0x1)
+		out[6] = int32((uint32(b[0]) >> 6) & 0x1)
+		out[7] = int32((uint32(b[0]) >> 7) & 0x1)
+		b, out = b[1:], out[8:]
+	}
+}
+
+func unpackGroups2(b []byte, out []int32) {
+
+	for len(b) >= 2 && len(out) >= 8 {
+		_ = b[1]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) & 0x3)
+		out[1] = int32((uint32(b[0]) >> 2) & 0x3)
+		out[2] = int32((uint32(b[0]) >> 4) & 0x3)
+		out[3] = int32((uint32(b[0]) >> 6) & 0x3)
+		out[4] = int32(uint32(b[1]) & 0x3)
+		out[5] = int32((uint32(b[1]) >> 2) & 0x3)
+		out[6] = int32((uint32(b[1]) >> 4) & 0x3)
+		out[7] = int32((uint32(b[1]) >> 6) & 0x3)
+		b, out = b[2:], out[8:]
+	}
+}
+
+func unpackGroups3(b []byte, out []int32) {
+
+	for len(b) >= 3 && len(out) >= 8 {
+		_ = b[2]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) & 0x7)
+		out[1] = int32((uint32(b[0]) >> 3) & 0x7)
+		out[2] = int32((uint32(b[0])>>6 | uint32(b[1])<<2) & 0x7)
+		out[3] = int32((uint32(b[1]) >> 1) & 0x7)
+		out[4] = int32((uint32(b[1]) >> 4) & 0x7)
+		out[5] = int32((uint32(b[1])>>7 | uint32(b[2])<<1) & 0x7)
+		out[6] = int32((uint32(b[2]) >> 2) & 0x7)
+		out[7] = int32((uint32(b[2]) >> 5) & 0x7)
+		b, out = b[3:], out[8:]
+	}
+}
+
+func unpackGroups4(b []byte, out []int32) {
+
+	for len(b) >= 4 && len(out) >= 8 {
+		_ = b[3]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) & 0xF)
+		out[1] = int32((uint32(b[0]) >> 4) & 0xF)
+		out[2] = int32(uint32(b[1]) & 0xF)
+		out[3] = int32((uint32(b[1]) >> 4) & 0xF)
+		out[4] = int32(uint32(b[2]) & 0xF)
+		out[5] = int32((uint32(b[2]) >> 4) & 0xF)
+		out[6] = int32(uint32(b[3]) & 0xF)
+		out[7] = int32((uint32(b[3]) >> 4) & 0xF)
+		b, out = b[4:], out[8:]
+	}
+}
+
+func unpackGroups5(b []byte, out []int32) {
+
+	for len(b) >= 5 && len(out) >= 8 {
+		_ = b[4]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) & 0x1F)
+		out[1] = int32((uint32(b[0])>>5 | uint32(b[1])<<3) & 0x1F)
+		out[2] = int32((uint32(b[1]) >> 2) & 0x1F)
+		out[3] = int32((uint32(b[1])>>7 | uint32(b[2])<<1) & 0x1F)
+		out[4] = int32((uint32(b[2])>>4 | uint32(b[3])<<4) & 0x1F)
+		out[5] = int32((uint32(b[3]) >> 1) & 0x1F)
+		out[6] = int32((uint32(b[3])>>6 | uint32(b[4])<<2) & 0x1F)
+		out[7] = int32((uint32(b[4]) >> 3) & 0x1F)
+		b, out = b[5:], out[8:]
+	}
+}
+
+func unpackGroups6(b []byte, out []int32) {
+
+	for len(b) >= 6 && len(out) >= 8 {
+		_ = b[5]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) & 0x3F)
+		out[1] = int32((uint32(b[0])>>6 | uint32(b[1])<<2) & 0x3F)
+		out[2] = int32((uint32(b[1])>>4 | uint32(b[2])<<4) & 0x3F)
+		out[3] = int32((uint32(b[2]) >> 2) & 0x3F)
+		out[4] = int32(uint32(b[3]) & 0x3F)
+		out[5] = int32((uint32(b[3])>>6 | uint32(b[4])<<2) & 0x3F)
+		out[6] = int32((uint32(b[4])>>4 | uint32(b[5])<<4) & 0x3F)
+		out[7] = int32((uint32(b[5]) >> 2) & 0x3F)
+		b, out = b[6:], out[8:]
+	}
+}
+
+func unpackGroups7(b []byte, out []int32) {
+
+	for len(b) >= 7 && len(out) >= 8 {
+		_ = b[6]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) & 0x7F)
+		out[1] = int32((uint32(b[0])>>7 | uint32(b[1])<<1) & 0x7F)
+		out[2] = int32((uint32(b[1])>>6 | uint32(b[2])<<2) & 0x7F)
+		out[3] = int32((uint32(b[2])>>5 | uint32(b[3])<<3) & 0x7F)
+		out[4] = int32((uint32(b[3])>>4 | uint32(b[4])<<4) & 0x7F)
+		out[5] = int32((uint32(b[4])>>3 | uint32(b[5])<<5) & 0x7F)
+		out[6] = int32((uint32(b[5])>>2 | uint32(b[6])<<6) & 0x7F)
+		out[7] = int32((uint32(b[6]) >> 1) & 0x7F)
+		b, out = b[7:], out[8:]
+	}
+}
+
+func unpackGroups8(b []byte, out []int32) {
+
+	for len(b) >= 8 && len(out) >= 8 {
+		_ = b[7]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]))
+		out[1] = int32(uint32(b[1]))
+		out[2] = int32(uint32(b[2]))
+		out[3] = int32(uint32(b[3]))
+		out[4] = int32(uint32(b[4]))
+		out[5] = int32(uint32(b[5]))
+		out[6] = int32(uint32(b[6]))
+		out[7] = int32(uint32(b[7]))
+		b, out = b[8:], out[8:]
+	}
+}
+
+func unpackGroups9(b []byte, out []int32) {
+
+	for len(b) >= 9 && len(out) >= 8 {
+		_ = b[8]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x1FF)
+		out[1] = int32((uint32(b[1])>>1 | uint32(b[2])<<7) & 0x1FF)
+		out[2] = int32((uint32(b[2])>>2 | uint32(b[3])<<6) & 0x1FF)
+		out[3] = int32((uint32(b[3])>>3 | uint32(b[4])<<5) & 0x1FF)
+		out[4] = int32((uint32(b[4])>>4 | uint32(b[5])<<4) & 0x1FF)
+		out[5] = int32((uint32(b[5])>>5 | uint32(b[6])<<3) & 0x1FF)
+		out[6] = int32((uint32(b[6])>>6 | uint32(b[7])<<2) & 0x1FF)
+		out[7] = int32((uint32(b[7])>>7 | uint32(b[8])<<1) & 0x1FF)
+		b, out = b[9:], out[8:]
+	}
+}
+
+func unpackGroups10(b []byte, out []int32) {
+
+	for len(b) >= 10 && len(out) >= 8 {
+		_ = b[9]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x3FF)
+		out[1] = int32((uint32(b[1])>>2 | uint32(b[2])<<6) & 0x3FF)
+		out[2] = int32((uint32(b[2])>>4 | uint32(b[3])<<4) & 0x3FF)
+		out[3] = int32((uint32(b[3])>>6 | uint32(b[4])<<2) & 0x3FF)
+		out[4] = int32((uint32(b[5]) | uint32(b[6])<<8) & 0x3FF)
+		out[5] = int32((uint32(b[6])>>2 | uint32(b[7])<<6) & 0x3FF)
+		out[6] = int32((uint32(b[7])>>4 | uint32(b[8])<<4) & 0x3FF)
+		out[7] = int32((uint32(b[8])>>6 | uint32(b[9])<<2) & 0x3FF)
+		b, out = b[10:], out[8:]
+	}
+}
+
+func unpackGroups11(b []byte, out []int32) {
+
+	for len(b) >= 11 && len(out) >= 8 {
+		_ = b[10]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x7FF)
+		out[1] = int32((uint32(b[1])>>3 | uint32(b[2])<<5) & 0x7FF)
+		out[2] = int32((uint32(b[2])>>6 | uint32(b[3])<<2 | uint32(b[4])<<10) & 0x7FF)
+		out[3] = int32((uint32(b[4])>>1 | uint32(b[5])<<7) & 0x7FF)
+		out[4] = int32((uint32(b[5])>>4 | uint32(b[6])<<4) & 0x7FF)
+		out[5] = int32((uint32(b[6])>>7 | uint32(b[7])<<1 | uint32(b[8])<<9) & 0x7FF)
+		out[6] = int32((uint32(b[8])>>2 | uint32(b[9])<<6) & 0x7FF)
+		out[7] = int32((uint32(b[9])>>5 | uint32(b[10])<<3) & 0x7FF)
+		b, out = b[11:], out[8:]
+	}
+}
+
+func unpackGroups12(b []byte, out []int32) {
+
+	for len(b) >= 12 && len(out) >= 8 {
+		_ = b[11]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0xFFF)
+		out[1] = int32((uint32(b[1])>>4 | uint32(b[2])<<4) & 0xFFF)
+		out[2] = int32((uint32(b[3]) | uint32(b[4])<<8) & 0xFFF)
+		out[3] = int32((uint32(b[4])>>4 | uint32(b[5])<<4) & 0xFFF)
+		out[4] = int32((uint32(b[6]) | uint32(b[7])<<8) & 0xFFF)
+		out[5] = int32((uint32(b[7])>>4 | uint32(b[8])<<4) & 0xFFF)
+		out[6] = int32((uint32(b[9]) | uint32(b[10])<<8) & 0xFFF)
+		out[7] = int32((uint32(b[10])>>4 | uint32(b[11])<<4) & 0xFFF)
+		b, out = b[12:], out[8:]
+	}
+}
+
+func unpackGroups13(b []byte, out []int32) {
+
+	for len(b) >= 13 && len(out) >= 8 {
+		_ = b[12]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x1FFF)
+		out[1] = int32((uint32(b[1])>>5 | uint32(b[2])<<3 | uint32(b[3])<<11) & 0x1FFF)
+		out[2] = int32((uint32(b[3])>>2 | uint32(b[4])<<6) & 0x1FFF)
+		out[3] = int32((uint32(b[4])>>7 | uint32(b[5])<<1 | uint32(b[6])<<9) & 0x1FFF)
+		out[4] = int32((uint32(b[6])>>4 | uint32(b[7])<<4 | uint32(b[8])<<12) & 0x1FFF)
+		out[5] = int32((uint32(b[8])>>1 | uint32(b[9])<<7) & 0x1FFF)
+		out[6] = int32((uint32(b[9])>>6 | uint32(b[10])<<2 | uint32(b[11])<<10) & 0x1FFF)
+		out[7] = int32((uint32(b[11])>>3 | uint32(b[12])<<5) & 0x1FFF)
+		b, out = b[13:], out[8:]
+	}
+}
+
+func unpackGroups14(b []byte, out []int32) {
+
+	for len(b) >= 14 && len(out) >= 8 {
+		_ = b[13]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x3FFF)
+		out[1] = int32((uint32(b[1])>>6 | uint32(b[2])<<2 | uint32(b[3])<<10) & 0x3FFF)
+		out[2] = int32((uint32(b[3])>>4 | uint32(b[4])<<4 | uint32(b[5])<<12) & 0x3FFF)
+		out[3] = int32((uint32(b[5])>>2 | uint32(b[6])<<6) & 0x3FFF)
+		out[4] = int32((uint32(b[7]) | uint32(b[8])<<8) & 0x3FFF)
+		out[5] = int32((uint32(b[8])>>6 | uint32(b[9])<<2 | uint32(b[10])<<10) & 0x3FFF)
+		out[6] = int32((uint32(b[10])>>4 | uint32(b[11])<<4 | uint32(b[12])<<12) & 0x3FFF)
+		out[7] = int32((uint32(b[12])>>2 | uint32(b[13])<<6) & 0x3FFF)
+		b, out = b[14:], out[8:]
+	}
+}
+
+func unpackGroups15(b []byte, out []int32) {
+
+	for len(b) >= 15 && len(out) >= 8 {
+		_ = b[14]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8) & 0x7FFF)
+		out[1] = int32((uint32(b[1])>>7 | uint32(b[2])<<1 | uint32(b[3])<<9) & 0x7FFF)
+		out[2] = int32((uint32(b[3])>>6 | uint32(b[4])<<2 | uint32(b[5])<<10) & 0x7FFF)
+		out[3] = int32((uint32(b[5])>>5 | uint32(b[6])<<3 | uint32(b[7])<<11) & 0x7FFF)
+		out[4] = int32((uint32(b[7])>>4 | uint32(b[8])<<4 | uint32(b[9])<<12) & 0x7FFF)
+		out[5] = int32((uint32(b[9])>>3 | uint32(b[10])<<5 | uint32(b[11])<<13) & 0x7FFF)
+		out[6] = int32((uint32(b[11])>>2 | uint32(b[12])<<6 | uint32(b[13])<<14) & 0x7FFF)
+		out[7] = int32((uint32(b[13])>>1 | uint32(b[14])<<7) & 0x7FFF)
+		b, out = b[15:], out[8:]
+	}
+}
+
+func unpackGroups16(b []byte, out []int32) {
+
+	for len(b) >= 16 && len(out) >= 8 {
+		_ = b[15]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) | uint32(b[1])<<8)
+		out[1] = int32(uint32(b[2]) | uint32(b[3])<<8)
+		out[2] = int32(uint32(b[4]) | uint32(b[5])<<8)
+		out[3] = int32(uint32(b[6]) | uint32(b[7])<<8)
+		out[4] = int32(uint32(b[8]) | uint32(b[9])<<8)
+		out[5] = int32(uint32(b[10]) | uint32(b[11])<<8)
+		out[6] = int32(uint32(b[12]) | uint32(b[13])<<8)
+		out[7] = int32(uint32(b[14]) | uint32(b[15])<<8)
+		b, out = b[16:], out[8:]
+	}
+}
+
+func unpackGroups17(b []byte, out []int32) {
+
+	for len(b) >= 17 && len(out) >= 8 {
+		_ = b[16]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x1FFFF)
+		out[1] = int32((uint32(b[2])>>1 | uint32(b[3])<<7 | uint32(b[4])<<15) & 0x1FFFF)
+		out[2] = int32((uint32(b[4])>>2 | uint32(b[5])<<6 | uint32(b[6])<<14) & 0x1FFFF)
+		out[3] = int32((uint32(b[6])>>3 | uint32(b[7])<<5 | uint32(b[8])<<13) & 0x1FFFF)
+		out[4] = int32((uint32(b[8])>>4 | uint32(b[9])<<4 | uint32(b[10])<<12) & 0x1FFFF)
+		out[5] = int32((uint32(b[10])>>5 | uint32(b[11])<<3 | uint32(b[12])<<11) & 0x1FFFF)
+		out[6] = int32((uint32(b[12])>>6 | uint32(b[13])<<2 | uint32(b[14])<<10) & 0x1FFFF)
+		out[7] = int32((uint32(b[14])>>7 | uint32(b[15])<<1 | uint32(b[16])<<9) & 0x1FFFF)
+		b, out = b[17:], out[8:]
+	}
+}
+
+func unpackGroups18(b []byte, out []int32) {
+
+	for len(b) >= 18 && len(out) >= 8 {
+		_ = b[17]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x3FFFF)
+		out[1] = int32((uint32(b[2])>>2 | uint32(b[3])<<6 | uint32(b[4])<<14) & 0x3FFFF)
+		out[2] = int32((uint32(b[4])>>4 | uint32(b[5])<<4 | uint32(b[6])<<12) & 0x3FFFF)
+		out[3] = int32((uint32(b[6])>>6 | uint32(b[7])<<2 | uint32(b[8])<<10) & 0x3FFFF)
+		out[4] = int32((uint32(b[9]) | uint32(b[10])<<8 | uint32(b[11])<<16) & 0x3FFFF)
+		out[5] = int32((uint32(b[11])>>2 | uint32(b[12])<<6 | uint32(b[13])<<14) & 0x3FFFF)
+		out[6] = int32((uint32(b[13])>>4 | uint32(b[14])<<4 | uint32(b[15])<<12) & 0x3FFFF)
+		out[7] = int32((uint32(b[15])>>6 | uint32(b[16])<<2 | uint32(b[17])<<10) & 0x3FFFF)
+		b, out = b[18:], out[8:]
+	}
+}
+
+func unpackGroups19(b []byte, out []int32) {
+
+	for len(b) >= 19 && len(out) >= 8 {
+		_ = b[18]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x7FFFF)
+		out[1] = int32((uint32(b[2])>>3 | uint32(b[3])<<5 | uint32(b[4])<<13) & 0x7FFFF)
+		out[2] = int32((uint32(b[4])>>6 | uint32(b[5])<<2 | uint32(b[6])<<10 | uint32(b[7])<<18) & 0x7FFFF)
+		out[3] = int32((uint32(b[7])>>1 | uint32(b[8])<<7 | uint32(b[9])<<15) & 0x7FFFF)
+		out[4] = int32((uint32(b[9])>>4 | uint32(b[10])<<4 | uint32(b[11])<<12) & 0x7FFFF)
+		out[5] = int32((uint32(b[11])>>7 | uint32(b[12])<<1 | uint32(b[13])<<9 | uint32(b[14])<<17) & 0x7FFFF)
+		out[6] = int32((uint32(b[14])>>2 | uint32(b[15])<<6 | uint32(b[16])<<14) & 0x7FFFF)
+		out[7] = int32((uint32(b[16])>>5 | uint32(b[17])<<3 | uint32(b[18])<<11) & 0x7FFFF)
+		b, out = b[19:], out[8:]
+	}
+}
+
+func unpackGroups20(b []byte, out []int32) {
+
+	for len(b) >= 20 && len(out) >= 8 {
+		_ = b[19]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0xFFFFF)
+		out[1] = int32((uint32(b[2])>>4 | uint32(b[3])<<4 | uint32(b[4])<<12) & 0xFFFFF)
+		out[2] = int32((uint32(b[5]) | uint32(b[6])<<8 | uint32(b[7])<<16) & 0xFFFFF)
+		out[3] = int32((uint32(b[7])>>4 | uint32(b[8])<<4 | uint32(b[9])<<12) & 0xFFFFF)
+		out[4] = int32((uint32(b[10]) | uint32(b[11])<<8 | uint32(b[12])<<16) & 0xFFFFF)
+		out[5] = int32((uint32(b[12])>>4 | uint32(b[13])<<4 | uint32(b[14])<<12) & 0xFFFFF)
+		out[6] = int32((uint32(b[15]) | uint32(b[16])<<8 | uint32(b[17])<<16) & 0xFFFFF)
+		out[7] = int32((uint32(b[17])>>4 | uint32(b[18])<<4 | uint32(b[19])<<12) & 0xFFFFF)
+		b, out = b[20:], out[8:]
+	}
+}
+
+func unpackGroups21(b []byte, out []int32) {
+
+	for len(b) >= 21 && len(out) >= 8 {
+		_ = b[20]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x1FFFFF)
+		out[1] = int32((uint32(b[2])>>5 | uint32(b[3])<<3 | uint32(b[4])<<11 | uint32(b[5])<<19) & 0x1FFFFF)
+		out[2] = int32((uint32(b[5])>>2 | uint32(b[6])<<6 | uint32(b[7])<<14) & 0x1FFFFF)
+		out[3] = int32((uint32(b[7])>>7 | uint32(b[8])<<1 | uint32(b[9])<<9 | uint32(b[10])<<17) & 0x1FFFFF)
+		out[4] = int32((uint32(b[10])>>4 | uint32(b[11])<<4 | uint32(b[12])<<12 | uint32(b[13])<<20) & 0x1FFFFF)
+		out[5] = int32((uint32(b[13])>>1 | uint32(b[14])<<7 | uint32(b[15])<<15) & 0x1FFFFF)
+		out[6] = int32((uint32(b[15])>>6 | uint32(b[16])<<2 | uint32(b[17])<<10 | uint32(b[18])<<18) & 0x1FFFFF)
+		out[7] = int32((uint32(b[18])>>3 | uint32(b[19])<<5 | uint32(b[20])<<13) & 0x1FFFFF)
+		b, out = b[21:], out[8:]
+	}
+}
+
+func unpackGroups22(b []byte, out []int32) {
+
+	for len(b) >= 22 && len(out) >= 8 {
+		_ = b[21]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x3FFFFF)
+		out[1] = int32((uint32(b[2])>>6 | uint32(b[3])<<2 | uint32(b[4])<<10 | uint32(b[5])<<18) & 0x3FFFFF)
+		out[2] = int32((uint32(b[5])>>4 | uint32(b[6])<<4 | uint32(b[7])<<12 | uint32(b[8])<<20) & 0x3FFFFF)
+		out[3] = int32((uint32(b[8])>>2 | uint32(b[9])<<6 | uint32(b[10])<<14) & 0x3FFFFF)
+		out[4] = int32((uint32(b[11]) | uint32(b[12])<<8 | uint32(b[13])<<16) & 0x3FFFFF)
+		out[5] = int32((uint32(b[13])>>6 | uint32(b[14])<<2 | uint32(b[15])<<10 | uint32(b[16])<<18) & 0x3FFFFF)
+		out[6] = int32((uint32(b[16])>>4 | uint32(b[17])<<4 | uint32(b[18])<<12 | uint32(b[19])<<20) & 0x3FFFFF)
+		out[7] = int32((uint32(b[19])>>2 | uint32(b[20])<<6 | uint32(b[21])<<14) & 0x3FFFFF)
+		b, out = b[22:], out[8:]
+	}
+}
+
+func unpackGroups23(b []byte, out []int32) {
+
+	for len(b) >= 23 && len(out) >= 8 {
+		_ = b[22]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16) & 0x7FFFFF)
+		out[1] = int32((uint32(b[2])>>7 | uint32(b[3])<<1 | uint32(b[4])<<9 | uint32(b[5])<<17) & 0x7FFFFF)
+		out[2] = int32((uint32(b[5])>>6 | uint32(b[6])<<2 | uint32(b[7])<<10 | uint32(b[8])<<18) & 0x7FFFFF)
+		out[3] = int32((uint32(b[8])>>5 | uint32(b[9])<<3 | uint32(b[10])<<11 | uint32(b[11])<<19) & 0x7FFFFF)
+		out[4] = int32((uint32(b[11])>>4 | uint32(b[12])<<4 | uint32(b[13])<<12 | uint32(b[14])<<20) & 0x7FFFFF)
+		out[5] = int32((uint32(b[14])>>3 | uint32(b[15])<<5 | uint32(b[16])<<13 | uint32(b[17])<<21) & 0x7FFFFF)
+		out[6] = int32((uint32(b[17])>>2 | uint32(b[18])<<6 | uint32(b[19])<<14 | uint32(b[20])<<22) & 0x7FFFFF)
+		out[7] = int32((uint32(b[20])>>1 | uint32(b[21])<<7 | uint32(b[22])<<15) & 0x7FFFFF)
+		b, out = b[23:], out[8:]
+	}
+}
+
+func unpackGroups24(b []byte, out []int32) {
+
+	for len(b) >= 24 && len(out) >= 8 {
+		_ = b[23]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16)
+		out[1] = int32(uint32(b[3]) | uint32(b[4])<<8 | uint32(b[5])<<16)
+		out[2] = int32(uint32(b[6]) | uint32(b[7])<<8 | uint32(b[8])<<16)
+		out[3] = int32(uint32(b[9]) | uint32(b[10])<<8 | uint32(b[11])<<16)
+		out[4] = int32(uint32(b[12]) | uint32(b[13])<<8 | uint32(b[14])<<16)
+		out[5] = int32(uint32(b[15]) | uint32(b[16])<<8 | uint32(b[17])<<16)
+		out[6] = int32(uint32(b[18]) | uint32(b[19])<<8 | uint32(b[20])<<16)
+		out[7] = int32(uint32(b[21]) | uint32(b[22])<<8 | uint32(b[23])<<16)
+		b, out = b[24:], out[8:]
+	}
+}
+
+func unpackGroups25(b []byte, out []int32) {
+
+	for len(b) >= 25 && len(out) >= 8 {
+		_ = b[24]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x1FFFFFF)
+		out[1] = int32((uint32(b[3])>>1 | uint32(b[4])<<7 | uint32(b[5])<<15 | uint32(b[6])<<23) & 0x1FFFFFF)
+		out[2] = int32((uint32(b[6])>>2 | uint32(b[7])<<6 | uint32(b[8])<<14 | uint32(b[9])<<22) & 0x1FFFFFF)
+		out[3] = int32((uint32(b[9])>>3 | uint32(b[10])<<5 | uint32(b[11])<<13 | uint32(b[12])<<21) & 0x1FFFFFF)
+		out[4] = int32((uint32(b[12])>>4 | uint32(b[13])<<4 | uint32(b[14])<<12 | uint32(b[15])<<20) & 0x1FFFFFF)
+		out[5] = int32((uint32(b[15])>>5 | uint32(b[16])<<3 | uint32(b[17])<<11 | uint32(b[18])<<19) & 0x1FFFFFF)
+		out[6] = int32((uint32(b[18])>>6 | uint32(b[19])<<2 | uint32(b[20])<<10 | uint32(b[21])<<18) & 0x1FFFFFF)
+		out[7] = int32((uint32(b[21])>>7 | uint32(b[22])<<1 | uint32(b[23])<<9 | uint32(b[24])<<17) & 0x1FFFFFF)
+		b, out = b[25:], out[8:]
+	}
+}
+
+func unpackGroups26(b []byte, out []int32) {
+
+	for len(b) >= 26 && len(out) >= 8 {
+		_ = b[25]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x3FFFFFF)
+		out[1] = int32((uint32(b[3])>>2 | uint32(b[4])<<6 | uint32(b[5])<<14 | uint32(b[6])<<22) & 0x3FFFFFF)
+		out[2] = int32((uint32(b[6])>>4 | uint32(b[7])<<4 | uint32(b[8])<<12 | uint32(b[9])<<20) & 0x3FFFFFF)
+		out[3] = int32((uint32(b[9])>>6 | uint32(b[10])<<2 | uint32(b[11])<<10 | uint32(b[12])<<18) & 0x3FFFFFF)
+		out[4] = int32((uint32(b[13]) | uint32(b[14])<<8 | uint32(b[15])<<16 | uint32(b[16])<<24) & 0x3FFFFFF)
+		out[5] = int32((uint32(b[16])>>2 | uint32(b[17])<<6 | uint32(b[18])<<14 | uint32(b[19])<<22) & 0x3FFFFFF)
+		out[6] = int32((uint32(b[19])>>4 | uint32(b[20])<<4 | uint32(b[21])<<12 | uint32(b[22])<<20) & 0x3FFFFFF)
+		out[7] = int32((uint32(b[22])>>6 | uint32(b[23])<<2 | uint32(b[24])<<10 | uint32(b[25])<<18) & 0x3FFFFFF)
+		b, out = b[26:], out[8:]
+	}
+}
+
+func unpackGroups27(b []byte, out []int32) {
+
+	for len(b) >= 27 && len(out) >= 8 {
+		_ = b[26]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x7FFFFFF)
+		out[1] = int32((uint32(b[3])>>3 | uint32(b[4])<<5 | uint32(b[5])<<13 | uint32(b[6])<<21) & 0x7FFFFFF)
+		out[2] = int32((uint32(b[6])>>6 | uint32(b[7])<<2 | uint32(b[8])<<10 | uint32(b[9])<<18 | uint32(b[10])<<26) & 0x7FFFFFF)
+		out[3] = int32((uint32(b[10])>>1 | uint32(b[11])<<7 | uint32(b[12])<<15 | uint32(b[13])<<23) & 0x7FFFFFF)
+		out[4] = int32((uint32(b[13])>>4 | uint32(b[14])<<4 | uint32(b[15])<<12 | uint32(b[16])<<20) & 0x7FFFFFF)
+		out[5] = int32((uint32(b[16])>>7 | uint32(b[17])<<1 | uint32(b[18])<<9 | uint32(b[19])<<17 | uint32(b[20])<<25) & 0x7FFFFFF)
+		out[6] = int32((uint32(b[20])>>2 | uint32(b[21])<<6 | uint32(b[22])<<14 | uint32(b[23])<<22) & 0x7FFFFFF)
+		out[7] = int32((uint32(b[23])>>5 | uint32(b[24])<<3 | uint32(b[25])<<11 | uint32(b[26])<<19) & 0x7FFFFFF)
+		b, out = b[27:], out[8:]
+	}
+}
+
+func unpackGroups28(b []byte, out []int32) {
+
+	for len(b) >= 28 && len(out) >= 8 {
+		_ = b[27]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0xFFFFFFF)
+		out[1] = int32((uint32(b[3])>>4 | uint32(b[4])<<4 | uint32(b[5])<<12 | uint32(b[6])<<20) & 0xFFFFFFF)
+		out[2] = int32((uint32(b[7]) | uint32(b[8])<<8 | uint32(b[9])<<16 | uint32(b[10])<<24) & 0xFFFFFFF)
+		out[3] = int32((uint32(b[10])>>4 | uint32(b[11])<<4 | uint32(b[12])<<12 | uint32(b[13])<<20) & 0xFFFFFFF)
+		out[4] = int32((uint32(b[14]) | uint32(b[15])<<8 | uint32(b[16])<<16 | uint32(b[17])<<24) & 0xFFFFFFF)
+		out[5] = int32((uint32(b[17])>>4 | uint32(b[18])<<4 | uint32(b[19])<<12 | uint32(b[20])<<20) & 0xFFFFFFF)
+		out[6] = int32((uint32(b[21]) | uint32(b[22])<<8 | uint32(b[23])<<16 | uint32(b[24])<<24) & 0xFFFFFFF)
+		out[7] = int32((uint32(b[24])>>4 | uint32(b[25])<<4 | uint32(b[26])<<12 | uint32(b[27])<<20) & 0xFFFFFFF)
+		b, out = b[28:], out[8:]
+	}
+}
+
+func unpackGroups29(b []byte, out []int32) {
+
+	for len(b) >= 29 && len(out) >= 8 {
+		_ = b[28]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x1FFFFFFF)
+		out[1] = int32((uint32(b[3])>>5 | uint32(b[4])<<3 | uint32(b[5])<<11 | uint32(b[6])<<19 | uint32(b[7])<<27) & 0x1FFFFFFF)
+		out[2] = int32((uint32(b[7])>>2 | uint32(b[8])<<6 | uint32(b[9])<<14 | uint32(b[10])<<22) & 0x1FFFFFFF)
+		out[3] = int32((uint32(b[10])>>7 | uint32(b[11])<<1 | uint32(b[12])<<9 | uint32(b[13])<<17 | uint32(b[14])<<25) & 0x1FFFFFFF)
+		out[4] = int32((uint32(b[14])>>4 | uint32(b[15])<<4 | uint32(b[16])<<12 | uint32(b[17])<<20 | uint32(b[18])<<28) & 0x1FFFFFFF)
+		out[5] = int32((uint32(b[18])>>1 | uint32(b[19])<<7 | uint32(b[20])<<15 | uint32(b[21])<<23) & 0x1FFFFFFF)
+		out[6] = int32((uint32(b[21])>>6 | uint32(b[22])<<2 | uint32(b[23])<<10 | uint32(b[24])<<18 | uint32(b[25])<<26) & 0x1FFFFFFF)
+		out[7] = int32((uint32(b[25])>>3 | uint32(b[26])<<5 | uint32(b[27])<<13 | uint32(b[28])<<21) & 0x1FFFFFFF)
+		b, out = b[29:], out[8:]
+	}
+}
+
+func unpackGroups30(b []byte, out []int32) {
+
+	for len(b) >= 30 && len(out) >= 8 {
+		_ = b[29]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x3FFFFFFF)
+		out[1] = int32((uint32(b[3])>>6 | uint32(b[4])<<2 | uint32(b[5])<<10 | uint32(b[6])<<18 | uint32(b[7])<<26) & 0x3FFFFFFF)
+		out[2] = int32((uint32(b[7])>>4 | uint32(b[8])<<4 | uint32(b[9])<<12 | uint32(b[10])<<20 | uint32(b[11])<<28) & 0x3FFFFFFF)
+		out[3] = int32((uint32(b[11])>>2 | uint32(b[12])<<6 | uint32(b[13])<<14 | uint32(b[14])<<22) & 0x3FFFFFFF)
+		out[4] = int32((uint32(b[15]) | uint32(b[16])<<8 | uint32(b[17])<<16 | uint32(b[18])<<24) & 0x3FFFFFFF)
+		out[5] = int32((uint32(b[18])>>6 | uint32(b[19])<<2 | uint32(b[20])<<10 | uint32(b[21])<<18 | uint32(b[22])<<26) & 0x3FFFFFFF)
+		out[6] = int32((uint32(b[22])>>4 | uint32(b[23])<<4 | uint32(b[24])<<12 | uint32(b[25])<<20 | uint32(b[26])<<28) & 0x3FFFFFFF)
+		out[7] = int32((uint32(b[26])>>2 | uint32(b[27])<<6 | uint32(b[28])<<14 | uint32(b[29])<<22) & 0x3FFFFFFF)
+		b, out = b[30:], out[8:]
+	}
+}
+
+func unpackGroups31(b []byte, out []int32) {
+
+	for len(b) >= 31 && len(out) >= 8 {
+		_ = b[30]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32((uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24) & 0x7FFFFFFF)
+		out[1] = int32((uint32(b[3])>>7 | uint32(b[4])<<1 | uint32(b[5])<<9 | uint32(b[6])<<17 | uint32(b[7])<<25) & 0x7FFFFFFF)
+		out[2] = int32((uint32(b[7])>>6 | uint32(b[8])<<2 | uint32(b[9])<<10 | uint32(b[10])<<18 | uint32(b[11])<<26) & 0x7FFFFFFF)
+		out[3] = int32((uint32(b[11])>>5 | uint32(b[12])<<3 | uint32(b[13])<<11 | uint32(b[14])<<19 | uint32(b[15])<<27) & 0x7FFFFFFF)
+		out[4] = int32((uint32(b[15])>>4 | uint32(b[16])<<4 | uint32(b[17])<<12 | uint32(b[18])<<20 | uint32(b[19])<<28) & 0x7FFFFFFF)
+		out[5] = int32((uint32(b[19])>>3 | uint32(b[20])<<5 | uint32(b[21])<<13 | uint32(b[22])<<21 | uint32(b[23])<<29) & 0x7FFFFFFF)
+		out[6] = int32((uint32(b[23])>>2 | uint32(b[24])<<6 | uint32(b[25])<<14 | uint32(b[26])<<22 | uint32(b[27])<<30) & 0x7FFFFFFF)
+		out[7] = int32((uint32(b[27])>>1 | uint32(b[28])<<7 | uint32(b[29])<<15 | uint32(b[30])<<23) & 0x7FFFFFFF)
+		b, out = b[31:], out[8:]
+	}
+}
+
+func unpackGroups32(b []byte, out []int32) {
+
+	for len(b) >= 32 && len(out) >= 8 {
+		_ = b[31]
+		_ = out[7] // eliminate bounds checks
+		out[0] = int32(uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24)
+		out[1] = int32(uint32(b[4]) | uint32(b[5])<<8 | uint32(b[6])<<16 | uint32(b[7])<<24)
+		out[2] = int32(uint32(b[8]) | uint32(b[9])<<8 | uint32(b[10])<<16 | uint32(b[11])<<24)
+		out[3] = int32(uint32(b[12]) | uint32(b[13])<<8 | uint32(b[14])<<16 | uint32(b[15])<<24)
+		out[4] = int32(uint32(b[16]) | uint32(b[17])<<8 | uint32(b[18])<<16 | uint32(b[19])<<24)
+		out[5] = int32(uint32(b[20]) | uint32(b[21])<<8 | uint32(b[22])<<16 | uint32(b[23])<<24)
+		out[6] = int32(uint32(b[24]) | uint32(b[25])<<8 | uint32(b[26])<<16 | uint32(b[27])<<24)
+		out[7] = int32(uint32(b[28]) | uint32(b[29])<<8 | uint32(b[30])<<16 | uint32(b[31])<<24)
+		b, out = b[32:], out[8:]
+	}
+}
+
+// unpackGroupsFuncForWidth returns the function that unpacks the groups of
+// 8 values of bitWidth bits, 1 <= bitWidth <= 32.
+func unpackGroupsFuncForWidth(bitWidth uint) func([]byte, []int32) {
+	switch bitWidth {
+	case 1:
+		return unpackGroups1
+	case 2:
+		return unpackGroups2
+	case 3:
+		return unpackGroups3
+	case 4:
+		return unpackGroups4
+	case 5:
+		return unpackGroups5
+	case 6:
+		return unpackGroups6
+	case 7:
+		return unpackGroups7
+	case 8:
+		return unpackGroups8
+	case 9:
+		return unpackGroups9
+	case 10:
+		return unpackGroups10
+	case 11:
+		return unpackGroups11
+	case 12:
+		return unpackGroups12
+	case 13:
+		return unpackGroups13
+	case 14:
+		return unpackGroups14
+	case 15:
+		return unpackGroups15
+	case 16:
+		return unpackGroups16
+	case 17:
+		return unpackGroups17
+	case 18:
+		return unpackGroups18
+	case 19:
+		return unpackGroups19
+	case 20:
+		return unpackGroups20
+	case 21:
+		return unpackGroups21
+	case 22:
+		return unpackGroups22
+	case 23:
+		return unpackGroups23
+	case 24:
+		return unpackGroups24
+	case 25:
+		return unpackGroups25
+	case 26:
+		return unpackGroups26
+	case 27:
+		return unpackGroups27
+	case 28:
+		return unpackGroups28
+	case 29:
+		return unpackGroups29
+	case 30:
+		return unpackGroups30
+	case 31:
+		return unpackGroups31
+	case 32:
+		return unpackGroups32
+	default:
+		panic(fmt.Sprint("invalid bitWidth: ", bitWidth))
+	}
+}
+
+func unpack8Int64Width33(b []byte, out []int64) {
+
+	_ = b[32]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0x1FFFFFFFF)
+	out[1] = int64((uint64(b[4])>>1 | uint64(b[5])<<7 | uint64(b[6])<<15 | uint64(b[7])<<23 | uint64(b[8])<<31) & 0x1FFFFFFFF)
+	out[2] = int64((uint64(b[8])>>2 | uint64(b[9])<<6 | uint64(b[10])<<14 | uint64(b[11])<<22 | uint64(b[12])<<30) & 0x1FFFFFFFF)
+	out[3] = int64((uint64(b[12])>>3 | uint64(b[13])<<5 | uint64(b[14])<<13 | uint64(b[15])<<21 | uint64(b[16])<<29) & 0x1FFFFFFFF)
+	out[4] = int64((uint64(b[16])>>4 | uint64(b[17])<<4 | uint64(b[18])<<12 | uint64(b[19])<<20 | uint64(b[20])<<28) & 0x1FFFFFFFF)
+	out[5] = int64((uint64(b[20])>>5 | uint64(b[21])<<3 | uint64(b[22])<<11 | uint64(b[23])<<19 | uint64(b[24])<<27) & 0x1FFFFFFFF)
+	out[6] = int64((uint64(b[24])>>6 | uint64(b[25])<<2 | uint64(b[26])<<10 | uint64(b[27])<<18 | uint64(b[28])<<26) & 0x1FFFFFFFF)
+	out[7] = int64((uint64(b[28])>>7 | uint64(b[29])<<1 | uint64(b[30])<<9 | uint64(b[31])<<17 | uint64(b[32])<<25) & 0x1FFFFFFFF)
+}
+
+func unpack8Int64Width34(b []byte, out []int64) {
+
+	_ = b[33]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0x3FFFFFFFF)
+	out[1] = int64((uint64(b[4])>>2 | uint64(b[5])<<6 | uint64(b[6])<<14 | uint64(b[7])<<22 | uint64(b[8])<<30) & 0x3FFFFFFFF)
+	out[2] = int64((uint64(b[8])>>4 | uint64(b[9])<<4 | uint64(b[10])<<12 | uint64(b[11])<<20 | uint64(b[12])<<28) & 0x3FFFFFFFF)
+	out[3] = int64((uint64(b[12])>>6 | uint64(b[13])<<2 | uint64(b[14])<<10 | uint64(b[15])<<18 | uint64(b[16])<<26) & 0x3FFFFFFFF)
+	out[4] = int64((uint64(b[17]) | uint64(b[18])<<8 | uint64(b[19])<<16 | uint64(b[20])<<24 | uint64(b[21])<<32) & 0x3FFFFFFFF)
+	out[5] = int64((uint64(b[21])>>2 | uint64(b[22])<<6 | uint64(b[23])<<14 | uint64(b[24])<<22 | uint64(b[25])<<30) & 0x3FFFFFFFF)
+	out[6] = int64((uint64(b[25])>>4 | uint64(b[26])<<4 | uint64(b[27])<<12 | uint64(b[28])<<20 | uint64(b[29])<<28) & 0x3FFFFFFFF)
+	out[7] = int64((uint64(b[29])>>6 | uint64(b[30])<<2 | uint64(b[31])<<10 | uint64(b[32])<<18 | uint64(b[33])<<26) & 0x3FFFFFFFF)
+}
+
+func unpack8Int64Width35(b []byte, out []int64) {
+
+	_ = b[34]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0x7FFFFFFFF)
+	out[1] = int64((uint64(b[4])>>3 | uint64(b[5])<<5 | uint64(b[6])<<13 | uint64(b[7])<<21 | uint64(b[8])<<29) & 0x7FFFFFFFF)
+	out[2] = int64((uint64(b[8])>>6 | uint64(b[9])<<2 | uint64(b[10])<<10 | uint64(b[11])<<18 | uint64(b[12])<<26 | uint64(b[13])<<34) & 0x7FFFFFFFF)
+	out[3] = int64((uint64(b[13])>>1 | uint64(b[14])<<7 | uint64(b[15])<<15 | uint64(b[16])<<23 | uint64(b[17])<<31) & 0x7FFFFFFFF)
+	out[4] = int64((uint64(b[17])>>4 | uint64(b[18])<<4 | uint64(b[19])<<12 | uint64(b[20])<<20 | uint64(b[21])<<28) & 0x7FFFFFFFF)
+	out[5] = int64((uint64(b[21])>>7 | uint64(b[22])<<1 | uint64(b[23])<<9 | uint64(b[24])<<17 | uint64(b[25])<<25 | uint64(b[26])<<33) & 0x7FFFFFFFF)
+	out[6] = int64((uint64(b[26])>>2 | uint64(b[27])<<6 | uint64(b[28])<<14 | uint64(b[29])<<22 | uint64(b[30])<<30) & 0x7FFFFFFFF)
+	out[7] = int64((uint64(b[30])>>5 | uint64(b[31])<<3 | uint64(b[32])<<11 | uint64(b[33])<<19 | uint64(b[34])<<27) & 0x7FFFFFFFF)
+}
+
+func unpack8Int64Width36(b []byte, out []int64) {
+
+	_ = b[35]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0xFFFFFFFFF)
+	out[1] = int64((uint64(b[4])>>4 | uint64(b[5])<<4 | uint64(b[6])<<12 | uint64(b[7])<<20 | uint64(b[8])<<28) & 0xFFFFFFFFF)
+	out[2] = int64((uint64(b[9]) | uint64(b[10])<<8 | uint64(b[11])<<16 | uint64(b[12])<<24 | uint64(b[13])<<32) & 0xFFFFFFFFF)
+	out[3] = int64((uint64(b[13])>>4 | uint64(b[14])<<4 | uint64(b[15])<<12 | uint64(b[16])<<20 | uint64(b[17])<<28) & 0xFFFFFFFFF)
+	out[4] = int64((uint64(b[18]) | uint64(b[19])<<8 | uint64(b[20])<<16 | uint64(b[21])<<24 | uint64(b[22])<<32) & 0xFFFFFFFFF)
+	out[5] = int64((uint64(b[22])>>4 | uint64(b[23])<<4 | uint64(b[24])<<12 | uint64(b[25])<<20 | uint64(b[26])<<28) & 0xFFFFFFFFF)
+	out[6] = int64((uint64(b[27]) | uint64(b[28])<<8 | uint64(b[29])<<16 | uint64(b[30])<<24 | uint64(b[31])<<32) & 0xFFFFFFFFF)
+	out[7] = int64((uint64(b[31])>>4 | uint64(b[32])<<4 | uint64(b[33])<<12 | uint64(b[34])<<20 | uint64(b[35])<<28) & 0xFFFFFFFFF)
+}
+
+func unpack8Int64Width37(b []byte, out []int64) {
+
+	_ = b[36]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0x1FFFFFFFFF)
+	out[1] = int64((uint64(b[4])>>5 | uint64(b[5])<<3 | uint64(b[6])<<11 | uint64(b[7])<<19 | uint64(b[8])<<27 | uint64(b[9])<<35) & 0x1FFFFFFFFF)
+	out[2] = int64((uint64(b[9])>>2 | uint64(b[10])<<6 | uint64(b[11])<<14 | uint64(b[12])<<22 | uint64(b[13])<<30) & 0x1FFFFFFFFF)
+	out[3] = int64((uint64(b[13])>>7 | uint64(b[14])<<1 | uint64(b[15])<<9 | uint64(b[16])<<17 | uint64(b[17])<<25 | uint64(b[18])<<33) & 0x1FFFFFFFFF)
+	out[4] = int64((uint64(b[18])>>4 | uint64(b[19])<<4 | uint64(b[20])<<12 | uint64(b[21])<<20 | uint64(b[22])<<28 | uint64(b[23])<<36) & 0x1FFFFFFFFF)
+	out[5] = int64((uint64(b[23])>>1 | uint64(b[24])<<7 | uint64(b[25])<<15 | uint64(b[26])<<23 | uint64(b[27])<<31) & 0x1FFFFFFFFF)
+	out[6] = int64((uint64(b[27])>>6 | uint64(b[28])<<2 | uint64(b[29])<<10 | uint64(b[30])<<18 | uint64(b[31])<<26 | uint64(b[32])<<34) & 0x1FFFFFFFFF)
+	out[7] = int64((uint64(b[32])>>3 | uint64(b[33])<<5 | uint64(b[34])<<13 | uint64(b[35])<<21 | uint64(b[36])<<29) & 0x1FFFFFFFFF)
+}
+
+func unpack8Int64Width38(b []byte, out []int64) {
+
+	_ = b[37]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0x3FFFFFFFFF)
+	out[1] = int64((uint64(b[4])>>6 | uint64(b[5])<<2 | uint64(b[6])<<10 | uint64(b[7])<<18 | uint64(b[8])<<26 | uint64(b[9])<<34) & 0x3FFFFFFFFF)
+	out[2] = int64((uint64(b[9])>>4 | uint64(b[10])<<4 | uint64(b[11])<<12 | uint64(b[12])<<20 | uint64(b[13])<<28 | uint64(b[14])<<36) & 0x3FFFFFFFFF)
+	out[3] = int64((uint64(b[14])>>2 | uint64(b[15])<<6 | uint64(b[16])<<14 | uint64(b[17])<<22 | uint64(b[18])<<30) & 0x3FFFFFFFFF)
+	out[4] = int64((uint64(b[19]) | uint64(b[20])<<8 | uint64(b[21])<<16 | uint64(b[22])<<24 | uint64(b[23])<<32) & 0x3FFFFFFFFF)
+	out[5] = int64((uint64(b[23])>>6 | uint64(b[24])<<2 | uint64(b[25])<<10 | uint64(b[26])<<18 | uint64(b[27])<<26 | uint64(b[28])<<34) & 0x3FFFFFFFFF)
+	out[6] = int64((uint64(b[28])>>4 | uint64(b[29])<<4 | uint64(b[30])<<12 | uint64(b[31])<<20 | uint64(b[32])<<28 | uint64(b[33])<<36) & 0x3FFFFFFFFF)
+	out[7] = int64((uint64(b[33])>>2 | uint64(b[34])<<6 | uint64(b[35])<<14 | uint64(b[36])<<22 | uint64(b[37])<<30) & 0x3FFFFFFFFF)
+}
+
+func unpack8Int64Width39(b []byte, out []int64) {
+
+	_ = b[38]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0x7FFFFFFFFF)
+	out[1] = int64((uint64(b[4])>>7 | uint64(b[5])<<1 | uint64(b[6])<<9 | uint64(b[7])<<17 | uint64(b[8])<<25 | uint64(b[9])<<33) & 0x7FFFFFFFFF)
+	out[2] = int64((uint64(b[9])>>6 | uint64(b[10])<<2 | uint64(b[11])<<10 | uint64(b[12])<<18 | uint64(b[13])<<26 | uint64(b[14])<<34) & 0x7FFFFFFFFF)
+	out[3] = int64((uint64(b[14])>>5 | uint64(b[15])<<3 | uint64(b[16])<<11 | uint64(b[17])<<19 | uint64(b[18])<<27 | uint64(b[19])<<35) & 0x7FFFFFFFFF)
+	out[4] = int64((uint64(b[19])>>4 | uint64(b[20])<<4 | uint64(b[21])<<12 | uint64(b[22])<<20 | uint64(b[23])<<28 | uint64(b[24])<<36) & 0x7FFFFFFFFF)
+	out[5] = int64((uint64(b[24])>>3 | uint64(b[25])<<5 | uint64(b[26])<<13 | uint64(b[27])<<21 | uint64(b[28])<<29 | uint64(b[29])<<37) & 0x7FFFFFFFFF)
+	out[6] = int64((uint64(b[29])>>2 | uint64(b[30])<<6 | uint64(b[31])<<14 | uint64(b[32])<<22 | uint64(b[33])<<30 | uint64(b[34])<<38) & 0x7FFFFFFFFF)
+	out[7] = int64((uint64(b[34])>>1 | uint64(b[35])<<7 | uint64(b[36])<<15 | uint64(b[37])<<23 | uint64(b[38])<<31) & 0x7FFFFFFFFF)
+}
+
+func unpack8Int64Width40(b []byte, out []int64) {
+
+	_ = b[39]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32) & 0xFFFFFFFFFF)
+	out[1] = int64((uint64(b[5]) | uint64(b[6])<<8 | uint64(b[7])<<16 | uint64(b[8])<<24 | uint64(b[9])<<32) & 0xFFFFFFFFFF)
+	out[2] = int64((uint64(b[10]) | uint64(b[11])<<8 | uint64(b[12])<<16 | uint64(b[13])<<24 | uint64(b[14])<<32) & 0xFFFFFFFFFF)
+	out[3] = int64((uint64(b[15]) | uint64(b[16])<<8 | uint64(b[17])<<16 | uint64(b[18])<<24 | uint64(b[19])<<32) & 0xFFFFFFFFFF)
+	out[4] = int64((uint64(b[20]) | uint64(b[21])<<8 | uint64(b[22])<<16 | uint64(b[23])<<24 | uint64(b[24])<<32) & 0xFFFFFFFFFF)
+	out[5] = int64((uint64(b[25]) | uint64(b[26])<<8 | uint64(b[27])<<16 | uint64(b[28])<<24 | uint64(b[29])<<32) & 0xFFFFFFFFFF)
+	out[6] = int64((uint64(b[30]) | uint64(b[31])<<8 | uint64(b[32])<<16 | uint64(b[33])<<24 | uint64(b[34])<<32) & 0xFFFFFFFFFF)
+	out[7] = int64((uint64(b[35]) | uint64(b[36])<<8 | uint64(b[37])<<16 | uint64(b[38])<<24 | uint64(b[39])<<32) & 0xFFFFFFFFFF)
+}
+
+func unpack8Int64Width41(b []byte, out []int64) {
+
+	_ = b[40]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0x1FFFFFFFFFF)
+	out[1] = int64((uint64(b[5])>>1 | uint64(b[6])<<7 | uint64(b[7])<<15 | uint64(b[8])<<23 | uint64(b[9])<<31 | uint64(b[10])<<39) & 0x1FFFFFFFFFF)
+	out[2] = int64((uint64(b[10])>>2 | uint64(b[11])<<6 | uint64(b[12])<<14 | uint64(b[13])<<22 | uint64(b[14])<<30 | uint64(b[15])<<38) & 0x1FFFFFFFFFF)
+	out[3] = int64((uint64(b[15])>>3 | uint64(b[16])<<5 | uint64(b[17])<<13 | uint64(b[18])<<21 | uint64(b[19])<<29 | uint64(b[20])<<37) & 0x1FFFFFFFFFF)
+	out[4] = int64((uint64(b[20])>>4 | uint64(b[21])<<4 | uint64(b[22])<<12 | uint64(b[23])<<20 | uint64(b[24])<<28 | uint64(b[25])<<36) & 0x1FFFFFFFFFF)
+	out[5] = int64((uint64(b[25])>>5 | uint64(b[26])<<3 | uint64(b[27])<<11 | uint64(b[28])<<19 | uint64(b[29])<<27 | uint64(b[30])<<35) & 0x1FFFFFFFFFF)
+	out[6] = int64((uint64(b[30])>>6 | uint64(b[31])<<2 | uint64(b[32])<<10 | uint64(b[33])<<18 | uint64(b[34])<<26 | uint64(b[35])<<34) & 0x1FFFFFFFFFF)
+	out[7] = int64((uint64(b[35])>>7 | uint64(b[36])<<1 | uint64(b[37])<<9 | uint64(b[38])<<17 | uint64(b[39])<<25 | uint64(b[40])<<33) & 0x1FFFFFFFFFF)
+}
+
+func unpack8Int64Width42(b []byte, out []int64) {
+
+	_ = b[41]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0x3FFFFFFFFFF)
+	out[1] = int64((uint64(b[5])>>2 | uint64(b[6])<<6 | uint64(b[7])<<14 | uint64(b[8])<<22 | uint64(b[9])<<30 | uint64(b[10])<<38) & 0x3FFFFFFFFFF)
+	out[2] = int64((uint64(b[10])>>4 | uint64(b[11])<<4 | uint64(b[12])<<12 | uint64(b[13])<<20 | uint64(b[14])<<28 | uint64(b[15])<<36) & 0x3FFFFFFFFFF)
+	out[3] = int64((uint64(b[15])>>6 | uint64(b[16])<<2 | uint64(b[17])<<10 | uint64(b[18])<<18 | uint64(b[19])<<26 | uint64(b[20])<<34) & 0x3FFFFFFFFFF)
+	out[4] = int64((uint64(b[21]) | uint64(b[22])<<8 | uint64(b[23])<<16 | uint64(b[24])<<24 | uint64(b[25])<<32 | uint64(b[26])<<40) & 0x3FFFFFFFFFF)
+	out[5] = int64((uint64(b[26])>>2 | uint64(b[27])<<6 | uint64(b[28])<<14 | uint64(b[29])<<22 | uint64(b[30])<<30 | uint64(b[31])<<38) & 0x3FFFFFFFFFF)
+	out[6] = int64((uint64(b[31])>>4 | uint64(b[32])<<4 | uint64(b[33])<<12 | uint64(b[34])<<20 | uint64(b[35])<<28 | uint64(b[36])<<36) & 0x3FFFFFFFFFF)
+	out[7] = int64((uint64(b[36])>>6 | uint64(b[37])<<2 | uint64(b[38])<<10 | uint64(b[39])<<18 | uint64(b[40])<<26 | uint64(b[41])<<34) & 0x3FFFFFFFFFF)
+}
+
+func unpack8Int64Width43(b []byte, out []int64) {
+
+	_ = b[42]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0x7FFFFFFFFFF)
+	out[1] = int64((uint64(b[5])>>3 | uint64(b[6])<<5 | uint64(b[7])<<13 | uint64(b[8])<<21 | uint64(b[9])<<29 | uint64(b[10])<<37) & 0x7FFFFFFFFFF)
+	out[2] = int64((uint64(b[10])>>6 | uint64(b[11])<<2 | uint64(b[12])<<10 | uint64(b[13])<<18 | uint64(b[14])<<26 | uint64(b[15])<<34 | uint64(b[16])<<42) & 0x7FFFFFFFFFF)
+	out[3] = int64((uint64(b[16])>>1 | uint64(b[17])<<7 | uint64(b[18])<<15 | uint64(b[19])<<23 | uint64(b[20])<<31 | uint64(b[21])<<39) & 0x7FFFFFFFFFF)
+	out[4] = int64((uint64(b[21])>>4 | uint64(b[22])<<4 | uint64(b[23])<<12 | uint64(b[24])<<20 | uint64(b[25])<<28 | uint64(b[26])<<36) & 0x7FFFFFFFFFF)
+	out[5] = int64((uint64(b[26])>>7 | uint64(b[27])<<1 | uint64(b[28])<<9 | uint64(b[29])<<17 | uint64(b[30])<<25 | uint64(b[31])<<33 | uint64(b[32])<<41) & 0x7FFFFFFFFFF)
+	out[6] = int64((uint64(b[32])>>2 | uint64(b[33])<<6 | uint64(b[34])<<14 | uint64(b[35])<<22 | uint64(b[36])<<30 | uint64(b[37])<<38) & 0x7FFFFFFFFFF)
+	out[7] = int64((uint64(b[37])>>5 | uint64(b[38])<<3 | uint64(b[39])<<11 | uint64(b[40])<<19 | uint64(b[41])<<27 | uint64(b[42])<<35) & 0x7FFFFFFFFFF)
+}
+
+func unpack8Int64Width44(b []byte, out []int64) {
+
+	_ = b[43]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0xFFFFFFFFFFF)
+	out[1] = int64((uint64(b[5])>>4 | uint64(b[6])<<4 | uint64(b[7])<<12 | uint64(b[8])<<20 | uint64(b[9])<<28 | uint64(b[10])<<36) & 0xFFFFFFFFFFF)
+	out[2] = int64((uint64(b[11]) | uint64(b[12])<<8 | uint64(b[13])<<16 | uint64(b[14])<<24 | uint64(b[15])<<32 | uint64(b[16])<<40) & 0xFFFFFFFFFFF)
+	out[3] = int64((uint64(b[16])>>4 | uint64(b[17])<<4 | uint64(b[18])<<12 | uint64(b[19])<<20 | uint64(b[20])<<28 | uint64(b[21])<<36) & 0xFFFFFFFFFFF)
+	out[4] = int64((uint64(b[22]) | uint64(b[23])<<8 | uint64(b[24])<<16 | uint64(b[25])<<24 | uint64(b[26])<<32 | uint64(b[27])<<40) & 0xFFFFFFFFFFF)
+	out[5] = int64((uint64(b[27])>>4 | uint64(b[28])<<4 | uint64(b[29])<<12 | uint64(b[30])<<20 | uint64(b[31])<<28 | uint64(b[32])<<36) & 0xFFFFFFFFFFF)
+	out[6] = int64((uint64(b[33]) | uint64(b[34])<<8 | uint64(b[35])<<16 | uint64(b[36])<<24 | uint64(b[37])<<32 | uint64(b[38])<<40) & 0xFFFFFFFFFFF)
+	out[7] = int64((uint64(b[38])>>4 | uint64(b[39])<<4 | uint64(b[40])<<12 | uint64(b[41])<<20 | uint64(b[42])<<28 | uint64(b[43])<<36) & 0xFFFFFFFFFFF)
+}
+
+func unpack8Int64Width45(b []byte, out []int64) {
+
+	_ = b[44]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0x1FFFFFFFFFFF)
+	out[1] = int64((uint64(b[5])>>5 | uint64(b[6])<<3 | uint64(b[7])<<11 | uint64(b[8])<<19 | uint64(b[9])<<27 | uint64(b[10])<<35 | uint64(b[11])<<43) & 0x1FFFFFFFFFFF)
+	out[2] = int64((uint64(b[11])>>2 | uint64(b[12])<<6 | uint64(b[13])<<14 | uint64(b[14])<<22 | uint64(b[15])<<30 | uint64(b[16])<<38) & 0x1FFFFFFFFFFF)
+	out[3] = int64((uint64(b[16])>>7 | uint64(b[17])<<1 | uint64(b[18])<<9 | uint64(b[19])<<17 | uint64(b[20])<<25 | uint64(b[21])<<33 | uint64(b[22])<<41) & 0x1FFFFFFFFFFF)
+	out[4] = int64((uint64(b[22])>>4 | uint64(b[23])<<4 | uint64(b[24])<<12 | uint64(b[25])<<20 | uint64(b[26])<<28 | uint64(b[27])<<36 | uint64(b[28])<<44) & 0x1FFFFFFFFFFF)
+	out[5] = int64((uint64(b[28])>>1 | uint64(b[29])<<7 | uint64(b[30])<<15 | uint64(b[31])<<23 | uint64(b[32])<<31 | uint64(b[33])<<39) & 0x1FFFFFFFFFFF)
+	out[6] = int64((uint64(b[33])>>6 | uint64(b[34])<<2 | uint64(b[35])<<10 | uint64(b[36])<<18 | uint64(b[37])<<26 | uint64(b[38])<<34 | uint64(b[39])<<42) & 0x1FFFFFFFFFFF)
+	out[7] = int64((uint64(b[39])>>3 | uint64(b[40])<<5 | uint64(b[41])<<13 | uint64(b[42])<<21 | uint64(b[43])<<29 | uint64(b[44])<<37) & 0x1FFFFFFFFFFF)
+}
+
+func unpack8Int64Width46(b []byte, out []int64) {
+
+	_ = b[45]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0x3FFFFFFFFFFF)
+	out[1] = int64((uint64(b[5])>>6 | uint64(b[6])<<2 | uint64(b[7])<<10 | uint64(b[8])<<18 | uint64(b[9])<<26 | uint64(b[10])<<34 | uint64(b[11])<<42) & 0x3FFFFFFFFFFF)
+	out[2] = int64((uint64(b[11])>>4 | uint64(b[12])<<4 | uint64(b[13])<<12 | uint64(b[14])<<20 | uint64(b[15])<<28 | uint64(b[16])<<36 | uint64(b[17])<<44) & 0x3FFFFFFFFFFF)
+	out[3] = int64((uint64(b[17])>>2 | uint64(b[18])<<6 | uint64(b[19])<<14 | uint64(b[20])<<22 | uint64(b[21])<<30 | uint64(b[22])<<38) & 0x3FFFFFFFFFFF)
+	out[4] = int64((uint64(b[23]) | uint64(b[24])<<8 | uint64(b[25])<<16 | uint64(b[26])<<24 | uint64(b[27])<<32 | uint64(b[28])<<40) & 0x3FFFFFFFFFFF)
+	out[5] = int64((uint64(b[28])>>6 | uint64(b[29])<<2 | uint64(b[30])<<10 | uint64(b[31])<<18 | uint64(b[32])<<26 | uint64(b[33])<<34 | uint64(b[34])<<42) & 0x3FFFFFFFFFFF)
+	out[6] = int64((uint64(b[34])>>4 | uint64(b[35])<<4 | uint64(b[36])<<12 | uint64(b[37])<<20 | uint64(b[38])<<28 | uint64(b[39])<<36 | uint64(b[40])<<44) & 0x3FFFFFFFFFFF)
+	out[7] = int64((uint64(b[40])>>2 | uint64(b[41])<<6 | uint64(b[42])<<14 | uint64(b[43])<<22 | uint64(b[44])<<30 | uint64(b[45])<<38) & 0x3FFFFFFFFFFF)
+}
+
+func unpack8Int64Width47(b []byte, out []int64) {
+
+	_ = b[46]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0x7FFFFFFFFFFF)
+	out[1] = int64((uint64(b[5])>>7 | uint64(b[6])<<1 | uint64(b[7])<<9 | uint64(b[8])<<17 | uint64(b[9])<<25 | uint64(b[10])<<33 | uint64(b[11])<<41) & 0x7FFFFFFFFFFF)
+	out[2] = int64((uint64(b[11])>>6 | uint64(b[12])<<2 | uint64(b[13])<<10 | uint64(b[14])<<18 | uint64(b[15])<<26 | uint64(b[16])<<34 | uint64(b[17])<<42) & 0x7FFFFFFFFFFF)
+	out[3] = int64((uint64(b[17])>>5 | uint64(b[18])<<3 | uint64(b[19])<<11 | uint64(b[20])<<19 | uint64(b[21])<<27 | uint64(b[22])<<35 | uint64(b[23])<<43) & 0x7FFFFFFFFFFF)
+	out[4] = int64((uint64(b[23])>>4 | uint64(b[24])<<4 | uint64(b[25])<<12 | uint64(b[26])<<20 | uint64(b[27])<<28 | uint64(b[28])<<36 | uint64(b[29])<<44) & 0x7FFFFFFFFFFF)
+	out[5] = int64((uint64(b[29])>>3 | uint64(b[30])<<5 | uint64(b[31])<<13 | uint64(b[32])<<21 | uint64(b[33])<<29 | uint64(b[34])<<37 | uint64(b[35])<<45) & 0x7FFFFFFFFFFF)
+	out[6] = int64((uint64(b[35])>>2 | uint64(b[36])<<6 | uint64(b[37])<<14 | uint64(b[38])<<22 | uint64(b[39])<<30 | uint64(b[40])<<38 | uint64(b[41])<<46) & 0x7FFFFFFFFFFF)
+	out[7] = int64((uint64(b[41])>>1 | uint64(b[42])<<7 | uint64(b[43])<<15 | uint64(b[44])<<23 | uint64(b[45])<<31 | uint64(b[46])<<39) & 0x7FFFFFFFFFFF)
+}
+
+func unpack8Int64Width48(b []byte, out []int64) {
+
+	_ = b[47]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40) & 0xFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6]) | uint64(b[7])<<8 | uint64(b[8])<<16 | uint64(b[9])<<24 | uint64(b[10])<<32 | uint64(b[11])<<40) & 0xFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[12]) | uint64(b[13])<<8 | uint64(b[14])<<16 | uint64(b[15])<<24 | uint64(b[16])<<32 | uint64(b[17])<<40) & 0xFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[18]) | uint64(b[19])<<8 | uint64(b[20])<<16 | uint64(b[21])<<24 | uint64(b[22])<<32 | uint64(b[23])<<40) & 0xFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[24]) | uint64(b[25])<<8 | uint64(b[26])<<16 | uint64(b[27])<<24 | uint64(b[28])<<32 | uint64(b[29])<<40) & 0xFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[30]) | uint64(b[31])<<8 | uint64(b[32])<<16 | uint64(b[33])<<24 | uint64(b[34])<<32 | uint64(b[35])<<40) & 0xFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[36]) | uint64(b[37])<<8 | uint64(b[38])<<16 | uint64(b[39])<<24 | uint64(b[40])<<32 | uint64(b[41])<<40) & 0xFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[42]) | uint64(b[43])<<8 | uint64(b[44])<<16 | uint64(b[45])<<24 | uint64(b[46])<<32 | uint64(b[47])<<40) & 0xFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width49(b []byte, out []int64) {
+
+	_ = b[48]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0x1FFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6])>>1 | uint64(b[7])<<7 | uint64(b[8])<<15 | uint64(b[9])<<23 | uint64(b[10])<<31 | uint64(b[11])<<39 | uint64(b[12])<<47) & 0x1FFFFFFFFFFFF)
+	out[2] = int64((uint64(b[12])>>2 | uint64(b[13])<<6 | uint64(b[14])<<14 | uint64(b[15])<<22 | uint64(b[16])<<30 | uint64(b[17])<<38 | uint64(b[18])<<46) & 0x1FFFFFFFFFFFF)
+	out[3] = int64((uint64(b[18])>>3 | uint64(b[19])<<5 | uint64(b[20])<<13 | uint64(b[21])<<21 | uint64(b[22])<<29 | uint64(b[23])<<37 | uint64(b[24])<<45) & 0x1FFFFFFFFFFFF)
+	out[4] = int64((uint64(b[24])>>4 | uint64(b[25])<<4 | uint64(b[26])<<12 | uint64(b[27])<<20 | uint64(b[28])<<28 | uint64(b[29])<<36 | uint64(b[30])<<44) & 0x1FFFFFFFFFFFF)
+	out[5] = int64((uint64(b[30])>>5 | uint64(b[31])<<3 | uint64(b[32])<<11 | uint64(b[33])<<19 | uint64(b[34])<<27 | uint64(b[35])<<35 | uint64(b[36])<<43) & 0x1FFFFFFFFFFFF)
+	out[6] = int64((uint64(b[36])>>6 | uint64(b[37])<<2 | uint64(b[38])<<10 | uint64(b[39])<<18 | uint64(b[40])<<26 | uint64(b[41])<<34 | uint64(b[42])<<42) & 0x1FFFFFFFFFFFF)
+	out[7] = int64((uint64(b[42])>>7 | uint64(b[43])<<1 | uint64(b[44])<<9 | uint64(b[45])<<17 | uint64(b[46])<<25 | uint64(b[47])<<33 | uint64(b[48])<<41) & 0x1FFFFFFFFFFFF)
+}
+
+func unpack8Int64Width50(b []byte, out []int64) {
+
+	_ = b[49]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0x3FFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6])>>2 | uint64(b[7])<<6 | uint64(b[8])<<14 | uint64(b[9])<<22 | uint64(b[10])<<30 | uint64(b[11])<<38 | uint64(b[12])<<46) & 0x3FFFFFFFFFFFF)
+	out[2] = int64((uint64(b[12])>>4 | uint64(b[13])<<4 | uint64(b[14])<<12 | uint64(b[15])<<20 | uint64(b[16])<<28 | uint64(b[17])<<36 | uint64(b[18])<<44) & 0x3FFFFFFFFFFFF)
+	out[3] = int64((uint64(b[18])>>6 | uint64(b[19])<<2 | uint64(b[20])<<10 | uint64(b[21])<<18 | uint64(b[22])<<26 | uint64(b[23])<<34 | uint64(b[24])<<42) & 0x3FFFFFFFFFFFF)
+	out[4] = int64((uint64(b[25]) | uint64(b[26])<<8 | uint64(b[27])<<16 | uint64(b[28])<<24 | uint64(b[29])<<32 | uint64(b[30])<<40 | uint64(b[31])<<48) & 0x3FFFFFFFFFFFF)
+	out[5] = int64((uint64(b[31])>>2 | uint64(b[32])<<6 | uint64(b[33])<<14 | uint64(b[34])<<22 | uint64(b[35])<<30 | uint64(b[36])<<38 | uint64(b[37])<<46) & 0x3FFFFFFFFFFFF)
+	out[6] = int64((uint64(b[37])>>4 | uint64(b[38])<<4 | uint64(b[39])<<12 | uint64(b[40])<<20 | uint64(b[41])<<28 | uint64(b[42])<<36 | uint64(b[43])<<44) & 0x3FFFFFFFFFFFF)
+	out[7] = int64((uint64(b[43])>>6 | uint64(b[44])<<2 | uint64(b[45])<<10 | uint64(b[46])<<18 | uint64(b[47])<<26 | uint64(b[48])<<34 | uint64(b[49])<<42) & 0x3FFFFFFFFFFFF)
+}
+
+func unpack8Int64Width51(b []byte, out []int64) {
+
+	_ = b[50]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0x7FFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6])>>3 | uint64(b[7])<<5 | uint64(b[8])<<13 | uint64(b[9])<<21 | uint64(b[10])<<29 | uint64(b[11])<<37 | uint64(b[12])<<45) & 0x7FFFFFFFFFFFF)
+	out[2] = int64((uint64(b[12])>>6 | uint64(b[13])<<2 | uint64(b[14])<<10 | uint64(b[15])<<18 | uint64(b[16])<<26 | uint64(b[17])<<34 | uint64(b[18])<<42 | uint64(b[19])<<50) & 0x7FFFFFFFFFFFF)
+	out[3] = int64((uint64(b[19])>>1 | uint64(b[20])<<7 | uint64(b[21])<<15 | uint64(b[22])<<23 | uint64(b[23])<<31 | uint64(b[24])<<39 | uint64(b[25])<<47) & 0x7FFFFFFFFFFFF)
+	out[4] = int64((uint64(b[25])>>4 | uint64(b[26])<<4 | uint64(b[27])<<12 | uint64(b[28])<<20 | uint64(b[29])<<28 | uint64(b[30])<<36 | uint64(b[31])<<44) & 0x7FFFFFFFFFFFF)
+	out[5] = int64((uint64(b[31])>>7 | uint64(b[32])<<1 | uint64(b[33])<<9 | uint64(b[34])<<17 | uint64(b[35])<<25 | uint64(b[36])<<33 | uint64(b[37])<<41 | uint64(b[38])<<49) & 0x7FFFFFFFFFFFF)
+	out[6] = int64((uint64(b[38])>>2 | uint64(b[39])<<6 | uint64(b[40])<<14 | uint64(b[41])<<22 | uint64(b[42])<<30 | uint64(b[43])<<38 | uint64(b[44])<<46) & 0x7FFFFFFFFFFFF)
+	out[7] = int64((uint64(b[44])>>5 | uint64(b[45])<<3 | uint64(b[46])<<11 | uint64(b[47])<<19 | uint64(b[48])<<27 | uint64(b[49])<<35 | uint64(b[50])<<43) & 0x7FFFFFFFFFFFF)
+}
+
+func unpack8Int64Width52(b []byte, out []int64) {
+
+	_ = b[51]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0xFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6])>>4 | uint64(b[7])<<4 | uint64(b[8])<<12 | uint64(b[9])<<20 | uint64(b[10])<<28 | uint64(b[11])<<36 | uint64(b[12])<<44) & 0xFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[13]) | uint64(b[14])<<8 | uint64(b[15])<<16 | uint64(b[16])<<24 | uint64(b[17])<<32 | uint64(b[18])<<40 | uint64(b[19])<<48) & 0xFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[19])>>4 | uint64(b[20])<<4 | uint64(b[21])<<12 | uint64(b[22])<<20 | uint64(b[23])<<28 | uint64(b[24])<<36 | uint64(b[25])<<44) & 0xFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[26]) | uint64(b[27])<<8 | uint64(b[28])<<16 | uint64(b[29])<<24 | uint64(b[30])<<32 | uint64(b[31])<<40 | uint64(b[32])<<48) & 0xFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[32])>>4 | uint64(b[33])<<4 | uint64(b[34])<<12 | uint64(b[35])<<20 | uint64(b[36])<<28 | uint64(b[37])<<36 | uint64(b[38])<<44) & 0xFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[39]) | uint64(b[40])<<8 | uint64(b[41])<<16 | uint64(b[42])<<24 | uint64(b[43])<<32 | uint64(b[44])<<40 | uint64(b[45])<<48) & 0xFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[45])>>4 | uint64(b[46])<<4 | uint64(b[47])<<12 | uint64(b[48])<<20 | uint64(b[49])<<28 | uint64(b[50])<<36 | uint64(b[51])<<44) & 0xFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width53(b []byte, out []int64) {
+
+	_ = b[52]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0x1FFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6])>>5 | uint64(b[7])<<3 | uint64(b[8])<<11 | uint64(b[9])<<19 | uint64(b[10])<<27 | uint64(b[11])<<35 | uint64(b[12])<<43 | uint64(b[13])<<51) & 0x1FFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[13])>>2 | uint64(b[14])<<6 | uint64(b[15])<<14 | uint64(b[16])<<22 | uint64(b[17])<<30 | uint64(b[18])<<38 | uint64(b[19])<<46) & 0x1FFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[19])>>7 | uint64(b[20])<<1 | uint64(b[21])<<9 | uint64(b[22])<<17 | uint64(b[23])<<25 | uint64(b[24])<<33 | uint64(b[25])<<41 | uint64(b[26])<<49) & 0x1FFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[26])>>4 | uint64(b[27])<<4 | uint64(b[28])<<12 | uint64(b[29])<<20 | uint64(b[30])<<28 | uint64(b[31])<<36 | uint64(b[32])<<44 | uint64(b[33])<<52) & 0x1FFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[33])>>1 | uint64(b[34])<<7 | uint64(b[35])<<15 | uint64(b[36])<<23 | uint64(b[37])<<31 | uint64(b[38])<<39 | uint64(b[39])<<47) & 0x1FFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[39])>>6 | uint64(b[40])<<2 | uint64(b[41])<<10 | uint64(b[42])<<18 | uint64(b[43])<<26 | uint64(b[44])<<34 | uint64(b[45])<<42 | uint64(b[46])<<50) & 0x1FFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[46])>>3 | uint64(b[47])<<5 | uint64(b[48])<<13 | uint64(b[49])<<21 | uint64(b[50])<<29 | uint64(b[51])<<37 | uint64(b[52])<<45) & 0x1FFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width54(b []byte, out []int64) {
+
+	_ = b[53]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0x3FFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6])>>6 | uint64(b[7])<<2 | uint64(b[8])<<10 | uint64(b[9])<<18 | uint64(b[10])<<26 | uint64(b[11])<<34 | uint64(b[12])<<42 | uint64(b[13])<<50) & 0x3FFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[13])>>4 | uint64(b[14])<<4 | uint64(b[15])<<12 | uint64(b[16])<<20 | uint64(b[17])<<28 | uint64(b[18])<<36 | uint64(b[19])<<44 | uint64(b[20])<<52) & 0x3FFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[20])>>2 | uint64(b[21])<<6 | uint64(b[22])<<14 | uint64(b[23])<<22 | uint64(b[24])<<30 | uint64(b[25])<<38 | uint64(b[26])<<46) & 0x3FFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[27]) | uint64(b[28])<<8 | uint64(b[29])<<16 | uint64(b[30])<<24 | uint64(b[31])<<32 | uint64(b[32])<<40 | uint64(b[33])<<48) & 0x3FFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[33])>>6 | uint64(b[34])<<2 | uint64(b[35])<<10 | uint64(b[36])<<18 | uint64(b[37])<<26 | uint64(b[38])<<34 | uint64(b[39])<<42 | uint64(b[40])<<50) & 0x3FFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[40])>>4 | uint64(b[41])<<4 | uint64(b[42])<<12 | uint64(b[43])<<20 | uint64(b[44])<<28 | uint64(b[45])<<36 | uint64(b[46])<<44 | uint64(b[47])<<52) & 0x3FFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[47])>>2 | uint64(b[48])<<6 | uint64(b[49])<<14 | uint64(b[50])<<22 | uint64(b[51])<<30 | uint64(b[52])<<38 | uint64(b[53])<<46) & 0x3FFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width55(b []byte, out []int64) {
+
+	_ = b[54]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0x7FFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[6])>>7 | uint64(b[7])<<1 | uint64(b[8])<<9 | uint64(b[9])<<17 | uint64(b[10])<<25 | uint64(b[11])<<33 | uint64(b[12])<<41 | uint64(b[13])<<49) & 0x7FFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[13])>>6 | uint64(b[14])<<2 | uint64(b[15])<<10 | uint64(b[16])<<18 | uint64(b[17])<<26 | uint64(b[18])<<34 | uint64(b[19])<<42 | uint64(b[20])<<50) & 0x7FFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[20])>>5 | uint64(b[21])<<3 | uint64(b[22])<<11 | uint64(b[23])<<19 | uint64(b[24])<<27 | uint64(b[25])<<35 | uint64(b[26])<<43 | uint64(b[27])<<51) & 0x7FFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[27])>>4 | uint64(b[28])<<4 | uint64(b[29])<<12 | uint64(b[30])<<20 | uint64(b[31])<<28 | uint64(b[32])<<36 | uint64(b[33])<<44 | uint64(b[34])<<52) & 0x7FFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[34])>>3 | uint64(b[35])<<5 | uint64(b[36])<<13 | uint64(b[37])<<21 | uint64(b[38])<<29 | uint64(b[39])<<37 | uint64(b[40])<<45 | uint64(b[41])<<53) & 0x7FFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[41])>>2 | uint64(b[42])<<6 | uint64(b[43])<<14 | uint64(b[44])<<22 | uint64(b[45])<<30 | uint64(b[46])<<38 | uint64(b[47])<<46 | uint64(b[48])<<54) & 0x7FFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[48])>>1 | uint64(b[49])<<7 | uint64(b[50])<<15 | uint64(b[51])<<23 | uint64(b[52])<<31 | uint64(b[53])<<39 | uint64(b[54])<<47) & 0x7FFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width56(b []byte, out []int64) {
+
+	_ = b[55]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48) & 0xFFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7]) | uint64(b[8])<<8 | uint64(b[9])<<16 | uint64(b[10])<<24 | uint64(b[11])<<32 | uint64(b[12])<<40 | uint64(b[13])<<48) & 0xFFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[14]) | uint64(b[15])<<8 | uint64(b[16])<<16 | uint64(b[17])<<24 | uint64(b[18])<<32 | uint64(b[19])<<40 | uint64(b[20])<<48) & 0xFFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[21]) | uint64(b[22])<<8 | uint64(b[23])<<16 | uint64(b[24])<<24 | uint64(b[25])<<32 | uint64(b[26])<<40 | uint64(b[27])<<48) & 0xFFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[28]) | uint64(b[29])<<8 | uint64(b[30])<<16 | uint64(b[31])<<24 | uint64(b[32])<<32 | uint64(b[33])<<40 | uint64(b[34])<<48) & 0xFFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[35]) | uint64(b[36])<<8 | uint64(b[37])<<16 | uint64(b[38])<<24 | uint64(b[39])<<32 | uint64(b[40])<<40 | uint64(b[41])<<48) & 0xFFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[42]) | uint64(b[43])<<8 | uint64(b[44])<<16 | uint64(b[45])<<24 | uint64(b[46])<<32 | uint64(b[47])<<40 | uint64(b[48])<<48) & 0xFFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[49]) | uint64(b[50])<<8 | uint64(b[51])<<16 | uint64(b[52])<<24 | uint64(b[53])<<32 | uint64(b[54])<<40 | uint64(b[55])<<48) & 0xFFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width57(b []byte, out []int64) {
+
+	_ = b[56]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56) & 0x1FFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7])>>1 | uint64(b[8])<<7 | uint64(b[9])<<15 | uint64(b[10])<<23 | uint64(b[11])<<31 | uint64(b[12])<<39 | uint64(b[13])<<47 | uint64(b[14])<<55) & 0x1FFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[14])>>2 | uint64(b[15])<<6 | uint64(b[16])<<14 | uint64(b[17])<<22 | uint64(b[18])<<30 | uint64(b[19])<<38 | uint64(b[20])<<46 | uint64(b[21])<<54) & 0x1FFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[21])>>3 | uint64(b[22])<<5 | uint64(b[23])<<13 | uint64(b[24])<<21 | uint64(b[25])<<29 | uint64(b[26])<<37 | uint64(b[27])<<45 | uint64(b[28])<<53) & 0x1FFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[28])>>4 | uint64(b[29])<<4 | uint64(b[30])<<12 | uint64(b[31])<<20 | uint64(b[32])<<28 | uint64(b[33])<<36 | uint64(b[34])<<44 | uint64(b[35])<<52) & 0x1FFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[35])>>5 | uint64(b[36])<<3 | uint64(b[37])<<11 | uint64(b[38])<<19 | uint64(b[39])<<27 | uint64(b[40])<<35 | uint64(b[41])<<43 | uint64(b[42])<<51) & 0x1FFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[42])>>6 | uint64(b[43])<<2 | uint64(b[44])<<10 | uint64(b[45])<<18 | uint64(b[46])<<26 | uint64(b[47])<<34 | uint64(b[48])<<42 | uint64(b[49])<<50) & 0x1FFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[49])>>7 | uint64(b[50])<<1 | uint64(b[51])<<9 | uint64(b[52])<<17 | uint64(b[53])<<25 | uint64(b[54])<<33 | uint64(b[55])<<41 | uint64(b[56])<<49) & 0x1FFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width58(b []byte, out []int64) {
+
+	_ = b[57]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56) & 0x3FFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7])>>2 | uint64(b[8])<<6 | uint64(b[9])<<14 | uint64(b[10])<<22 | uint64(b[11])<<30 | uint64(b[12])<<38 | uint64(b[13])<<46 | uint64(b[14])<<54) & 0x3FFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[14])>>4 | uint64(b[15])<<4 | uint64(b[16])<<12 | uint64(b[17])<<20 | uint64(b[18])<<28 | uint64(b[19])<<36 | uint64(b[20])<<44 | uint64(b[21])<<52) & 0x3FFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[21])>>6 | uint64(b[22])<<2 | uint64(b[23])<<10 | uint64(b[24])<<18 | uint64(b[25])<<26 | uint64(b[26])<<34 | uint64(b[27])<<42 | uint64(b[28])<<50) & 0x3FFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[29]) | uint64(b[30])<<8 | uint64(b[31])<<16 | uint64(b[32])<<24 | uint64(b[33])<<32 | uint64(b[34])<<40 | uint64(b[35])<<48 | uint64(b[36])<<56) & 0x3FFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[36])>>2 | uint64(b[37])<<6 | uint64(b[38])<<14 | uint64(b[39])<<22 | uint64(b[40])<<30 | uint64(b[41])<<38 | uint64(b[42])<<46 | uint64(b[43])<<54) & 0x3FFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[43])>>4 | uint64(b[44])<<4 | uint64(b[45])<<12 | uint64(b[46])<<20 | uint64(b[47])<<28 | uint64(b[48])<<36 | uint64(b[49])<<44 | uint64(b[50])<<52) & 0x3FFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[50])>>6 | uint64(b[51])<<2 | uint64(b[52])<<10 | uint64(b[53])<<18 | uint64(b[54])<<26 | uint64(b[55])<<34 | uint64(b[56])<<42 | uint64(b[57])<<50) & 0x3FFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width59(b []byte, out []int64) {
+
+	_ = b[58]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56) & 0x7FFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7])>>3 | uint64(b[8])<<5 | uint64(b[9])<<13 | uint64(b[10])<<21 | uint64(b[11])<<29 | uint64(b[12])<<37 | uint64(b[13])<<45 | uint64(b[14])<<53) & 0x7FFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[14])>>6 | uint64(b[15])<<2 | uint64(b[16])<<10 | uint64(b[17])<<18 | uint64(b[18])<<26 | uint64(b[19])<<34 | uint64(b[20])<<42 | uint64(b[21])<<50 | uint64(b[22])<<58) & 0x7FFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[22])>>1 | uint64(b[23])<<7 | uint64(b[24])<<15 | uint64(b[25])<<23 | uint64(b[26])<<31 | uint64(b[27])<<39 | uint64(b[28])<<47 | uint64(b[29])<<55) & 0x7FFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[29])>>4 | uint64(b[30])<<4 | uint64(b[31])<<12 | uint64(b[32])<<20 | uint64(b[33])<<28 | uint64(b[34])<<36 | uint64(b[35])<<44 | uint64(b[36])<<52) & 0x7FFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[36])>>7 | uint64(b[37])<<1 | uint64(b[38])<<9 | uint64(b[39])<<17 | uint64(b[40])<<25 | uint64(b[41])<<33 | uint64(b[42])<<41 | uint64(b[43])<<49 | uint64(b[44])<<57) & 0x7FFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[44])>>2 | uint64(b[45])<<6 | uint64(b[46])<<14 | uint64(b[47])<<22 | uint64(b[48])<<30 | uint64(b[49])<<38 | uint64(b[50])<<46 | uint64(b[51])<<54) & 0x7FFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[51])>>5 | uint64(b[52])<<3 | uint64(b[53])<<11 | uint64(b[54])<<19 | uint64(b[55])<<27 | uint64(b[56])<<35 | uint64(b[57])<<43 | uint64(b[58])<<51) & 0x7FFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width60(b []byte, out []int64) {
+
+	_ = b[59]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56) & 0xFFFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7])>>4 | uint64(b[8])<<4 | uint64(b[9])<<12 | uint64(b[10])<<20 | uint64(b[11])<<28 | uint64(b[12])<<36 | uint64(b[13])<<44 | uint64(b[14])<<52) & 0xFFFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[15]) | uint64(b[16])<<8 | uint64(b[17])<<16 | uint64(b[18])<<24 | uint64(b[19])<<32 | uint64(b[20])<<40 | uint64(b[21])<<48 | uint64(b[22])<<56) & 0xFFFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[22])>>4 | uint64(b[23])<<4 | uint64(b[24])<<12 | uint64(b[25])<<20 | uint64(b[26])<<28 | uint64(b[27])<<36 | uint64(b[28])<<44 | uint64(b[29])<<52) & 0xFFFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[30]) | uint64(b[31])<<8 | uint64(b[32])<<16 | uint64(b[33])<<24 | uint64(b[34])<<32 | uint64(b[35])<<40 | uint64(b[36])<<48 | uint64(b[37])<<56) & 0xFFFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[37])>>4 | uint64(b[38])<<4 | uint64(b[39])<<12 | uint64(b[40])<<20 | uint64(b[41])<<28 | uint64(b[42])<<36 | uint64(b[43])<<44 | uint64(b[44])<<52) & 0xFFFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[45]) | uint64(b[46])<<8 | uint64(b[47])<<16 | uint64(b[48])<<24 | uint64(b[49])<<32 | uint64(b[50])<<40 | uint64(b[51])<<48 | uint64(b[52])<<56) & 0xFFFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[52])>>4 | uint64(b[53])<<4 | uint64(b[54])<<12 | uint64(b[55])<<20 | uint64(b[56])<<28 | uint64(b[57])<<36 | uint64(b[58])<<44 | uint64(b[59])<<52) & 0xFFFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width61(b []byte, out []int64) {
+
+	_ = b[60]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56) & 0x1FFFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7])>>5 | uint64(b[8])<<3 | uint64(b[9])<<11 | uint64(b[10])<<19 | uint64(b[11])<<27 | uint64(b[12])<<35 | uint64(b[13])<<43 | uint64(b[14])<<51 | uint64(b[15])<<59) & 0x1FFFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[15])>>2 | uint64(b[16])<<6 | uint64(b[17])<<14 | uint64(b[18])<<22 | uint64(b[19])<<30 | uint64(b[20])<<38 | uint64(b[21])<<46 | uint64(b[22])<<54) & 0x1FFFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[22])>>7 | uint64(b[23])<<1 | uint64(b[24])<<9 | uint64(b[25])<<17 | uint64(b[26])<<25 | uint64(b[27])<<33 | uint64(b[28])<<41 | uint64(b[29])<<49 | uint64(b[30])<<57) & 0x1FFFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[30])>>4 | uint64(b[31])<<4 | uint64(b[32])<<12 | uint64(b[33])<<20 | uint64(b[34])<<28 | uint64(b[35])<<36 | uint64(b[36])<<44 | uint64(b[37])<<52 | uint64(b[38])<<60) & 0x1FFFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[38])>>1 | uint64(b[39])<<7 | uint64(b[40])<<15 | uint64(b[41])<<23 | uint64(b[42])<<31 | uint64(b[43])<<39 | uint64(b[44])<<47 | uint64(b[45])<<55) & 0x1FFFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[45])>>6 | uint64(b[46])<<2 | uint64(b[47])<<10 | uint64(b[48])<<18 | uint64(b[49])<<26 | uint64(b[50])<<34 | uint64(b[51])<<42 | uint64(b[52])<<50 | uint64(b[53])<<58) & 0x1FFFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[53])>>3 | uint64(b[54])<<5 | uint64(b[55])<<13 | uint64(b[56])<<21 | uint64(b[57])<<29 | uint64(b[58])<<37 | uint64(b[59])<<45 | uint64(b[60])<<53) & 0x1FFFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width62(b []byte, out []int64) {
+
+	_ = b[61]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56) & 0x3FFFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7])>>6 | uint64(b[8])<<2 | uint64(b[9])<<10 | uint64(b[10])<<18 | uint64(b[11])<<26 | uint64(b[12])<<34 | uint64(b[13])<<42 | uint64(b[14])<<50 | uint64(b[15])<<58) & 0x3FFFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[15])>>4 | uint64(b[16])<<4 | uint64(b[17])<<12 | uint64(b[18])<<20 | uint64(b[19])<<28 | uint64(b[20])<<36 | uint64(b[21])<<44 | uint64(b[22])<<52 | uint64(b[23])<<60) & 0x3FFFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[23])>>2 | uint64(b[24])<<6 | uint64(b[25])<<14 | uint64(b[26])<<22 | uint64(b[27])<<30 | uint64(b[28])<<38 | uint64(b[29])<<46 | uint64(b[30])<<54) & 0x3FFFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[31]) | uint64(b[32])<<8 | uint64(b[33])<<16 | uint64(b[34])<<24 | uint64(b[35])<<32 | uint64(b[36])<<40 | uint64(b[37])<<48 | uint64(b[38])<<56) & 0x3FFFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[38])>>6 | uint64(b[39])<<2 | uint64(b[40])<<10 | uint64(b[41])<<18 | uint64(b[42])<<26 | uint64(b[43])<<34 | uint64(b[44])<<42 | uint64(b[45])<<50 | uint64(b[46])<<58) & 0x3FFFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[46])>>4 | uint64(b[47])<<4 | uint64(b[48])<<12 | uint64(b[49])<<20 | uint64(b[50])<<28 | uint64(b[51])<<36 | uint64(b[52])<<44 | uint64(b[53])<<52 | uint64(b[54])<<60) & 0x3FFFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[54])>>2 | uint64(b[55])<<6 | uint64(b[56])<<14 | uint64(b[57])<<22 | uint64(b[58])<<30 | uint64(b[59])<<38 | uint64(b[60])<<46 | uint64(b[61])<<54) & 0x3FFFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width63(b []byte, out []int64) {
+
+	_ = b[62]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64((uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56) & 0x7FFFFFFFFFFFFFFF)
+	out[1] = int64((uint64(b[7])>>7 | uint64(b[8])<<1 | uint64(b[9])<<9 | uint64(b[10])<<17 | uint64(b[11])<<25 | uint64(b[12])<<33 | uint64(b[13])<<41 | uint64(b[14])<<49 | uint64(b[15])<<57) & 0x7FFFFFFFFFFFFFFF)
+	out[2] = int64((uint64(b[15])>>6 | uint64(b[16])<<2 | uint64(b[17])<<10 | uint64(b[18])<<18 | uint64(b[19])<<26 | uint64(b[20])<<34 | uint64(b[21])<<42 | uint64(b[22])<<50 | uint64(b[23])<<58) & 0x7FFFFFFFFFFFFFFF)
+	out[3] = int64((uint64(b[23])>>5 | uint64(b[24])<<3 | uint64(b[25])<<11 | uint64(b[26])<<19 | uint64(b[27])<<27 | uint64(b[28])<<35 | uint64(b[29])<<43 | uint64(b[30])<<51 | uint64(b[31])<<59) & 0x7FFFFFFFFFFFFFFF)
+	out[4] = int64((uint64(b[31])>>4 | uint64(b[32])<<4 | uint64(b[33])<<12 | uint64(b[34])<<20 | uint64(b[35])<<28 | uint64(b[36])<<36 | uint64(b[37])<<44 | uint64(b[38])<<52 | uint64(b[39])<<60) & 0x7FFFFFFFFFFFFFFF)
+	out[5] = int64((uint64(b[39])>>3 | uint64(b[40])<<5 | uint64(b[41])<<13 | uint64(b[42])<<21 | uint64(b[43])<<29 | uint64(b[44])<<37 | uint64(b[45])<<45 | uint64(b[46])<<53 | uint64(b[47])<<61) & 0x7FFFFFFFFFFFFFFF)
+	out[6] = int64((uint64(b[47])>>2 | uint64(b[48])<<6 | uint64(b[49])<<14 | uint64(b[50])<<22 | uint64(b[51])<<30 | uint64(b[52])<<38 | uint64(b[53])<<46 | uint64(b[54])<<54 | uint64(b[55])<<62) & 0x7FFFFFFFFFFFFFFF)
+	out[7] = int64((uint64(b[55])>>1 | uint64(b[56])<<7 | uint64(b[57])<<15 | uint64(b[58])<<23 | uint64(b[59])<<31 | uint64(b[60])<<39 | uint64(b[61])<<47 | uint64(b[62])<<55) & 0x7FFFFFFFFFFFFFFF)
+}
+
+func unpack8Int64Width64(b []byte, out []int64) {
+
+	_ = b[63]
+	_ = out[7] // eliminate bounds checks
+	out[0] = int64(uint64(b[0]) | uint64(b[1])<<8 | uint64(b[2])<<16 | uint64(b[3])<<24 | uint64(b[4])<<32 | uint64(b[5])<<40 | uint64(b[6])<<48 | uint64(b[7])<<56)
+	out[1] = int64(uint64(b[8]) | uint64(b[9])<<8 | uint64(b[10])<<16 | uint64(b[11])<<24 | uint64(b[12])<<32 | uint64(b[13])<<40 | uint64(b[14])<<48 | uint64(b[15])<<56)
+	out[2] = int64(uint64(b[16]) | uint64(b[17])<<8 | uint64(b[18])<<16 | uint64(b[19])<<24 | uint64(b[20])<<32 | uint64(b[21])<<40 | uint64(b[22])<<48 | uint64(b[23])<<56)
+	out[3] = int64(uint64(b[24]) | uint64(b[25])<<8 | uint64(b[26])<<16 | uint64(b[27])<<24 | uint64(b[28])<<32 | uint64(b[29])<<40 | uint64(b[30])<<48 | uint64(b[31])<<56)
+	out[4] = int64(uint64(b[32]) | uint64(b[33])<<8 | uint64(b[34])<<16 | uint64(b[35])<<24 | uint64(b[36])<<32 | uint64(b[37])<<40 | uint64(b[38])<<48 | uint64(b[39])<<56)
+	out[5] = int64(uint64(b[40]) | uint64(b[41])<<8 | uint64(b[42])<<16 | uint64(b[43])<<24 | uint64(b[44])<<32 | uint64(b[45])<<40 | uint64(b[46])<<48 | uint64(b[47])<<56)
+	out[6] = int64(uint64(b[48]) | uint64(b[49])<<8 | uint64(b[50])<<16 | uint64(b[51])<<24 | uint64(b[52])<<32 | uint64(b[53])<<40 | uint64(b[54])<<48 | uint64(b[55])<<56)
+	out[7] = int64(uint64(b[56]) | uint64(b[57])<<8 | uint64(b[58])<<16 | uint64(b[59])<<24 | uint64(b[60])<<32 | uint64(b[61])<<40 | uint64(b[62])<<48 | uint64(b[63])<<56)
+}
+
+// unpack8Int64FuncForWidth returns the function that unpacks 8 values of
+// bitWidth bits, 33 <= bitWidth <= 64.
+func unpack8Int64FuncForWidth(bitWidth uint) func([]byte, []int64) {
+	switch bitWidth {
+	case 33:
+		return unpack8Int64Width33
+	case 34:
+		return unpack8Int64Width34
+	case 35:
+		return unpack8Int64Width35
+	case 36:
+		return unpack8Int64Width36
+	case 37:
+		return unpack8Int64Width37
+	case 38:
+		return unpack8Int64Width38
+	case 39:
+		return unpack8Int64Width39
+	case 40:
+		return unpack8Int64Width40
+	case 41:
+		return unpack8Int64Width41
+	case 42:
+		return unpack8Int64Width42
+	case 43:
+		return unpack8Int64Width43
+	case 44:
+		return unpack8Int64Width44
+	case 45:
+		return unpack8Int64Width45
+	case 46:
+		return unpack8Int64Width46
+	case 47:
+		return unpack8Int64Width47
+	case 48:
+		return unpack8Int64Width48
+	case 49:
+		return unpack8Int64Width49
+	case 50:
+		return unpack8Int64Width50
+	case 51:
+		return unpack8Int64Width51
+	case 52:
+		return unpack8Int64Width52
+	case 53:
+		return unpack8Int64Width53
+	case 54:
+		return unpack8Int64Width54
+	case 55:
+		return unpack8Int64Width55
+	case 56:
+		return unpack8Int64Width56
+	case 57:
+		return unpack8Int64Width57
+	case 58:
+		return unpack8Int64Width58
+	case 59:
+		return unpack8Int64Width59
+	case 60:
+		return unpack8Int64Width60
+	case 61:
+		return unpack8Int64Width61
+	case 62:
+		return unpack8Int64Width62
+	case 63:
+		return unpack8Int64Width63
+	case 64:
+		return unpack8Int64Width64
+	default:
+		panic(fmt.Sprint("invalid bitWidth: ", bitWidth))
+	}
+}
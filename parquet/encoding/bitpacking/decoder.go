@@ -13,6 +13,7 @@ type Decoder struct {
 	b      [32]byte
 	p      []byte
 	decode decodef
+	groups func([]byte, []int32) // see UnpackGroups
 }
 
 func NewDecoder(bitWidth uint) *Decoder {
@@ -92,6 +93,7 @@ func NewDecoder(bitWidth uint) *Decoder {
 	default:
 		panic("invalid bitWidth")
 	}
+	d.groups = unpackGroupsFuncForWidth(bitWidth)
 
 	return d
 }
@@ -117,27 +119,44 @@ func (d *Decoder) ReadLength(r io.Reader) (uint, error) {
 	return 0, fmt.Errorf("invalid header: rle header found, expected bitpacking header")
 }
 
-func (d *Decoder) Read(r io.Reader, out []int32) error {
-	// this assumes len(out) has the exact right
-	// amount of data to read
-	buffer := make([]int32, 8)
+// Unpack8 decodes the 8 values packed in b into out. b must hold exactly
+// bitWidth bytes and out must have room for at least 8 values.
+func (d *Decoder) Unpack8(b []byte, out []int32) error {
+	return d.decode(b, out)
+}
+
+// UnpackGroups decodes len(out)/8 groups of 8 values from b, with the
+// unpacker of the groups of the bit width: a single call per run. b must
+// hold at least bitWidth bytes for every group.
+func (d *Decoder) UnpackGroups(b []byte, out []int32) error {
+	groups := len(out) / 8
+	if len(b) < groups*len(d.p) {
+		return fmt.Errorf("unpack: %d bytes for %d groups of %d bytes", len(b), groups, len(d.p))
+	}
+	d.groups(b, out[:groups*8])
+	return nil
+}
 
-	i := 0
+// Read decodes len(out) values from r. Values are read in groups of 8 so
+// the last group is fully consumed even when len(out) is not a multiple of 8.
+func (d *Decoder) Read(r io.Reader, out []int32) error {
+	var buffer [8]int32
 
-	for i < len(out) {
-		_, err := r.Read(d.p)
-		if err != nil {
+	for i := 0; i < len(out); i += 8 {
+		if _, err := io.ReadFull(r, d.p); err != nil {
 			return fmt.Errorf("decodeRLE:reader:%s", err)
 		}
 
-		if err := d.decode(d.p, buffer); err != nil {
+		dst := buffer[:]
+		if len(out)-i >= 8 {
+			dst = out[i : i+8]
+		}
+		if err := d.decode(d.p, dst); err != nil {
 			return fmt.Errorf("decodeRLE:decode:%s", err)
 		}
-
-		for j := 0; j < 8 && i < len(out); j, i = j+1, i+1 {
-			out[i] = buffer[j]
+		if len(out)-i < 8 {
+			copy(out[i:], buffer[:])
 		}
-
 	}
 
 	return nil
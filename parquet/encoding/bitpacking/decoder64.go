@@ -0,0 +1,76 @@
+package bitpacking
+
+import (
+	"fmt"
+	"io"
+)
+
+// Decoder64 reads groups of 8 bit-packed values that can be up to 64 bits
+// wide.
+type Decoder64 struct {
+	b      [64]byte
+	p      []byte
+	unpack func([]byte, []int64)
+
+	// values up to 32 bits wide are decoded by the int32 unpackers
+	narrow *Decoder
+	buffer [8]int32
+}
+
+// NewDecoder64 returns a Decoder64 for values of bitWidth bits.
+func NewDecoder64(bitWidth uint) *Decoder64 {
+	if bitWidth == 0 || bitWidth > 64 {
+		panic("invalid 0 > bitWidth <= 64")
+	}
+
+	d := &Decoder64{}
+	d.p = d.b[:bitWidth] // 8 values of bitWidth bits take bitWidth bytes
+
+	if bitWidth <= 32 {
+		d.narrow = NewDecoder(bitWidth)
+		d.unpack = d.unpackNarrow
+	} else {
+		d.unpack = unpack8Int64FuncForWidth(bitWidth)
+	}
+
+	return d
+}
+
+// UnpackGroups decodes len(out)/8 groups of 8 values from b. b must hold at
+// least bitWidth bytes for every group.
+func (d *Decoder64) UnpackGroups(b []byte, out []int64) {
+	size := len(d.p)
+	for i := 0; i+8 <= len(out); i += 8 {
+		d.unpack(b[:size], out[i:i+8])
+		b = b[size:]
+	}
+}
+
+// Read decodes len(out) values from r. Values are read in groups of 8 so
+// the last group is fully consumed even when len(out) is not a multiple of 8.
+func (d *Decoder64) Read(r io.Reader, out []int64) error {
+	var buffer [8]int64
+
+	for i := 0; i < len(out); i += 8 {
+		if _, err := io.ReadFull(r, d.p); err != nil {
+			return fmt.Errorf("decodeRLE:reader:%s", err)
+		}
+
+		if len(out)-i >= 8 {
+			d.unpack(d.p, out[i:i+8])
+		} else {
+			d.unpack(d.p, buffer[:])
+			copy(out[i:], buffer[:])
+		}
+	}
+
+	return nil
+}
+
+func (d *Decoder64) unpackNarrow(b []byte, out []int64) {
+	d.narrow.decode(b, d.buffer[:])
+	for i, v := range d.buffer {
+		// values are unsigned: do not sign extend 32 bits wide values
+		out[i] = int64(uint32(v))
+	}
+}
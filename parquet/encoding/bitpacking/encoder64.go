@@ -0,0 +1,75 @@
+package bitpacking
+
+import (
+	"io"
+)
+
+// Encoder64 packs groups of 8 values that can be up to 64 bits wide.
+type Encoder64 struct {
+	b        [64]byte
+	bitWidth uint
+}
+
+// NewEncoder64 returns an Encoder64 for values of bitWidth bits.
+func NewEncoder64(bitWidth uint) *Encoder64 {
+	if bitWidth == 0 || bitWidth > 64 {
+		panic("invalid 0 > bitWidth <= 64")
+	}
+	return &Encoder64{bitWidth: bitWidth}
+}
+
+// Write writes in w all the values, padding the last group of 8 with zeros,
+// and returns the total number of bytes written.
+func (e *Encoder64) Write(w io.Writer, values []uint64) (int, error) {
+	var (
+		total  int
+		buffer [8]uint64
+	)
+
+	for i := 0; i < len(values); i += 8 {
+		group := values[i:]
+		if len(group) < 8 {
+			copy(buffer[:], group)
+			for j := len(group); j < 8; j++ {
+				buffer[j] = 0
+			}
+			group = buffer[:]
+		}
+
+		n, err := w.Write(e.pack8(group))
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// pack8 packs 8 values from the least significant bit to the most
+// significant bit of each byte.
+func (e *Encoder64) pack8(values []uint64) []byte {
+	b := e.b[:e.bitWidth]
+	for i := range b {
+		b[i] = 0
+	}
+
+	bit := uint(0)
+	for _, v := range values[:8] {
+		if e.bitWidth < 64 {
+			v &= 1<<e.bitWidth - 1
+		}
+		for written := uint(0); written < e.bitWidth; {
+			b[bit/8] |= byte(v << (bit % 8))
+			n := 8 - bit%8
+			if n > e.bitWidth-written {
+				n = e.bitWidth - written
+			}
+			v >>= n
+			bit += n
+			written += n
+		}
+	}
+
+	return b
+}
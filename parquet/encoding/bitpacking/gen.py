@@ -100,163 +100,143 @@ for bitWidth in range (1, 33):
 
 	print >>fd, "\n\treturn b[:%d]\n}\n" % buffer_index
 
-# What is the minimum amount of bytes to read without having pending values
+# Decoders: every value is built by OR-ing the (shifted) bytes it spans and
+# masking the result. Working on uint32 keeps the shifts from overflowing and
+# the code is simple enough for the compiler to keep everything in registers.
 
 for bitWidth in range (1, 32+1):
-	# given 8 number how many bytes will be required to encode them
-	byteBoundary = ((bitWidth + 7) / 8)
-
 	print >>fd, "func (d *Decoder) decode%dRLE(b []byte, out []int32) error { " % bitWidth
 	print >>fd, """
 	if len(b) != %d {
 		panic(fmt.Sprint("expected: ", %d, " got ", len(b)) )
-	}""" % ( (bitWidth * 8)/8, (bitWidth * 8)/8)
+	}
+	_ = out[7] // eliminate bounds checks""" % (bitWidth, bitWidth)
 
-	buffer_index = 0
-	bit_consumed = 0
+	mask = (1 << bitWidth) - 1
 
-	def select_and_shift(buffer_index, byte_index, shift_right=0, shift_left=0, assign=False):
-		bitWidthMask = int( ''.join(['1']*byteBoundary), 2) >> (byteBoundary-bitWidth)
+	for index in range(0, 8):
+		start = index * bitWidth
+		stop = start + bitWidth
 
-		select_byte = "b[%d]" % buffer_index
-		# Mask it
-		mask = ("0x%0X" % bitWidthMask) if bitWidthMask < 0xFF else '0xFF'
-		# select_byte = "(%s & %s)" % (select_byte, mask)
+		byteStart = start / 8
+		byteStop = (stop + 7) / 8
+		shift = start % 8
 
-		if shift_right > 0 & shift_left > 0:
-			op = "%s >> %d << %d)" % (select_byte, shift_right, shift_left)
-		elif shift_right > 0:
-			op = "%s >> %d" % (select_byte, shift_right)
-		elif shift_left > 0:
-			op = "%s << %d" % (select_byte, shift_left)
-		else:
-			op = "%s" % (select_byte)
+		ops = []
+		for byteIndex in range(byteStart, byteStop):
+			op = "uint32(b[%d])" % byteIndex
+			if byteIndex == byteStart:
+				if shift > 0:
+					op = "%s >> %d" % (op, shift)
+			else:
+				op = "%s << %d" % (op, (byteIndex - byteStart) * 8 - shift)
+			ops.append(op)
 
-		op =  "(%s) & %s" % (op, mask)
+		value = ' | '.join(ops)
+		if bitWidth < 32 and (shift > 0 or stop % 8 != 0):
+			if len(ops) > 1 or shift > 0:
+				value = "(%s)" % value
+			value = "%s & 0x%X" % (value, mask)
 
-		if assign:
-			print >>fd, "\tout[%d] = int32(%s)" % (index , op)
-		else:
-			print >>fd, "\tout[%d] += int32(%s) " % (index, op)
-
-	def selectBytes(byteIndex, mask, shift, rshift=0):
-		if mask == "11111111":
-			mask = None
-
-		byteSelect = "b[%d]" % byteIndex
-
-		if mask:
-			byteSelect = "(%s & 0x%0x)" % (byteSelect, int(mask,2))
-
-		if shift > 0:
-			byteSelect = "%s >> %d" % (byteSelect, shift)
-
-		if rshift == 8:
-			rshift = 0
-
-		if rshift > 0:
-			byteSelect = "(%s) << %d" % (byteSelect, rshift)
-
-		return byteSelect
-
-	def sliceByteMask(bytes, bitSlices):
-		splits = len(bytes)
-		slices = []
-		j = 0
-		while splits > 1:
-			for i in range(len(bitSlices)):
-				if bitSlices[i] == 7:
-					slices.append(bitSlices[j:i+1])
-					j = i+1
-					splits-=1
-			slices.append(bitSlices[j:])
-			splits -= 1
-
-		if not slices:
-			slices = [bitSlices]
-
-		return slices
-
-	def computeByteMask(bytes, bitSlices):
-		slices = sliceByteMask(bytes, bitSlices)
-		add_ops = []
-		for i, b in enumerate(bytes):
-			if len(slices[i]) == 1:
-				bitToSelect = slices[i][0]
-				mask = '1' + '0' * bitToSelect
-				shift = bitToSelect
-				add_ops.append(selectBytes(b, mask, shift))
-				previousShift = 1
-			else:
-				bitMask = slices[i][-1] - slices[i][0]
-				mask = ('1' * (bitMask+1)) + '0' * slices[i][0]
-				shift = slices[i][0]
-				if i == 0:
-					add_ops.append(selectBytes(b, mask, shift))
-				else:
-					add_ops.append(selectBytes(b, mask, shift, previousShift))
-				previousShift = (bitMask+1)
-
-		return add_ops
-
-	def aggregate(operations):
-		ops = []
-		if bitWidth < 8:
-			return ' | '.join(operations)
+		print >>fd, "\tout[%d] = int32(%s)" % (index, value)
 
-		for i,op in enumerate(operations):
-			if i == 0:
-				ops.append("int32(%s)" % op)
-			else:
-				ops.append("(int32(%s) << %d)" % (op, i * 8))
+	print >>fd, "\n\treturn nil\n}\n"
 
-		return ' + '.join(ops)
+# Group decoders: the same code in a loop over the groups of 8 values, to
+# unpack a run without an indirect call per group.
 
-	def other(bytes, bitSlices):
-		start = None
-		masks = []
-		maskSize = 0
+for bitWidth in range (1, 32+1):
+	print >>fd, "func unpackGroups%d(b []byte, out []int32) { " % bitWidth
+	print >>fd, """
+	for len(b) >= %d && len(out) >= 8 {
+		_ = b[%d]
+		_ = out[7] // eliminate bounds checks""" % (bitWidth, bitWidth - 1)
 
-		for bit in bitSlices:
-			if start is None:
-				start = bit
-				last = bit
-				continue
+	mask = (1 << bitWidth) - 1
 
-			if bit == last + 1:
-				maskSize = (bit-start+1)
-				last = bit
-				continue
-			else:
-				# new byte
-				mask = '1' * maskSize + '0'*start
-				masks.append( ("%s" % mask, " >> %d" % start) )
-				maskSize = 0
-				start = bit
-				last = bit
-
-		if len(masks) != len(bytes):
-			lastMask = maskSize
-			maskSize = (bit-start+1)
-			mask = '1' * maskSize + '0'*start
-			masks.append( ("%s" % mask, " >> %d" % start ) )
-
-		return masks
-
-	byteIdx = 0
 	for index in range(0, 8):
-		bit_pending = 0
-
-		start = (index * bitWidth)
-		stop = (index+1) * bitWidth
+		start = index * bitWidth
+		stop = start + bitWidth
 
 		byteStart = start / 8
-		byteStop = (stop+7) / 8
+		byteStop = (stop + 7) / 8
+		shift = start % 8
 
-		bitSlices = [ i%8 for i in range(start, stop)]
+		ops = []
+		for byteIndex in range(byteStart, byteStop):
+			op = "uint32(b[%d])" % byteIndex
+			if byteIndex == byteStart:
+				if shift > 0:
+					op = "%s >> %d" % (op, shift)
+			else:
+				op = "%s << %d" % (op, (byteIndex - byteStart) * 8 - shift)
+			ops.append(op)
 
-		byteIndexes = range(byteStart, byteStop)
+		value = ' | '.join(ops)
+		if bitWidth < 32 and (shift > 0 or stop % 8 != 0):
+			if len(ops) > 1 or shift > 0:
+				value = "(%s)" % value
+			value = "%s & 0x%X" % (value, mask)
 
-		print >>fd, "\tout[%d] = int32(%s)" % (index, aggregate(computeByteMask(byteIndexes, bitSlices)))
+		print >>fd, "\t\tout[%d] = int32(%s)" % (index, value)
 
-	print >>fd, "\n\treturn nil\n}\n"
+	print >>fd, "\t\tb, out = b[%d:], out[8:]\n\t}\n}\n" % bitWidth
+
+print >>fd, """// unpackGroupsFuncForWidth returns the function that unpacks the groups of
+// 8 values of bitWidth bits, 1 <= bitWidth <= 32.
+func unpackGroupsFuncForWidth(bitWidth uint) func([]byte, []int32) {
+	switch bitWidth {"""
+for bitWidth in range (1, 32+1):
+	print >>fd, "\tcase %d:\n\t\treturn unpackGroups%d" % (bitWidth, bitWidth)
+print >>fd, """	default:
+		panic(fmt.Sprint("invalid bitWidth: ", bitWidth))
+	}
+}
+"""
+
+# 64 bit decoders for the values that do not fit in an int32.
+
+for bitWidth in range (33, 64+1):
+	print >>fd, "func unpack8Int64Width%d(b []byte, out []int64) { " % bitWidth
+	print >>fd, """
+	_ = b[%d]
+	_ = out[7] // eliminate bounds checks""" % (bitWidth - 1)
+
+	mask = (1 << bitWidth) - 1
+
+	for index in range(0, 8):
+		start = index * bitWidth
+		stop = start + bitWidth
+
+		byteStart = start / 8
+		byteStop = (stop + 7) / 8
+		shift = start % 8
+
+		ops = []
+		for byteIndex in range(byteStart, byteStop):
+			op = "uint64(b[%d])" % byteIndex
+			if byteIndex == byteStart:
+				if shift > 0:
+					op = "%s >> %d" % (op, shift)
+			else:
+				op = "%s << %d" % (op, (byteIndex - byteStart) * 8 - shift)
+			ops.append(op)
+
+		value = ' | '.join(ops)
+		if bitWidth < 64:
+			value = "(%s) & 0x%X" % (value, mask)
+
+		print >>fd, "\tout[%d] = int64(%s)" % (index, value)
+
+	print >>fd, "}\n"
+
+print >>fd, """// unpack8Int64FuncForWidth returns the function that unpacks 8 values of
+// bitWidth bits, 33 <= bitWidth <= 64.
+func unpack8Int64FuncForWidth(bitWidth uint) func([]byte, []int64) {
+	switch bitWidth {"""
+for bitWidth in range (33, 64+1):
+	print >>fd, "\tcase %d:\n\t\treturn unpack8Int64Width%d" % (bitWidth, bitWidth)
+print >>fd, """	default:
+		panic(fmt.Sprint("invalid bitWidth: ", bitWidth))
+	}
+}"""
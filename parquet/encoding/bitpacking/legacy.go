@@ -0,0 +1,47 @@
+package bitpacking
+
+import (
+	"fmt"
+	"io"
+)
+
+// ReadBitPacked reads count values of the deprecated BIT_PACKED=4 encoding
+// still used by old parquet-mr files for repetition and definition levels.
+//
+// Unlike the bit-packed runs of the RLE/bit-packing hybrid, the values are
+// packed from the most significant bit to the least significant bit of each
+// byte and are not preceded by any header: the data is exactly
+// ceil(count*bitWidth/8) bytes long.
+func ReadBitPacked(r io.Reader, bitWidth uint, count int) ([]int32, error) {
+	if bitWidth == 0 || bitWidth > 32 {
+		return nil, fmt.Errorf("invalid bit width: %d", bitWidth)
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("invalid count: %d", count)
+	}
+
+	b := make([]byte, (uint64(count)*uint64(bitWidth)+7)/8)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, fmt.Errorf("could not read %d bit packed values: %s", count, err)
+	}
+
+	out := make([]int32, count)
+	bit := uint(0)
+	for i := range out {
+		var v uint32
+		for left := bitWidth; left > 0; {
+			available := 8 - bit%8
+			n := available
+			if n > left {
+				n = left
+			}
+			chunk := uint32(b[bit/8]>>(available-n)) & (1<<n - 1)
+			v = v<<n | chunk
+			bit += n
+			left -= n
+		}
+		out[i] = int32(v)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,241 @@
+package encoding
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// BYTE_STREAM_SPLIT stores N values of K bytes in K streams of N bytes: the
+// stream k holds the byte k of every value. The values are not compressed but
+// floating point data usually compresses much better afterwards.
+
+// splitStreams scatters the width bytes long values in src into dst.
+func splitStreams(dst, src []byte, width int) {
+	n := len(src) / width
+	for i := 0; i < n; i++ {
+		for k := 0; k < width; k++ {
+			dst[k*n+i] = src[i*width+k]
+		}
+	}
+}
+
+// joinStreams is the inverse of splitStreams.
+func joinStreams(dst, src []byte, width int) {
+	n := len(src) / width
+	for k := 0; k < width; k++ {
+		stream := src[k*n : (k+1)*n]
+		for i, b := range stream {
+			dst[i*width+k] = b
+		}
+	}
+}
+
+// byteStreamSplitDecoder
+type byteStreamSplitDecoder struct {
+	unsupportedDecoder
+	r     io.Reader
+	count uint
+	data  []byte // values with their bytes joined back together
+	width int
+	pos   int
+}
+
+// NewByteStreamSplitDecoder creates a new Decoder that uses the
+// BYTE_STREAM_SPLIT=9 encoding. FLOAT, DOUBLE, INT32, INT64 and
+// FIXED_LEN_BYTE_ARRAY values can be decoded. The decoder reads r until EOF:
+// the number of values is derived from the size of the data.
+func NewByteStreamSplitDecoder(r io.Reader, numValues uint) Decoder {
+	return &byteStreamSplitDecoder{
+		unsupportedDecoder: unsupportedDecoder{"byteStreamSplitDecoder"},
+		r:                  r,
+		count:              numValues,
+	}
+}
+
+// next returns the bytes of the next count values of width bytes.
+func (d *byteStreamSplitDecoder) next(count uint, width int) ([]byte, error) {
+	if d.data == nil {
+		streams, err := ioutil.ReadAll(d.r)
+		if err != nil {
+			return nil, fmt.Errorf("%s: could not read values: %s", d, err)
+		}
+		if len(streams)%width != 0 {
+			return nil, fmt.Errorf("%s: %d bytes is not a multiple of the value size %d", d, len(streams), width)
+		}
+		d.data = make([]byte, len(streams))
+		d.width = width
+		joinStreams(d.data, streams, width)
+	}
+	if width != d.width {
+		return nil, fmt.Errorf("%s: values of %d bytes were already decoded, got %d", d, d.width, width)
+	}
+
+	count = min(count, d.count)
+	if available := uint(len(d.data)-d.pos) / uint(width); count > available {
+		return nil, fmt.Errorf("%s: expected %d values but only %d are left", d, count, available)
+	}
+	p := d.data[d.pos : d.pos+int(count)*width]
+	d.pos += len(p)
+
+	return p, nil
+}
+
+// DecodeInt32
+func (d *byteStreamSplitDecoder) DecodeInt32(out []int32) (uint, error) {
+	p, err := d.next(uint(len(out)), 4)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(p)/4; i++ {
+		out[i] = int32(binary.LittleEndian.Uint32(p[i*4:]))
+	}
+	return uint(len(p) / 4), nil
+}
+
+// DecodeInt64
+func (d *byteStreamSplitDecoder) DecodeInt64(out []int64) (uint, error) {
+	p, err := d.next(uint(len(out)), 8)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(p)/8; i++ {
+		out[i] = int64(binary.LittleEndian.Uint64(p[i*8:]))
+	}
+	return uint(len(p) / 8), nil
+}
+
+// DecodeFloat32
+func (d *byteStreamSplitDecoder) DecodeFloat32(out []float32) (uint, error) {
+	p, err := d.next(uint(len(out)), 4)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(p)/4; i++ {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(p[i*4:]))
+	}
+	return uint(len(p) / 4), nil
+}
+
+// DecodeFloat64
+func (d *byteStreamSplitDecoder) DecodeFloat64(out []float64) (uint, error) {
+	p, err := d.next(uint(len(out)), 8)
+	if err != nil {
+		return 0, err
+	}
+	for i := 0; i < len(p)/8; i++ {
+		out[i] = math.Float64frombits(binary.LittleEndian.Uint64(p[i*8:]))
+	}
+	return uint(len(p) / 8), nil
+}
+
+// DecodeFixedByteArray
+func (d *byteStreamSplitDecoder) DecodeFixedByteArray(out [][]byte, size uint) (uint, error) {
+	if size == 0 {
+		return 0, fmt.Errorf("%s: invalid value size 0", d)
+	}
+	p, err := d.next(uint(len(out)), int(size))
+	if err != nil {
+		return 0, err
+	}
+	n := uint(len(p)) / size
+	for i := uint(0); i < n; i++ {
+		out[i] = p[i*size : (i+1)*size : (i+1)*size]
+	}
+	return n, nil
+}
+
+// byteStreamSplitEncoder
+type byteStreamSplitEncoder struct {
+	unsupportedEncoder
+	numValues int
+}
+
+// NewByteStreamSplitEncoder creates an encoder that uses the
+// BYTE_STREAM_SPLIT encoding to store FLOAT, DOUBLE, INT32, INT64 and
+// FIXED_LEN_BYTE_ARRAY values.
+//
+// Every call writes a complete set of streams, all the values of a page
+// have to be written at once.
+func NewByteStreamSplitEncoder() Encoder {
+	return &byteStreamSplitEncoder{
+		unsupportedEncoder: unsupportedEncoder{"byteStreamSplitEncoder"},
+	}
+}
+
+func (e *byteStreamSplitEncoder) NumValues() int {
+	return e.numValues
+}
+
+func (e *byteStreamSplitEncoder) Type() thrift.Encoding {
+	return thrift.Encoding_BYTE_STREAM_SPLIT
+}
+
+func (e *byteStreamSplitEncoder) write(w io.Writer, values []byte, width int) error {
+	streams := make([]byte, len(values))
+	splitStreams(streams, values, width)
+	if _, err := w.Write(streams); err != nil {
+		return fmt.Errorf("could not write streams: %s", err)
+	}
+	e.numValues += len(values) / width
+	return nil
+}
+
+// WriteInt32
+func (e *byteStreamSplitEncoder) WriteInt32(w io.Writer, v []int32) error {
+	p := make([]byte, len(v)*4)
+	for i, value := range v {
+		binary.LittleEndian.PutUint32(p[i*4:], uint32(value))
+	}
+	return e.write(w, p, 4)
+}
+
+// WriteInt64
+func (e *byteStreamSplitEncoder) WriteInt64(w io.Writer, v []int64) error {
+	p := make([]byte, len(v)*8)
+	for i, value := range v {
+		binary.LittleEndian.PutUint64(p[i*8:], uint64(value))
+	}
+	return e.write(w, p, 8)
+}
+
+// WriteFloat32
+func (e *byteStreamSplitEncoder) WriteFloat32(w io.Writer, v []float32) error {
+	p := make([]byte, len(v)*4)
+	for i, value := range v {
+		binary.LittleEndian.PutUint32(p[i*4:], math.Float32bits(value))
+	}
+	return e.write(w, p, 4)
+}
+
+// WriteFloat64
+func (e *byteStreamSplitEncoder) WriteFloat64(w io.Writer, v []float64) error {
+	p := make([]byte, len(v)*8)
+	for i, value := range v {
+		binary.LittleEndian.PutUint64(p[i*8:], math.Float64bits(value))
+	}
+	return e.write(w, p, 8)
+}
+
+// WriteFixedByteArray writes values that must all have the same size.
+func (e *byteStreamSplitEncoder) WriteFixedByteArray(w io.Writer, v [][]byte) error {
+	if len(v) == 0 {
+		return nil
+	}
+	size := len(v[0])
+	if size == 0 {
+		return fmt.Errorf("%s: invalid value size 0", e.name)
+	}
+	p := make([]byte, 0, len(v)*size)
+	for _, value := range v {
+		if len(value) != size {
+			return fmt.Errorf("%s: expected a value of %d bytes but got %d", e.name, size, len(value))
+		}
+		p = append(p, value...)
+	}
+	return e.write(w, p, size)
+}
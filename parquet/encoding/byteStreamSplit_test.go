@@ -0,0 +1,96 @@
+package encoding
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestByteStreamSplitFloat32(t *testing.T) {
+	values := []float32{1.5, -2.25, float32(math.Inf(1)), 0}
+
+	var b bytes.Buffer
+	if err := NewByteStreamSplitEncoder().WriteFloat32(&b, values); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// 1.5 = 0x3FC00000, -2.25 = 0xC0100000, +Inf = 0x7F800000
+	want := []byte{
+		0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0xC0, 0x10, 0x80, 0x00,
+		0x3F, 0xC0, 0x7F, 0x00,
+	}
+	if !bytes.Equal(b.Bytes(), want) {
+		t.Errorf("got %#v, want %#v", b.Bytes(), want)
+	}
+
+	got := make([]float32, len(values))
+	n, err := NewByteStreamSplitDecoder(&b, uint(len(values))).DecodeFloat32(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != uint(len(values)) || !reflect.DeepEqual(got, values) {
+		t.Errorf("got %v, want %v", got, values)
+	}
+}
+
+func TestByteStreamSplitRoundTrip(t *testing.T) {
+	e := NewByteStreamSplitEncoder()
+
+	var b bytes.Buffer
+	float64s := []float64{math.Pi, math.E, -1, math.MaxFloat64}
+	if err := e.WriteFloat64(&b, float64s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	gotFloat64s := make([]float64, len(float64s))
+	if _, err := NewByteStreamSplitDecoder(&b, 4).DecodeFloat64(gotFloat64s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(gotFloat64s, float64s) {
+		t.Errorf("got %v, want %v", gotFloat64s, float64s)
+	}
+
+	b.Reset()
+	int32s := []int32{math.MinInt32, 0, 1, math.MaxInt32, -1}
+	if err := e.WriteInt32(&b, int32s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	gotInt32s := make([]int32, len(int32s))
+	if _, err := NewByteStreamSplitDecoder(&b, 5).DecodeInt32(gotInt32s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(gotInt32s, int32s) {
+		t.Errorf("got %v, want %v", gotInt32s, int32s)
+	}
+
+	b.Reset()
+	int64s := []int64{math.MinInt64, 0, math.MaxInt64}
+	if err := e.WriteInt64(&b, int64s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	gotInt64s := make([]int64, len(int64s))
+	if _, err := NewByteStreamSplitDecoder(&b, 3).DecodeInt64(gotInt64s); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(gotInt64s, int64s) {
+		t.Errorf("got %v, want %v", gotInt64s, int64s)
+	}
+
+	b.Reset()
+	fixed := [][]byte{[]byte("abc"), []byte("def")}
+	if err := e.(*byteStreamSplitEncoder).WriteFixedByteArray(&b, fixed); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := b.String(); got != "adbecf" {
+		t.Errorf("got %q, want %q", got, "adbecf")
+	}
+	gotFixed := make([][]byte, len(fixed))
+	if _, err := NewByteStreamSplitDecoder(&b, 2).DecodeFixedByteArray(gotFixed, 3); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(gotFixed, fixed) {
+		t.Errorf("got %q, want %q", gotFixed, fixed)
+	}
+}
@@ -0,0 +1,416 @@
+package encoding
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/kostya-sh/parquet-go/parquet/encoding/bitpacking"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// byteReader is the reader used by the decoders that need to parse varints
+// without reading past the end of the encoded data.
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+func newByteReader(r io.Reader) byteReader {
+	if br, ok := r.(byteReader); ok {
+		return br
+	}
+	return bufio.NewReader(r)
+}
+
+// deltaBitPackReader reads integers encoded with the DELTA_BINARY_PACKED
+// encoding:
+//
+//	<header> <block 1> <block 2> ... <block n>
+//
+//	header := <block size in values> <number of miniblocks in a block>
+//	          <total value count> <first value>
+//	block  := <min delta> <list of bitwidths of miniblocks> <miniblocks>
+//
+// Deltas are stored as the difference from the min delta of the block and
+// bit-packed in miniblocks, each with its own bit width.
+type deltaBitPackReader struct {
+	r byteReader
+
+	miniBlocks    int
+	miniBlockSize int
+	left          int // values left to read, including the first one
+
+	first     bool
+	last      int64
+	minDelta  int64
+	widths    []byte
+	miniBlock int // index of the current miniblock in widths
+
+	deltas    []int64
+	pos       int
+	buffer    []byte
+	unpackers [65]*bitpacking.Decoder64
+}
+
+func newDeltaBitPackReader(r byteReader) (*deltaBitPackReader, error) {
+	d := &deltaBitPackReader{r: r}
+
+	blockSize, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("delta: could not read block size: %s", err)
+	}
+	miniBlocks, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("delta: could not read number of miniblocks: %s", err)
+	}
+	total, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("delta: could not read total value count: %s", err)
+	}
+	d.last, err = binary.ReadVarint(r) // zigzag encoded
+	if err != nil {
+		return nil, fmt.Errorf("delta: could not read first value: %s", err)
+	}
+
+	if blockSize == 0 || miniBlocks == 0 || blockSize%miniBlocks != 0 || (blockSize/miniBlocks)%8 != 0 {
+		return nil, fmt.Errorf("delta: invalid block size %d with %d miniblocks", blockSize, miniBlocks)
+	}
+
+	d.miniBlocks = int(miniBlocks)
+	d.miniBlockSize = int(blockSize / miniBlocks)
+	d.left = int(total)
+	d.first = total > 0
+	d.widths = make([]byte, d.miniBlocks)
+	d.miniBlock = d.miniBlocks
+	d.deltas = make([]int64, d.miniBlockSize)
+	d.pos = d.miniBlockSize
+
+	return d, nil
+}
+
+// read decodes up to len(out) values and returns the number of values
+// decoded. It returns io.EOF when all the values were already read.
+func (d *deltaBitPackReader) read(out []int64) (int, error) {
+	if d.left == 0 && len(out) > 0 {
+		return 0, io.EOF
+	}
+
+	n := 0
+	for n < len(out) && d.left > 0 {
+		if d.first {
+			out[n] = d.last
+			d.first = false
+			n++
+			d.left--
+			continue
+		}
+
+		if d.pos == len(d.deltas) {
+			if err := d.readMiniBlock(); err != nil {
+				return n, err
+			}
+		}
+
+		// the values wrap around on overflow as the deltas do when encoding
+		d.last += d.deltas[d.pos] + d.minDelta
+		d.pos++
+		out[n] = d.last
+		n++
+		d.left--
+	}
+
+	return n, nil
+}
+
+func (d *deltaBitPackReader) readMiniBlock() error {
+	if d.miniBlock == d.miniBlocks {
+		minDelta, err := binary.ReadVarint(d.r)
+		if err != nil {
+			return fmt.Errorf("delta: could not read min delta: %s", err)
+		}
+		if _, err := io.ReadFull(d.r, d.widths); err != nil {
+			return fmt.Errorf("delta: could not read miniblock bit widths: %s", err)
+		}
+		d.minDelta = minDelta
+		d.miniBlock = 0
+	}
+
+	width := uint(d.widths[d.miniBlock])
+	d.miniBlock++
+	d.pos = 0
+
+	if width == 0 {
+		for i := range d.deltas {
+			d.deltas[i] = 0
+		}
+		return nil
+	}
+	if width > 64 {
+		return fmt.Errorf("delta: invalid miniblock bit width %d", width)
+	}
+
+	size := d.miniBlockSize / 8 * int(width)
+	if cap(d.buffer) < size {
+		d.buffer = make([]byte, size)
+	}
+	d.buffer = d.buffer[:size]
+	if _, err := io.ReadFull(d.r, d.buffer); err != nil {
+		return fmt.Errorf("delta: could not read miniblock: %s", err)
+	}
+
+	if d.unpackers[width] == nil {
+		d.unpackers[width] = bitpacking.NewDecoder64(width)
+	}
+	d.unpackers[width].UnpackGroups(d.buffer, d.deltas)
+
+	return nil
+}
+
+// deltaBinaryPackedDecoder
+type deltaBinaryPackedDecoder struct {
+	unsupportedDecoder
+	r      byteReader
+	count  uint
+	reader *deltaBitPackReader
+}
+
+// NewDeltaBinaryPackedDecoder creates a new Decoder that uses the
+// DELTA_BINARY_PACKED=5 encoding. Only INT32 and INT64 values can be decoded.
+func NewDeltaBinaryPackedDecoder(r io.Reader, numValues uint) Decoder {
+	return &deltaBinaryPackedDecoder{
+		unsupportedDecoder: unsupportedDecoder{"deltaBinaryPackedDecoder"},
+		r:                  newByteReader(r),
+		count:              numValues,
+	}
+}
+
+func (d *deltaBinaryPackedDecoder) readInt64(out []int64) (uint, error) {
+	if d.reader == nil {
+		reader, err := newDeltaBitPackReader(d.r)
+		if err != nil {
+			return 0, err
+		}
+		d.reader = reader
+	}
+
+	out = out[:min(d.count, uint(len(out)))]
+	n, err := d.reader.read(out)
+	if err != nil {
+		return uint(n), err
+	}
+	if n < len(out) {
+		return uint(n), fmt.Errorf("delta: expected %d values but got only %d", len(out), n)
+	}
+
+	return uint(n), nil
+}
+
+// DecodeInt32
+func (d *deltaBinaryPackedDecoder) DecodeInt32(out []int32) (uint, error) {
+	values := make([]int64, len(out))
+	n, err := d.readInt64(values)
+	for i := uint(0); i < n; i++ {
+		out[i] = int32(values[i])
+	}
+	return n, err
+}
+
+// DecodeInt64
+func (d *deltaBinaryPackedDecoder) DecodeInt64(out []int64) (uint, error) {
+	return d.readInt64(out)
+}
+
+// Default DELTA_BINARY_PACKED layout, the same used by parquet-mr.
+const (
+	DefaultDeltaBlockSize  = 128
+	DefaultDeltaMiniBlocks = 4
+)
+
+// deltaBitPackWriter writes integers using the DELTA_BINARY_PACKED encoding.
+type deltaBitPackWriter struct {
+	blockSize     int
+	miniBlocks    int
+	miniBlockSize int
+
+	b        [binary.MaxVarintLen64]byte
+	deltas   []int64
+	relative []uint64
+	widths   []byte
+	packers  [65]*bitpacking.Encoder64
+}
+
+func newDeltaBitPackWriter(blockSize int, miniBlocks int) *deltaBitPackWriter {
+	if blockSize <= 0 || blockSize%128 != 0 {
+		panic("delta: block size must be a multiple of 128")
+	}
+	if miniBlocks <= 0 || blockSize%miniBlocks != 0 || (blockSize/miniBlocks)%32 != 0 {
+		panic("delta: the number of values in a miniblock must be a multiple of 32")
+	}
+	return &deltaBitPackWriter{
+		blockSize:     blockSize,
+		miniBlocks:    miniBlocks,
+		miniBlockSize: blockSize / miniBlocks,
+		deltas:        make([]int64, 0, blockSize),
+		relative:      make([]uint64, blockSize),
+		widths:        make([]byte, miniBlocks),
+	}
+}
+
+// write encodes values as a complete DELTA_BINARY_PACKED stream. When
+// narrow is true the values are INT32: deltas wrap around at 32 bits so
+// that no miniblock is wider than 32 bits.
+func (e *deltaBitPackWriter) write(w io.Writer, values []int64, narrow bool) error {
+	var first int64
+	if len(values) > 0 {
+		first = values[0]
+	}
+
+	if err := e.writeUvarint(w, uint64(e.blockSize)); err != nil {
+		return err
+	}
+	if err := e.writeUvarint(w, uint64(e.miniBlocks)); err != nil {
+		return err
+	}
+	if err := e.writeUvarint(w, uint64(len(values))); err != nil {
+		return err
+	}
+	if err := e.writeVarint(w, first); err != nil {
+		return err
+	}
+
+	for i := 1; i < len(values); i += e.blockSize {
+		end := i + e.blockSize
+		if end > len(values) {
+			end = len(values)
+		}
+
+		e.deltas = e.deltas[:0]
+		for j := i; j < end; j++ {
+			delta := values[j] - values[j-1]
+			if narrow {
+				delta = int64(int32(delta))
+			}
+			e.deltas = append(e.deltas, delta)
+		}
+
+		if err := e.writeBlock(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *deltaBitPackWriter) writeBlock(w io.Writer) error {
+	minDelta := e.deltas[0]
+	for _, delta := range e.deltas {
+		if delta < minDelta {
+			minDelta = delta
+		}
+	}
+
+	for i := range e.relative {
+		e.relative[i] = 0
+	}
+	for i, delta := range e.deltas {
+		e.relative[i] = uint64(delta - minDelta)
+	}
+
+	// miniblocks without values are not written, their bit width is 0
+	used := (len(e.deltas) + e.miniBlockSize - 1) / e.miniBlockSize
+	for i := range e.widths {
+		e.widths[i] = 0
+		if i < used {
+			var max uint64
+			for _, v := range e.relative[i*e.miniBlockSize : (i+1)*e.miniBlockSize] {
+				max |= v
+			}
+			e.widths[i] = byte(bits.Len64(max))
+		}
+	}
+
+	if err := e.writeVarint(w, minDelta); err != nil {
+		return err
+	}
+	if _, err := w.Write(e.widths); err != nil {
+		return fmt.Errorf("delta: could not write miniblock bit widths: %s", err)
+	}
+
+	for i := 0; i < used; i++ {
+		width := uint(e.widths[i])
+		if width == 0 {
+			continue
+		}
+		if e.packers[width] == nil {
+			e.packers[width] = bitpacking.NewEncoder64(width)
+		}
+		if _, err := e.packers[width].Write(w, e.relative[i*e.miniBlockSize:(i+1)*e.miniBlockSize]); err != nil {
+			return fmt.Errorf("delta: could not write miniblock: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *deltaBitPackWriter) writeUvarint(w io.Writer, v uint64) error {
+	if _, err := w.Write(e.b[:binary.PutUvarint(e.b[:], v)]); err != nil {
+		return fmt.Errorf("delta: could not write header: %s", err)
+	}
+	return nil
+}
+
+func (e *deltaBitPackWriter) writeVarint(w io.Writer, v int64) error {
+	// zigzag encoded
+	if _, err := w.Write(e.b[:binary.PutVarint(e.b[:], v)]); err != nil {
+		return fmt.Errorf("delta: could not write header: %s", err)
+	}
+	return nil
+}
+
+// deltaBinaryPackedEncoder
+type deltaBinaryPackedEncoder struct {
+	unsupportedEncoder
+	writer    *deltaBitPackWriter
+	numValues int
+}
+
+// NewDeltaBinaryPackedEncoder creates an encoder that uses the
+// DELTA_BINARY_PACKED encoding to store INT32 and INT64 values. blockSize
+// must be a multiple of 128 and blockSize / miniBlocks a multiple of 32, see
+// DefaultDeltaBlockSize and DefaultDeltaMiniBlocks.
+//
+// Every call to WriteInt32 or WriteInt64 writes a complete stream, all the
+// values of a page have to be written at once.
+func NewDeltaBinaryPackedEncoder(blockSize int, miniBlocks int) Encoder {
+	return &deltaBinaryPackedEncoder{
+		unsupportedEncoder: unsupportedEncoder{"deltaBinaryPackedEncoder"},
+		writer:             newDeltaBitPackWriter(blockSize, miniBlocks),
+	}
+}
+
+func (e *deltaBinaryPackedEncoder) NumValues() int {
+	return e.numValues
+}
+
+func (e *deltaBinaryPackedEncoder) Type() thrift.Encoding {
+	return thrift.Encoding_DELTA_BINARY_PACKED
+}
+
+// WriteInt32
+func (e *deltaBinaryPackedEncoder) WriteInt32(w io.Writer, v []int32) error {
+	values := make([]int64, len(v))
+	for i := range v {
+		values[i] = int64(v[i])
+	}
+	e.numValues += len(v)
+	return e.writer.write(w, values, true)
+}
+
+// WriteInt64
+func (e *deltaBinaryPackedEncoder) WriteInt64(w io.Writer, v []int64) error {
+	e.numValues += len(v)
+	return e.writer.write(w, v, false)
+}
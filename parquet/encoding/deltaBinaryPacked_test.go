@@ -0,0 +1,114 @@
+package encoding
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestDeltaBinaryPackedDecoder(t *testing.T) {
+	// 7, 5, 3, 1, 2, 3, 4, 5: deltas -2, -2, -2, 1, 1, 1, 1
+	// min delta -2 so the stored values are 0, 0, 0, 3, 3, 3, 3 with 2 bits
+	data := []byte{
+		0x80, 0x01, // block size 128
+		0x04,                   // 4 miniblocks
+		0x08,                   // 8 values
+		0x0E,                   // first value: zigzag(7)
+		0x03,                   // min delta: zigzag(-2)
+		0x02, 0x00, 0x00, 0x00, // bit widths
+		0xC0, 0x3F, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // 32 x 2 bits
+	}
+	want := []int32{7, 5, 3, 1, 2, 3, 4, 5}
+
+	d := NewDeltaBinaryPackedDecoder(bytes.NewReader(data), uint(len(want)))
+	got := make([]int32, len(want))
+	n, err := d.DecodeInt32(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != uint(len(want)) || !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got[:n], want)
+	}
+}
+
+func TestDeltaBinaryPackedDecoderSingleValue(t *testing.T) {
+	// only the header is present when there is a single value
+	data := []byte{0x80, 0x01, 0x04, 0x01, 0x01} // first value: zigzag(-1)
+
+	d := NewDeltaBinaryPackedDecoder(bytes.NewReader(data), 1)
+	got := make([]int64, 1)
+	if _, err := d.DecodeInt64(got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got[0] != -1 {
+		t.Errorf("got %d, want -1", got[0])
+	}
+}
+
+func TestDeltaBinaryPackedEncoder(t *testing.T) {
+	var b bytes.Buffer
+	e := NewDeltaBinaryPackedEncoder(DefaultDeltaBlockSize, DefaultDeltaMiniBlocks)
+	if err := e.WriteInt32(&b, []int32{7, 5, 3, 1, 2, 3, 4, 5}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []byte{
+		0x80, 0x01, 0x04, 0x08, 0x0E,
+		0x03, 0x02, 0x00, 0x00, 0x00,
+		0xC0, 0x3F, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	if !bytes.Equal(b.Bytes(), want) {
+		t.Errorf("got %#v, want %#v", b.Bytes(), want)
+	}
+}
+
+func TestDeltaBinaryPackedRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	int32s := []int32{math.MinInt32, math.MaxInt32, 0, math.MaxInt32, math.MinInt32, -1}
+	int64s := []int64{math.MinInt64, math.MaxInt64, 0, math.MaxInt64, math.MinInt64, -1}
+	for i := 0; i < 1000; i++ {
+		int32s = append(int32s, int32(i*3)+int32(r.Intn(10)))
+		int64s = append(int64s, r.Int63()-r.Int63())
+	}
+
+	layouts := []struct {
+		blockSize  int
+		miniBlocks int
+	}{
+		{DefaultDeltaBlockSize, DefaultDeltaMiniBlocks},
+		{128, 1},
+		{256, 8},
+	}
+
+	for _, layout := range layouts {
+		for _, n := range []int{0, 1, 2, 33, len(int32s)} {
+			var b bytes.Buffer
+			e := NewDeltaBinaryPackedEncoder(layout.blockSize, layout.miniBlocks)
+			if err := e.WriteInt32(&b, int32s[:n]); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			got32 := make([]int32, n)
+			if _, err := NewDeltaBinaryPackedDecoder(&b, uint(n)).DecodeInt32(got32); err != nil {
+				t.Fatalf("%v %d values: unexpected error: %s", layout, n, err)
+			}
+			if !reflect.DeepEqual(got32, int32s[:n]) {
+				t.Errorf("%v %d values: got %v, want %v", layout, n, got32, int32s[:n])
+			}
+
+			b.Reset()
+			if err := e.WriteInt64(&b, int64s[:n]); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			got64 := make([]int64, n)
+			if _, err := NewDeltaBinaryPackedDecoder(&b, uint(n)).DecodeInt64(got64); err != nil {
+				t.Fatalf("%v %d values: unexpected error: %s", layout, n, err)
+			}
+			if !reflect.DeepEqual(got64, int64s[:n]) {
+				t.Errorf("%v %d values: got %v, want %v", layout, n, got64, int64s[:n])
+			}
+		}
+	}
+}
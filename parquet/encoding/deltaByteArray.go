@@ -0,0 +1,140 @@
+package encoding
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// deltaByteArrayDecoder decodes the DELTA_BYTE_ARRAY encoding (incremental
+// encoding): the length of the prefix each value shares with the previous one
+// DELTA_BINARY_PACKED followed by the suffixes DELTA_LENGTH_BYTE_ARRAY
+// encoded.
+type deltaByteArrayDecoder struct {
+	unsupportedDecoder
+	r        byteReader
+	count    uint
+	prefixes []int64
+	suffixes *deltaLengthByteArrayDecoder
+	previous []byte
+	pos      int
+}
+
+// NewDeltaByteArrayDecoder creates a new Decoder that uses the
+// DELTA_BYTE_ARRAY=7 encoding. BYTE_ARRAY and FIXED_LEN_BYTE_ARRAY values
+// can be decoded.
+func NewDeltaByteArrayDecoder(r io.Reader, numValues uint) Decoder {
+	br := newByteReader(r)
+	return &deltaByteArrayDecoder{
+		unsupportedDecoder: unsupportedDecoder{"deltaByteArrayDecoder"},
+		r:                  br,
+		count:              numValues,
+		suffixes:           NewDeltaLengthByteArrayDecoder(br, numValues).(*deltaLengthByteArrayDecoder),
+	}
+}
+
+// DecodeByteArray
+func (d *deltaByteArrayDecoder) DecodeByteArray(out [][]byte) (uint, error) {
+	if d.prefixes == nil {
+		prefixes, err := readLengths(d.r)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %s", d, err)
+		}
+		d.prefixes = prefixes
+	}
+
+	count := min(d.count, uint(len(out)))
+	n, err := d.suffixes.DecodeByteArray(out[:count])
+	for i := uint(0); i < n; i++ {
+		if d.pos >= len(d.prefixes) {
+			return i, fmt.Errorf("%s: expected %d values but got only %d", d, count, i)
+		}
+		prefix := d.prefixes[d.pos]
+		if prefix < 0 || prefix > int64(len(d.previous)) {
+			return i, fmt.Errorf("%s: invalid prefix length %d", d, prefix)
+		}
+
+		value := make([]byte, 0, int(prefix)+len(out[i]))
+		value = append(value, d.previous[:prefix]...)
+		value = append(value, out[i]...)
+		out[i] = value
+
+		d.previous = value
+		d.pos++
+	}
+
+	return n, err
+}
+
+// DecodeFixedByteArray
+func (d *deltaByteArrayDecoder) DecodeFixedByteArray(out [][]byte, size uint) (uint, error) {
+	n, err := d.DecodeByteArray(out)
+	for i := uint(0); i < n; i++ {
+		if uint(len(out[i])) != size {
+			return i, fmt.Errorf("%s: expected a value of %d bytes but got %d", d, size, len(out[i]))
+		}
+	}
+	return n, err
+}
+
+// deltaByteArrayEncoder
+type deltaByteArrayEncoder struct {
+	unsupportedEncoder
+	prefixes  *deltaBitPackWriter
+	suffixes  *deltaLengthByteArrayEncoder
+	numValues int
+}
+
+// NewDeltaByteArrayEncoder creates an encoder that uses the DELTA_BYTE_ARRAY
+// encoding to store BYTE_ARRAY and FIXED_LEN_BYTE_ARRAY values.
+//
+// Every call to WriteByteArray writes a complete stream, all the values of
+// a page have to be written at once.
+func NewDeltaByteArrayEncoder() Encoder {
+	return &deltaByteArrayEncoder{
+		unsupportedEncoder: unsupportedEncoder{"deltaByteArrayEncoder"},
+		prefixes:           newDeltaBitPackWriter(DefaultDeltaBlockSize, DefaultDeltaMiniBlocks),
+		suffixes:           NewDeltaLengthByteArrayEncoder().(*deltaLengthByteArrayEncoder),
+	}
+}
+
+func (e *deltaByteArrayEncoder) NumValues() int {
+	return e.numValues
+}
+
+func (e *deltaByteArrayEncoder) Type() thrift.Encoding {
+	return thrift.Encoding_DELTA_BYTE_ARRAY
+}
+
+// WriteByteArray
+func (e *deltaByteArrayEncoder) WriteByteArray(w io.Writer, v [][]byte) error {
+	var previous []byte
+
+	prefixes := make([]int64, len(v))
+	suffixes := make([][]byte, len(v))
+	for i, b := range v {
+		prefix := 0
+		for prefix < len(previous) && prefix < len(b) && previous[prefix] == b[prefix] {
+			prefix++
+		}
+		prefixes[i] = int64(prefix)
+		suffixes[i] = b[prefix:]
+		previous = b
+	}
+
+	if err := e.prefixes.write(w, prefixes, true); err != nil {
+		return fmt.Errorf("could not write prefix lengths: %s", err)
+	}
+	if err := e.suffixes.WriteByteArray(w, suffixes); err != nil {
+		return fmt.Errorf("could not write suffixes: %s", err)
+	}
+	e.numValues += len(v)
+
+	return nil
+}
+
+// WriteFixedByteArray
+func (e *deltaByteArrayEncoder) WriteFixedByteArray(w io.Writer, v [][]byte) error {
+	return e.WriteByteArray(w, v)
+}
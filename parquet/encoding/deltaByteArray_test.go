@@ -0,0 +1,58 @@
+package encoding
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestDeltaByteArray(t *testing.T) {
+	values := [][]byte{
+		[]byte("axis"),
+		[]byte("axle"),
+		[]byte("babble"),
+		[]byte("babyhood"),
+		[]byte("babyhood"),
+		{},
+	}
+
+	var b bytes.Buffer
+	if err := NewDeltaByteArrayEncoder().WriteByteArray(&b, values); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// only the suffixes are stored
+	if !bytes.HasSuffix(b.Bytes(), []byte("axislebabbleyhood")) {
+		t.Errorf("unexpected suffixes in %q", b.Bytes())
+	}
+
+	got := make([][]byte, len(values))
+	n, err := NewDeltaByteArrayDecoder(&b, uint(len(values))).DecodeByteArray(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != uint(len(values)) || !reflect.DeepEqual(got, values) {
+		t.Errorf("got %q, want %q", got, values)
+	}
+}
+
+func TestDeltaByteArrayFixedLength(t *testing.T) {
+	values := [][]byte{[]byte("abc1"), []byte("abc2"), []byte("abd3")}
+
+	var b bytes.Buffer
+	if err := NewDeltaByteArrayEncoder().WriteByteArray(&b, values); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := make([][]byte, len(values))
+	if _, err := NewDeltaByteArrayDecoder(bytes.NewReader(b.Bytes()), 3).DecodeFixedByteArray(got, 4); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("got %q, want %q", got, values)
+	}
+
+	if _, err := NewDeltaByteArrayDecoder(bytes.NewReader(b.Bytes()), 3).DecodeFixedByteArray(got, 5); err == nil {
+		t.Errorf("expected an error for values of the wrong size")
+	}
+}
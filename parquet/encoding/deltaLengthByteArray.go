@@ -0,0 +1,122 @@
+package encoding
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// deltaLengthByteArrayDecoder decodes the DELTA_LENGTH_BYTE_ARRAY encoding:
+// the lengths of all the values DELTA_BINARY_PACKED followed by the
+// concatenated values.
+type deltaLengthByteArrayDecoder struct {
+	unsupportedDecoder
+	r       byteReader
+	count   uint
+	lengths []int64
+	pos     int
+}
+
+// NewDeltaLengthByteArrayDecoder creates a new Decoder that uses the
+// DELTA_LENGTH_BYTE_ARRAY=6 encoding. Only BYTE_ARRAY values can be decoded.
+func NewDeltaLengthByteArrayDecoder(r io.Reader, numValues uint) Decoder {
+	return &deltaLengthByteArrayDecoder{
+		unsupportedDecoder: unsupportedDecoder{"deltaLengthByteArrayDecoder"},
+		r:                  newByteReader(r),
+		count:              numValues,
+	}
+}
+
+// readLengths reads the lengths of all the values in a DELTA_BINARY_PACKED
+// stream.
+func readLengths(r byteReader) ([]int64, error) {
+	reader, err := newDeltaBitPackReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read lengths: %s", err)
+	}
+
+	lengths := make([]int64, reader.left)
+	if _, err := reader.read(lengths); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("could not read lengths: %s", err)
+	}
+
+	return lengths, nil
+}
+
+// DecodeByteArray
+func (d *deltaLengthByteArrayDecoder) DecodeByteArray(out [][]byte) (uint, error) {
+	if d.lengths == nil {
+		lengths, err := readLengths(d.r)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %s", d, err)
+		}
+		d.lengths = lengths
+	}
+
+	count := min(d.count, uint(len(out)))
+	for i := uint(0); i < count; i++ {
+		if d.pos >= len(d.lengths) {
+			return i, fmt.Errorf("%s: expected %d values but got only %d", d, count, i)
+		}
+		size := d.lengths[d.pos]
+		if size < 0 {
+			return i, fmt.Errorf("%s: invalid length %d", d, size)
+		}
+		p := make([]byte, size)
+		if _, err := io.ReadFull(d.r, p); err != nil {
+			return i, fmt.Errorf("%s: short read: %s", d, err)
+		}
+		out[i] = p
+		d.pos++
+	}
+
+	return count, nil
+}
+
+// deltaLengthByteArrayEncoder
+type deltaLengthByteArrayEncoder struct {
+	unsupportedEncoder
+	lengths   *deltaBitPackWriter
+	numValues int
+}
+
+// NewDeltaLengthByteArrayEncoder creates an encoder that uses the
+// DELTA_LENGTH_BYTE_ARRAY encoding to store BYTE_ARRAY values.
+//
+// Every call to WriteByteArray writes a complete stream, all the values of
+// a page have to be written at once.
+func NewDeltaLengthByteArrayEncoder() Encoder {
+	return &deltaLengthByteArrayEncoder{
+		unsupportedEncoder: unsupportedEncoder{"deltaLengthByteArrayEncoder"},
+		lengths:            newDeltaBitPackWriter(DefaultDeltaBlockSize, DefaultDeltaMiniBlocks),
+	}
+}
+
+func (e *deltaLengthByteArrayEncoder) NumValues() int {
+	return e.numValues
+}
+
+func (e *deltaLengthByteArrayEncoder) Type() thrift.Encoding {
+	return thrift.Encoding_DELTA_LENGTH_BYTE_ARRAY
+}
+
+// WriteByteArray
+func (e *deltaLengthByteArrayEncoder) WriteByteArray(w io.Writer, v [][]byte) error {
+	lengths := make([]int64, len(v))
+	for i, b := range v {
+		lengths[i] = int64(len(b))
+	}
+	if err := e.lengths.write(w, lengths, true); err != nil {
+		return fmt.Errorf("could not write lengths: %s", err)
+	}
+
+	for _, b := range v {
+		if _, err := w.Write(b); err != nil {
+			return fmt.Errorf("could not write byte array: %s", err)
+		}
+	}
+	e.numValues += len(v)
+
+	return nil
+}
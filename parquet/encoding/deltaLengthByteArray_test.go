@@ -0,0 +1,43 @@
+package encoding
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestDeltaLengthByteArray(t *testing.T) {
+	values := [][]byte{
+		[]byte("Hello"),
+		[]byte("World"),
+		[]byte("Foobar"),
+		[]byte("ABCDEF"),
+		{},
+	}
+
+	var b bytes.Buffer
+	if err := NewDeltaLengthByteArrayEncoder().WriteByteArray(&b, values); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// lengths 5, 5, 6, 6, 0: deltas 0, 1, 0, -6, min delta -6 so 6, 7, 6, 0
+	// are packed with 3 bits
+	want := []byte{
+		0x80, 0x01, 0x04, 0x05, 0x0A,
+		0x0B, 0x03, 0x00, 0x00, 0x00,
+		0xBE, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	}
+	want = append(want, "HelloWorldFoobarABCDEF"...)
+	if !bytes.Equal(b.Bytes(), want) {
+		t.Errorf("got %#v, want %#v", b.Bytes(), want)
+	}
+
+	got := make([][]byte, len(values))
+	n, err := NewDeltaLengthByteArrayDecoder(&b, uint(len(values))).DecodeByteArray(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != uint(len(values)) || !reflect.DeepEqual(got, values) {
+		t.Errorf("got %q, want %q", got, values)
+	}
+}
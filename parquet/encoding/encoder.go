@@ -1,9 +1,11 @@
 package encoding
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
 // Encoder interface
@@ -11,10 +13,11 @@ type Encoder interface {
 	WriteBool(io.Writer, []bool) (int, error)
 	WriteInt32(io.Writer, []int32) error
 	WriteInt64(io.Writer, []int64) error
-	//WriteInt96([]int64, []int32) (count uint, err error)
+	WriteInt96(io.Writer, []datatypes.Int96) error
 	WriteFloat32(io.Writer, []float32) error
 	WriteFloat64(io.Writer, []float64) error
 	WriteByteArray(io.Writer, [][]byte) error
+	WriteFixedByteArray(io.Writer, [][]byte) error
 }
 
 // Decoder interface
@@ -28,3 +31,30 @@ type Decoder interface {
 	DecodeFloat32([]float32) (count uint, err error)
 	DecodeFloat64([]float64) (count uint, err error)
 }
+
+// NewDecoder returns the Decoder for the values of a page stored with the
+// encoding enc. The dictionary is only used by the dictionary encodings and
+// can be nil otherwise.
+func NewDecoder(enc thrift.Encoding, r io.Reader, numValues uint, dictionary Dictionary) (Decoder, error) {
+	switch enc {
+	case thrift.Encoding_PLAIN:
+		return NewPlainDecoder(r, numValues), nil
+	case thrift.Encoding_DELTA_BINARY_PACKED:
+		return NewDeltaBinaryPackedDecoder(r, numValues), nil
+	case thrift.Encoding_DELTA_LENGTH_BYTE_ARRAY:
+		return NewDeltaLengthByteArrayDecoder(r, numValues), nil
+	case thrift.Encoding_DELTA_BYTE_ARRAY:
+		return NewDeltaByteArrayDecoder(r, numValues), nil
+	case thrift.Encoding_BYTE_STREAM_SPLIT:
+		return NewByteStreamSplitDecoder(r, numValues), nil
+	case thrift.Encoding_RLE:
+		return NewRLEBooleanDecoder(r, numValues), nil
+	case thrift.Encoding_PLAIN_DICTIONARY, thrift.Encoding_RLE_DICTIONARY:
+		if dictionary == nil {
+			return nil, fmt.Errorf("%s encoded values but no dictionary was defined", enc)
+		}
+		return NewPlainDictionaryDecoder(r, dictionary, numValues), nil
+	default:
+		return nil, fmt.Errorf("encoding %s is not supported", enc)
+	}
+}
@@ -1,5 +1,91 @@
 package encoding
 
+import (
+	"fmt"
+	"io"
+
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+)
+
+// unsupportedDecoder is embedded by the decoders that support only some of
+// the physical types: it returns an error for all of them.
+type unsupportedDecoder struct {
+	name string
+}
+
+func (d unsupportedDecoder) DecodeBool([]bool) (uint, error) {
+	return 0, fmt.Errorf("%s: boolean values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) DecodeInt32([]int32) (uint, error) {
+	return 0, fmt.Errorf("%s: int32 values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) DecodeInt64([]int64) (uint, error) {
+	return 0, fmt.Errorf("%s: int64 values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) DecodeInt96([]datatypes.Int96) (uint, error) {
+	return 0, fmt.Errorf("%s: int96 values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) DecodeByteArray([][]byte) (uint, error) {
+	return 0, fmt.Errorf("%s: byte array values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) DecodeFixedByteArray([][]byte, uint) (uint, error) {
+	return 0, fmt.Errorf("%s: fixed byte array values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) DecodeFloat32([]float32) (uint, error) {
+	return 0, fmt.Errorf("%s: float values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) DecodeFloat64([]float64) (uint, error) {
+	return 0, fmt.Errorf("%s: double values are not supported", d.name)
+}
+
+func (d unsupportedDecoder) String() string {
+	return d.name
+}
+
+// unsupportedEncoder is the Encoder counterpart of unsupportedDecoder.
+type unsupportedEncoder struct {
+	name string
+}
+
+func (e unsupportedEncoder) WriteBool(io.Writer, []bool) (int, error) {
+	return 0, fmt.Errorf("%s: boolean values are not supported", e.name)
+}
+
+func (e unsupportedEncoder) WriteInt32(io.Writer, []int32) error {
+	return fmt.Errorf("%s: int32 values are not supported", e.name)
+}
+
+func (e unsupportedEncoder) WriteInt64(io.Writer, []int64) error {
+	return fmt.Errorf("%s: int64 values are not supported", e.name)
+}
+
+func (e unsupportedEncoder) WriteInt96(io.Writer, []datatypes.Int96) error {
+	return fmt.Errorf("%s: int96 values are not supported", e.name)
+}
+
+func (e unsupportedEncoder) WriteFloat32(io.Writer, []float32) error {
+	return fmt.Errorf("%s: float values are not supported", e.name)
+}
+
+func (e unsupportedEncoder) WriteFloat64(io.Writer, []float64) error {
+	return fmt.Errorf("%s: double values are not supported", e.name)
+}
+
+func (e unsupportedEncoder) WriteByteArray(io.Writer, [][]byte) error {
+	return fmt.Errorf("%s: byte array values are not supported", e.name)
+}
+
+func (e unsupportedEncoder) WriteFixedByteArray(io.Writer, [][]byte) error {
+	return fmt.Errorf("%s: fixed byte array values are not supported", e.name)
+}
+
 func trailingZeros(i uint32) uint32 {
 	var count uint32
 
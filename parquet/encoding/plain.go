@@ -4,198 +4,182 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/kostya-sh/parquet-go/parquet/datatypes"
-	"github.com/kostya-sh/parquet-go/parquet/encoding/rle"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
 // Plain
 type plainDecoder struct {
 	r     io.Reader
-	count uint
+	count uint // values left
+	buf   []byte
+
+	// booleans are bit-packed: bits of the current byte left to read
+	bits     byte
+	bitsLeft uint
 }
 
 // NewPlainDecoder creates a new Decoder that uses the PLAIN=0 encoding
 func NewPlainDecoder(r io.Reader, numValues uint) Decoder {
-	return &plainDecoder{r, numValues}
+	return &plainDecoder{r: r, count: numValues}
 }
 
-// DecodeBool
-func (d *plainDecoder) DecodeBool(out []bool) (uint, error) {
-	outx, err := rle.ReadInt32(d.r, 1, uint(len(out)))
-
-	if err != nil {
-		return 0, err
+// read reads the next count values of size bytes each.
+func (d *plainDecoder) read(count uint, size int) ([]byte, error) {
+	n := int(count) * size
+	if cap(d.buf) < n {
+		d.buf = make([]byte, n)
+	}
+	p := d.buf[:n]
+	if read, err := io.ReadFull(d.r, p); err != nil {
+		return p[:read], fmt.Errorf("plain decoder: expected %d values but got only %d: %s", count, read/size, err)
 	}
+	return p, nil
+}
+
+// DecodeBool reads bit-packed booleans, the first value being the least
+// significant bit of the first byte.
+func (d *plainDecoder) DecodeBool(out []bool) (uint, error) {
+	count := min(d.count, uint(len(out)))
 
-	for i, v := range outx {
-		out[i] = v == 1
+	for i := uint(0); i < count; i++ {
+		if d.bitsLeft == 0 {
+			var b [1]byte
+			if _, err := io.ReadFull(d.r, b[:]); err != nil {
+				d.count -= i
+				return i, fmt.Errorf("plain decoder: expected %d booleans but got only %d: %s", count, i, err)
+			}
+			d.bits = b[0]
+			d.bitsLeft = 8
+		}
+		out[i] = d.bits&1 == 1
+		d.bits >>= 1
+		d.bitsLeft--
 	}
 
-	return uint(len(out)), nil
+	d.count -= count
+	return count, nil
 }
 
 // DecodeInt32
 func (d *plainDecoder) DecodeInt32(out []int32) (uint, error) {
-	count := d.count
-
-	for i := uint(0); i < count; i++ {
-		var value int32
-		err := binary.Read(d.r, binary.LittleEndian, &value)
-		if err != nil {
-			return i, fmt.Errorf("expected %d int32 but got only %d: %s", count, i, err) // FIXME
-		}
+	count := min(d.count, uint(len(out)))
 
-		out[i] = value
+	p, err := d.read(count, 4)
+	for i := 0; i < len(p)/4; i++ {
+		out[i] = int32(binary.LittleEndian.Uint32(p[i*4:]))
 	}
+	d.count -= uint(len(p) / 4)
 
-	return count, nil
+	return uint(len(p) / 4), err
 }
 
 // DecodeInt64
 func (d *plainDecoder) DecodeInt64(out []int64) (uint, error) {
-	count := d.count
-	var value int64
-
-	for i := uint(0); i < min(count, uint(len(out))); i++ {
-		err := binary.Read(d.r, binary.LittleEndian, &value)
-		if err != nil {
-			return i, fmt.Errorf("expected %d int64 but got only %d: %s", count, i, err) // FIXME
-		}
+	count := min(d.count, uint(len(out)))
 
-		out[i] = value
+	p, err := d.read(count, 8)
+	for i := 0; i < len(p)/8; i++ {
+		out[i] = int64(binary.LittleEndian.Uint64(p[i*8:]))
 	}
+	d.count -= uint(len(p) / 8)
 
-	return count, nil
+	return uint(len(p) / 8), err
 }
 
-// DecodeInt64
+// DecodeInt96 reads 12 bytes values: a little endian int64 followed by a
+// little endian int32.
 func (d *plainDecoder) DecodeInt96(out []datatypes.Int96) (uint, error) {
-	count := d.count
-	var (
-		value int64
-		n32   int32
-	)
-
-	for i := uint(0); i < min(count, uint(len(out))); i++ {
-		err := binary.Read(d.r, binary.LittleEndian, &value)
-		if err != nil {
-			return i, fmt.Errorf("expected %d int64 but got only %d: %s", count, i, err) // FIXME
-		}
-
-		err = binary.Read(d.r, binary.LittleEndian, &n32)
-		if err != nil {
-			return i, fmt.Errorf("expected %d int32 but got only %d: %s", count, i, err) // FIXME
-		}
+	count := min(d.count, uint(len(out)))
 
-		out[i].N1 = value
-		out[i].N2 = n32
+	p, err := d.read(count, 12)
+	for i := 0; i < len(p)/12; i++ {
+		out[i].N1 = int64(binary.LittleEndian.Uint64(p[i*12:]))
+		out[i].N2 = int32(binary.LittleEndian.Uint32(p[i*12+8:]))
 	}
+	d.count -= uint(len(p) / 12)
 
-	return count, nil
+	return uint(len(p) / 12), err
 }
 
-// DecodeStr , returns the number of element read, or error
+// DecodeString , returns the number of element read, or error
 func (d *plainDecoder) DecodeString(out []string) (uint, error) {
-	var count uint
-	var size int32
-
-	for i := uint(0); i < min(d.count, uint(len(out))); i++ {
-		err := binary.Read(d.r, binary.LittleEndian, &size)
-		if err != nil {
-			return 0, err
-		}
-		p := make([]byte, size)
-		n, err := d.r.Read(p)
-		if err != nil {
-			return i, fmt.Errorf("plain decoder: short read: %s", err)
-		}
-
-		out[i] = string(p[:n])
-		count++
+	values := make([][]byte, len(out))
+	n, err := d.DecodeByteArray(values)
+	for i := uint(0); i < n; i++ {
+		out[i] = string(values[i])
 	}
-
-	return count, nil
+	return n, err
 }
 
-// DecodeByteArray , returns the number of element read, or error
+// DecodeByteArray reads values prefixed by their length as a little endian
+// uint32, returns the number of element read, or error
 func (d *plainDecoder) DecodeByteArray(out [][]byte) (uint, error) {
-	var count uint
+	count := min(d.count, uint(len(out)))
 
-	var size int32
-
-	for i := uint(0); i < min(d.count, uint(len(out))); i++ {
-		err := binary.Read(d.r, binary.LittleEndian, &size)
-		if err != nil {
-			return 0, err
+	var size [4]byte
+	for i := uint(0); i < count; i++ {
+		if _, err := io.ReadFull(d.r, size[:]); err != nil {
+			d.count -= i
+			return i, fmt.Errorf("plain decoder: could not read byte array length: %s", err)
 		}
-		p := make([]byte, size)
-		n, err := d.r.Read(p)
-		if err != nil {
+		p := make([]byte, binary.LittleEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(d.r, p); err != nil {
+			d.count -= i
 			return i, fmt.Errorf("plain decoder: short read: %s", err)
 		}
-		out[i] = p[:n]
-		count++
+		out[i] = p
 	}
 
+	d.count -= count
 	return count, nil
 }
 
 // DecodeFixedByteArray , returns the number of element read, or error
 func (d *plainDecoder) DecodeFixedByteArray(out [][]byte, size uint) (uint, error) {
-	var count uint
+	count := min(d.count, uint(len(out)))
 
-	for i := uint(0); i < min(d.count, uint(len(out))); i++ {
+	for i := uint(0); i < count; i++ {
 		p := make([]byte, size)
-		n, err := d.r.Read(p)
-		if err != nil {
+		if _, err := io.ReadFull(d.r, p); err != nil {
+			d.count -= i
 			return i, fmt.Errorf("plain decoder: short read: %s", err)
 		}
-		out[i] = p[:n]
-		count++
+		out[i] = p
 	}
 
+	d.count -= count
 	return count, nil
 }
 
 // DecodeFloat32 returns the number of elements read, or error
 // The data has to be 4 bytes IEEE little endian back to back
 func (d *plainDecoder) DecodeFloat32(out []float32) (uint, error) {
-	var count uint
-
-	var value float32
-
-	for i := uint(0); i < min(d.count, uint(len(out))); i++ {
-		err := binary.Read(d.r, binary.LittleEndian, &value)
-		if err != nil {
-			return i, fmt.Errorf("plain decoder: binary.Read: %s", err)
-		}
+	count := min(d.count, uint(len(out)))
 
-		out[i] = value
-		count++
+	p, err := d.read(count, 4)
+	for i := 0; i < len(p)/4; i++ {
+		out[i] = math.Float32frombits(binary.LittleEndian.Uint32(p[i*4:]))
 	}
+	d.count -= uint(len(p) / 4)
 
-	return count, nil
+	return uint(len(p) / 4), err
 }
 
 // DecodeFloat64 returns the number of elements read, or error
 // The data has to be 8 bytes IEEE little endian back to back
 func (d *plainDecoder) DecodeFloat64(out []float64) (uint, error) {
-	var count uint
-
-	var value float64
+	count := min(d.count, uint(len(out)))
 
-	for i := uint(0); i < min(d.count, uint(len(out))); i++ {
-		err := binary.Read(d.r, binary.LittleEndian, &value)
-		if err != nil {
-			return 0, fmt.Errorf("plain decoder: binary.Read: %s", err)
-		}
-		out[i] = value
-		count++
+	p, err := d.read(count, 8)
+	for i := 0; i < len(p)/8; i++ {
+		out[i] = math.Float64frombits(binary.LittleEndian.Uint64(p[i*8:]))
 	}
+	d.count -= uint(len(p) / 8)
 
-	return count, nil
+	return uint(len(p) / 8), err
 }
 
 func (d *plainDecoder) String() string {
@@ -225,62 +209,108 @@ func (p *plainEncoder) Type() thrift.Encoding {
 	return thrift.Encoding_PLAIN
 }
 
-// WriteBool
+// WriteBool packs the values one bit each, the first value being the least
+// significant bit of the first byte. The last byte is padded with zeros so
+// all the booleans of a page have to be written at once.
 func (e *plainEncoder) WriteBool(w io.Writer, v []bool) (int, error) {
+	p := make([]byte, (len(v)+7)/8)
+	for i, b := range v {
+		if b {
+			p[i/8] |= 1 << uint(i%8)
+		}
+	}
+	n, err := w.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("could not write booleans: %s", err)
+	}
 	e.numValues += len(v)
-	return rle.WriteBool(w, v)
+	return n, nil
 }
 
 // WriteInt32
 func (e *plainEncoder) WriteInt32(w io.Writer, v []int32) error {
-	e.numValues += len(v)
-	return binary.Write(w, binary.LittleEndian, v)
+	p := make([]byte, len(v)*4)
+	for i, value := range v {
+		binary.LittleEndian.PutUint32(p[i*4:], uint32(value))
+	}
+	return e.write(w, p, len(v))
 }
 
 // WriteInt64
 func (e *plainEncoder) WriteInt64(w io.Writer, v []int64) error {
-	e.numValues += len(v)
-	return binary.Write(w, binary.LittleEndian, v)
+	p := make([]byte, len(v)*8)
+	for i, value := range v {
+		binary.LittleEndian.PutUint64(p[i*8:], uint64(value))
+	}
+	return e.write(w, p, len(v))
+}
+
+// WriteInt96 writes each value as a little endian int64 followed by a little
+// endian int32.
+func (e *plainEncoder) WriteInt96(w io.Writer, v []datatypes.Int96) error {
+	p := make([]byte, len(v)*12)
+	for i, value := range v {
+		binary.LittleEndian.PutUint64(p[i*12:], uint64(value.N1))
+		binary.LittleEndian.PutUint32(p[i*12+8:], uint32(value.N2))
+	}
+	return e.write(w, p, len(v))
 }
 
 // WriteFloat32
 func (e *plainEncoder) WriteFloat32(w io.Writer, v []float32) error {
-	e.numValues += len(v)
-	return binary.Write(w, binary.LittleEndian, v)
+	p := make([]byte, len(v)*4)
+	for i, value := range v {
+		binary.LittleEndian.PutUint32(p[i*4:], math.Float32bits(value))
+	}
+	return e.write(w, p, len(v))
 }
 
 // WriteFloat64
 func (e *plainEncoder) WriteFloat64(w io.Writer, v []float64) error {
-	e.numValues += len(v)
-	return binary.Write(w, binary.LittleEndian, v)
+	p := make([]byte, len(v)*8)
+	for i, value := range v {
+		binary.LittleEndian.PutUint64(p[i*8:], math.Float64bits(value))
+	}
+	return e.write(w, p, len(v))
+}
+
+func (e *plainEncoder) write(w io.Writer, p []byte, count int) error {
+	if _, err := w.Write(p); err != nil {
+		return fmt.Errorf("could not write values: %s", err)
+	}
+	e.numValues += count
+	return nil
 }
 
 // WriteByteArray
 func (e *plainEncoder) WriteByteArray(w io.Writer, v [][]byte) error {
-	e.numValues += len(v)
+	var size [4]byte
 	for _, b := range v {
-		err := binary.Write(w, binary.LittleEndian, uint32(len(b)))
-		if err != nil {
+		binary.LittleEndian.PutUint32(size[:], uint32(len(b)))
+		if _, err := w.Write(size[:]); err != nil {
 			return fmt.Errorf("could not write byte array len: %s", err)
 		}
-		_, err = w.Write(b)
-		if err != nil {
+		if _, err := w.Write(b); err != nil {
 			return fmt.Errorf("could not write byte array: %s", err)
 		}
+		e.numValues++
 	}
 
 	return nil
 }
 
-// WriteFixedByteArray
+// WriteFixedByteArray writes values that must all have the same size.
 func (e *plainEncoder) WriteFixedByteArray(w io.Writer, v [][]byte) error {
-	e.numValues += len(v)
 	for _, b := range v {
-		// FIXME(fmilo) enforce size?
-		_, err := w.Write(b)
-		if err != nil {
+		if len(b) != len(v[0]) {
+			return fmt.Errorf("expected a value of %d bytes but got %d", len(v[0]), len(b))
+		}
+	}
+	for _, b := range v {
+		if _, err := w.Write(b); err != nil {
 			return fmt.Errorf("could not write byte array: %s", err)
 		}
+		e.numValues++
 	}
 
 	return nil
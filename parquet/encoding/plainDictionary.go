@@ -12,7 +12,10 @@ import (
 type plainDictionaryDecoder struct {
 	rb         *bufio.Reader
 	dictionary Dictionary
-	count      uint
+	count      uint // values left
+	indices    *rle.Decoder
+	buf        []int32
+	keys       []uint32
 }
 
 type Dictionary interface {
@@ -25,6 +28,19 @@ type Dictionary interface {
 	MapFloat64(keys []uint32, out []float64) error
 }
 
+// IndexDecoder is implemented by the decoders of the dictionary encodings.
+// DecodeIndices returns the indices of the values in the dictionary instead
+// of the values themselves, e.g. to process each distinct value only once.
+// The indices and the values share the same position in the page: a decoder
+// must be used to read either of them, not both.
+type IndexDecoder interface {
+	DecodeIndices(out []uint32) (count uint, err error)
+}
+
+// NewPlainDictionaryDecoder creates a new Decoder for the values of a data
+// page using the PLAIN_DICTIONARY=2 or RLE_DICTIONARY=8 encoding: the bit
+// width of the indices stored in one byte followed by the indices RLE/bit
+// packing hybrid encoded. The returned Decoder also implements IndexDecoder.
 func NewPlainDictionaryDecoder(r io.Reader, dictionary Dictionary, numValues uint) Decoder {
 	if dictionary == nil {
 		panic("null dictionary")
@@ -32,85 +48,116 @@ func NewPlainDictionaryDecoder(r io.Reader, dictionary Dictionary, numValues uin
 	return &plainDictionaryDecoder{rb: bufio.NewReader(r), dictionary: dictionary, count: numValues}
 }
 
-func (d *plainDictionaryDecoder) readKeys() ([]uint32, error) {
-	bitWidth, err := d.rb.ReadByte()
-	if err != nil {
-		return nil, err
+// readKeys reads the next keys, at most max of them.
+func (d *plainDictionaryDecoder) readKeys(max int) ([]uint32, error) {
+	if d.count == 0 {
+		return nil, nil
+	}
+	if d.indices == nil {
+		bitWidth, err := d.rb.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("could not read bit width: %s", err)
+		}
+		if err := rle.CheckBitWidth(uint(bitWidth)); err != nil {
+			return nil, err
+		}
+		d.indices = rle.NewDecoder(uint(bitWidth))
+		d.indices.InitReader(d.rb)
+		d.indices.Limit(int(d.count))
 	}
 
-	keys, err := rle.ReadUint32(d.rb, uint(bitWidth), d.count)
+	count := int(min(d.count, uint(max)))
+	if cap(d.buf) < count {
+		d.buf = make([]int32, count)
+		d.keys = make([]uint32, count)
+	}
 
-	if err != nil {
-		return nil, fmt.Errorf("rle: could not read %d values with bitWidth %d: %s", d.count, uint(bitWidth), err)
+	n, err := d.indices.NextBatch(d.buf[:count])
+	for i, k := range d.buf[:n] {
+		d.keys[i] = uint32(k)
 	}
+	d.count -= uint(n)
+	if err == io.EOF {
+		err = fmt.Errorf("expected %d values but got only %d", count, n)
+	}
+
+	return d.keys[:n], err
+}
 
-	return keys, nil
+// DecodeIndices
+func (d *plainDictionaryDecoder) DecodeIndices(out []uint32) (uint, error) {
+	keys, err := d.readKeys(len(out))
+	copy(out, keys)
+	if err != nil {
+		return uint(len(keys)), fmt.Errorf("could not read dictionary keys: %s", err)
+	}
+	return uint(len(keys)), nil
 }
 
 func (d *plainDictionaryDecoder) DecodeBool(out []bool) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
-	return uint(len(keys)), d.dictionary.MapBool(keys, out)
+	return uint(len(keys)), d.dictionary.MapBool(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) DecodeInt32(out []int32) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
-	return uint(len(keys)), d.dictionary.MapInt32(keys, out)
+	return uint(len(keys)), d.dictionary.MapInt32(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) DecodeInt64(out []int64) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
-	return uint(len(keys)), d.dictionary.MapInt64(keys, out)
+	return uint(len(keys)), d.dictionary.MapInt64(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) DecodeInt96(out []datatypes.Int96) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
-	return uint(len(keys)), d.dictionary.MapInt96(keys, out)
+	return uint(len(keys)), d.dictionary.MapInt96(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) DecodeFloat32(out []float32) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
-	return uint(len(keys)), d.dictionary.MapFloat32(keys, out)
+	return uint(len(keys)), d.dictionary.MapFloat32(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) DecodeFloat64(out []float64) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
-	return uint(len(keys)), d.dictionary.MapFloat64(keys, out)
+	return uint(len(keys)), d.dictionary.MapFloat64(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) DecodeByteArray(out [][]byte) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
 
-	return uint(len(keys)), d.dictionary.MapByteArray(keys, out)
+	return uint(len(keys)), d.dictionary.MapByteArray(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) DecodeFixedByteArray(out [][]byte, _ uint) (uint, error) {
-	keys, err := d.readKeys()
+	keys, err := d.readKeys(len(out))
 	if err != nil {
 		return 0, fmt.Errorf("could not read dictionary keys: %s", err)
 	}
 
-	return uint(len(keys)), d.dictionary.MapByteArray(keys, out)
+	return uint(len(keys)), d.dictionary.MapByteArray(keys, out[:len(keys)])
 }
 
 func (d *plainDictionaryDecoder) String() string {
@@ -0,0 +1,96 @@
+package encoding
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+	"github.com/kostya-sh/parquet-go/parquet/encoding/rle"
+)
+
+// int32Dictionary is a Dictionary of int32 values only.
+type int32Dictionary []int32
+
+func (d int32Dictionary) MapInt32(keys []uint32, out []int32) error {
+	for i, k := range keys {
+		if k >= uint32(len(d)) {
+			return fmt.Errorf("key out of bounds %d max: %d", k, len(d))
+		}
+		out[i] = d[k]
+	}
+	return nil
+}
+
+func (d int32Dictionary) MapBool([]uint32, []bool) error             { return fmt.Errorf("bool") }
+func (d int32Dictionary) MapInt64([]uint32, []int64) error           { return fmt.Errorf("int64") }
+func (d int32Dictionary) MapInt96([]uint32, []datatypes.Int96) error { return fmt.Errorf("int96") }
+func (d int32Dictionary) MapByteArray([]uint32, [][]byte) error      { return fmt.Errorf("byte array") }
+func (d int32Dictionary) MapFloat32([]uint32, []float32) error       { return fmt.Errorf("float32") }
+func (d int32Dictionary) MapFloat64([]uint32, []float64) error       { return fmt.Errorf("float64") }
+
+func encodeIndices(t *testing.T, bitWidth uint, indices []int32) []byte {
+	b := bytes.NewBuffer([]byte{byte(bitWidth)})
+	if _, err := rle.WriteInt32(b, bitWidth, indices); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return b.Bytes()
+}
+
+func TestPlainDictionaryDecoder(t *testing.T) {
+	dictionary := int32Dictionary{100, 200, 300}
+	indices := []int32{2, 2, 2, 2, 2, 2, 2, 2, 2, 0, 1, 0, 1, 2}
+	data := encodeIndices(t, 2, indices)
+
+	d := NewPlainDictionaryDecoder(bytes.NewReader(data), dictionary, uint(len(indices)))
+	var got []int32
+	out := make([]int32, 4)
+	for {
+		n, err := d.DecodeInt32(out)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if n == 0 {
+			break
+		}
+		got = append(got, out[:n]...)
+	}
+
+	want := make([]int32, len(indices))
+	for i, k := range indices {
+		want[i] = dictionary[k]
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPlainDictionaryDecodeIndices(t *testing.T) {
+	indices := []int32{0, 1, 1, 0, 1}
+	data := encodeIndices(t, 1, indices)
+
+	d := NewPlainDictionaryDecoder(bytes.NewReader(data), int32Dictionary{7, 8}, uint(len(indices)))
+	got := make([]uint32, 10)
+	n, err := d.(IndexDecoder).DecodeIndices(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []uint32{0, 1, 1, 0, 1}; !reflect.DeepEqual(got[:n], want) {
+		t.Errorf("got %v, want %v", got[:n], want)
+	}
+}
+
+func TestPlainDictionaryDecoderErrors(t *testing.T) {
+	// index 3 is not in the dictionary
+	data := encodeIndices(t, 2, []int32{0, 3})
+	d := NewPlainDictionaryDecoder(bytes.NewReader(data), int32Dictionary{1, 2}, 2)
+	if _, err := d.DecodeInt32(make([]int32, 2)); err == nil {
+		t.Errorf("expected an error for an index out of bounds")
+	}
+
+	d = NewPlainDictionaryDecoder(bytes.NewReader([]byte{33}), int32Dictionary{1}, 1)
+	if _, err := d.DecodeInt32(make([]int32, 1)); err == nil {
+		t.Errorf("expected an error for an invalid bit width")
+	}
+}
@@ -0,0 +1,230 @@
+package encoding
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestPlainDecodeBool(t *testing.T) {
+	// 10 values: 1,0,1,1,0,0,0,1 | 0,1
+	data := []byte{0x8D, 0x02}
+	want := []bool{true, false, true, true, false, false, false, true, false, true}
+
+	d := NewPlainDecoder(bytes.NewReader(data), uint(len(want)))
+
+	// read in batches that do not line up with the bytes
+	got := make([]bool, 0, len(want))
+	out := make([]bool, 3)
+	for {
+		n, err := d.DecodeBool(out)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if n == 0 {
+			break
+		}
+		got = append(got, out[:n]...)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestPlainDecodeNumbers(t *testing.T) {
+	data := []byte{
+		0x01, 0x00, 0x00, 0x00, 0xFE, 0xFF, 0xFF, 0xFF, // int32 1, -2
+		0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, // int64 math.MinInt64 + 3
+		0x00, 0x00, 0xC0, 0x3F, // float32 1.5
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xC0, // float64 -2.25
+		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, // int96
+	}
+	r := bytes.NewReader(data)
+
+	i32 := make([]int32, 2)
+	if n, err := NewPlainDecoder(r, 2).DecodeInt32(i32); err != nil || n != 2 {
+		t.Fatalf("DecodeInt32: got %d, %v", n, err)
+	}
+	if i32[0] != 1 || i32[1] != -2 {
+		t.Errorf("DecodeInt32: got %v", i32)
+	}
+
+	i64 := make([]int64, 1)
+	if n, err := NewPlainDecoder(r, 1).DecodeInt64(i64); err != nil || n != 1 {
+		t.Fatalf("DecodeInt64: got %d, %v", n, err)
+	}
+	if i64[0] != -1<<63+3 {
+		t.Errorf("DecodeInt64: got %v", i64)
+	}
+
+	f32 := make([]float32, 1)
+	if n, err := NewPlainDecoder(r, 1).DecodeFloat32(f32); err != nil || n != 1 {
+		t.Fatalf("DecodeFloat32: got %d, %v", n, err)
+	}
+	if f32[0] != 1.5 {
+		t.Errorf("DecodeFloat32: got %v", f32)
+	}
+
+	f64 := make([]float64, 1)
+	if n, err := NewPlainDecoder(r, 1).DecodeFloat64(f64); err != nil || n != 1 {
+		t.Fatalf("DecodeFloat64: got %d, %v", n, err)
+	}
+	if f64[0] != -2.25 {
+		t.Errorf("DecodeFloat64: got %v", f64)
+	}
+
+	i96 := make([]datatypes.Int96, 1)
+	if n, err := NewPlainDecoder(r, 1).DecodeInt96(i96); err != nil || n != 1 {
+		t.Fatalf("DecodeInt96: got %d, %v", n, err)
+	}
+	if i96[0].N1 != 1 || i96[0].N2 != 2 {
+		t.Errorf("DecodeInt96: got %v", i96)
+	}
+}
+
+func TestPlainDecodeByteArrays(t *testing.T) {
+	data := []byte{
+		0x03, 0x00, 0x00, 0x00, 'f', 'o', 'o',
+		0x00, 0x00, 0x00, 0x00,
+		0x02, 0x00, 0x00, 0x00, 'h', 'i',
+		'a', 'b', 'c', 'd',
+	}
+	r := bytes.NewReader(data)
+
+	got := make([][]byte, 3)
+	if n, err := NewPlainDecoder(r, 3).DecodeByteArray(got); err != nil || n != 3 {
+		t.Fatalf("DecodeByteArray: got %d, %v", n, err)
+	}
+	want := [][]byte{[]byte("foo"), {}, []byte("hi")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeByteArray: got %q, want %q", got, want)
+	}
+
+	got = make([][]byte, 2)
+	if n, err := NewPlainDecoder(r, 2).DecodeFixedByteArray(got, 2); err != nil || n != 2 {
+		t.Fatalf("DecodeFixedByteArray: got %d, %v", n, err)
+	}
+	want = [][]byte{[]byte("ab"), []byte("cd")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DecodeFixedByteArray: got %q, want %q", got, want)
+	}
+}
+
+func TestPlainDecodeShortRead(t *testing.T) {
+	d := NewPlainDecoder(bytes.NewReader([]byte{0x01, 0x00, 0x00, 0x00, 0x02}), 2)
+	out := make([]int32, 2)
+	n, err := d.DecodeInt32(out)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if n != 1 || out[0] != 1 {
+		t.Errorf("got %d values %v", n, out[:n])
+	}
+}
+
+func TestNewDecoder(t *testing.T) {
+	if _, err := NewDecoder(thrift.Encoding_PLAIN, bytes.NewReader(nil), 0, nil); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if _, err := NewDecoder(thrift.Encoding_RLE_DICTIONARY, bytes.NewReader(nil), 0, nil); err == nil {
+		t.Errorf("expected an error without a dictionary")
+	}
+	if _, err := NewDecoder(thrift.Encoding_BIT_PACKED, bytes.NewReader(nil), 0, nil); err == nil {
+		t.Errorf("expected an error for an unsupported encoding")
+	}
+}
+
+func TestPlainEncodeBool(t *testing.T) {
+	values := []bool{true, false, true, true, false, false, false, true, false, true}
+
+	var b bytes.Buffer
+	n, err := NewPlainEncoder().WriteBool(&b, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []byte{0x8D, 0x02}
+	if n != len(want) || !bytes.Equal(b.Bytes(), want) {
+		t.Errorf("got %#v, want %#v", b.Bytes(), want)
+	}
+}
+
+func TestPlainRoundTrip(t *testing.T) {
+	var b bytes.Buffer
+	e := NewPlainEncoder().(*plainEncoder)
+
+	bools := []bool{false, true, true}
+	i32 := []int32{0, -1, 1 << 30}
+	i64 := []int64{-1 << 63, 42}
+	i96 := []datatypes.Int96{{N1: -5, N2: 7}}
+	f32 := []float32{0.5, -3}
+	f64 := []float64{1e300}
+	arrays := [][]byte{[]byte("parquet"), {}, []byte("go")}
+	fixed := [][]byte{[]byte("abc"), []byte("def")}
+
+	if _, err := e.WriteBool(&b, bools); err != nil {
+		t.Fatal(err)
+	}
+	for _, err := range []error{
+		e.WriteInt32(&b, i32),
+		e.WriteInt64(&b, i64),
+		e.WriteInt96(&b, i96),
+		e.WriteFloat32(&b, f32),
+		e.WriteFloat64(&b, f64),
+		e.WriteByteArray(&b, arrays),
+		e.WriteFixedByteArray(&b, fixed),
+	} {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if e.NumValues() != 17 {
+		t.Errorf("NumValues: got %d, want 17", e.NumValues())
+	}
+
+	r := bytes.NewReader(b.Bytes())
+	gotBools := make([]bool, len(bools))
+	NewPlainDecoder(r, uint(len(bools))).DecodeBool(gotBools)
+	gotI32 := make([]int32, len(i32))
+	NewPlainDecoder(r, uint(len(i32))).DecodeInt32(gotI32)
+	gotI64 := make([]int64, len(i64))
+	NewPlainDecoder(r, uint(len(i64))).DecodeInt64(gotI64)
+	gotI96 := make([]datatypes.Int96, len(i96))
+	NewPlainDecoder(r, uint(len(i96))).DecodeInt96(gotI96)
+	gotF32 := make([]float32, len(f32))
+	NewPlainDecoder(r, uint(len(f32))).DecodeFloat32(gotF32)
+	gotF64 := make([]float64, len(f64))
+	NewPlainDecoder(r, uint(len(f64))).DecodeFloat64(gotF64)
+	gotArrays := make([][]byte, len(arrays))
+	NewPlainDecoder(r, uint(len(arrays))).DecodeByteArray(gotArrays)
+	gotFixed := make([][]byte, len(fixed))
+	NewPlainDecoder(r, uint(len(fixed))).DecodeFixedByteArray(gotFixed, 3)
+
+	for _, c := range []struct{ got, want interface{} }{
+		{gotBools, bools},
+		{gotI32, i32},
+		{gotI64, i64},
+		{gotI96, i96},
+		{gotF32, f32},
+		{gotF64, f64},
+		{gotArrays, arrays},
+		{gotFixed, fixed},
+	} {
+		if !reflect.DeepEqual(c.got, c.want) {
+			t.Errorf("got %v, want %v", c.got, c.want)
+		}
+	}
+	if r.Len() != 0 {
+		t.Errorf("%d bytes were not decoded", r.Len())
+	}
+}
+
+func TestPlainEncodeFixedByteArraySize(t *testing.T) {
+	var b bytes.Buffer
+	err := NewPlainEncoder().WriteFixedByteArray(&b, [][]byte{[]byte("ab"), []byte("c")})
+	if err == nil {
+		t.Errorf("expected an error for values of different sizes")
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"fmt"
 	"io"
-	"log"
 
 	"github.com/kostya-sh/parquet-go/parquet/encoding/bitpacking"
 )
@@ -30,249 +29,123 @@ func ReadVarint32(r io.ByteReader) (int32, error) {
 	}
 }
 
-// type HybridBitPackingRLEEncoder struct {
-// 	w      *bufio.Writer
-// 	buffer []byte
-// }
-
-// func NewHybridBitPackingRLEEncoder(w io.Writer) *HybridBitPackingRLEEncoder {
-// 	return &HybridBitPackingRLEEncoder{bufio.NewWriter(w), make([]byte, binary.MaxVarintLen64)}
-// }
-
-// func (e *HybridBitPackingRLEEncoder) Write(count uint32, value int32) (err error) {
-// 	var (
-// 		indicator int32 = int32(count<<1 | 0)
-// 		i         int
-// 	)
-
-// 	i = binary.PutVarint(e.buffer, indicator)
-// 	i, err = e.w.Write(e.buffer[:i])
-// 	if err != nil {
-// 		return err
-// 	}
-
-// 	err = binary.Write(e.w, binary.LittleEndian, value)
-// 	return
-// }
-
-// ReadBool
+// ReadBool reads count booleans encoded with a bit width of 1.
 func ReadBool(r io.Reader, count uint) ([]bool, error) {
-	var out []bool
-	bitWidth := uint(1) // fixed for booleans
-	byteWidth := (bitWidth + uint(7)) / uint(8)
-	p := make([]byte, byteWidth)
-
-	br := bufio.NewReader(r)
-
-	for {
-
-		// run := <bit-packed-run> | <rle-run>
-		header, err := ReadVarint32(br)
-
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, err
-		}
-
-		if (header & 1) == 1 {
-			// bit-packed-header := varint-encode(<bit-pack-count> << 1 | 1)
-			// we always bit-pack a multiple of 8 values at a time, so we only store the number of values / 8
-			// bit-pack-count := (number of values in this run) / 8
-			literalCount := (header >> 1) * 8
-
-			if uint(literalCount) > ((count - uint(len(out))) + 7) {
-				return nil, fmt.Errorf("bitcoding.bool:bad encoding found more elements (%d) than expected (%d)", uint(len(out))+uint(literalCount), count)
-			}
-
-			r := bitpacking.NewDecoder(bitWidth)
-
-			values := make([]int32, literalCount)
-
-			if err := r.Read(br, values); err != nil {
-				return nil, err
-			}
-
-			for i := int32(0); i < literalCount; i++ {
-				out = append(out, values[i] == 1)
-			}
-
-		} else {
-			// rle-run := <rle-header> <repeated-value>
-			// rle-header := varint-encode( (number of times repeated) << 1)
-			// repeated-value := value that is repeated, using a fixed-width of round-up-to-next-byte(bit-width)
-			repeatCount := int32(header >> 1)
-
-			if _, err := br.Read(p); err != nil {
-				return nil, fmt.Errorf("short read value: %s", err)
-			}
-			value := unpackLittleEndianInt32(p)
-
-			if uint(repeatCount) > (count - uint(len(out))) {
-				return nil, fmt.Errorf("rle.bool:bad encoding: found more elements (%d) than expected (%d)", uint(len(out))+uint(repeatCount), count)
-			}
-
-			for i := int32(0); i < repeatCount; i++ {
-				out = append(out, value == 1)
-			}
-		}
+	values, err := ReadInt32(r, 1, count)
+	if err != nil {
+		return nil, err
 	}
 
-	if uint(len(out)) < count {
-		return nil, fmt.Errorf("could not decode %d values only %d", count, len(out))
+	out := make([]bool, count)
+	for i, v := range values {
+		out[i] = v == 1
 	}
 
-	return out[:count], nil
+	return out, nil
 }
 
-// ReadInt32 .
+// ReadInt32 reads count values of bitWidth bits from r.
 func ReadInt32(r io.Reader, bitWidth uint, count uint) ([]int32, error) {
-	var out []int32
-	byteWidth := (bitWidth + uint(7)) / uint(8)
-	p := make([]byte, byteWidth)
-
-	br := bufio.NewReader(r)
-
-	dec := bitpacking.NewDecoder(bitWidth)
-
-	run := -1
-
-	for {
-		run++
-		log.Printf("run %d:%v", run, out)
-		// run := <bit-packed-run> | <rle-run>
-		header, err := ReadVarint32(br)
-
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, err
-		}
-
-		if (header & 1) == 1 {
-			// bit-packed-header := varint-encode(<bit-pack-count> << 1 | 1)
-			// we always bit-pack a multiple of 8 values at a time, so we only store the number of values / 8
-			// bit-pack-count := (number of values in this run) / 8
-			literalCount := int32(header>>1) * 8
-
-			log.Printf("run %d (bitcoding):%d", run, literalCount)
-
-			if int(literalCount) > (int(count)-len(out))+7 {
-				return out, fmt.Errorf("bitcoding.int32: bad encoding: found more elements (%d) than expected (%d) run %d", uint(len(out))+uint(literalCount), count, run)
-			}
-
-			values := make([]int32, literalCount)
-
-			if err := dec.Read(br, values); err != nil {
-				return nil, err
-			}
-
-			for i := int32(0); i < literalCount; i++ {
-				out = append(out, int32(values[i]))
-			}
-
-		} else {
-			// rle-run := <rle-header> <repeated-value>
-			// rle-header := varint-encode( (number of times repeated) << 1)
-			// repeated-value := value that is repeated, using a fixed-width of round-up-to-next-byte(bit-width)
-			repeatCount := int32(header >> 1)
+	if err := CheckBitWidth(bitWidth); err != nil {
+		return nil, err
+	}
+	out := make([]int32, count)
 
-			log.Printf("run %d (rle):%d", run, repeatCount)
+	d := NewDecoder(bitWidth)
+	d.InitReader(r)
+	d.Limit(int(count))
 
-			if _, err := br.Read(p); err != nil {
-				return nil, fmt.Errorf("short read value: %s", err)
-			}
-			value := unpackLittleEndianInt32(p)
-
-			if uint(repeatCount) > (count - uint(len(out))) {
-				return nil, fmt.Errorf("rle.int32:bad encoding: found more elements (%d) than expected (%d)", uint(len(out))+uint(repeatCount), count)
-			}
+	if n, err := d.NextBatch(out); err == io.EOF {
+		return nil, fmt.Errorf("could not decode %d values only %d", count, n)
+	} else if err != nil {
+		return nil, err
+	}
 
-			for i := int32(0); i < repeatCount; i++ {
-				out = append(out, int32(value))
-			}
+	return out, nil
+}
 
-		}
+// ReadUint32 reads count values of bitWidth bits from r.
+func ReadUint32(r io.Reader, bitWidth uint, count uint) ([]uint32, error) {
+	values, err := ReadInt32(r, bitWidth, count)
+	if err != nil {
+		return nil, err
 	}
 
-	if uint(len(out)) < count {
-		return nil, fmt.Errorf("could not decode %d values only %d", count, len(out))
+	out := make([]uint32, count)
+	for i, v := range values {
+		out[i] = uint32(v)
 	}
 
-	return out[:count], nil
+	return out, nil
 }
 
-// ReadInt32 .
-func ReadUint32(r io.Reader, bitWidth uint, count uint) ([]uint32, error) {
-	var out []uint32
+// ReadInt64 reads count values of bitWidth bits, with bitWidth up to 64.
+func ReadInt64(r io.Reader, bitWidth uint, count uint) ([]int64, error) {
+	if bitWidth > 64 {
+		return nil, &DataError{Err: ErrInvalidBitWidth, Offset: -1, Detail: fmt.Sprintf("%d > 64", bitWidth)}
+	}
+	if bitWidth == 0 {
+		// all the values are zeros and nothing is stored
+		return make([]int64, count), nil
+	}
+
+	var out []int64
 	byteWidth := (bitWidth + uint(7)) / uint(8)
 	p := make([]byte, byteWidth)
 
 	br := bufio.NewReader(r)
 
-	dec := bitpacking.NewDecoder(bitWidth)
-
-	run := -1
+	dec := bitpacking.NewDecoder64(bitWidth)
 
 	for {
-		run++
-
 		// run := <bit-packed-run> | <rle-run>
 		header, err := ReadVarint32(br)
 
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			return nil, err
+			return nil, &DataError{Err: ErrInvalidRunHeader, Offset: -1, Detail: err.Error()}
 		}
 
 		if (header & 1) == 1 {
 			// bit-packed-header := varint-encode(<bit-pack-count> << 1 | 1)
 			// we always bit-pack a multiple of 8 values at a time, so we only store the number of values / 8
 			// bit-pack-count := (number of values in this run) / 8
-			literalCount := (header >> 1) * 8
-
-			log.Printf("run %d (bitcoding):%d", run, literalCount)
+			literalCount := int(header>>1) * 8
 
-			if uint(literalCount) > ((count - uint(len(out))) + 7) {
-				return nil, fmt.Errorf("bitcoding.int32.bad encoding found more elements (%d) than expected (%d) run %d", uint(len(out))+uint(literalCount), count, run)
+			if literalCount > (int(count)-len(out))+7 {
+				return nil, &DataError{Err: ErrTooManyValues, Offset: -1, Detail: fmt.Sprintf("bit-packed run of %d values, %d left", literalCount, int(count)-len(out))}
 			}
 
-			values := make([]int32, literalCount)
+			values := make([]int64, literalCount)
 
 			if err := dec.Read(br, values); err != nil {
 				return nil, err
 			}
 
-			for i := int32(0); i < literalCount; i++ {
-				out = append(out, uint32(values[i]))
-			}
+			out = append(out, values...)
 
 		} else {
 			// rle-run := <rle-header> <repeated-value>
 			// rle-header := varint-encode( (number of times repeated) << 1)
 			// repeated-value := value that is repeated, using a fixed-width of round-up-to-next-byte(bit-width)
-			repeatCount := header >> 1
-
-			log.Printf("run %d (rle):%d", run, repeatCount)
+			repeatCount := int(header >> 1)
 
-			if _, err := br.Read(p); err != nil {
-				return nil, fmt.Errorf("short read value: %s", err)
+			if _, err := io.ReadFull(br, p); err != nil {
+				return nil, &DataError{Err: ErrTruncated, Offset: -1, Detail: fmt.Sprintf("could not read repeated value: %s", err)}
 			}
-			value := unpackLittleEndianInt32(p)
+			value := unpackLittleEndianInt64(p)
 
-			if uint(repeatCount) > (count - uint(len(out))) {
-				return nil, fmt.Errorf("rle.int32:bad encoding: found more elements (%d) than expected (%d)", uint(len(out))+uint(repeatCount), count)
+			if repeatCount > int(count)-len(out) {
+				return nil, &DataError{Err: ErrTooManyValues, Offset: -1, Detail: fmt.Sprintf("RLE run of %d values, %d left", repeatCount, int(count)-len(out))}
 			}
 
-			for i := int32(0); i < repeatCount; i++ {
-				out = append(out, uint32(value))
+			for i := 0; i < repeatCount; i++ {
+				out = append(out, value)
 			}
 		}
 	}
 
-	log.Println("total values read: ", len(out), " of ", count)
-
 	if uint(len(out)) < count {
 		return nil, fmt.Errorf("could not decode %d values only %d", count, len(out))
 	}
@@ -371,14 +244,25 @@ func unpackLittleEndianInt32(bytes []byte) int32 {
 	case 1:
 		return int32(bytes[0])
 	case 2:
-		return int32(bytes[1]) + int32(bytes[0])<<8
+		return int32(bytes[0]) + int32(bytes[1])<<8
 	case 3:
 		return int32(bytes[0]) + int32(bytes[1])<<8 + int32(bytes[2])<<16
 	case 4:
 		return int32(bytes[0]) + int32(bytes[1])<<8 + int32(bytes[2])<<16 + int32(bytes[3])<<24
 	default:
-		panic("invalid argument: " + string(len(bytes)))
+		panic(fmt.Sprintf("invalid argument: %d", len(bytes)))
+	}
+}
+
+func unpackLittleEndianInt64(bytes []byte) int64 {
+	if len(bytes) == 0 || len(bytes) > 8 {
+		panic(fmt.Sprintf("invalid argument: %d", len(bytes)))
+	}
+	var v int64
+	for i := len(bytes) - 1; i >= 0; i-- {
+		v = v<<8 | int64(bytes[i])
 	}
+	return v
 }
 
 func packLittleEndianInt32(bytes []byte, value int32) int {
@@ -402,6 +286,6 @@ func packLittleEndianInt32(bytes []byte, value int32) int {
 		bytes[3] = byte(value >> 24)
 		return 4
 	default:
-		panic("invalid argument: " + string(len(bytes)))
+		panic(fmt.Sprintf("invalid argument: %d", len(bytes)))
 	}
 }
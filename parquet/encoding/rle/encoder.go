@@ -1,6 +1,7 @@
 package rle
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -11,32 +12,200 @@ import (
 // WriteBool writes to w all the values inside v or returns an error.
 // returns the total number of byte written
 func WriteBool(w io.Writer, v []bool) (int, error) {
-	btpack := bitpacking.NewEncoder(1, bitpacking.RLE)
 	vv := make([]int32, len(v))
 	for i := 0; i < len(v); i++ {
 		if v[i] {
 			vv[i] = 1
-		} else {
-			vv[i] = 0
 		}
 	}
 
-	boundary := (len(vv) + 7) / 8
+	return WriteInt32(w, 1, vv)
+}
+
+// WriteInt32 writes to w all the values inside v using the RLE/bit-packing
+// hybrid encoding with the given bitWidth. It returns the total number of
+// bytes written.
+func WriteInt32(w io.Writer, bitWidth uint, v []int32) (int, error) {
+	enc := NewEncoder(w, bitWidth)
+	if err := enc.Write(v); err != nil {
+		return enc.n, err
+	}
+	err := enc.Flush()
+	return enc.n, err
+}
+
+// Encoder serializes values using the RLE/bit-packing hybrid encoding.
+//
+// Values are buffered 8 at a time: once the same value has been seen 8
+// times in a row an RLE run is started and extended until a different value
+// is found, otherwise the buffered values are appended to the current
+// bit-packed run. A bit-packed run is closed after 63 groups of 8 values so
+// its header always fits in a single byte. This is the same strategy used
+// by parquet-mr.
+type Encoder struct {
+	w         io.Writer
+	bitWidth  uint
+	byteWidth uint
+	packer    *bitpacking.Encoder
+
+	// values that have not been written yet
+	buffered    [8]int32
+	numBuffered int
+
+	// current RLE candidate
+	previous    int32
+	repeatCount int
+
+	// pending bit-packed run, its header is written when the run is closed
+	packed       bytes.Buffer
+	packedGroups int
+
+	b [binary.MaxVarintLen32]byte
+	n int // total number of bytes written to w
+}
+
+// NewEncoder returns an Encoder that writes values of bitWidth bits to w.
+func NewEncoder(w io.Writer, bitWidth uint) *Encoder {
+	if bitWidth == 0 || bitWidth > 32 {
+		panic("invalid 0 > bitWidth <= 32")
+	}
+	return &Encoder{
+		w:         w,
+		bitWidth:  bitWidth,
+		byteWidth: (bitWidth + 7) / 8,
+		packer:    bitpacking.NewEncoder(bitWidth, bitpacking.RLE),
+	}
+}
+
+// Write encodes values. Some of them might be kept in memory until Flush is
+// called.
+func (e *Encoder) Write(values []int32) error {
+	for _, v := range values {
+		if err := e.writeValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *Encoder) writeValue(v int32) error {
+	if v == e.previous {
+		e.repeatCount++
+		if e.repeatCount >= 8 {
+			// this is going to be an RLE run: keep counting
+			return nil
+		}
+	} else {
+		if e.repeatCount >= 8 {
+			if err := e.writeRLERun(); err != nil {
+				return err
+			}
+		}
+		e.repeatCount = 1
+		e.previous = v
+	}
+
+	e.buffered[e.numBuffered] = v
+	e.numBuffered++
+	if e.numBuffered == 8 {
+		return e.appendBitPackedGroup()
+	}
+
+	return nil
+}
+
+// appendBitPackedGroup adds the 8 buffered values to the current bit-packed
+// run, starting a new run if the current one is full.
+func (e *Encoder) appendBitPackedGroup() error {
+	if e.packedGroups >= 63 {
+		if err := e.endBitPackedRun(); err != nil {
+			return err
+		}
+	}
 
-	boundary = (boundary << 1) & 1
+	if _, err := e.packer.Write(&e.packed, e.buffered[:]); err != nil {
+		return err
+	}
+	e.packedGroups++
+	e.numBuffered = 0
+	e.repeatCount = 0
+
+	return nil
+}
 
-	b := make([]byte, binary.MaxVarintLen32)
+// endBitPackedRun writes the pending bit-packed run (if any) to w.
+func (e *Encoder) endBitPackedRun() error {
+	if e.packedGroups == 0 {
+		return nil
+	}
+
+	// bit-packed-header := varint-encode(<bit-pack-count> << 1 | 1)
+	if err := e.writeHeader(uint64(e.packedGroups<<1 | 1)); err != nil {
+		return err
+	}
+	n, err := e.w.Write(e.packed.Bytes())
+	e.n += n
+	if err != nil {
+		return fmt.Errorf("could not write bit-packed run: %s", err)
+	}
 
-	n := binary.PutUvarint(b, uint64(boundary))
+	e.packed.Reset()
+	e.packedGroups = 0
+
+	return nil
+}
+
+func (e *Encoder) writeRLERun() error {
+	if err := e.endBitPackedRun(); err != nil {
+		return err
+	}
+
+	// rle-header := varint-encode( (number of times repeated) << 1)
+	if err := e.writeHeader(uint64(e.repeatCount) << 1); err != nil {
+		return err
+	}
 
-	if _, err := w.Write(b[:n]); err != nil {
-		return 0, fmt.Errorf("could not write header:%s", err)
+	// repeated-value := value that is repeated, using a fixed-width of round-up-to-next-byte(bit-width)
+	packLittleEndianInt32(e.b[:e.byteWidth], e.previous)
+	n, err := e.w.Write(e.b[:e.byteWidth])
+	e.n += n
+	if err != nil {
+		return fmt.Errorf("could not write rle value: %s", err)
 	}
 
-	m, err := btpack.Write(w, vv)
+	e.repeatCount = 0
+	e.numBuffered = 0
 
-	//debug(n != len(vv), "rle.WriteBool: could not write all the values %d != %d", n, len(v))
-	return n + m, err
+	return nil
+}
+
+func (e *Encoder) writeHeader(header uint64) error {
+	n, err := e.w.Write(e.b[:binary.PutUvarint(e.b[:], header)])
+	e.n += n
+	if err != nil {
+		return fmt.Errorf("could not write header: %s", err)
+	}
+	return nil
+}
+
+// Flush writes all the buffered values to the underlying writer. The last
+// bit-packed run is padded with zeros up to a multiple of 8 values.
+func (e *Encoder) Flush() error {
+	if e.repeatCount >= 8 {
+		return e.writeRLERun()
+	}
+
+	if e.numBuffered > 0 {
+		for i := e.numBuffered; i < 8; i++ {
+			e.buffered[i] = 0
+		}
+		e.numBuffered = 8
+		if err := e.appendBitPackedGroup(); err != nil {
+			return err
+		}
+	}
+
+	return e.endBitPackedRun()
 }
 
 // rleByteconsumed returns how many bytes would be used by an RLE run
@@ -51,33 +220,3 @@ func rleByteConsumed(bitWidth int, numValues int) int {
 func bitpackingByteConsumed(bitWidth int, numValues int) int {
 	return ((bitWidth * numValues) + 7) / 8
 }
-
-// // An Encoder serializes data in the RLE format.
-// type Encoder struct {
-// 	w     RLEWriter // where to send the data
-// 	value int64     // last seen values
-// 	count uint64    // how many times we have seen the value
-// }
-
-// func NewEncoder(w RLEWriter) *Encoder {
-// 	return &Encoder{w: w}
-// }
-
-// func (e *Encoder) Encode(value int64) error {
-// 	if value != e.value {
-// 		if err := e.Flush(); err != nil {
-// 			return err
-// 		}
-// 		e.value = value
-// 		e.count = 1
-// 	} else {
-// 		e.count++
-// 	}
-
-// 	return nil
-// }
-
-// // Flush writes the current running value in the underlying writer
-// func (e *Encoder) Flush() (err error) {
-// 	return e.w.Write(e.count, e.value)
-// }
@@ -0,0 +1,42 @@
+package rle
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned, wrapped in a *DataError, for malformed data.
+var (
+	ErrInvalidBitWidth  = errors.New("invalid bit width")
+	ErrInvalidRunHeader = errors.New("invalid run header")
+	ErrTooManyValues    = errors.New("run longer than the number of values left")
+	ErrTruncated        = errors.New("truncated data")
+)
+
+// DataError describes malformed RLE/bit-packing hybrid data.
+type DataError struct {
+	Err    error // one of the Err* variables
+	Offset int   // offset of the run in the data, -1 when reading a stream
+	Detail string
+}
+
+func (e *DataError) Error() string {
+	msg := "rle: " + e.Err.Error()
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	if e.Offset >= 0 {
+		msg += fmt.Sprintf(" at offset %d", e.Offset)
+	}
+	return msg
+}
+
+// CheckBitWidth returns an error unless bitWidth is a valid bit width for
+// the values of the RLE/bit-packing hybrid encoding, that is at most 32.
+// Dictionary indices and levels are never wider.
+func CheckBitWidth(bitWidth uint) error {
+	if bitWidth > 32 {
+		return &DataError{Err: ErrInvalidBitWidth, Offset: -1, Detail: fmt.Sprintf("%d > 32", bitWidth)}
+	}
+	return nil
+}
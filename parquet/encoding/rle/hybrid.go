@@ -0,0 +1,436 @@
+package rle
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/kostya-sh/parquet-go/parquet/encoding/bitpacking"
+)
+
+// Decoder reads values encoded with the RLE/bit-packing hybrid encoding one
+// run at a time, either from an in-memory buffer (Init) or from a stream
+// (InitReader).
+//
+// Unlike ReadInt32 it does not need to know the number of values in advance
+// and it does not allocate a slice for the decoded values. When the number
+// of values is known, Limit makes the decoder reject runs that are longer.
+//
+// Malformed data is reported with a *DataError.
+type Decoder struct {
+	bitWidth  uint
+	byteWidth uint
+	unpacker  *bitpacking.Decoder
+
+	// source of the encoded data: r when streaming, data[pos:] otherwise
+	r    *bufio.Reader
+	data []byte
+	pos  int
+
+	// current RLE run
+	rleCount int
+	rleValue int32
+
+	// current bit-packed run: bpCount values are left, the first of them
+	// are buffered in bpRun[bpPos:]
+	bpCount int
+	bpRun   [8]int32
+	bpPos   int
+	bpGroup [32]byte
+
+	left int // values left to read, -1 when unknown
+}
+
+// NewDecoder returns a Decoder for values of bitWidth bits. Init or
+// InitReader must be called before reading any value.
+//
+// A bitWidth of 0 is valid (e.g. dictionary indices of a dictionary with a
+// single entry): such a decoder does not read any data and always returns
+// zeros, the caller is responsible for reading only the number of values
+// declared in the page.
+func NewDecoder(bitWidth uint) *Decoder {
+	if bitWidth > 32 {
+		panic("invalid bitWidth > 32")
+	}
+	d := &Decoder{
+		bitWidth:  bitWidth,
+		byteWidth: (bitWidth + 7) / 8,
+		bpPos:     8,
+		left:      -1,
+	}
+	if bitWidth > 0 {
+		d.unpacker = bitpacking.NewDecoder(bitWidth)
+	}
+	return d
+}
+
+// Init resets the decoder to read the values encoded in data.
+func (d *Decoder) Init(data []byte) {
+	d.reset()
+	d.r = nil
+	d.data = data
+}
+
+// InitReader resets the decoder to read the values encoded in r. Data is
+// consumed from r as values are requested so the whole stream never needs
+// to be held in memory. r is wrapped in a bufio.Reader unless it already is
+// one.
+func (d *Decoder) InitReader(r io.Reader) {
+	d.reset()
+	d.data = nil
+	d.r = bufio.NewReader(r)
+}
+
+func (d *Decoder) reset() {
+	d.pos = 0
+	d.rleCount = 0
+	d.bpCount = 0
+	d.bpPos = 8
+	d.left = -1
+}
+
+// Limit declares that the data holds n values, e.g. the number of values of
+// the page. It must be called after Init or InitReader. Runs declaring more
+// values than left are rejected with ErrTooManyValues (bit-packed runs may
+// be padded with up to 7 values) and io.EOF is returned once n values were
+// read.
+func (d *Decoder) Limit(n int) {
+	d.left = n
+}
+
+func (d *Decoder) errorf(err error, format string, args ...interface{}) error {
+	offset := d.pos
+	if d.r != nil {
+		offset = -1
+	}
+	return &DataError{Err: err, Offset: offset, Detail: fmt.Sprintf(format, args...)}
+}
+
+// Next returns the next value. It returns io.EOF when there are no more
+// values.
+func (d *Decoder) Next() (int32, error) {
+	var v [1]int32
+	if _, err := d.NextBatch(v[:]); err != nil {
+		return 0, err
+	}
+	return v[0], nil
+}
+
+// NextBatch decodes up to len(dst) values into dst and returns the number
+// of values decoded. When the data ends before dst is full it returns the
+// number of values decoded so far together with io.EOF.
+func (d *Decoder) NextBatch(dst []int32) (int, error) {
+	limited := false
+	if d.left >= 0 && len(dst) > d.left {
+		dst = dst[:d.left]
+		limited = true
+	}
+
+	n, err := d.nextBatch(dst)
+	if d.left >= 0 {
+		d.left -= n
+	}
+	if err == nil && limited {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (d *Decoder) nextBatch(dst []int32) (n int, err error) {
+	if d.bitWidth == 0 {
+		for i := range dst {
+			dst[i] = 0
+		}
+		return len(dst), nil
+	}
+
+	for n < len(dst) {
+		switch {
+		case d.rleCount > 0:
+			c := len(dst) - n
+			if c > d.rleCount {
+				c = d.rleCount
+			}
+			for i := n; i < n+c; i++ {
+				dst[i] = d.rleValue
+			}
+			n += c
+			d.rleCount -= c
+
+		case d.bpCount > 0:
+			if d.bpPos < 8 {
+				c := copy(dst[n:], d.bpRun[d.bpPos:])
+				if c > d.bpCount {
+					c = d.bpCount
+				}
+				n += c
+				d.bpPos += c
+				d.bpCount -= c
+				continue
+			}
+			// unpack whole groups directly into dst
+			if d.r == nil {
+				groups := (len(dst) - n) / 8
+				if groups > d.bpCount/8 {
+					groups = d.bpCount / 8
+				}
+				if available := (len(d.data) - d.pos) / int(d.bitWidth); groups > available {
+					groups = available
+				}
+				if groups > 0 {
+					size := groups * int(d.bitWidth)
+					if err := d.unpacker.UnpackGroups(d.data[d.pos:d.pos+size], dst[n:n+groups*8]); err != nil {
+						return n, fmt.Errorf("rle: could not unpack values: %s", err)
+					}
+					d.pos += size
+					n += groups * 8
+					d.bpCount -= groups * 8
+				}
+			}
+			for len(dst)-n >= 8 && d.bpCount >= 8 {
+				c, err := d.unpack(dst[n:])
+				if err != nil {
+					return n, err
+				}
+				n += c
+				d.bpCount -= 8
+				if c < 8 {
+					d.bpCount = 0
+				}
+			}
+			if n < len(dst) && d.bpCount > 0 {
+				c, err := d.unpack(d.bpRun[:])
+				if err != nil {
+					return n, err
+				}
+				d.bpPos = 0
+				if c < 8 && c < d.bpCount {
+					d.bpCount = c
+				}
+			}
+
+		default:
+			if err := d.nextRun(); err != nil {
+				return n, err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+// Skip discards the next n values without decoding them and returns the
+// number of values skipped. When the data ends before n values are skipped
+// it returns io.EOF.
+func (d *Decoder) Skip(n int) (int, error) {
+	limited := false
+	if d.left >= 0 && n > d.left {
+		n = d.left
+		limited = true
+	}
+
+	skipped, err := d.skip(n)
+	if d.left >= 0 {
+		d.left -= skipped
+	}
+	if err == nil && limited {
+		err = io.EOF
+	}
+	return skipped, err
+}
+
+func (d *Decoder) skip(n int) (skipped int, err error) {
+	if d.bitWidth == 0 {
+		return n, nil
+	}
+
+	for skipped < n {
+		switch {
+		case d.rleCount > 0:
+			c := n - skipped
+			if c > d.rleCount {
+				c = d.rleCount
+			}
+			skipped += c
+			d.rleCount -= c
+
+		case d.bpCount > 0:
+			if d.bpPos < 8 {
+				c := 8 - d.bpPos
+				if c > d.bpCount {
+					c = d.bpCount
+				}
+				if c > n-skipped {
+					c = n - skipped
+				}
+				skipped += c
+				d.bpPos += c
+				d.bpCount -= c
+				continue
+			}
+			// whole groups are skipped without unpacking them
+			if groups := (n - skipped) / 8; groups > 0 {
+				if groups > d.bpCount/8 {
+					groups = d.bpCount / 8
+				}
+				size := groups * int(d.bitWidth)
+				discarded, err := d.discard(size)
+				if err != nil {
+					return skipped, err
+				}
+				if discarded < size {
+					// truncated run: only whole groups are skipped
+					d.bpCount = 0
+					groups = discarded / int(d.bitWidth)
+					skipped += groups * 8
+					continue
+				}
+				skipped += groups * 8
+				d.bpCount -= groups * 8
+			}
+			if skipped < n && d.bpCount > 0 {
+				c, err := d.unpack(d.bpRun[:])
+				if err != nil {
+					return skipped, err
+				}
+				d.bpPos = 0
+				if c < 8 && c < d.bpCount {
+					d.bpCount = c
+				}
+			}
+
+		default:
+			if err := d.nextRun(); err != nil {
+				return skipped, err
+			}
+		}
+	}
+
+	return skipped, nil
+}
+
+// unpack decodes the next group of 8 bit-packed values into out and returns
+// the number of valid values. The last group of a run is allowed to be
+// truncated: missing bits are zeros and less than 8 values are returned.
+func (d *Decoder) unpack(out []int32) (int, error) {
+	var (
+		group = d.bpGroup[:d.bitWidth]
+		n     int
+	)
+
+	if d.r != nil {
+		var err error
+		n, err = io.ReadFull(d.r, group)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, fmt.Errorf("rle: could not read bit-packed values: %s", err)
+		}
+	} else if d.pos+len(group) <= len(d.data) {
+		group = d.data[d.pos : d.pos+len(group)]
+		n = len(group)
+		d.pos += n
+	} else {
+		n = copy(group, d.data[d.pos:])
+		d.pos += n
+	}
+
+	for i := n; i < len(group); i++ {
+		group[i] = 0
+	}
+
+	if err := d.unpacker.Unpack8(group, out); err != nil {
+		return 0, fmt.Errorf("rle: could not unpack values: %s", err)
+	}
+	if n < len(group) {
+		return n * 8 / int(d.bitWidth), nil
+	}
+	return 8, nil
+}
+
+// discard skips the next n bytes of encoded data and returns the number of
+// bytes skipped.
+func (d *Decoder) discard(n int) (int, error) {
+	if d.r != nil {
+		discarded, err := d.r.Discard(n)
+		if err != nil && err != io.EOF {
+			return discarded, fmt.Errorf("rle: could not skip bit-packed values: %s", err)
+		}
+		return discarded, nil
+	}
+
+	if d.pos+n > len(d.data) {
+		n = len(d.data) - d.pos
+	}
+	d.pos += n
+	return n, nil
+}
+
+// nextRun reads the header of the next run.
+func (d *Decoder) nextRun() error {
+	// run := <bit-packed-run> | <rle-run>
+	header, err := d.readHeader()
+	if err != nil {
+		return err
+	}
+
+	if header > math.MaxUint32 {
+		return d.errorf(ErrInvalidRunHeader, "%d does not fit in 32 bits", header)
+	}
+
+	if header&1 == 1 {
+		// bit-packed-header := varint-encode(<bit-pack-count> << 1 | 1)
+		count := int(header>>1) * 8
+		if d.left >= 0 && count > d.left+7 {
+			return d.errorf(ErrTooManyValues, "bit-packed run of %d values, %d left", count, d.left)
+		}
+		d.bpCount = count
+		d.bpPos = 8
+		return nil
+	}
+
+	count := int(header >> 1)
+	if d.left >= 0 && count > d.left {
+		return d.errorf(ErrTooManyValues, "RLE run of %d values, %d left", count, d.left)
+	}
+
+	// rle-header := varint-encode( (number of times repeated) << 1)
+	// repeated-value := value that is repeated, using a fixed-width of round-up-to-next-byte(bit-width)
+	p := d.bpGroup[:d.byteWidth]
+	if d.r != nil {
+		if _, err := io.ReadFull(d.r, p); err != nil {
+			return d.errorf(ErrTruncated, "could not read repeated value: %s", err)
+		}
+	} else {
+		if d.pos+len(p) > len(d.data) {
+			return d.errorf(ErrTruncated, "could not read repeated value")
+		}
+		p = d.data[d.pos : d.pos+len(p)]
+		d.pos += len(p)
+	}
+	d.rleValue = unpackLittleEndianInt32(p)
+	d.rleCount = count
+
+	return nil
+}
+
+// readHeader reads a run header. It returns io.EOF if there is no more data.
+func (d *Decoder) readHeader() (uint64, error) {
+	if d.r != nil {
+		header, err := binary.ReadUvarint(d.r)
+		if err != nil && err != io.EOF {
+			return 0, d.errorf(ErrInvalidRunHeader, "%s", err)
+		}
+		return header, err
+	}
+
+	if d.pos >= len(d.data) {
+		return 0, io.EOF
+	}
+	header, n := binary.Uvarint(d.data[d.pos:])
+	if n <= 0 {
+		return 0, d.errorf(ErrInvalidRunHeader, "malformed varint")
+	}
+	d.pos += n
+	return header, nil
+}
@@ -3,6 +3,7 @@ package rle
 import (
 	"bytes"
 	"encoding/binary"
+	"io"
 	"reflect"
 	"testing"
 )
@@ -96,3 +97,326 @@ func TestRLEDecoder(t *testing.T) {
 		}
 	}
 }
+
+func TestRLEEncoder(t *testing.T) {
+	for i, test := range testcases {
+		var b bytes.Buffer
+		n, err := WriteInt32(&b, test.width, test.values)
+		if err != nil {
+			t.Errorf("test %d. unexpected error: %s", i, err)
+		}
+		if n != b.Len() {
+			t.Errorf("test %d. reported %d bytes written, got %d", i, n, b.Len())
+		}
+		if !bytes.Equal(b.Bytes(), test.data) {
+			t.Errorf("test %d. got %#v, want %#v", i, b.Bytes(), test.data)
+		}
+	}
+}
+
+func TestRLEEncoderRoundTrip(t *testing.T) {
+	var values []int32
+	for i := 0; i < 1000; i++ {
+		values = append(values, int32(i%7))
+	}
+	values = append(values, repeatInt32(100, 3)...)
+	values = append(values, 1, 2, 3)
+
+	for _, width := range []uint{3, 8, 9, 16, 17, 24, 31, 32} {
+		var b bytes.Buffer
+		if _, err := WriteInt32(&b, width, values); err != nil {
+			t.Fatalf("width %d. unexpected error: %s", width, err)
+		}
+		got, err := ReadInt32(bytes.NewReader(b.Bytes()), width, uint(len(values)))
+		if err != nil {
+			t.Fatalf("width %d. unexpected error: %s", width, err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("width %d. got %v, want %v", width, got, values)
+		}
+	}
+}
+
+func TestRLEDecoderInt64(t *testing.T) {
+	for i, test := range testcases {
+		values, err := ReadInt64(bytes.NewReader(test.data), test.width, uint(len(test.values)))
+		if err != nil {
+			t.Errorf("test %d. unexpected error: %s", i, err)
+		}
+		for j, v := range test.values {
+			if values[j] != int64(v) {
+				t.Errorf("test %d. got %v, want %v", i, values, test.values)
+				break
+			}
+		}
+	}
+
+	// RLE run: 40-bits per value, 5 x 0x0102030405
+	data := packVarInt(5<<1, 0x05, 0x04, 0x03, 0x02, 0x01)
+	values, err := ReadInt64(bytes.NewReader(data), 40, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, v := range values {
+		if v != 0x0102030405 {
+			t.Fatalf("got %x, want 0x0102030405", values)
+		}
+	}
+}
+
+func TestDecoderNextBatch(t *testing.T) {
+	for i, test := range testcases {
+		for _, size := range []int{1, 3, 8, 100} {
+			d := NewDecoder(test.width)
+			d.Init(test.data)
+
+			var values []int32
+			batch := make([]int32, size)
+			for {
+				n, err := d.NextBatch(batch)
+				values = append(values, batch[:n]...)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("test %d. unexpected error: %s", i, err)
+				}
+			}
+
+			if len(values) < len(test.values) || !reflect.DeepEqual(values[:len(test.values)], test.values) {
+				t.Errorf("test %d. batch %d: got %v, want %v", i, size, values, test.values)
+			}
+		}
+	}
+}
+
+func TestDecoderNext(t *testing.T) {
+	test := testcases[len(testcases)-1]
+	d := NewDecoder(test.width)
+	d.Init(test.data)
+	for i, want := range test.values {
+		got, err := d.Next()
+		if err != nil {
+			t.Fatalf("value %d. unexpected error: %s", i, err)
+		}
+		if got != want {
+			t.Errorf("value %d. got %d, want %d", i, got, want)
+		}
+	}
+	if _, err := d.Next(); err != io.EOF {
+		t.Errorf("got %v, want io.EOF", err)
+	}
+}
+
+func TestDecoderSkip(t *testing.T) {
+	test := testcases[len(testcases)-1]
+	for skip := 0; skip <= len(test.values); skip++ {
+		d := NewDecoder(test.width)
+		d.Init(test.data)
+
+		n, err := d.Skip(skip)
+		if err != nil {
+			t.Fatalf("skip %d. unexpected error: %s", skip, err)
+		}
+		if n != skip {
+			t.Fatalf("skip %d. skipped %d values", skip, n)
+		}
+
+		values := make([]int32, len(test.values)-skip)
+		if _, err := d.NextBatch(values); err != nil {
+			t.Fatalf("skip %d. unexpected error: %s", skip, err)
+		}
+		if !reflect.DeepEqual(values, test.values[skip:]) {
+			t.Errorf("skip %d. got %v, want %v", skip, values, test.values[skip:])
+		}
+	}
+
+	d := NewDecoder(test.width)
+	d.Init(test.data)
+	if n, err := d.Skip(len(test.values) + 1); err != io.EOF || n != len(test.values) {
+		t.Errorf("got %d, %v, want %d, io.EOF", n, err, len(test.values))
+	}
+}
+
+func TestZeroBitWidth(t *testing.T) {
+	// a dictionary with a single entry: 10 x 0 encoded with a 0 bit width
+	data := packVarInt(10 << 1)
+
+	values, err := ReadInt32(bytes.NewReader(data), 0, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(values, repeatInt32(10, 0)) {
+		t.Errorf("got %v, want 10 x 0", values)
+	}
+
+	keys, err := ReadUint32(bytes.NewReader(nil), 0, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(keys, []uint32{0, 0, 0}) {
+		t.Errorf("got %v, want 3 x 0", keys)
+	}
+
+	d := NewDecoder(0)
+	d.Init(data)
+	batch := []int32{1, 2, 3, 4}
+	if n, err := d.NextBatch(batch); err != nil || n != 4 {
+		t.Fatalf("got %d, %v", n, err)
+	}
+	if !reflect.DeepEqual(batch, repeatInt32(4, 0)) {
+		t.Errorf("got %v, want 4 x 0", batch)
+	}
+	if n, err := d.Skip(100); err != nil || n != 100 {
+		t.Errorf("got %d, %v", n, err)
+	}
+}
+
+func TestDecoderInitReader(t *testing.T) {
+	for i, test := range testcases {
+		d := NewDecoder(test.width)
+		d.InitReader(bytes.NewReader(test.data))
+
+		values := make([]int32, len(test.values))
+		n, err := d.NextBatch(values)
+		if err != nil {
+			t.Fatalf("test %d. unexpected error: %s", i, err)
+		}
+		if n != len(values) || !reflect.DeepEqual(values, test.values) {
+			t.Errorf("test %d. got %v, want %v", i, values[:n], test.values)
+		}
+	}
+
+	test := testcases[len(testcases)-1]
+	for skip := 0; skip <= len(test.values); skip++ {
+		d := NewDecoder(test.width)
+		d.InitReader(bytes.NewReader(test.data))
+
+		if _, err := d.Skip(skip); err != nil {
+			t.Fatalf("skip %d. unexpected error: %s", skip, err)
+		}
+		values := make([]int32, len(test.values)-skip)
+		if _, err := d.NextBatch(values); err != nil {
+			t.Fatalf("skip %d. unexpected error: %s", skip, err)
+		}
+		if !reflect.DeepEqual(values, test.values[skip:]) {
+			t.Errorf("skip %d. got %v, want %v", skip, values, test.values[skip:])
+		}
+		if _, err := d.Next(); err != io.EOF {
+			t.Errorf("skip %d. got %v, want io.EOF", skip, err)
+		}
+	}
+}
+
+func TestDecoderTruncatedRun(t *testing.T) {
+	// the header declares 2 groups of 8 values but only 3 bytes (12 values
+	// of 2 bits) follow
+	data := []byte{0x05, 0xE4, 0x19, 0x14}
+	want := []int32{0, 1, 2, 3, 1, 2, 1, 0, 0, 1, 1, 0}
+
+	for _, stream := range []bool{false, true} {
+		d := NewDecoder(2)
+		if stream {
+			d.InitReader(bytes.NewReader(data))
+		} else {
+			d.Init(data)
+		}
+
+		values := make([]int32, 16)
+		n, err := d.NextBatch(values)
+		if err != io.EOF {
+			t.Errorf("got %v, want io.EOF", err)
+		}
+		if !reflect.DeepEqual(values[:n], want) {
+			t.Errorf("stream %t. got %v, want %v", stream, values[:n], want)
+		}
+	}
+}
+
+func TestDecoderLongBitPackedRun(t *testing.T) {
+	values := make([]int32, 200)
+	for i := range values {
+		values[i] = int32(i*7) % 8
+	}
+	var b bytes.Buffer
+	if _, err := WriteInt32(&b, 3, values); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, size := range []int{1, 3, 7, 8, 9, 30} {
+		for _, stream := range []bool{false, true} {
+			d := NewDecoder(3)
+			if stream {
+				d.InitReader(bytes.NewReader(b.Bytes()))
+			} else {
+				d.Init(b.Bytes())
+			}
+			if _, err := d.Skip(5); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []int32
+			batch := make([]int32, size)
+			for len(got) < len(values)-5 {
+				n, err := d.NextBatch(batch)
+				got = append(got, batch[:n]...)
+				if err != nil {
+					break
+				}
+			}
+			if !reflect.DeepEqual(got, values[5:]) {
+				t.Errorf("batch %d, stream %t: got %v, want %v", size, stream, got, values[5:])
+			}
+		}
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	tests := []struct {
+		data  []byte
+		limit int
+		err   error
+	}{
+		// RLE run of 10 values, 8 expected
+		{[]byte{0x14, 0x01}, 8, ErrTooManyValues},
+		// bit-packed run of 2 groups, 8 expected
+		{[]byte{0x05, 0xFF, 0xFF}, 8, ErrTooManyValues},
+		// run header larger than 32 bits
+		{[]byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x01}, -1, ErrInvalidRunHeader},
+		// unterminated varint
+		{[]byte{0x80, 0x80}, -1, ErrInvalidRunHeader},
+		// missing repeated value
+		{[]byte{0x10}, -1, ErrTruncated},
+	}
+
+	for i, test := range tests {
+		d := NewDecoder(1)
+		d.Init(test.data)
+		if test.limit >= 0 {
+			d.Limit(test.limit)
+		}
+		_, err := d.NextBatch(make([]int32, 16))
+		if e, ok := err.(*DataError); !ok || e.Err != test.err {
+			t.Errorf("test %d: got %v, want %s", i, err, test.err)
+		}
+	}
+}
+
+func TestDecoderLimit(t *testing.T) {
+	// 8 bit-packed values, only 5 of them are part of the data
+	d := NewDecoder(1)
+	d.Init([]byte{0x03, 0xFF})
+	d.Limit(5)
+	values := make([]int32, 8)
+	n, err := d.NextBatch(values)
+	if n != 5 || err != io.EOF {
+		t.Errorf("got %d, %v, want 5, io.EOF", n, err)
+	}
+}
+
+func TestReadInt32InvalidBitWidth(t *testing.T) {
+	_, err := ReadInt32(bytes.NewReader(nil), 33, 1)
+	if e, ok := err.(*DataError); !ok || e.Err != ErrInvalidBitWidth {
+		t.Errorf("got %v, want %s", err, ErrInvalidBitWidth)
+	}
+}
@@ -0,0 +1,113 @@
+package encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/kostya-sh/parquet-go/parquet/encoding/rle"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// rleBooleanDecoder decodes BOOLEAN values stored with the RLE encoding:
+// the size of the encoded data as 4 bytes little endian followed by the
+// values RLE/bit packing hybrid encoded with a bit width of 1.
+type rleBooleanDecoder struct {
+	unsupportedDecoder
+	r      io.Reader
+	count  uint
+	values *rle.Decoder
+	buf    []int32
+}
+
+// NewRLEBooleanDecoder creates a new Decoder that uses the RLE=3 encoding.
+// Only BOOLEAN values can be decoded.
+func NewRLEBooleanDecoder(r io.Reader, numValues uint) Decoder {
+	return &rleBooleanDecoder{
+		unsupportedDecoder: unsupportedDecoder{"rleBooleanDecoder"},
+		r:                  r,
+		count:              numValues,
+	}
+}
+
+// DecodeBool
+func (d *rleBooleanDecoder) DecodeBool(out []bool) (uint, error) {
+	if d.values == nil {
+		var size [4]byte
+		if _, err := io.ReadFull(d.r, size[:]); err != nil {
+			return 0, fmt.Errorf("%s: could not read length: %s", d, err)
+		}
+		// the length is not trusted to allocate the buffer
+		length := binary.LittleEndian.Uint32(size[:])
+		data, err := ioutil.ReadAll(io.LimitReader(d.r, int64(length)))
+		if err != nil {
+			return 0, fmt.Errorf("%s: could not read values: %s", d, err)
+		}
+		if len(data) != int(length) {
+			return 0, fmt.Errorf("%s: short read: expected %d bytes but got %d", d, length, len(data))
+		}
+		d.values = rle.NewDecoder(1)
+		d.values.Init(data)
+		d.values.Limit(int(d.count))
+	}
+
+	count := min(d.count, uint(len(out)))
+	if uint(cap(d.buf)) < count {
+		d.buf = make([]int32, count)
+	}
+	n, err := d.values.NextBatch(d.buf[:count])
+	for i, v := range d.buf[:n] {
+		out[i] = v == 1
+	}
+	d.count -= uint(n)
+	if err == io.EOF {
+		return uint(n), fmt.Errorf("%s: expected %d values but got only %d", d, count, n)
+	}
+
+	return uint(n), err
+}
+
+// rleBooleanEncoder
+type rleBooleanEncoder struct {
+	unsupportedEncoder
+	numValues int
+}
+
+// NewRLEBooleanEncoder creates an encoder that uses the RLE encoding to
+// store BOOLEAN values, as allowed by the data pages v2.
+//
+// Every call to WriteBool writes a complete length prefixed run, all the
+// values of a page have to be written at once.
+func NewRLEBooleanEncoder() Encoder {
+	return &rleBooleanEncoder{
+		unsupportedEncoder: unsupportedEncoder{"rleBooleanEncoder"},
+	}
+}
+
+func (e *rleBooleanEncoder) NumValues() int {
+	return e.numValues
+}
+
+func (e *rleBooleanEncoder) Type() thrift.Encoding {
+	return thrift.Encoding_RLE
+}
+
+// WriteBool
+func (e *rleBooleanEncoder) WriteBool(w io.Writer, v []bool) (int, error) {
+	var b bytes.Buffer
+	b.Write([]byte{0, 0, 0, 0})
+	if _, err := rle.WriteBool(&b, v); err != nil {
+		return 0, fmt.Errorf("could not encode booleans: %s", err)
+	}
+	p := b.Bytes()
+	binary.LittleEndian.PutUint32(p, uint32(len(p)-4))
+
+	n, err := w.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("could not write booleans: %s", err)
+	}
+	e.numValues += len(v)
+	return n, nil
+}
@@ -0,0 +1,51 @@
+package encoding
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestRLEBoolean(t *testing.T) {
+	values := make([]bool, 100)
+	for i := range values {
+		values[i] = i < 50 || i%3 == 0
+	}
+
+	var b bytes.Buffer
+	if _, err := NewRLEBooleanEncoder().WriteBool(&b, values); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// trailing data after the booleans must not be consumed
+	b.WriteString("next")
+
+	r := bytes.NewReader(b.Bytes())
+	d := NewRLEBooleanDecoder(r, uint(len(values)))
+	var got []bool
+	out := make([]bool, 30)
+	for {
+		n, err := d.DecodeBool(out)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if n == 0 {
+			break
+		}
+		got = append(got, out[:n]...)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("got %v, want %v", got, values)
+	}
+	if r.Len() != len("next") {
+		t.Errorf("expected %d bytes left, got %d", len("next"), r.Len())
+	}
+}
+
+func TestRLEBooleanTruncated(t *testing.T) {
+	// 8 values in a single RLE run, 10 are expected
+	data := []byte{0x02, 0x00, 0x00, 0x00, 0x10, 0x01}
+	d := NewRLEBooleanDecoder(bytes.NewReader(data), 10)
+	if _, err := d.DecodeBool(make([]bool, 10)); err == nil {
+		t.Errorf("expected an error")
+	}
+}
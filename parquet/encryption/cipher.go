@@ -0,0 +1,186 @@
+// Package encryption implements the modular encryption of parquet files.
+//
+// The footer, the metadata of the column chunks, the page headers, the pages,
+// the page indexes and the Bloom filters of an encrypted file are separate
+// modules, each encrypted with AES and bound to its place in the file by its
+// AAD (additional authenticated data). A module is stored as its length on 4
+// bytes, a nonce of 12 bytes and the ciphertext, followed by a tag of 16
+// bytes with AES GCM. The format is described here:
+// https://github.com/apache/parquet-format/blob/master/Encryption.md
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	// NonceSize is the size in bytes of the nonce of a module.
+	NonceSize = 12
+	// TagSize is the size in bytes of the tag of a module encrypted with
+	// AES GCM.
+	TagSize = 16
+
+	lengthSize = 4
+)
+
+// ModuleType is the type of a module, part of its AAD.
+type ModuleType int8
+
+const (
+	Footer ModuleType = iota
+	ColumnMetaData
+	DataPage
+	DictionaryPage
+	DataPageHeader
+	DictionaryPageHeader
+	ColumnIndex
+	OffsetIndex
+	BloomFilterHeader
+	BloomFilterBitset
+)
+
+// ModuleAAD returns the AAD of a module of type t: fileAAD followed by the
+// type and, for all the modules but the footer, the ordinals of the row group
+// and of the column chunk in the row group. The ordinal of the page in the
+// column chunk, from 0, follows for the data pages and their headers.
+func ModuleAAD(fileAAD []byte, t ModuleType, rowGroup, column, page int) ([]byte, error) {
+	aad := append(append(make([]byte, 0, len(fileAAD)+7), fileAAD...), byte(t))
+	if t == Footer {
+		return aad, nil
+	}
+	ordinals := []int{rowGroup, column}
+	if t == DataPage || t == DataPageHeader {
+		ordinals = append(ordinals, page)
+	}
+	for _, o := range ordinals {
+		if o < 0 || o > math.MaxInt16 {
+			return nil, fmt.Errorf("encryption: invalid ordinal %d in the AAD of a module", o)
+		}
+		aad = append(aad, byte(o), byte(o>>8))
+	}
+	return aad, nil
+}
+
+// Cipher encrypts and decrypts modules with a key of 16, 24 or 32 bytes, with
+// AES GCM or, for the pages of the AES_GCM_CTR_V1 algorithm, AES CTR. The
+// modules encrypted with AES CTR are not authenticated, their AAD is
+// ignored.
+type Cipher struct {
+	block cipher.Block
+	gcm   cipher.AEAD // nil for AES CTR
+}
+
+// NewGCM returns a Cipher using AES GCM with key.
+func NewGCM(key []byte) (*Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: %s", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: %s", err)
+	}
+	return &Cipher{block: block, gcm: gcm}, nil
+}
+
+// NewCTR returns a Cipher using AES CTR with key.
+func NewCTR(key []byte) (*Cipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: %s", err)
+	}
+	return &Cipher{block: block}, nil
+}
+
+// Encrypt returns plaintext encrypted as a module with a random nonce,
+// preceded by its length.
+func (c *Cipher) Encrypt(plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, NonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("encryption: could not generate nonce: %s", err)
+	}
+	return c.encrypt(plaintext, nonce, aad), nil
+}
+
+func (c *Cipher) encrypt(plaintext, nonce, aad []byte) []byte {
+	size := NonceSize + len(plaintext)
+	if c.gcm != nil {
+		size += TagSize
+	}
+	module := make([]byte, lengthSize+size)
+	binary.LittleEndian.PutUint32(module, uint32(size))
+	copy(module[lengthSize:], nonce)
+	if c.gcm != nil {
+		c.gcm.Seal(module[:lengthSize+NonceSize], nonce, plaintext, aad)
+	} else {
+		cipher.NewCTR(c.block, ctrIV(nonce)).XORKeyStream(module[lengthSize+NonceSize:], plaintext)
+	}
+	return module
+}
+
+// Decrypt returns the plaintext of module, preceded by its length.
+func (c *Cipher) Decrypt(module, aad []byte) ([]byte, error) {
+	if len(module) < lengthSize {
+		return nil, fmt.Errorf("encryption: module of %d bytes is too small", len(module))
+	}
+	size := binary.LittleEndian.Uint32(module)
+	if int64(size) != int64(len(module)-lengthSize) {
+		return nil, fmt.Errorf("encryption: module of %d bytes with a length of %d", len(module), size)
+	}
+	minSize := NonceSize
+	if c.gcm != nil {
+		minSize += TagSize
+	}
+	if int(size) < minSize {
+		return nil, fmt.Errorf("encryption: module of %d bytes is too small", size)
+	}
+
+	nonce, ciphertext := module[lengthSize:lengthSize+NonceSize], module[lengthSize+NonceSize:]
+	if c.gcm == nil {
+		plaintext := make([]byte, len(ciphertext))
+		cipher.NewCTR(c.block, ctrIV(nonce)).XORKeyStream(plaintext, ciphertext)
+		return plaintext, nil
+	}
+	plaintext, err := c.gcm.Open(nil, nonce, ciphertext, aad)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: could not decrypt module: %s", err)
+	}
+	return plaintext, nil
+}
+
+// ctrIV returns the initialization vector of AES CTR for nonce: the nonce
+// followed by a counter starting at 1.
+func ctrIV(nonce []byte) []byte {
+	iv := make([]byte, aes.BlockSize)
+	copy(iv, nonce)
+	iv[aes.BlockSize-1] = 1
+	return iv
+}
+
+// ReadModule reads a module from r, preceded by its length. It returns
+// io.EOF if r is at its end.
+func ReadModule(r io.Reader) ([]byte, error) {
+	var length [lengthSize]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("encryption: could not read module length: %s", err)
+	}
+	size := binary.LittleEndian.Uint32(length[:])
+	if size > math.MaxInt32 {
+		return nil, fmt.Errorf("encryption: invalid module length %d", size)
+	}
+	module := make([]byte, lengthSize+int(size))
+	copy(module, length[:])
+	if _, err := io.ReadFull(r, module[lengthSize:]); err != nil {
+		return nil, fmt.Errorf("encryption: could not read module of %d bytes: %s", size, err)
+	}
+	return module, nil
+}
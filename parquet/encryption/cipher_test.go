@@ -0,0 +1,124 @@
+package encryption
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestModuleAAD(t *testing.T) {
+	fileAAD := []byte("prefixunique")
+	for _, tt := range []struct {
+		t                      ModuleType
+		rowGroup, column, page int
+		want                   []byte
+	}{
+		{Footer, 1, 2, 3, []byte("prefixunique\x00")},
+		{ColumnMetaData, 1, 2, 3, []byte("prefixunique\x01\x01\x00\x02\x00")},
+		{DataPage, 1, 2, 3, []byte("prefixunique\x02\x01\x00\x02\x00\x03\x00")},
+		{DictionaryPage, 1, 2, 3, []byte("prefixunique\x03\x01\x00\x02\x00")},
+		{DataPageHeader, 1, 258, 3, []byte("prefixunique\x04\x01\x00\x02\x01\x03\x00")},
+		{BloomFilterBitset, 0, 0, 0, []byte("prefixunique\x09\x00\x00\x00\x00")},
+	} {
+		got, err := ModuleAAD(fileAAD, tt.t, tt.rowGroup, tt.column, tt.page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("module %d: got %q, want %q", tt.t, got, tt.want)
+		}
+	}
+
+	if _, err := ModuleAAD(fileAAD, DataPage, 0, 0, 1<<15); err == nil {
+		t.Errorf("no error for a page ordinal out of range")
+	}
+}
+
+func TestCipher(t *testing.T) {
+	key := []byte("0123456789012345")
+	gcm, err := NewGCM(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctr, err := NewCTR(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := []byte("the values of a page")
+	aad := []byte("aad")
+
+	for name, c := range map[string]*Cipher{"gcm": gcm, "ctr": ctr} {
+		module, err := c.Encrypt(plaintext, aad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := lengthSize + NonceSize + len(plaintext)
+		if c == gcm {
+			size += TagSize
+		}
+		if len(module) != size {
+			t.Errorf("%s: got a module of %d bytes, want %d", name, len(module), size)
+		}
+		got, err := c.Decrypt(module, aad)
+		if err != nil {
+			t.Fatalf("%s: %s", name, err)
+		}
+		if !bytes.Equal(got, plaintext) {
+			t.Errorf("%s: got %q, want %q", name, got, plaintext)
+		}
+		if _, err := c.Decrypt(module[:len(module)-1], aad); err == nil {
+			t.Errorf("%s: no error for a truncated module", name)
+		}
+	}
+
+	module, err := gcm.Encrypt(plaintext, aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := gcm.Decrypt(module, []byte("other")); err == nil {
+		t.Errorf("no error for another AAD")
+	}
+	module[len(module)-TagSize-1] ^= 1
+	if _, err := gcm.Decrypt(module, aad); err == nil {
+		t.Errorf("no error for a modified module")
+	}
+
+	if _, err := NewGCM([]byte("short")); err == nil {
+		t.Errorf("no error for a key of 5 bytes")
+	}
+}
+
+func TestReadModule(t *testing.T) {
+	c, err := NewGCM([]byte("0123456789012345"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var modules [][]byte
+	var b bytes.Buffer
+	for _, s := range []string{"header", "page"} {
+		module, err := c.Encrypt([]byte(s), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		modules = append(modules, module)
+		b.Write(module)
+	}
+
+	r := bytes.NewReader(b.Bytes())
+	for i := range modules {
+		module, err := ReadModule(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(module, modules[i]) {
+			t.Errorf("module %d: got %v, want %v", i, module, modules[i])
+		}
+	}
+	if _, err := ReadModule(r); err != io.EOF {
+		t.Errorf("got %v at the end, want io.EOF", err)
+	}
+	if _, err := ReadModule(bytes.NewReader(modules[0][:10])); err == nil || err == io.EOF {
+		t.Errorf("got %v for a truncated module", err)
+	}
+}
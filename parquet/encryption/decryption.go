@@ -0,0 +1,239 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// DecryptionProperties are the keys of an encrypted file.
+type DecryptionProperties struct {
+	// FooterKey decrypts the footer and the columns encrypted with it.
+	FooterKey []byte
+	// ColumnKeys are the keys of the columns encrypted with their own key,
+	// by the path of the column, its elements separated with ".". The
+	// columns without key cannot be read.
+	ColumnKeys map[string][]byte
+	// AADPrefix is the AAD prefix of the file, required when the writer did
+	// not store it in the file. If it is stored it must be the same.
+	AADPrefix []byte
+	// AADPrefixVerifier, if set, verifies the AAD prefix of the file, e.g.
+	// that it is the path the file was read from. The files encrypted
+	// without AAD prefix are rejected.
+	AADPrefixVerifier AADPrefixVerifier
+	// SkipFooterVerification disables the verification of the signature of
+	// a plaintext footer.
+	SkipFooterVerification bool
+	// KeyRetriever, if set, retrieves the keys missing from FooterKey and
+	// ColumnKeys from their key metadata in the file, e.g. a KeyUnwrapper
+	// of the keys wrapped by a KMS.
+	KeyRetriever KeyRetriever
+}
+
+// AADPrefixVerifier verifies the AAD prefix of an encrypted file, as the
+// AADPrefixVerifier of parquet-mr.
+type AADPrefixVerifier interface {
+	VerifyAADPrefix(prefix []byte) error
+}
+
+// FileDecryptor decrypts the modules of an encrypted file.
+type FileDecryptor struct {
+	properties *DecryptionProperties
+	footerKey  []byte // nil if not known
+	fileAAD    []byte
+	ctr        bool // whether the pages are encrypted with AES CTR
+}
+
+// NewFileDecryptor returns a decryptor of the modules of a file encrypted
+// with algorithm, whose footer key has the given key metadata, nil if the
+// file has none.
+func NewFileDecryptor(properties *DecryptionProperties, algorithm *thrift.EncryptionAlgorithm, footerKeyMetadata []byte) (*FileDecryptor, error) {
+	var aadPrefix, aadFileUnique []byte
+	var supplyAADPrefix, ctr bool
+	switch {
+	case algorithm.IsSetAES_GCM_V1():
+		a := algorithm.GetAES_GCM_V1()
+		aadPrefix, aadFileUnique, supplyAADPrefix = a.GetAadPrefix(), a.GetAadFileUnique(), a.GetSupplyAadPrefix()
+	case algorithm.IsSetAES_GCM_CTR_V1():
+		a := algorithm.GetAES_GCM_CTR_V1()
+		aadPrefix, aadFileUnique, supplyAADPrefix = a.GetAadPrefix(), a.GetAadFileUnique(), a.GetSupplyAadPrefix()
+		ctr = true
+	default:
+		return nil, fmt.Errorf("encryption: unsupported algorithm %s", algorithm)
+	}
+
+	switch {
+	case supplyAADPrefix && properties.AADPrefix == nil:
+		return nil, fmt.Errorf("encryption: the AAD prefix of the file is not stored, it must be supplied")
+	case aadPrefix != nil && properties.AADPrefix != nil && !bytes.Equal(aadPrefix, properties.AADPrefix):
+		return nil, fmt.Errorf("encryption: the AAD prefix does not match the prefix stored in the file")
+	case aadPrefix == nil && !supplyAADPrefix && properties.AADPrefix != nil:
+		return nil, fmt.Errorf("encryption: an AAD prefix is supplied but the file was encrypted without AAD prefix")
+	case aadPrefix == nil:
+		aadPrefix = properties.AADPrefix
+	}
+	if properties.AADPrefixVerifier != nil {
+		if aadPrefix == nil {
+			return nil, fmt.Errorf("encryption: the file was encrypted without AAD prefix to verify")
+		}
+		if err := properties.AADPrefixVerifier.VerifyAADPrefix(aadPrefix); err != nil {
+			return nil, fmt.Errorf("encryption: invalid AAD prefix: %s", err)
+		}
+	}
+
+	footerKey := properties.FooterKey
+	if footerKey == nil && properties.KeyRetriever != nil && footerKeyMetadata != nil {
+		var err error
+		if footerKey, err = properties.KeyRetriever.RetrieveKey(footerKeyMetadata); err != nil {
+			return nil, fmt.Errorf("encryption: could not retrieve footer key: %s", err)
+		}
+	}
+
+	fileAAD := append(append(make([]byte, 0, len(aadPrefix)+len(aadFileUnique)), aadPrefix...), aadFileUnique...)
+	return &FileDecryptor{properties: properties, footerKey: footerKey, fileAAD: fileAAD, ctr: ctr}, nil
+}
+
+func (d *FileDecryptor) footerCipher() (*Cipher, error) {
+	if d.footerKey == nil {
+		return nil, fmt.Errorf("encryption: no footer key")
+	}
+	return NewGCM(d.footerKey)
+}
+
+// DecryptFooter returns the plaintext of the encrypted footer module.
+func (d *FileDecryptor) DecryptFooter(module []byte) ([]byte, error) {
+	c, err := d.footerCipher()
+	if err != nil {
+		return nil, err
+	}
+	aad, _ := ModuleAAD(d.fileAAD, Footer, 0, 0, 0)
+	footer, err := c.Decrypt(module, aad)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: could not decrypt footer: %s", err)
+	}
+	return footer, nil
+}
+
+// VerifyFooter returns an error if the signature of the plaintext footer,
+// a nonce followed by a tag, does not match the footer. The footer is
+// signed with the tag of its encryption with the footer key.
+func (d *FileDecryptor) VerifyFooter(footer, signature []byte) error {
+	if len(signature) != NonceSize+TagSize {
+		return fmt.Errorf("encryption: footer signature of %d bytes", len(signature))
+	}
+	c, err := d.footerCipher()
+	if err != nil {
+		return err
+	}
+	aad, _ := ModuleAAD(d.fileAAD, Footer, 0, 0, 0)
+	module := c.encrypt(footer, signature[:NonceSize], aad)
+	if subtle.ConstantTimeCompare(module[len(module)-TagSize:], signature[NonceSize:]) != 1 {
+		return fmt.Errorf("encryption: the signature of the footer does not match")
+	}
+	return nil
+}
+
+// columnKey returns the key of the column chunk with the given crypto
+// metadata, nil if it is not known.
+func (d *FileDecryptor) columnKey(crypto *thrift.ColumnCryptoMetaData) ([]byte, error) {
+	if !crypto.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		return d.footerKey, nil
+	}
+	c := crypto.GetENCRYPTION_WITH_COLUMN_KEY()
+	path := strings.Join(c.GetPathInSchema(), ".")
+	if key, ok := d.properties.ColumnKeys[path]; ok || d.properties.KeyRetriever == nil || c.KeyMetadata == nil {
+		return key, nil
+	}
+	key, err := d.properties.KeyRetriever.RetrieveKey(c.KeyMetadata)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: could not retrieve key of column %s: %s", path, err)
+	}
+	return key, nil
+}
+
+// CanDecrypt returns whether the key of the column chunk with the given
+// crypto metadata is known.
+func (d *FileDecryptor) CanDecrypt(crypto *thrift.ColumnCryptoMetaData) bool {
+	key, err := d.columnKey(crypto)
+	return key != nil && err == nil
+}
+
+// ColumnDecryptor returns the decryptor of the modules of the column chunk
+// with the given crypto metadata, the chunk at index column in the row group
+// at index rowGroup in the file.
+func (d *FileDecryptor) ColumnDecryptor(crypto *thrift.ColumnCryptoMetaData, rowGroup, column int) (*ColumnDecryptor, error) {
+	key, err := d.columnKey(crypto)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		if crypto.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+			path := crypto.GetENCRYPTION_WITH_COLUMN_KEY().GetPathInSchema()
+			return nil, fmt.Errorf("encryption: no key for column %s", strings.Join(path, "."))
+		}
+		return nil, fmt.Errorf("encryption: no footer key")
+	}
+	metadata, err := NewGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	data := metadata
+	if d.ctr {
+		if data, err = NewCTR(key); err != nil {
+			return nil, err
+		}
+	}
+	return &ColumnDecryptor{metadata: metadata, data: data, fileAAD: d.fileAAD, rowGroup: rowGroup, column: column}, nil
+}
+
+// ColumnDecryptor decrypts the modules of a column chunk.
+type ColumnDecryptor struct {
+	metadata *Cipher // the metadata, the page headers, the indexes and the filters
+	data     *Cipher // the pages
+	fileAAD  []byte
+	rowGroup int
+	column   int
+}
+
+// Decrypt returns the plaintext of module, of type t. page is the ordinal
+// of the page of the data pages and their headers in the column chunk,
+// ignored for the other modules.
+func (d *ColumnDecryptor) Decrypt(module []byte, t ModuleType, page int) ([]byte, error) {
+	aad, err := ModuleAAD(d.fileAAD, t, d.rowGroup, d.column, page)
+	if err != nil {
+		return nil, err
+	}
+	c := d.metadata
+	if t == DataPage || t == DictionaryPage {
+		c = d.data
+	}
+	return c.Decrypt(module, aad)
+}
+
+// ReadModule reads a module of type t from r and returns its plaintext. It
+// returns io.EOF if r is at its end.
+func (d *ColumnDecryptor) ReadModule(r io.Reader, t ModuleType, page int) ([]byte, error) {
+	module, err := ReadModule(r)
+	if err != nil {
+		return nil, err
+	}
+	return d.Decrypt(module, t, page)
+}
+
+// DecryptColumnMetaData returns the metadata of the column chunk from its
+// encrypted_column_metadata.
+func (d *ColumnDecryptor) DecryptColumnMetaData(module []byte) (*thrift.ColumnMetaData, error) {
+	plaintext, err := d.Decrypt(module, ColumnMetaData, 0)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: could not decrypt column metadata: %s", err)
+	}
+	var meta thrift.ColumnMetaData
+	if err := meta.Read(bytes.NewReader(plaintext)); err != nil {
+		return nil, fmt.Errorf("encryption: could not read column metadata: %s", err)
+	}
+	return &meta, nil
+}
@@ -0,0 +1,169 @@
+package encryption
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// testAADPrefixVerifier accepts a single AAD prefix.
+type testAADPrefixVerifier string
+
+func (v testAADPrefixVerifier) VerifyAADPrefix(prefix []byte) error {
+	if string(prefix) != string(v) {
+		return fmt.Errorf("got AAD prefix %q, want %q", prefix, string(v))
+	}
+	return nil
+}
+
+func TestFileDecryptorAADPrefix(t *testing.T) {
+	key := []byte("0123456789012345")
+	footer := []byte("footer")
+	c, err := NewGCM(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aad, err := ModuleAAD([]byte("prefixunique"), Footer, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err := c.Encrypt(footer, aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	supply := true
+	stored := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadPrefix: []byte("prefix"), AadFileUnique: []byte("unique")}}
+	supplied := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadFileUnique: []byte("unique"), SupplyAadPrefix: &supply}}
+	none := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadFileUnique: []byte("unique")}}
+	verifier := testAADPrefixVerifier("prefix")
+	for _, tt := range []struct {
+		name      string
+		algorithm *thrift.EncryptionAlgorithm
+		prefix    []byte
+		verifier  AADPrefixVerifier
+		ok        bool
+	}{
+		{"stored", stored, nil, nil, true},
+		{"stored and supplied", stored, []byte("prefix"), nil, true},
+		{"stored and another supplied", stored, []byte("other"), nil, false},
+		{"supplied", supplied, []byte("prefix"), nil, true},
+		{"not supplied", supplied, nil, nil, false},
+		{"supplied without prefix in the file", none, []byte("prefix"), nil, false},
+		{"stored and verified", stored, nil, verifier, true},
+		{"supplied and verified", supplied, []byte("prefix"), verifier, true},
+		{"stored and rejected", stored, nil, testAADPrefixVerifier("other"), false},
+		{"verified without prefix in the file", none, nil, verifier, false},
+	} {
+		properties := &DecryptionProperties{FooterKey: key, AADPrefix: tt.prefix, AADPrefixVerifier: tt.verifier}
+		d, err := NewFileDecryptor(properties, tt.algorithm, nil)
+		if err != nil {
+			if tt.ok {
+				t.Errorf("%s: unexpected error: %s", tt.name, err)
+			}
+			continue
+		}
+		if !tt.ok {
+			t.Errorf("%s: no error", tt.name)
+			continue
+		}
+		got, err := d.DecryptFooter(module)
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+		} else if !bytes.Equal(got, footer) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, footer)
+		}
+	}
+}
+
+func TestVerifyFooter(t *testing.T) {
+	key := []byte("0123456789012345")
+	algorithm := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadFileUnique: []byte("unique")}}
+	d, err := NewFileDecryptor(&DecryptionProperties{FooterKey: key}, algorithm, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	footer := []byte("plaintext footer")
+	c, err := NewGCM(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aad, _ := ModuleAAD([]byte("unique"), Footer, 0, 0, 0)
+	module, err := c.Encrypt(footer, aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := append(append([]byte(nil), module[lengthSize:lengthSize+NonceSize]...), module[len(module)-TagSize:]...)
+	if err := d.VerifyFooter(footer, signature); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	footer[0] ^= 1
+	if err := d.VerifyFooter(footer, signature); err == nil {
+		t.Errorf("no error for a modified footer")
+	}
+	if err := d.VerifyFooter(footer, signature[1:]); err == nil {
+		t.Errorf("no error for a truncated signature")
+	}
+}
+
+func TestColumnDecryptor(t *testing.T) {
+	footerKey, columnKey := []byte("0123456789012345"), []byte("1234567890123450")
+	algorithm := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadFileUnique: []byte("unique")}}
+	d, err := NewFileDecryptor(&DecryptionProperties{
+		FooterKey:  footerKey,
+		ColumnKeys: map[string][]byte{"a.b": columnKey},
+	}, algorithm, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withColumnKey := &thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_COLUMN_KEY: &thrift.EncryptionWithColumnKey{PathInSchema: []string{"a", "b"}}}
+	withFooterKey := &thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_FOOTER_KEY: thrift.NewEncryptionWithFooterKey()}
+	withoutKey := &thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_COLUMN_KEY: &thrift.EncryptionWithColumnKey{PathInSchema: []string{"c"}}}
+	if !d.CanDecrypt(withColumnKey) || !d.CanDecrypt(withFooterKey) || d.CanDecrypt(withoutKey) {
+		t.Errorf("got %t, %t, %t", d.CanDecrypt(withColumnKey), d.CanDecrypt(withFooterKey), d.CanDecrypt(withoutKey))
+	}
+	if _, err := d.ColumnDecryptor(withoutKey, 0, 0); err == nil {
+		t.Errorf("no error for a column without key")
+	}
+
+	meta := &thrift.ColumnMetaData{Type: thrift.Type_INT32, PathInSchema: []string{"a", "b"}, Encodings: []thrift.Encoding{}}
+	var plain bytes.Buffer
+	if _, err := meta.Write(&plain); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewGCM(columnKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aad, _ := ModuleAAD([]byte("unique"), ColumnMetaData, 1, 2, 0)
+	module, err := c.Encrypt(plain.Bytes(), aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cd, err := d.ColumnDecryptor(withColumnKey, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := cd.DecryptColumnMetaData(module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Type != meta.Type || len(got.PathInSchema) != 2 {
+		t.Errorf("got %v, want %v", got, meta)
+	}
+
+	// the metadata is bound to the chunk
+	cd, err = d.ColumnDecryptor(withColumnKey, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cd.DecryptColumnMetaData(module); err == nil {
+		t.Errorf("no error for the metadata of another chunk")
+	}
+}
@@ -0,0 +1,391 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// Algorithm is the algorithm used to encrypt a file.
+type Algorithm int
+
+const (
+	// AesGcmV1 encrypts all the modules with AES GCM.
+	AesGcmV1 Algorithm = iota
+	// AesGcmCtrV1 encrypts the pages with AES CTR, faster but not
+	// authenticated, and the other modules with AES GCM.
+	AesGcmCtrV1
+)
+
+// aadFileUniqueSize is the size in bytes of the random part of the AAD of
+// the modules that is unique to a file, as in parquet-mr.
+const aadFileUniqueSize = 8
+
+// validKey returns an error if key is not an AES key.
+func validKey(key []byte) error {
+	switch len(key) {
+	case 16, 24, 32:
+		return nil
+	}
+	return fmt.Errorf("encryption: invalid key of %d bytes, want 16, 24 or 32", len(key))
+}
+
+// ColumnEncryptionProperties are how a column is encrypted, built with a
+// ColumnEncryptionPropertiesBuilder.
+type ColumnEncryptionProperties struct {
+	path        string
+	key         []byte // nil to encrypt with the footer key
+	keyMetadata []byte
+}
+
+// Path returns the path of the column, its elements separated with ".".
+func (p *ColumnEncryptionProperties) Path() string {
+	return p.path
+}
+
+// ColumnEncryptionPropertiesBuilder builds the ColumnEncryptionProperties of
+// a column. Without key the column is encrypted with the footer key.
+type ColumnEncryptionPropertiesBuilder struct {
+	properties ColumnEncryptionProperties
+}
+
+// NewColumnEncryptionPropertiesBuilder returns a builder of the properties of
+// the column path, its elements separated with ".".
+func NewColumnEncryptionPropertiesBuilder(path string) *ColumnEncryptionPropertiesBuilder {
+	return &ColumnEncryptionPropertiesBuilder{properties: ColumnEncryptionProperties{path: path}}
+}
+
+// WithKey sets the key of the column, of 16, 24 or 32 bytes.
+func (b *ColumnEncryptionPropertiesBuilder) WithKey(key []byte) *ColumnEncryptionPropertiesBuilder {
+	b.properties.key = key
+	return b
+}
+
+// WithKeyMetadata sets the metadata stored in the file for the readers to
+// retrieve the key of the column.
+func (b *ColumnEncryptionPropertiesBuilder) WithKeyMetadata(metadata []byte) *ColumnEncryptionPropertiesBuilder {
+	b.properties.keyMetadata = metadata
+	return b
+}
+
+// WithKeyID is like WithKeyMetadata with the id of the key as metadata.
+func (b *ColumnEncryptionPropertiesBuilder) WithKeyID(id string) *ColumnEncryptionPropertiesBuilder {
+	return b.WithKeyMetadata([]byte(id))
+}
+
+// Build returns the properties of the column.
+func (b *ColumnEncryptionPropertiesBuilder) Build() (*ColumnEncryptionProperties, error) {
+	p := b.properties
+	if p.path == "" {
+		return nil, fmt.Errorf("encryption: no column path")
+	}
+	if p.key != nil {
+		if err := validKey(p.key); err != nil {
+			return nil, fmt.Errorf("column %s: %s", p.path, err)
+		}
+	} else if p.keyMetadata != nil {
+		return nil, fmt.Errorf("encryption: column %s: key metadata without key", p.path)
+	}
+	return &p, nil
+}
+
+// FileEncryptionProperties are how a file is encrypted, built with a
+// FileEncryptionPropertiesBuilder.
+type FileEncryptionProperties struct {
+	algorithm         Algorithm
+	footerKey         []byte
+	footerKeyMetadata []byte
+	aadPrefix         []byte
+	storeAADPrefix    bool
+	plaintextFooter   bool
+	columns           map[string]*ColumnEncryptionProperties // all the columns if empty
+}
+
+// EncryptedColumns returns the paths of the encrypted columns, nil if all
+// the columns are encrypted with the footer key.
+func (p *FileEncryptionProperties) EncryptedColumns() []string {
+	if len(p.columns) == 0 {
+		return nil
+	}
+	paths := make([]string, 0, len(p.columns))
+	for path := range p.columns {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// FileEncryptionPropertiesBuilder builds the FileEncryptionProperties of a
+// file. By default the footer is encrypted and all the columns are
+// encrypted with the footer key with AesGcmV1.
+type FileEncryptionPropertiesBuilder struct {
+	properties FileEncryptionProperties
+	err        error
+}
+
+// NewFileEncryptionPropertiesBuilder returns a builder of the properties of
+// a file encrypted with footerKey, of 16, 24 or 32 bytes.
+func NewFileEncryptionPropertiesBuilder(footerKey []byte) *FileEncryptionPropertiesBuilder {
+	return &FileEncryptionPropertiesBuilder{properties: FileEncryptionProperties{footerKey: footerKey, storeAADPrefix: true}}
+}
+
+// WithAlgorithm sets the algorithm of the file, AesGcmV1 by default.
+func (b *FileEncryptionPropertiesBuilder) WithAlgorithm(algorithm Algorithm) *FileEncryptionPropertiesBuilder {
+	b.properties.algorithm = algorithm
+	return b
+}
+
+// WithFooterKeyMetadata sets the metadata stored in the file for the
+// readers to retrieve the footer key.
+func (b *FileEncryptionPropertiesBuilder) WithFooterKeyMetadata(metadata []byte) *FileEncryptionPropertiesBuilder {
+	b.properties.footerKeyMetadata = metadata
+	return b
+}
+
+// WithFooterKeyID is like WithFooterKeyMetadata with the id of the key as
+// metadata.
+func (b *FileEncryptionPropertiesBuilder) WithFooterKeyID(id string) *FileEncryptionPropertiesBuilder {
+	return b.WithFooterKeyMetadata([]byte(id))
+}
+
+// WithAADPrefix sets the AAD prefix of the file, e.g. its path or the id of
+// its dataset, binding the modules of the file to it: the readers can
+// check that the file was not replaced by another one or moved. See
+// DecryptionProperties.AADPrefixVerifier.
+func (b *FileEncryptionPropertiesBuilder) WithAADPrefix(prefix []byte) *FileEncryptionPropertiesBuilder {
+	b.properties.aadPrefix = prefix
+	return b
+}
+
+// WithoutAADPrefixStorage does not store the AAD prefix in the file: the
+// readers must supply it.
+func (b *FileEncryptionPropertiesBuilder) WithoutAADPrefixStorage() *FileEncryptionPropertiesBuilder {
+	b.properties.storeAADPrefix = false
+	return b
+}
+
+// WithPlaintextFooter leaves the footer in plaintext, signed with the footer
+// key, for the readers without keys to read the plaintext columns.
+func (b *FileEncryptionPropertiesBuilder) WithPlaintextFooter() *FileEncryptionPropertiesBuilder {
+	b.properties.plaintextFooter = true
+	return b
+}
+
+// WithEncryptedColumns sets the encrypted columns, the other columns are
+// not encrypted.
+func (b *FileEncryptionPropertiesBuilder) WithEncryptedColumns(columns ...*ColumnEncryptionProperties) *FileEncryptionPropertiesBuilder {
+	b.properties.columns = make(map[string]*ColumnEncryptionProperties, len(columns))
+	for _, c := range columns {
+		if _, ok := b.properties.columns[c.path]; ok && b.err == nil {
+			b.err = fmt.Errorf("encryption: column %s is encrypted twice", c.path)
+		}
+		b.properties.columns[c.path] = c
+	}
+	return b
+}
+
+// Build returns the properties of the file.
+func (b *FileEncryptionPropertiesBuilder) Build() (*FileEncryptionProperties, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	p := b.properties
+	if err := validKey(p.footerKey); err != nil {
+		return nil, fmt.Errorf("footer key: %s", err)
+	}
+	switch {
+	case p.algorithm != AesGcmV1 && p.algorithm != AesGcmCtrV1:
+		return nil, fmt.Errorf("encryption: unsupported algorithm %d", p.algorithm)
+	case p.aadPrefix != nil && len(p.aadPrefix) == 0:
+		return nil, fmt.Errorf("encryption: empty AAD prefix")
+	case !p.storeAADPrefix && p.aadPrefix == nil:
+		return nil, fmt.Errorf("encryption: no AAD prefix to supply")
+	}
+	return &p, nil
+}
+
+// FileEncryptor encrypts the modules of a file.
+type FileEncryptor struct {
+	properties *FileEncryptionProperties
+	algorithm  *thrift.EncryptionAlgorithm
+	fileAAD    []byte
+}
+
+// NewFileEncryptor returns an encryptor of the modules of a new file, with a
+// random AAD.
+func NewFileEncryptor(properties *FileEncryptionProperties) (*FileEncryptor, error) {
+	aadFileUnique := make([]byte, aadFileUniqueSize)
+	if _, err := rand.Read(aadFileUnique); err != nil {
+		return nil, fmt.Errorf("encryption: could not generate AAD: %s", err)
+	}
+
+	var aadPrefix []byte
+	var supplyAADPrefix *bool
+	if properties.storeAADPrefix {
+		aadPrefix = properties.aadPrefix
+	} else {
+		supply := true
+		supplyAADPrefix = &supply
+	}
+	algorithm := thrift.NewEncryptionAlgorithm()
+	switch properties.algorithm {
+	case AesGcmV1:
+		algorithm.AES_GCM_V1 = &thrift.AesGcmV1{AadPrefix: aadPrefix, AadFileUnique: aadFileUnique, SupplyAadPrefix: supplyAADPrefix}
+	case AesGcmCtrV1:
+		algorithm.AES_GCM_CTR_V1 = &thrift.AesGcmCtrV1{AadPrefix: aadPrefix, AadFileUnique: aadFileUnique, SupplyAadPrefix: supplyAADPrefix}
+	default:
+		return nil, fmt.Errorf("encryption: unsupported algorithm %d", properties.algorithm)
+	}
+
+	fileAAD := append(append(make([]byte, 0, len(properties.aadPrefix)+len(aadFileUnique)), properties.aadPrefix...), aadFileUnique...)
+	return &FileEncryptor{properties: properties, algorithm: algorithm, fileAAD: fileAAD}, nil
+}
+
+// FooterEncrypted returns whether the footer is encrypted, signed if not.
+func (e *FileEncryptor) FooterEncrypted() bool {
+	return !e.properties.plaintextFooter
+}
+
+// Algorithm returns the algorithm of the file, in the crypto metadata of an
+// encrypted footer or in a plaintext footer.
+func (e *FileEncryptor) Algorithm() *thrift.EncryptionAlgorithm {
+	return e.algorithm
+}
+
+// FooterKeyMetadata returns the metadata of the footer key, nil if none was
+// set.
+func (e *FileEncryptor) FooterKeyMetadata() []byte {
+	return e.properties.footerKeyMetadata
+}
+
+// FileCryptoMetaData returns the crypto metadata stored before an encrypted
+// footer.
+func (e *FileEncryptor) FileCryptoMetaData() *thrift.FileCryptoMetaData {
+	return &thrift.FileCryptoMetaData{EncryptionAlgorithm: e.algorithm, KeyMetadata: e.properties.footerKeyMetadata}
+}
+
+// EncryptFooter returns the footer encrypted as a module.
+func (e *FileEncryptor) EncryptFooter(footer []byte) ([]byte, error) {
+	c, err := NewGCM(e.properties.footerKey)
+	if err != nil {
+		return nil, err
+	}
+	aad, _ := ModuleAAD(e.fileAAD, Footer, 0, 0, 0)
+	return c.Encrypt(footer, aad)
+}
+
+// SignFooter returns the signature of a plaintext footer: the nonce and the
+// tag of its encryption with the footer key.
+func (e *FileEncryptor) SignFooter(footer []byte) ([]byte, error) {
+	module, err := e.EncryptFooter(footer)
+	if err != nil {
+		return nil, err
+	}
+	signature := make([]byte, 0, NonceSize+TagSize)
+	signature = append(signature, module[lengthSize:lengthSize+NonceSize]...)
+	return append(signature, module[len(module)-TagSize:]...), nil
+}
+
+// column returns the properties of the column path, nil if it is not
+// encrypted.
+func (e *FileEncryptor) column(path []string) *ColumnEncryptionProperties {
+	if len(e.properties.columns) == 0 {
+		return &ColumnEncryptionProperties{path: strings.Join(path, ".")}
+	}
+	return e.properties.columns[strings.Join(path, ".")]
+}
+
+// ColumnCryptoMetaData returns the crypto metadata of the chunks of the
+// column path, nil if the column is not encrypted.
+func (e *FileEncryptor) ColumnCryptoMetaData(path []string) *thrift.ColumnCryptoMetaData {
+	c := e.column(path)
+	switch {
+	case c == nil:
+		return nil
+	case c.key == nil:
+		return &thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_FOOTER_KEY: thrift.NewEncryptionWithFooterKey()}
+	default:
+		return &thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_COLUMN_KEY: &thrift.EncryptionWithColumnKey{
+			PathInSchema: append([]string(nil), path...),
+			KeyMetadata:  c.keyMetadata,
+		}}
+	}
+}
+
+// ColumnEncryptor returns the encryptor of the modules of the chunk of the
+// column path at index column in the row group at index rowGroup in the
+// file, nil if the column is not encrypted.
+func (e *FileEncryptor) ColumnEncryptor(path []string, rowGroup, column int) (*ColumnEncryptor, error) {
+	c := e.column(path)
+	if c == nil {
+		return nil, nil
+	}
+	key := c.key
+	if key == nil {
+		key = e.properties.footerKey
+	}
+	metadata, err := NewGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	data := metadata
+	if e.properties.algorithm == AesGcmCtrV1 {
+		if data, err = NewCTR(key); err != nil {
+			return nil, err
+		}
+	}
+	return &ColumnEncryptor{
+		metadata:  metadata,
+		data:      data,
+		fileAAD:   e.fileAAD,
+		rowGroup:  rowGroup,
+		column:    column,
+		footerKey: c.key == nil,
+	}, nil
+}
+
+// ColumnEncryptor encrypts the modules of a column chunk.
+type ColumnEncryptor struct {
+	metadata  *Cipher // the metadata, the page headers, the indexes and the filters
+	data      *Cipher // the pages
+	fileAAD   []byte
+	rowGroup  int
+	column    int
+	footerKey bool // whether the column is encrypted with the footer key
+}
+
+// EncryptedWithFooterKey returns whether the column is encrypted with the
+// footer key: its metadata is not encrypted separately in an encrypted
+// footer.
+func (e *ColumnEncryptor) EncryptedWithFooterKey() bool {
+	return e.footerKey
+}
+
+// Encrypt returns plaintext encrypted as a module of type t. page is the
+// ordinal of the page of the data pages and their headers in the column
+// chunk, ignored for the other modules.
+func (e *ColumnEncryptor) Encrypt(plaintext []byte, t ModuleType, page int) ([]byte, error) {
+	aad, err := ModuleAAD(e.fileAAD, t, e.rowGroup, e.column, page)
+	if err != nil {
+		return nil, err
+	}
+	c := e.metadata
+	if t == DataPage || t == DictionaryPage {
+		c = e.data
+	}
+	return c.Encrypt(plaintext, aad)
+}
+
+// EncryptColumnMetaData returns the encrypted_column_metadata of the column
+// chunk of metadata meta.
+func (e *ColumnEncryptor) EncryptColumnMetaData(meta *thrift.ColumnMetaData) ([]byte, error) {
+	var b bytes.Buffer
+	if _, err := meta.Write(&b); err != nil {
+		return nil, fmt.Errorf("encryption: could not write column metadata: %s", err)
+	}
+	return e.Encrypt(b.Bytes(), ColumnMetaData, 0)
+}
@@ -0,0 +1,132 @@
+package encryption
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestFileEncryptionPropertiesBuilder(t *testing.T) {
+	key := []byte("0123456789012345")
+	column, err := NewColumnEncryptionPropertiesBuilder("a.b").WithKey([]byte("abcdefghijklmnop")).WithKeyID("kc").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	properties, err := NewFileEncryptionPropertiesBuilder(key).WithEncryptedColumns(column).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := properties.EncryptedColumns(); len(got) != 1 || got[0] != "a.b" {
+		t.Errorf("got encrypted columns %v, want [a.b]", got)
+	}
+
+	for _, b := range []*FileEncryptionPropertiesBuilder{
+		NewFileEncryptionPropertiesBuilder([]byte("short")),
+		NewFileEncryptionPropertiesBuilder(key).WithAlgorithm(Algorithm(2)),
+		NewFileEncryptionPropertiesBuilder(key).WithoutAADPrefixStorage(),
+		NewFileEncryptionPropertiesBuilder(key).WithAADPrefix([]byte{}),
+		NewFileEncryptionPropertiesBuilder(key).WithEncryptedColumns(column, column),
+	} {
+		if _, err := b.Build(); err == nil {
+			t.Errorf("no error for %+v", b.properties)
+		}
+	}
+	if _, err := NewColumnEncryptionPropertiesBuilder("a").WithKey([]byte("short")).Build(); err == nil {
+		t.Errorf("no error for a column key of 5 bytes")
+	}
+	if _, err := NewColumnEncryptionPropertiesBuilder("a").WithKeyID("k").Build(); err == nil {
+		t.Errorf("no error for a column key id without key")
+	}
+}
+
+func TestFileEncryptor(t *testing.T) {
+	footerKey, columnKey := []byte("0123456789012345"), []byte("abcdefghijklmnop")
+	column, err := NewColumnEncryptionPropertiesBuilder("a.b").WithKey(columnKey).WithKeyID("kc").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	properties, err := NewFileEncryptionPropertiesBuilder(footerKey).
+		WithFooterKeyID("kf").
+		WithAADPrefix([]byte("file.parquet")).
+		WithoutAADPrefixStorage().
+		WithEncryptedColumns(column).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := NewFileEncryptor(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := e.FileCryptoMetaData().GetKeyMetadata(); string(got) != "kf" {
+		t.Errorf("got footer key metadata %q, want kf", got)
+	}
+	if a := e.Algorithm().GetAES_GCM_V1(); a.GetAadPrefix() != nil || !a.GetSupplyAadPrefix() || len(a.GetAadFileUnique()) != aadFileUniqueSize {
+		t.Errorf("got algorithm %s", e.Algorithm())
+	}
+
+	if _, err := NewFileDecryptor(&DecryptionProperties{FooterKey: footerKey}, e.Algorithm(), nil); err == nil {
+		t.Errorf("no error without the AAD prefix")
+	}
+	d, err := NewFileDecryptor(&DecryptionProperties{
+		FooterKey:  footerKey,
+		ColumnKeys: map[string][]byte{"a.b": columnKey},
+		AADPrefix:  []byte("file.parquet"),
+	}, e.Algorithm(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	footer := []byte("footer")
+	module, err := e.EncryptFooter(footer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := d.DecryptFooter(module); err != nil || !bytes.Equal(got, footer) {
+		t.Errorf("got footer %q, %v, want %q", got, err, footer)
+	}
+	signature, err := e.SignFooter(footer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := d.VerifyFooter(footer, signature); err != nil {
+		t.Errorf("signature not verified: %s", err)
+	}
+
+	if ce, err := e.ColumnEncryptor([]string{"c"}, 0, 0); ce != nil || err != nil {
+		t.Errorf("got encryptor %v, %v for a plaintext column", ce, err)
+	}
+	if crypto := e.ColumnCryptoMetaData([]string{"c"}); crypto != nil {
+		t.Errorf("got crypto metadata %s for a plaintext column", crypto)
+	}
+	crypto := e.ColumnCryptoMetaData([]string{"a", "b"})
+	if key := crypto.GetENCRYPTION_WITH_COLUMN_KEY(); key == nil || string(key.GetKeyMetadata()) != "kc" {
+		t.Fatalf("got crypto metadata %s", crypto)
+	}
+	ce, err := e.ColumnEncryptor([]string{"a", "b"}, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ce.EncryptedWithFooterKey() {
+		t.Errorf("column encrypted with the footer key")
+	}
+	meta := &thrift.ColumnMetaData{Type: thrift.Type_INT32, PathInSchema: []string{"a", "b"}, NumValues: 3}
+	encrypted, err := ce.EncryptColumnMetaData(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cd, err := d.ColumnDecryptor(crypto, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := cd.DecryptColumnMetaData(encrypted); err != nil || got.GetNumValues() != 3 {
+		t.Errorf("got column metadata %s, %v", got, err)
+	}
+	if cd, err = d.ColumnDecryptor(crypto, 1, 3); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cd.DecryptColumnMetaData(encrypted); err == nil {
+		t.Errorf("no error for the metadata of another column chunk")
+	}
+}
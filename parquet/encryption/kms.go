@@ -0,0 +1,251 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// KmsClient wraps and unwraps the keys of the files with the master keys
+// of a KMS (key management service), as the KmsClient of parquet-mr. The
+// master keys never leave the KMS.
+type KmsClient interface {
+	// WrapKey returns key encrypted with the master key of id masterKeyID.
+	WrapKey(key []byte, masterKeyID string) (string, error)
+	// UnwrapKey returns the key wrapped by WrapKey with the master key of
+	// id masterKeyID.
+	UnwrapKey(wrappedKey string, masterKeyID string) ([]byte, error)
+}
+
+// KeyRetriever returns the key of the key metadata stored in a file, see
+// DecryptionProperties.
+type KeyRetriever interface {
+	RetrieveKey(keyMetadata []byte) ([]byte, error)
+}
+
+const (
+	// KeyMaterialType is the type of the key material written by
+	// KeyWrapper, the only one known to parquet-mr.
+	KeyMaterialType = "PKMT1"
+	// DefaultKmsInstance is the id and the URL of the KMS instance of the
+	// key material when they are not set, as in parquet-mr.
+	DefaultKmsInstance = "DEFAULT"
+
+	// the size in bytes of the key encryption keys and of their ids
+	kekSize   = 16
+	kekIDSize = 16
+)
+
+// KeyMaterial is the key material of parquet-mr's KeyToolkit: the wrapped
+// key of a column or of the footer and how to unwrap it. With the internal
+// storage used by KeyWrapper the key metadata stored in the file is the key
+// material marshalled to JSON, with the external storage of parquet-mr it
+// only has the reference of the key material stored next to the file.
+type KeyMaterial struct {
+	Type            string `json:"keyMaterialType"`
+	InternalStorage bool   `json:"internalStorage"`
+	KeyReference    string `json:"keyReference,omitempty"` // only with the external storage
+	IsFooterKey     bool   `json:"isFooterKey"`
+	KmsInstanceID   string `json:"kmsInstanceID,omitempty"`  // only for the footer key
+	KmsInstanceURL  string `json:"kmsInstanceURL,omitempty"` // only for the footer key
+	MasterKeyID     string `json:"masterKeyID,omitempty"`
+	WrappedDEK      string `json:"wrappedDEK,omitempty"`
+	DoubleWrapping  bool   `json:"doubleWrapping"`
+	KEKID           string `json:"keyEncryptionKeyID,omitempty"` // only with the double wrapping
+	WrappedKEK      string `json:"wrappedKEK,omitempty"`         // only with the double wrapping
+}
+
+// ParseKeyMaterial returns the key material of keyMetadata, written with
+// the internal storage.
+func ParseKeyMaterial(keyMetadata []byte) (*KeyMaterial, error) {
+	var m KeyMaterial
+	if err := json.Unmarshal(keyMetadata, &m); err != nil {
+		return nil, fmt.Errorf("encryption: invalid key metadata: %s", err)
+	}
+	switch {
+	case m.Type != KeyMaterialType:
+		return nil, fmt.Errorf("encryption: unsupported key material type %q", m.Type)
+	case !m.InternalStorage:
+		return nil, fmt.Errorf("encryption: key material %q stored outside the file is not supported", m.KeyReference)
+	case m.MasterKeyID == "" || m.WrappedDEK == "":
+		return nil, fmt.Errorf("encryption: key material without master key or wrapped key")
+	case m.DoubleWrapping && (m.KEKID == "" || m.WrappedKEK == ""):
+		return nil, fmt.Errorf("encryption: double wrapped key material without key encryption key")
+	}
+	return &m, nil
+}
+
+// EncryptKeyLocally returns key encrypted with AES GCM with masterKey and
+// aad, encoded in base64 as in parquet-mr: the nonce, the ciphertext and the
+// tag without length. KmsClient implementations holding the master keys
+// can wrap the keys with it, with the id of the master key as aad.
+func EncryptKeyLocally(key, masterKey, aad []byte) (string, error) {
+	c, err := NewGCM(masterKey)
+	if err != nil {
+		return "", err
+	}
+	module, err := c.Encrypt(key, aad)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(module[lengthSize:]), nil
+}
+
+// DecryptKeyLocally returns the key encrypted by EncryptKeyLocally.
+func DecryptKeyLocally(encryptedKey string, masterKey, aad []byte) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(encryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: invalid encrypted key: %s", err)
+	}
+	c, err := NewGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	module := make([]byte, lengthSize+len(data))
+	binary.LittleEndian.PutUint32(module, uint32(len(data)))
+	copy(module[lengthSize:], data)
+	return c.Decrypt(module, aad)
+}
+
+// kek is a key encryption key of the double wrapping.
+type kek struct {
+	key     []byte
+	id      []byte
+	wrapped string // wrapped with the master key
+}
+
+// KeyWrapper wraps the keys of a file with a KmsClient and returns their key
+// metadata, as the FileKeyWrapper of parquet-mr. With the double wrapping,
+// the default of parquet-mr, the keys are encrypted locally with a random
+// key encryption key, wrapped by the KMS once for each master key.
+type KeyWrapper struct {
+	client         KmsClient
+	kmsInstanceID  string
+	kmsInstanceURL string
+	doubleWrapping bool
+	keks           map[string]*kek // by master key id
+}
+
+// NewKeyWrapper returns a wrapper of the keys with client, the client of the
+// KMS instance of the given id and URL, DefaultKmsInstance if empty.
+func NewKeyWrapper(client KmsClient, kmsInstanceID, kmsInstanceURL string, doubleWrapping bool) *KeyWrapper {
+	if kmsInstanceID == "" {
+		kmsInstanceID = DefaultKmsInstance
+	}
+	if kmsInstanceURL == "" {
+		kmsInstanceURL = DefaultKmsInstance
+	}
+	return &KeyWrapper{
+		client:         client,
+		kmsInstanceID:  kmsInstanceID,
+		kmsInstanceURL: kmsInstanceURL,
+		doubleWrapping: doubleWrapping,
+		keks:           make(map[string]*kek),
+	}
+}
+
+// Wrap returns the key metadata of key, the footer key or the key of a
+// column, wrapped with the master key of id masterKeyID. It is the key
+// metadata of FileEncryptionPropertiesBuilder.WithFooterKeyMetadata or
+// ColumnEncryptionPropertiesBuilder.WithKeyMetadata.
+func (w *KeyWrapper) Wrap(key []byte, masterKeyID string, footer bool) ([]byte, error) {
+	m := KeyMaterial{
+		Type:            KeyMaterialType,
+		InternalStorage: true,
+		IsFooterKey:     footer,
+		MasterKeyID:     masterKeyID,
+		DoubleWrapping:  w.doubleWrapping,
+	}
+	if footer {
+		m.KmsInstanceID, m.KmsInstanceURL = w.kmsInstanceID, w.kmsInstanceURL
+	}
+
+	var err error
+	if !w.doubleWrapping {
+		if m.WrappedDEK, err = w.client.WrapKey(key, masterKeyID); err != nil {
+			return nil, fmt.Errorf("encryption: could not wrap key with master key %s: %s", masterKeyID, err)
+		}
+	} else {
+		k, err := w.kek(masterKeyID)
+		if err != nil {
+			return nil, err
+		}
+		if m.WrappedDEK, err = EncryptKeyLocally(key, k.key, k.id); err != nil {
+			return nil, err
+		}
+		m.KEKID, m.WrappedKEK = base64.StdEncoding.EncodeToString(k.id), k.wrapped
+	}
+	return json.Marshal(&m)
+}
+
+// kek returns the key encryption key of the master key of id masterKeyID,
+// generated and wrapped on the first use.
+func (w *KeyWrapper) kek(masterKeyID string) (*kek, error) {
+	if k, ok := w.keks[masterKeyID]; ok {
+		return k, nil
+	}
+	key, id := make([]byte, kekSize), make([]byte, kekIDSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("encryption: could not generate key encryption key: %s", err)
+	}
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("encryption: could not generate key encryption key: %s", err)
+	}
+	wrapped, err := w.client.WrapKey(key, masterKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: could not wrap key with master key %s: %s", masterKeyID, err)
+	}
+	k := &kek{key: key, id: id, wrapped: wrapped}
+	w.keks[masterKeyID] = k
+	return k, nil
+}
+
+// KeyUnwrapper is a KeyRetriever of the keys wrapped by a KeyWrapper or by
+// parquet-mr with the internal storage, as the FileKeyUnwrapper of
+// parquet-mr. The unwrapped key encryption keys are cached. It is safe for
+// concurrent use if its client is.
+type KeyUnwrapper struct {
+	client KmsClient
+	mu     sync.Mutex
+	keks   map[string][]byte // by key encryption key id
+}
+
+// NewKeyUnwrapper returns a retriever of the keys unwrapped with client.
+func NewKeyUnwrapper(client KmsClient) *KeyUnwrapper {
+	return &KeyUnwrapper{client: client, keks: make(map[string][]byte)}
+}
+
+// RetrieveKey implements KeyRetriever.
+func (u *KeyUnwrapper) RetrieveKey(keyMetadata []byte) ([]byte, error) {
+	m, err := ParseKeyMaterial(keyMetadata)
+	if err != nil {
+		return nil, err
+	}
+	if !m.DoubleWrapping {
+		key, err := u.client.UnwrapKey(m.WrappedDEK, m.MasterKeyID)
+		if err != nil {
+			return nil, fmt.Errorf("encryption: could not unwrap key with master key %s: %s", m.MasterKeyID, err)
+		}
+		return key, nil
+	}
+
+	id, err := base64.StdEncoding.DecodeString(m.KEKID)
+	if err != nil {
+		return nil, fmt.Errorf("encryption: invalid key encryption key id: %s", err)
+	}
+	u.mu.Lock()
+	key, ok := u.keks[m.KEKID]
+	u.mu.Unlock()
+	if !ok {
+		if key, err = u.client.UnwrapKey(m.WrappedKEK, m.MasterKeyID); err != nil {
+			return nil, fmt.Errorf("encryption: could not unwrap key with master key %s: %s", m.MasterKeyID, err)
+		}
+		u.mu.Lock()
+		u.keks[m.KEKID] = key
+		u.mu.Unlock()
+	}
+	return DecryptKeyLocally(m.WrappedDEK, key, id)
+}
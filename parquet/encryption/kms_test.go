@@ -0,0 +1,205 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// testKmsClient holds the master keys in memory, as the InMemoryKMS of the
+// parquet-mr tests.
+type testKmsClient struct {
+	masterKeys map[string][]byte
+	wraps      int
+	unwraps    int
+}
+
+func (c *testKmsClient) WrapKey(key []byte, masterKeyID string) (string, error) {
+	masterKey, ok := c.masterKeys[masterKeyID]
+	if !ok {
+		return "", fmt.Errorf("unknown master key %s", masterKeyID)
+	}
+	c.wraps++
+	return EncryptKeyLocally(key, masterKey, []byte(masterKeyID))
+}
+
+func (c *testKmsClient) UnwrapKey(wrappedKey string, masterKeyID string) ([]byte, error) {
+	masterKey, ok := c.masterKeys[masterKeyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown master key %s", masterKeyID)
+	}
+	c.unwraps++
+	return DecryptKeyLocally(wrappedKey, masterKey, []byte(masterKeyID))
+}
+
+func newTestKmsClient() *testKmsClient {
+	return &testKmsClient{masterKeys: map[string][]byte{
+		"kf":  []byte("0123456789012345"),
+		"kc1": []byte("1234567890123450"),
+		"kc2": []byte("2345678901234501"),
+	}}
+}
+
+func TestKeyWrapper(t *testing.T) {
+	footerKey, columnKey := []byte("abcdefghijklmnop"), []byte("bcdefghijklmnopa")
+	for _, doubleWrapping := range []bool{false, true} {
+		client := newTestKmsClient()
+		w := NewKeyWrapper(client, "", "", doubleWrapping)
+		footerMetadata, err := w.Wrap(footerKey, "kf", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var metadata [][]byte
+		for i := 0; i < 3; i++ {
+			m, err := w.Wrap(columnKey, "kc1", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			metadata = append(metadata, m)
+		}
+		if _, err := w.Wrap(columnKey, "unknown", false); err == nil {
+			t.Errorf("double wrapping %t: no error for an unknown master key", doubleWrapping)
+		}
+		// the key encryption key of a master key is wrapped once
+		wantWraps := 4
+		if doubleWrapping {
+			wantWraps = 2
+		}
+		if client.wraps != wantWraps {
+			t.Errorf("double wrapping %t: %d keys wrapped by the KMS, want %d", doubleWrapping, client.wraps, wantWraps)
+		}
+
+		var fields map[string]interface{}
+		if err := json.Unmarshal(footerMetadata, &fields); err != nil {
+			t.Fatal(err)
+		}
+		for field, want := range map[string]interface{}{
+			"keyMaterialType": "PKMT1",
+			"internalStorage": true,
+			"isFooterKey":     true,
+			"kmsInstanceID":   "DEFAULT",
+			"kmsInstanceURL":  "DEFAULT",
+			"masterKeyID":     "kf",
+			"doubleWrapping":  doubleWrapping,
+		} {
+			if fields[field] != want {
+				t.Errorf("double wrapping %t: got %s %v, want %v", doubleWrapping, field, fields[field], want)
+			}
+		}
+
+		u := NewKeyUnwrapper(client)
+		if key, err := u.RetrieveKey(footerMetadata); err != nil || !bytes.Equal(key, footerKey) {
+			t.Errorf("double wrapping %t: got footer key %q, %v", doubleWrapping, key, err)
+		}
+		client.unwraps = 0
+		for _, m := range metadata {
+			if key, err := u.RetrieveKey(m); err != nil || !bytes.Equal(key, columnKey) {
+				t.Errorf("double wrapping %t: got column key %q, %v", doubleWrapping, key, err)
+			}
+		}
+		wantUnwraps := 3
+		if doubleWrapping {
+			wantUnwraps = 1
+		}
+		if client.unwraps != wantUnwraps {
+			t.Errorf("double wrapping %t: %d keys unwrapped by the KMS, want %d", doubleWrapping, client.unwraps, wantUnwraps)
+		}
+	}
+}
+
+func TestParseKeyMaterial(t *testing.T) {
+	m, err := ParseKeyMaterial([]byte(`{"keyMaterialType":"PKMT1","internalStorage":true,"isFooterKey":false,` +
+		`"masterKeyID":"kc1","wrappedDEK":"AAAA","doubleWrapping":true,"keyEncryptionKeyID":"BBBB","wrappedKEK":"CCCC"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.MasterKeyID != "kc1" || !m.DoubleWrapping || m.KEKID != "BBBB" || m.WrappedKEK != "CCCC" {
+		t.Errorf("got %+v", m)
+	}
+
+	for _, metadata := range []string{
+		`not json`,
+		`{"keyMaterialType":"PKMT2","internalStorage":true,"masterKeyID":"k","wrappedDEK":"AAAA"}`,
+		`{"keyMaterialType":"PKMT1","internalStorage":false,"keyReference":"ref"}`,
+		`{"keyMaterialType":"PKMT1","internalStorage":true,"wrappedDEK":"AAAA"}`,
+		`{"keyMaterialType":"PKMT1","internalStorage":true,"masterKeyID":"k","wrappedDEK":"AAAA","doubleWrapping":true}`,
+	} {
+		if _, err := ParseKeyMaterial([]byte(metadata)); err == nil {
+			t.Errorf("no error for %s", metadata)
+		}
+	}
+}
+
+func TestFileDecryptorKeyRetriever(t *testing.T) {
+	footerKey, columnKey := []byte("abcdefghijklmnop"), []byte("bcdefghijklmnopa")
+	client := newTestKmsClient()
+	w := NewKeyWrapper(client, "", "", true)
+	footerMetadata, err := w.Wrap(footerKey, "kf", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columnMetadata, err := w.Wrap(columnKey, "kc2", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	column, err := NewColumnEncryptionPropertiesBuilder("a").WithKey(columnKey).WithKeyMetadata(columnMetadata).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	properties, err := NewFileEncryptionPropertiesBuilder(footerKey).WithFooterKeyMetadata(footerMetadata).WithEncryptedColumns(column).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := NewFileEncryptor(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	footer, err := e.EncryptFooter([]byte("footer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ce, err := e.ColumnEncryptor([]string{"a"}, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta, err := ce.EncryptColumnMetaData(&thrift.ColumnMetaData{PathInSchema: []string{"a"}, NumValues: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := NewFileDecryptor(&DecryptionProperties{KeyRetriever: NewKeyUnwrapper(client)}, e.Algorithm(), e.FileCryptoMetaData().GetKeyMetadata())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := d.DecryptFooter(footer); err != nil || string(got) != "footer" {
+		t.Errorf("got footer %q, %v", got, err)
+	}
+	crypto := e.ColumnCryptoMetaData([]string{"a"})
+	if !d.CanDecrypt(crypto) {
+		t.Fatalf("cannot decrypt column a")
+	}
+	cd, err := d.ColumnDecryptor(crypto, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := cd.DecryptColumnMetaData(meta); err != nil || got.GetNumValues() != 5 {
+		t.Errorf("got column metadata %s, %v", got, err)
+	}
+
+	// the master key of the column is not in the KMS
+	delete(client.masterKeys, "kc2")
+	d, err = NewFileDecryptor(&DecryptionProperties{KeyRetriever: NewKeyUnwrapper(client)}, e.Algorithm(), e.FileCryptoMetaData().GetKeyMetadata())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.CanDecrypt(crypto) {
+		t.Errorf("can decrypt column a without its master key")
+	}
+	if _, err := d.ColumnDecryptor(crypto, 0, 0); err == nil {
+		t.Errorf("no error for column a without its master key")
+	}
+}
@@ -0,0 +1,176 @@
+package parquet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The field_id of the elements of a schema identifies the fields of the
+// tables whose schema evolves, e.g. the Iceberg tables: the fields keep
+// their ID when they are renamed or moved. They are set by the "fieldid"
+// option of the tags of SchemaOf, by the "= id" of ParseSchema or by
+// SetFieldIDs, and the rows are read by field ID with
+// ReaderOptions.FieldIDSchema.
+
+// SetFieldIDs sets the field_id of the fields of s, groups or columns, by
+// path, e.g. "address.zip" for the field zip of the group address. It
+// returns an error without setting any if a path is not in s.
+func (s *Schema) SetFieldIDs(ids map[string]int32) error {
+	elements := s.elementsByPath()
+	var missing []string
+	for path := range ids {
+		if elements[path] == nil {
+			missing = append(missing, path)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("set field ids: no field %s", strings.Join(missing, ", "))
+	}
+	for path, id := range ids {
+		id := id
+		elements[path].FieldID = &id
+	}
+	return nil
+}
+
+// elementsByPath returns the elements of the fields of s, groups and
+// columns, by path.
+func (s *Schema) elementsByPath() map[string]*thrift.SchemaElement {
+	elements := make(map[string]*thrift.SchemaElement)
+	if s.root.schemaElement == nil {
+		for name, cd := range s.columns {
+			elements[name] = cd.SchemaElement
+		}
+		return elements
+	}
+	var walk func(prefix string, children []schemaElement)
+	walk = func(prefix string, children []schemaElement) {
+		for _, child := range children {
+			e := elementOf(child)
+			elements[prefix+e.Name] = e
+			if g, ok := child.(*group); ok {
+				walk(prefix+e.Name+".", g.children)
+			}
+		}
+	}
+	walk("", s.root.children)
+	return elements
+}
+
+// ColumnByFieldID returns the column of s with the field_id id, nil if
+// there is none.
+func (s *Schema) ColumnByFieldID(id int32) *ColumnDescriptor {
+	for _, name := range s.columnsSequence {
+		cd := s.columns[name]
+		if cd.SchemaElement.FieldID != nil && *cd.SchemaElement.FieldID == id {
+			return &cd
+		}
+	}
+	return nil
+}
+
+// resolveFieldIDs returns the fields of the rows read with the fields want,
+// of ReaderOptions.FieldIDSchema, from the fields of the file: the fields
+// of the file with the field_id of a field of want, named after it, or the
+// fields of want without columns, read as null, if the file has none. The
+// fields of the groups are resolved the same way, the elements of the lists
+// and the keys and values of the maps by position. The columns of want must
+// have the type of the columns of the file, or a type that widens it, see
+// TypeWidened.
+func resolveFieldIDs(want, fields []*field) ([]*field, error) {
+	byID := make(map[int32]*field, len(fields))
+	for _, f := range fields {
+		if f.element.FieldID != nil {
+			byID[*f.element.FieldID] = f
+		}
+	}
+	resolved := make([]*field, len(want))
+	for i, w := range want {
+		if w.element.FieldID == nil {
+			return nil, fmt.Errorf("field %s has no field_id", w)
+		}
+		f, ok := byID[*w.element.FieldID]
+		if !ok {
+			resolved[i] = &field{name: w.name, path: w.path, element: w.element, kind: w.kind}
+			continue
+		}
+		r, err := resolveField(w, f)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = r
+	}
+	return resolved, nil
+}
+
+// resolveField returns the field f of the file, resolved to the field w.
+func resolveField(w, f *field) (*field, error) {
+	if w.kind != f.kind {
+		return nil, fmt.Errorf("field %s: the field %s of the file with the same field_id is of another kind", w, f)
+	}
+	r := *f
+	r.name = w.name
+	var err error
+	switch f.kind {
+	case columnField:
+		if !sameColumnType(f.element, w.element) {
+			if !widens(f.element, w.element) {
+				return nil, fmt.Errorf("field %s: the column %s of the file with the same field_id is of type %s",
+					w, f, fieldTypeName(&primitive{schemaElement: f.element}))
+			}
+			r.widened = w.element
+		}
+	case groupField:
+		r.children, err = resolveFieldIDs(w.children, f.children)
+	case listField:
+		r.elem, err = resolveField(w.elem, f.elem)
+		if err == nil {
+			r.elem.name = f.elem.name
+		}
+	case mapField:
+		if len(w.elem.children) != len(f.elem.children) {
+			return nil, fmt.Errorf("field %s: the map %s of the file with the same field_id has another number of fields", w, f)
+		}
+		elem := *f.elem
+		elem.children = make([]*field, len(f.elem.children))
+		for i, c := range f.elem.children {
+			if elem.children[i], err = resolveField(w.elem.children[i], c); err != nil {
+				return nil, err
+			}
+			elem.children[i].name = c.name
+		}
+		r.elem = &elem
+	}
+	return &r, err
+}
+
+// widenValueFunc returns the function converting the values read from a
+// column, with convert unless it is nil, to the values of the column element
+// of a type that widens the type of the column: the int32 to int64 and the
+// float32 to float64. The values of the DECIMAL columns are *big.Rat
+// whatever their precision, and the integers of fewer bits are int32.
+func widenValueFunc(convert func(v interface{}) (interface{}, error), element *thrift.SchemaElement) func(v interface{}) (interface{}, error) {
+	return func(v interface{}) (interface{}, error) {
+		if convert != nil {
+			var err error
+			if v, err = convert(v); err != nil {
+				return nil, err
+			}
+		}
+		switch value := v.(type) {
+		case int32:
+			if element.GetType() == thrift.Type_INT64 {
+				return int64(value), nil
+			}
+		case float32:
+			if element.GetType() == thrift.Type_DOUBLE {
+				return float64(value), nil
+			}
+		}
+		return v, nil
+	}
+}
@@ -0,0 +1,189 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSetFieldIDs(t *testing.T) {
+	s, err := ParseSchema(`message root {
+  required int64 id;
+  optional group address {
+    required binary zip (STRING);
+  }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SetFieldIDs(map[string]int32{"id": 1, "address.zip": 3, "other": 4}); err == nil {
+		t.Error("no error for a missing field")
+	}
+	if s.ColumnByFieldID(1) != nil {
+		t.Error("field ids set after an error")
+	}
+	if err := s.SetFieldIDs(map[string]int32{"id": 1, "address": 2, "address.zip": 3}); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.String(); !strings.Contains(got, "optional group address = 2 {") || !strings.Contains(got, "zip (STRING) = 3;") {
+		t.Errorf("schema %s", got)
+	}
+	if cd := s.ColumnByFieldID(3); cd == nil || cd.SchemaElement.GetName() != "zip" {
+		t.Errorf("column of field id 3: %v", cd)
+	}
+	if cd := s.ColumnByFieldID(2); cd != nil {
+		t.Errorf("column of the field id of a group: %v", cd)
+	}
+
+	// the elements of the written file have the field ids
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.Schema().String(), s.String(); got != want {
+		t.Errorf("got schema %s, want %s", got, want)
+	}
+}
+
+func TestReadByFieldID(t *testing.T) {
+	const schema = `message root {
+  required int64 id = 1;
+  optional binary name (STRING) = 2;
+  optional group address = 3 {
+    optional binary street (STRING) = 4;
+    optional binary zip (STRING) = 5;
+  }
+  optional group tags (LIST) = 6 {
+    repeated group list {
+      required group element = 7 {
+        required binary key (STRING) = 8;
+        optional int32 count = 9;
+      }
+    }
+  }
+  optional binary unnamed;
+}`
+	rows := []map[string]interface{}{
+		{
+			"id": int64(1), "name": "a", "unnamed": "u",
+			"address": map[string]interface{}{"street": "s", "zip": "z"},
+			"tags":    []map[string]interface{}{{"key": "k", "count": int32(2)}},
+		},
+		{"id": int64(2)},
+	}
+	f := writeListTestFile(t, schema, WriterOptions{}, rows)
+
+	// renamed and moved fields, a new field and a field that was dropped
+	want, err := ParseSchema(`message root {
+  optional binary email (STRING) = 10;
+  optional group location = 3 {
+    optional binary postal_code (STRING) = 5;
+  }
+  required int64 key = 1;
+  optional binary full_name (STRING) = 2;
+  optional group labels (LIST) = 6 {
+    repeated group list {
+      required group element = 7 {
+        optional int32 n = 9;
+        required binary label (STRING) = 8;
+      }
+    }
+  }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.options.FieldIDSchema = want
+	got := readTestRows(t, f)
+	expected := []map[string]interface{}{
+		{
+			"key": int64(1), "full_name": []byte("a"), "email": nil,
+			"location": map[string]interface{}{"postal_code": []byte("z")},
+			"labels":   []interface{}{map[string]interface{}{"label": []byte("k"), "n": int32(2)}},
+		},
+		{"key": int64(2), "full_name": nil, "email": nil, "location": nil, "labels": nil},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, want %v", got, expected)
+	}
+
+	for _, schema := range []string{
+		`message root {
+  required int64 id;
+}`,
+		`message root {
+  optional group name = 2 {
+    optional binary first (STRING) = 11;
+  }
+}`,
+	} {
+		s, err := ParseSchema(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.options.FieldIDSchema = s
+		rows := f.RowGroups()[0].Rows()
+		if rows.Scan() || rows.Err() == nil {
+			t.Errorf("no error for schema %s", schema)
+		}
+	}
+}
+
+func TestReadWidenedByFieldID(t *testing.T) {
+	const schema = `message root {
+  required int32 id = 1;
+  optional float ratio = 2;
+  required int32 small (INT_16) = 3;
+  required binary name (STRING) = 4;
+}`
+	rows := []map[string]interface{}{
+		{"id": int32(1), "ratio": float32(0.5), "small": int32(-2), "name": "a"},
+		{"id": int32(2), "small": int32(3), "name": "b"},
+	}
+	f := writeListTestFile(t, schema, WriterOptions{}, rows)
+
+	want, err := ParseSchema(`message root {
+  required int64 id = 1;
+  optional double ratio = 2;
+  required int32 small = 3;
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	old, err := ParseSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, compatible := CompatibleWith(old, want); !compatible {
+		t.Errorf("%s is not compatible", want)
+	}
+	f.options.FieldIDSchema = want
+	got := readTestRows(t, f)
+	expected := []map[string]interface{}{
+		{"id": int64(1), "ratio": float64(0.5), "small": int32(-2)},
+		{"id": int64(2), "ratio": nil, "small": int32(3)},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, want %v", got, expected)
+	}
+
+	s, err := ParseSchema(`message root {
+  required int64 name = 4;
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.options.FieldIDSchema = s
+	if rows := f.RowGroups()[0].Rows(); rows.Scan() || rows.Err() == nil {
+		t.Error("no error for a column of another type")
+	}
+}
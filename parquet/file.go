@@ -8,7 +8,9 @@ import (
 	"io"
 	"os"
 
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
 	"github.com/kostya-sh/parquet-go/parquet/column"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
@@ -19,12 +21,19 @@ const (
 
 var (
 	parquetMagic      = []byte{'P', 'A', 'R', '1'}
+	encryptedMagic    = []byte{'P', 'A', 'R', 'E'} // files with an encrypted footer
 	ErrNotParquetFile = errors.New("not a parquet file: invalid header")
 )
 
 // writeHeader
 func writeHeader(w io.Writer) error {
-	_, err := w.Write(parquetMagic)
+	return writeMagic(w, parquetMagic)
+}
+
+// writeMagic writes the header of a file starting with magic, encryptedMagic
+// for a file with an encrypted footer.
+func writeMagic(w io.Writer, magic []byte) error {
+	_, err := w.Write(magic)
 	if err != nil {
 		return fmt.Errorf("codec: header write error: %s", err)
 	}
@@ -58,68 +67,477 @@ func writeFileMetadata(w io.Writer, meta *thrift.FileMetaData) error {
 	return nil
 }
 
-// readFileMetaData reads thrift.FileMetaData object from r that provides
-// read interface to data in parquet format.
+// writeEncryptedFileMetadata is like writeFileMetadata for a file encrypted
+// with e. The metadata of the encrypted column chunks is encrypted, then the
+// footer is either encrypted, preceded by the crypto metadata of the file
+// and followed by encryptedMagic, or followed by its signature.
+func writeEncryptedFileMetadata(w io.Writer, meta *thrift.FileMetaData, e *encryption.FileEncryptor) error {
+	if err := encryptColumnMetadata(meta, e); err != nil {
+		return fmt.Errorf("codec: %s", err)
+	}
+
+	var footer bytes.Buffer
+	magic := parquetMagic
+	if e.FooterEncrypted() {
+		if _, err := e.FileCryptoMetaData().Write(&footer); err != nil {
+			return fmt.Errorf("codec: crypto metadata write error: %s", err)
+		}
+		var b bytes.Buffer
+		if _, err := meta.Write(&b); err != nil {
+			return fmt.Errorf("codec: filemetadata write error: %s", err)
+		}
+		module, err := e.EncryptFooter(b.Bytes())
+		if err != nil {
+			return fmt.Errorf("codec: %s", err)
+		}
+		footer.Write(module)
+		magic = encryptedMagic
+	} else {
+		meta.EncryptionAlgorithm = e.Algorithm()
+		meta.FooterSigningKeyMetadata = e.FooterKeyMetadata()
+		if _, err := meta.Write(&footer); err != nil {
+			return fmt.Errorf("codec: filemetadata write error: %s", err)
+		}
+		signature, err := e.SignFooter(footer.Bytes())
+		if err != nil {
+			return fmt.Errorf("codec: %s", err)
+		}
+		footer.Write(signature)
+	}
+
+	if _, err := w.Write(footer.Bytes()); err != nil {
+		return fmt.Errorf("codec: filemetadata write error: %s", err)
+	}
+	if err := binary.Write(w, binary.LittleEndian, int32(footer.Len())); err != nil {
+		return fmt.Errorf("codec: filemetadata size write error: %s", err)
+	}
+	if _, err := w.Write(magic); err != nil {
+		return fmt.Errorf("codec: footer write error: %s", err)
+	}
+	return nil
+}
+
+// encryptColumnMetadata sets the crypto metadata of the encrypted column
+// chunks of meta and their encrypted_column_metadata, except for the chunks
+// encrypted with the footer key in an encrypted footer. In a plaintext
+// footer the chunks keep their metadata without their statistics, size,
+// geospatial or encoding statistics, in an encrypted footer the chunks
+// encrypted with their own key have no plaintext metadata.
+func encryptColumnMetadata(meta *thrift.FileMetaData, e *encryption.FileEncryptor) error {
+	for i, rg := range meta.GetRowGroups() {
+		for j, cc := range rg.GetColumns() {
+			ce, err := columnEncryptor(e, cc, i, j)
+			if err != nil {
+				return err
+			}
+			if ce == nil {
+				continue
+			}
+			cc.CryptoMetadata = e.ColumnCryptoMetaData(cc.MetaData.PathInSchema)
+			if e.FooterEncrypted() && ce.EncryptedWithFooterKey() {
+				continue
+			}
+			if cc.EncryptedColumnMetadata, err = ce.EncryptColumnMetaData(cc.MetaData); err != nil {
+				return fmt.Errorf("column chunk %d of row group %d: %s", j, i, err)
+			}
+			if e.FooterEncrypted() {
+				cc.MetaData = nil
+			} else {
+				redacted := *cc.MetaData
+				redacted.Statistics, redacted.EncodingStats = nil, nil
+				redacted.SizeStatistics, redacted.GeospatialStatistics = nil, nil
+				cc.MetaData = &redacted
+			}
+		}
+	}
+	return nil
+}
+
+// columnEncryptor returns the encryptor of the modules of cc, the chunk j of
+// the row group i, nil if the file, encrypted with e, or the column is not
+// encrypted.
+func columnEncryptor(e *encryption.FileEncryptor, cc *thrift.ColumnChunk, i, j int) (*encryption.ColumnEncryptor, error) {
+	if e == nil {
+		return nil, nil
+	}
+	if cc.MetaData == nil {
+		return nil, fmt.Errorf("column chunk %d of row group %d has no metadata", j, i)
+	}
+	return e.ColumnEncryptor(cc.MetaData.PathInSchema, i, j)
+}
+
+// writeModule writes the thrift object written by write to w, encrypted as a
+// module of type t with e unless e is nil. It returns the number of bytes
+// written.
+func writeModule(w io.Writer, write func(io.Writer) (int, error), e *encryption.ColumnEncryptor, t encryption.ModuleType) (int, error) {
+	if e == nil {
+		return write(w)
+	}
+	var b bytes.Buffer
+	if _, err := write(&b); err != nil {
+		return 0, err
+	}
+	module, err := e.Encrypt(b.Bytes(), t, 0)
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(module)
+}
+
+// writeBloomFilters writes the Bloom filters of the column chunks of
+// rowGroups, filters[i][j] being the filter of the chunk j of the row group
+// i or nil, to w at offset in the file. As the spec suggests, the filters
+// are written after the row groups, before the page indexes. Their
+// locations are set in the metadata of the column chunks. The filters of
+// the encrypted columns of a file encrypted with e, nil if the file is not
+// encrypted, are written as two modules, their header and their bitset. It
+// returns the number of bytes written.
+func writeBloomFilters(w io.Writer, offset int64, rowGroups []*thrift.RowGroup, filters [][]*bloom.Filter, e *encryption.FileEncryptor) (int64, error) {
+	if len(filters) != len(rowGroups) {
+		return 0, fmt.Errorf("%d bloom filters for %d row groups", len(filters), len(rowGroups))
+	}
+	for i, rg := range rowGroups {
+		if len(filters[i]) != len(rg.Columns) {
+			return 0, fmt.Errorf("%d bloom filters for %d column chunks", len(filters[i]), len(rg.Columns))
+		}
+	}
+
+	n := int64(0)
+	for i, rg := range rowGroups {
+		for j, cc := range rg.Columns {
+			filter := filters[i][j]
+			if filter == nil {
+				continue
+			}
+			if cc.MetaData == nil {
+				return n, fmt.Errorf("column chunk %d of row group %d has no metadata", j, i)
+			}
+			size, err := writeBloomFilter(w, filter, e, cc, i, j)
+			if err != nil {
+				return n, err
+			}
+			filterOffset, filterLength := offset+n, int32(size)
+			cc.MetaData.BloomFilterOffset, cc.MetaData.BloomFilterLength = &filterOffset, &filterLength
+			n += int64(size)
+		}
+	}
+	return n, nil
+}
+
+// writeBloomFilter writes the filter of cc, the chunk j of the row group i
+// of a file encrypted with e, to w and returns the number of bytes written.
+func writeBloomFilter(w io.Writer, filter *bloom.Filter, e *encryption.FileEncryptor, cc *thrift.ColumnChunk, i, j int) (int, error) {
+	ce, err := columnEncryptor(e, cc, i, j)
+	if err != nil {
+		return 0, err
+	}
+	if ce == nil {
+		return filter.Write(w)
+	}
+	n, err := writeModule(w, filter.Header().Write, ce, encryption.BloomFilterHeader)
+	if err != nil {
+		return n, fmt.Errorf("could not write bloom filter header: %s", err)
+	}
+	module, err := ce.Encrypt(filter.Bitset(), encryption.BloomFilterBitset, 0)
+	if err != nil {
+		return n, fmt.Errorf("could not write bloom filter bitset: %s", err)
+	}
+	dn, err := w.Write(module)
+	n += dn
+	if err != nil {
+		return n, fmt.Errorf("could not write bloom filter bitset: %s", err)
+	}
+	return n, nil
+}
+
+// pageIndex is the page index of a column chunk. The column index is nil
+// when the values of the column have no order.
+type pageIndex struct {
+	columnIndex *thrift.ColumnIndex
+	offsetIndex *thrift.OffsetIndex
+}
+
+// writePageIndexes writes the page indexes of the column chunks of
+// rowGroups, indexes[i][j] being the index of the chunk j of the row group
+// i, to w at offset in the file, after the row groups and before the file
+// metadata. As parquet-mr does, the column indexes of all the chunks are
+// written first, then their offset indexes. Their locations are set in the
+// column chunks. The indexes of the encrypted columns of a file encrypted
+// with e, nil if the file is not encrypted, are encrypted. It returns the
+// number of bytes written.
+func writePageIndexes(w io.Writer, offset int64, rowGroups []*thrift.RowGroup, indexes [][]pageIndex, e *encryption.FileEncryptor) (int64, error) {
+	if len(indexes) != len(rowGroups) {
+		return 0, fmt.Errorf("%d page indexes for %d row groups", len(indexes), len(rowGroups))
+	}
+	for i, rg := range rowGroups {
+		if len(indexes[i]) != len(rg.Columns) {
+			return 0, fmt.Errorf("%d page indexes for %d column chunks", len(indexes[i]), len(rg.Columns))
+		}
+	}
+
+	n := int64(0)
+	for i, rg := range rowGroups {
+		for j, cc := range rg.Columns {
+			ci := indexes[i][j].columnIndex
+			if ci == nil {
+				continue
+			}
+			ce, err := columnEncryptor(e, cc, i, j)
+			if err != nil {
+				return n, err
+			}
+			size, err := writeModule(w, ci.Write, ce, encryption.ColumnIndex)
+			if err != nil {
+				return n, fmt.Errorf("could not write column index: %s", err)
+			}
+			indexOffset, indexLength := offset+n, int32(size)
+			cc.ColumnIndexOffset, cc.ColumnIndexLength = &indexOffset, &indexLength
+			n += int64(size)
+		}
+	}
+	for i, rg := range rowGroups {
+		for j, cc := range rg.Columns {
+			oi := indexes[i][j].offsetIndex
+			if oi == nil {
+				continue
+			}
+			ce, err := columnEncryptor(e, cc, i, j)
+			if err != nil {
+				return n, err
+			}
+			size, err := writeModule(w, oi.Write, ce, encryption.OffsetIndex)
+			if err != nil {
+				return n, fmt.Errorf("could not write offset index: %s", err)
+			}
+			indexOffset, indexLength := offset+n, int32(size)
+			cc.OffsetIndexOffset, cc.OffsetIndexLength = &indexOffset, &indexLength
+			n += int64(size)
+		}
+	}
+	return n, nil
+}
+
+// ReadMetadata reads the FileMetaData stored at the end of the parquet file
+// r of size bytes: the schema, the row groups and the metadata of their
+// column chunks. The files with an encrypted footer are read with
+// OpenFileWithOptions and their keys.
 //
 // Parquet format is described here:
 // https://github.com/apache/parquet-format/blob/master/README.md
 // Note that the File Metadata is at the END of the file.
 //
-func readFileMetaData(r io.ReadSeeker) (*thrift.FileMetaData, error) {
-	_, err := r.Seek(0, os.SEEK_SET)
-	if err != nil {
-		return nil, fmt.Errorf("read metadata: error seeking to header: %s", err)
+func ReadMetadata(r io.ReaderAt, size int64) (*thrift.FileMetaData, error) {
+	meta, _, err := readMetadata(r, size, nil)
+	return meta, err
+}
+
+// readMetadata is like ReadMetadata but the metadata of an encrypted file is
+// decrypted with properties, nil if the keys are not known. It also returns
+// the decryptor of the modules of the file, nil if the file is not
+// encrypted or without properties.
+func readMetadata(r io.ReaderAt, size int64, properties *encryption.DecryptionProperties) (*thrift.FileMetaData, *encryption.FileDecryptor, error) {
+	if size < magicSize+footerSize {
+		return nil, nil, fmt.Errorf("read metadata: file of %d bytes is too small", size)
 	}
 
 	buf := make([]byte, magicSize, magicSize)
 	// read and validate header
-	_, err = io.ReadFull(r, buf)
-	if err != nil {
-		return nil, fmt.Errorf("read metadata: error reading header: %s", err)
+	if _, err := r.ReadAt(buf, 0); err != nil {
+		return nil, nil, fmt.Errorf("read metadata: error reading header: %s", err)
 	}
-	if !bytes.Equal(buf, parquetMagic) {
-		return nil, ErrNotParquetFile
+	if !bytes.Equal(buf, parquetMagic) && !bytes.Equal(buf, encryptedMagic) {
+		return nil, nil, ErrNotParquetFile
 	}
 
 	// read and validate footer
-	_, err = r.Seek(-magicSize, os.SEEK_END)
+	footer := make([]byte, footerSize)
+	if _, err := r.ReadAt(footer, size-footerSize); err != nil {
+		return nil, nil, fmt.Errorf("read metadata: error reading footer: %s", err)
+	}
+	if !bytes.Equal(footer[magicSize:], buf) {
+		return nil, nil, ErrNotParquetFile
+	}
+	encryptedFooter := bytes.Equal(buf, encryptedMagic)
+
+	footerLength := int64(int32(binary.LittleEndian.Uint32(footer)))
+	if footerLength <= 0 || footerLength > size-footerSize-magicSize {
+		return nil, nil, fmt.Errorf("read metadata: invalid footer length %d", footerLength)
+	}
+	if encryptedFooter && properties == nil {
+		return nil, nil, fmt.Errorf("read metadata: the footer is encrypted, the keys of the file are required")
+	}
+
+	data := make([]byte, footerLength)
+	if _, err := r.ReadAt(data, size-footerSize-footerLength); err != nil {
+		return nil, nil, fmt.Errorf("read metadata: error reading file: %s", err)
+	}
+
+	var decryptor *encryption.FileDecryptor
+	if encryptedFooter {
+		var crypto thrift.FileCryptoMetaData
+		n, err := crypto.ReadFrom(bytes.NewReader(data))
+		if err != nil {
+			return nil, nil, fmt.Errorf("read metadata: error reading crypto metadata: %s", err)
+		}
+		if decryptor, err = encryption.NewFileDecryptor(properties, crypto.GetEncryptionAlgorithm(), crypto.GetKeyMetadata()); err != nil {
+			return nil, nil, fmt.Errorf("read metadata: %s", err)
+		}
+		if data, err = decryptor.DecryptFooter(data[n:]); err != nil {
+			return nil, nil, fmt.Errorf("read metadata: %s", err)
+		}
+	}
+
+	// read file metadata
+	var meta thrift.FileMetaData
+	n, err := meta.ReadFrom(bytes.NewReader(data))
 	if err != nil {
-		return nil, fmt.Errorf("read metadata: error seeking to footer: %s", err)
+		return nil, nil, fmt.Errorf("read metadata: error reading file: %s", err)
+	}
+
+	if !encryptedFooter && meta.IsSetEncryptionAlgorithm() && properties != nil {
+		// the plaintext footer is followed by its signature
+		if decryptor, err = encryption.NewFileDecryptor(properties, meta.GetEncryptionAlgorithm(), meta.GetFooterSigningKeyMetadata()); err != nil {
+			return nil, nil, fmt.Errorf("read metadata: %s", err)
+		}
+		if !properties.SkipFooterVerification {
+			if err := decryptor.VerifyFooter(data[:n], data[n:]); err != nil {
+				return nil, nil, fmt.Errorf("read metadata: %s", err)
+			}
+		}
+	}
+	if decryptor != nil {
+		if err := decryptColumnMetadata(&meta, decryptor); err != nil {
+			return nil, nil, fmt.Errorf("read metadata: %s", err)
+		}
+	}
+
+	return &meta, decryptor, nil
+}
+
+// decryptColumnMetadata replaces the metadata of the encrypted column
+// chunks of meta by their encrypted_column_metadata, set for the chunks
+// encrypted with their own key or in a plaintext footer. The chunks whose
+// key is not known keep their metadata, only their path if the footer is
+// encrypted: they cannot be read.
+func decryptColumnMetadata(meta *thrift.FileMetaData, decryptor *encryption.FileDecryptor) error {
+	for i, rg := range meta.GetRowGroups() {
+		for j, cc := range rg.GetColumns() {
+			crypto := cc.GetCryptoMetadata()
+			if crypto == nil {
+				continue
+			}
+			if !decryptor.CanDecrypt(crypto) || !cc.IsSetEncryptedColumnMetadata() {
+				if cc.MetaData == nil && crypto.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+					cc.MetaData = &thrift.ColumnMetaData{PathInSchema: crypto.GetENCRYPTION_WITH_COLUMN_KEY().GetPathInSchema()}
+				}
+				continue
+			}
+			d, err := decryptor.ColumnDecryptor(crypto, i, j)
+			if err != nil {
+				return err
+			}
+			if cc.MetaData, err = d.DecryptColumnMetaData(cc.GetEncryptedColumnMetadata()); err != nil {
+				return fmt.Errorf("column chunk %d of row group %d: %s", j, i, err)
+			}
+		}
 	}
-	_, err = io.ReadFull(r, buf)
+	return nil
+}
+
+// readFileMetaData reads thrift.FileMetaData object from r that provides
+// read interface to data in parquet format.
+func readFileMetaData(r io.ReadSeeker) (*thrift.FileMetaData, error) {
+	size, err := r.Seek(0, os.SEEK_END)
 	if err != nil {
-		return nil, fmt.Errorf("read metadata: error reading footer: %s", err)
+		return nil, fmt.Errorf("read metadata: error seeking to footer: %s", err)
 	}
 
-	if !bytes.Equal(buf, parquetMagic) {
-		return nil, ErrNotParquetFile
+	ra, ok := r.(io.ReaderAt)
+	if !ok {
+		ra = &readSeekerAt{rs: r}
 	}
+	return ReadMetadata(ra, size)
+}
+
+// File is a parquet file read through an io.ReaderAt: a local file, a
+// memory mapped region or a remote blob. Only the metadata is read when the
+// file is opened, the data is read at random offsets as needed.
+type File struct {
+	r         io.ReaderAt
+	size      int64
+	meta      *thrift.FileMetaData
+	schema    *Schema
+	options   ReaderOptions
+	decryptor *encryption.FileDecryptor // nil if the file is not encrypted
+}
+
+// OpenFile reads the metadata of the parquet file r of size bytes.
+func OpenFile(r io.ReaderAt, size int64) (*File, error) {
+	return OpenFileWithOptions(r, size, ReaderOptions{})
+}
 
-	_, err = r.Seek(-footerSize, os.SEEK_END)
+// OpenFileWithOptions is like OpenFile but the pages are read with the given
+// options. The keys of an encrypted file are given by options.Decryption.
+func OpenFileWithOptions(r io.ReaderAt, size int64, options ReaderOptions) (*File, error) {
+	meta, decryptor, err := readMetadata(r, size, options.Decryption)
 	if err != nil {
-		return nil, fmt.Errorf("read metadata: error seeking to footer length: %s", err)
+		return nil, err
 	}
-	var footerLength int32
-	err = binary.Read(r, binary.LittleEndian, &footerLength)
+
+	schema, err := schemaFromFileMetaData(meta)
 	if err != nil {
-		return nil, fmt.Errorf("read metadata: error reading footer length: %s", err)
+		return nil, fmt.Errorf("could not read schema: %s", err)
 	}
-	if footerLength <= 0 {
-		return nil, fmt.Errorf("read metadata: invalid footer length %d", footerLength)
+
+	return &File{r: r, size: size, meta: meta, schema: schema, options: options, decryptor: decryptor}, nil
+}
+
+// Schema returns the schema of the file.
+func (f *File) Schema() *Schema {
+	return f.schema
+}
+
+// Metadata returns the metadata stored in the footer of the file.
+func (f *File) Metadata() *thrift.FileMetaData {
+	return f.meta
+}
+
+// Encrypted returns whether the file is encrypted. Without keys only the
+// files with a plaintext footer can be opened: their schema, their row
+// groups and the metadata of their plaintext columns can be read, the
+// statistics of their encrypted columns are not in the footer.
+func (f *File) Encrypted() bool {
+	return f.decryptor != nil || f.meta.IsSetEncryptionAlgorithm()
+}
+
+// KeyValueMetadata returns the key/value metadata of the file, nil if it has
+// none.
+func (f *File) KeyValueMetadata() map[string]string {
+	return thrift.KeyValueMap(f.meta.GetKeyValueMetadata())
+}
+
+// RowGroups returns the row groups of the file.
+func (f *File) RowGroups() []*RowGroup {
+	rowGroups := make([]*RowGroup, len(f.meta.GetRowGroups()))
+	for i, rg := range f.meta.GetRowGroups() {
+		rowGroups[i] = &RowGroup{file: f, index: i, meta: rg}
 	}
+	return rowGroups
+}
 
-	// read file metadata
-	_, err = r.Seek(-footerSize-int64(footerLength), os.SEEK_END)
-	if err != nil {
-		return nil, fmt.Errorf("read metadata: error seeking to file: %s", err)
+// ColumnScanner returns a single scanner across all the Row Groups
+func (f *File) ColumnScanner(colname string) (*column.Scanner, error) {
+	col := f.schema.ColumnByName(colname)
+	if col == nil {
+		return nil, fmt.Errorf("no column %s", colname)
 	}
-	var meta thrift.FileMetaData
-	err = meta.Read(io.LimitReader(r, int64(footerLength)))
+
+	chunks, err := f.meta.GetColumnChunks(colname)
 	if err != nil {
-		return nil, fmt.Errorf("read metadata: error reading file: %s", err)
+		return nil, fmt.Errorf("could not get columnChunks: %s", err)
 	}
 
-	return &meta, nil
+	return column.NewScanner(io.NewSectionReader(f.r, 0, f.size), col.SchemaElement, chunks), nil
 }
 
 // FileDescriptor implements ReadSeekCloser
@@ -129,8 +547,8 @@ type FileDescriptor struct {
 	schema *Schema
 }
 
-// OpenFile reads the content of a file in parquet format
-func OpenFile(path string) (*FileDescriptor, error) {
+// Open opens the file path in parquet format
+func Open(path string) (*FileDescriptor, error) {
 	r, err := os.Open(path)
 	if err != nil {
 		return nil, fmt.Errorf("could not open %s: %s", path, err)
@@ -0,0 +1,561 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestReadMetadata(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/alltypes_plain.parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, err := ReadMetadata(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if meta.NumRows != 8 {
+		t.Errorf("NumRows: was %d, expected 8", meta.NumRows)
+	}
+	if len(meta.RowGroups) != 1 {
+		t.Fatalf("RowGroups: was %d, expected 1", len(meta.RowGroups))
+	}
+	if n := len(meta.RowGroups[0].Columns); n != len(meta.Schema)-1 {
+		t.Errorf("Columns: was %d, expected %d", n, len(meta.Schema)-1)
+	}
+	for _, col := range meta.RowGroups[0].Columns {
+		if col.MetaData == nil || col.MetaData.NumValues != 8 {
+			t.Errorf("unexpected column chunk metadata %+v", col.MetaData)
+		}
+	}
+
+	// the footer must be found relative to size, not to the end of r
+	if _, err := ReadMetadata(bytes.NewReader(append(b, 0, 0)), int64(len(b))); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if _, err := ReadMetadata(bytes.NewReader(b), int64(len(b))-1); err == nil {
+		t.Errorf("expected an error for a truncated file")
+	}
+	if _, err := ReadMetadata(bytes.NewReader(b[:10]), 10); err == nil {
+		t.Errorf("expected an error for a file too small")
+	}
+}
+
+func TestOpenFile(t *testing.T) {
+	f, err := os.Open("testdata/alltypes_plain.parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pf, err := OpenFile(f, info.Size())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if pf.Metadata().NumRows != 8 {
+		t.Errorf("NumRows: was %d, expected 8", pf.Metadata().NumRows)
+	}
+	if n := len(pf.Schema().Columns()); n != 11 {
+		t.Errorf("Columns: was %d, expected 11", n)
+	}
+
+	rowGroups := pf.RowGroups()
+	if len(rowGroups) != 1 || rowGroups[0].NumRows() != 8 {
+		t.Errorf("unexpected row groups %v", rowGroups)
+	}
+
+	if _, err := pf.ColumnScanner("id"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if _, err := pf.ColumnScanner("missing"); err == nil {
+		t.Errorf("expected an error for a missing column")
+	}
+}
+
+func TestWritePageIndexes(t *testing.T) {
+	rowGroups := []*thrift.RowGroup{
+		{Columns: []*thrift.ColumnChunk{{}, {}}},
+		{Columns: []*thrift.ColumnChunk{{}, {}}},
+	}
+	newIndex := func(min, max byte) pageIndex {
+		ci := &thrift.ColumnIndex{
+			NullPages:     []bool{false},
+			MinValues:     [][]byte{{min}},
+			MaxValues:     [][]byte{{max}},
+			BoundaryOrder: thrift.BoundaryOrder_ASCENDING,
+			NullCounts:    []int64{0},
+		}
+		oi := &thrift.OffsetIndex{PageLocations: []*thrift.PageLocation{{Offset: int64(min), CompressedPageSize: 10}}}
+		return pageIndex{columnIndex: ci, offsetIndex: oi}
+	}
+	indexes := [][]pageIndex{
+		{newIndex(1, 2), {offsetIndex: newIndex(3, 4).offsetIndex}},
+		{newIndex(5, 6), newIndex(7, 8)},
+	}
+
+	var b bytes.Buffer
+	b.WriteString("PAR1")
+	n, err := writePageIndexes(&b, 4, rowGroups, indexes, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(b.Len()-4) {
+		t.Errorf("wrote %d bytes, reported %d", b.Len()-4, n)
+	}
+
+	data := b.Bytes()
+	lastColumnIndex := int64(0)
+	for i, rg := range rowGroups {
+		for j, cc := range rg.Columns {
+			want := indexes[i][j]
+			if want.columnIndex == nil {
+				if cc.ColumnIndexOffset != nil {
+					t.Errorf("chunk %d.%d: column index written", i, j)
+				}
+			} else {
+				var ci thrift.ColumnIndex
+				start := cc.GetColumnIndexOffset()
+				lastColumnIndex = start
+				if err := ci.Read(bytes.NewReader(data[start : start+int64(cc.GetColumnIndexLength())])); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(&ci, want.columnIndex) {
+					t.Errorf("chunk %d.%d: got column index %v", i, j, &ci)
+				}
+			}
+
+			var oi thrift.OffsetIndex
+			start := cc.GetOffsetIndexOffset()
+			if start <= lastColumnIndex {
+				t.Errorf("chunk %d.%d: offset index at %d before the column indexes", i, j, start)
+			}
+			if err := oi.Read(bytes.NewReader(data[start : start+int64(cc.GetOffsetIndexLength())])); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(&oi, want.offsetIndex) {
+				t.Errorf("chunk %d.%d: got offset index %v", i, j, &oi)
+			}
+		}
+	}
+
+	if _, err := writePageIndexes(&b, 0, rowGroups, indexes[:1], nil); err == nil {
+		t.Errorf("no error for missing page indexes")
+	}
+}
+
+func TestWriteBloomFilters(t *testing.T) {
+	rowGroups := []*thrift.RowGroup{
+		{Columns: []*thrift.ColumnChunk{{MetaData: &thrift.ColumnMetaData{}}, {MetaData: &thrift.ColumnMetaData{}}}},
+		{Columns: []*thrift.ColumnChunk{{MetaData: &thrift.ColumnMetaData{}}, {MetaData: &thrift.ColumnMetaData{}}}},
+	}
+	newFilter := func(values ...int64) *bloom.Filter {
+		f := bloom.New(64)
+		for _, v := range values {
+			f.Insert(v)
+		}
+		return f
+	}
+	filters := [][]*bloom.Filter{
+		{newFilter(1, 2), nil},
+		{newFilter(3), newFilter(4, 5)},
+	}
+
+	var b bytes.Buffer
+	b.WriteString("PAR1")
+	n, err := writeBloomFilters(&b, 4, rowGroups, filters, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(b.Len()-4) {
+		t.Errorf("wrote %d bytes, reported %d", b.Len()-4, n)
+	}
+
+	data := b.Bytes()
+	for i, rg := range rowGroups {
+		for j, cc := range rg.Columns {
+			meta := cc.GetMetaData()
+			if filters[i][j] == nil {
+				if meta.BloomFilterOffset != nil || meta.BloomFilterLength != nil {
+					t.Errorf("chunk %d.%d: bloom filter written", i, j)
+				}
+				continue
+			}
+			start := meta.GetBloomFilterOffset()
+			f, err := bloom.Read(bytes.NewReader(data[start : start+int64(meta.GetBloomFilterLength())]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var b1, b2 bytes.Buffer
+			f.Write(&b1)
+			filters[i][j].Write(&b2)
+			if !bytes.Equal(b1.Bytes(), b2.Bytes()) {
+				t.Errorf("chunk %d.%d: got a different bloom filter", i, j)
+			}
+		}
+	}
+
+	if _, err := writeBloomFilters(&b, 0, rowGroups, filters[:1], nil); err == nil {
+		t.Errorf("no error for missing bloom filters")
+	}
+}
+
+// encryptedTestMetadata returns the metadata of a file of one row group of
+// two columns, "a" encrypted with the footer key and "b" with columnKey.
+func encryptedTestMetadata(t *testing.T, fileAAD, columnKey []byte) *thrift.FileMetaData {
+	numChildren := int32(2)
+	meta := &thrift.FileMetaData{
+		Schema:  []*thrift.SchemaElement{{Name: "root", NumChildren: &numChildren}},
+		NumRows: 1,
+	}
+	var columns []*thrift.ColumnChunk
+	for _, name := range []string{"a", "b"} {
+		meta.Schema = append(meta.Schema, &thrift.SchemaElement{
+			Name:           name,
+			Type:           thrift.TypePtr(thrift.Type_INT64),
+			RepetitionType: thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED),
+		})
+		columns = append(columns, &thrift.ColumnChunk{MetaData: &thrift.ColumnMetaData{
+			Type:         thrift.Type_INT64,
+			Encodings:    []thrift.Encoding{thrift.Encoding_PLAIN},
+			PathInSchema: []string{name},
+			NumValues:    1,
+		}})
+	}
+	columns[0].CryptoMetadata = &thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_FOOTER_KEY: thrift.NewEncryptionWithFooterKey()}
+	columns[1].CryptoMetadata = &thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_COLUMN_KEY: &thrift.EncryptionWithColumnKey{PathInSchema: []string{"b"}}}
+
+	var plain bytes.Buffer
+	if _, err := columns[1].MetaData.Write(&plain); err != nil {
+		t.Fatal(err)
+	}
+	c, err := encryption.NewGCM(columnKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aad, err := encryption.ModuleAAD(fileAAD, encryption.ColumnMetaData, 0, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if columns[1].EncryptedColumnMetadata, err = c.Encrypt(plain.Bytes(), aad); err != nil {
+		t.Fatal(err)
+	}
+	columns[1].MetaData = nil
+
+	meta.RowGroups = []*thrift.RowGroup{{Columns: columns, NumRows: 1}}
+	return meta
+}
+
+func TestOpenFileEncryptedFooter(t *testing.T) {
+	footerKey, columnKey := []byte("0123456789012345"), []byte("1234567890123450")
+	fileAAD := []byte("unique")
+	algorithm := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadFileUnique: fileAAD}}
+	meta := encryptedTestMetadata(t, fileAAD, columnKey)
+
+	var footer bytes.Buffer
+	if _, err := meta.Write(&footer); err != nil {
+		t.Fatal(err)
+	}
+	c, err := encryption.NewGCM(footerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aad, err := encryption.ModuleAAD(fileAAD, encryption.Footer, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err := c.Encrypt(footer.Bytes(), aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	b.Write(encryptedMagic)
+	crypto := &thrift.FileCryptoMetaData{EncryptionAlgorithm: algorithm}
+	n, err := crypto.Write(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Write(module)
+	if err := binary.Write(&b, binary.LittleEndian, int32(n+len(module))); err != nil {
+		t.Fatal(err)
+	}
+	b.Write(encryptedMagic)
+	data := b.Bytes()
+
+	if _, err := OpenFile(bytes.NewReader(data), int64(len(data))); err == nil {
+		t.Errorf("no error without keys")
+	}
+	options := ReaderOptions{Decryption: &encryption.DecryptionProperties{FooterKey: []byte("1234567890123456")}}
+	if _, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err == nil {
+		t.Errorf("no error with another footer key")
+	}
+
+	options.Decryption = &encryption.DecryptionProperties{FooterKey: footerKey, ColumnKeys: map[string][]byte{"b": columnKey}}
+	f, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunks := f.RowGroups()[0].ColumnChunks()
+	for _, cc := range chunks {
+		if !cc.Encrypted() || cc.Metadata().GetType() != thrift.Type_INT64 || cc.NumValues() != 1 {
+			t.Errorf("column %s: got metadata %v", cc.Name(), cc.Metadata())
+		}
+	}
+	if chunks[1].Name() != "b" {
+		t.Errorf("got column %s, want b", chunks[1].Name())
+	}
+
+	// without its key, the column can only be named
+	options.Decryption = &encryption.DecryptionProperties{FooterKey: footerKey}
+	if f, err = OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err != nil {
+		t.Fatal(err)
+	}
+	cc := f.RowGroups()[0].ColumnChunk("b")
+	if cc == nil || cc.NumValues() != 0 {
+		t.Fatalf("got chunk %v without the column key", cc)
+	}
+	if _, err := cc.Pages(); err == nil {
+		t.Errorf("no error reading the pages without the column key")
+	}
+}
+
+func TestOpenFilePlaintextFooter(t *testing.T) {
+	footerKey, columnKey := []byte("0123456789012345"), []byte("1234567890123450")
+	fileAAD := []byte("unique")
+	algorithm := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadFileUnique: fileAAD}}
+	meta := encryptedTestMetadata(t, fileAAD, columnKey)
+	meta.EncryptionAlgorithm = algorithm
+	// the plaintext footer has the metadata of the column encrypted with
+	// the footer key, stripped from the column encrypted with its own key
+	meta.RowGroups[0].Columns[1].MetaData = &thrift.ColumnMetaData{Type: thrift.Type_INT64, PathInSchema: []string{"b"}}
+
+	var footer bytes.Buffer
+	if _, err := meta.Write(&footer); err != nil {
+		t.Fatal(err)
+	}
+	c, err := encryption.NewGCM(footerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aad, err := encryption.ModuleAAD(fileAAD, encryption.Footer, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err := c.Encrypt(footer.Bytes(), aad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signature := append(append([]byte(nil), module[4:4+encryption.NonceSize]...), module[len(module)-encryption.TagSize:]...)
+
+	file := func(signature []byte) []byte {
+		var b bytes.Buffer
+		b.Write(parquetMagic)
+		b.Write(footer.Bytes())
+		b.Write(signature)
+		if err := binary.Write(&b, binary.LittleEndian, int32(footer.Len()+len(signature))); err != nil {
+			t.Fatal(err)
+		}
+		b.Write(parquetMagic)
+		return b.Bytes()
+	}
+	data := file(signature)
+
+	// the plaintext columns can be read without the keys
+	f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.RowGroups()[0].ColumnChunk("a").Pages(); err == nil {
+		t.Errorf("no error reading the pages of an encrypted column without keys")
+	}
+
+	options := ReaderOptions{Decryption: &encryption.DecryptionProperties{FooterKey: footerKey, ColumnKeys: map[string][]byte{"b": columnKey}}}
+	if f, err = OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err != nil {
+		t.Fatal(err)
+	}
+	if n := f.RowGroups()[0].ColumnChunk("b").NumValues(); n != 1 {
+		t.Errorf("got %d values in the decrypted metadata, want 1", n)
+	}
+
+	tampered := append([]byte(nil), signature...)
+	tampered[len(tampered)-1] ^= 1
+	data = file(tampered)
+	if _, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err == nil {
+		t.Errorf("no error for a wrong signature")
+	}
+	options.Decryption.SkipFooterVerification = true
+	if _, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err != nil {
+		t.Errorf("unexpected error without verification: %s", err)
+	}
+}
+
+func TestOpenFilePlaintextFooterWithoutKeys(t *testing.T) {
+	footerKey, columnKey := []byte("0123456789012345"), []byte("1234567890123450")
+	column, err := encryption.NewColumnEncryptionPropertiesBuilder("b").WithKey(columnKey).WithKeyID("kb").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	properties, err := encryption.NewFileEncryptionPropertiesBuilder(footerKey).WithPlaintextFooter().WithEncryptedColumns(column).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := encryption.NewFileEncryptor(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numChildren, min, max := int32(2), []byte{1, 0, 0, 0, 0, 0, 0, 0}, []byte{9, 0, 0, 0, 0, 0, 0, 0}
+	meta := &thrift.FileMetaData{
+		Schema:  []*thrift.SchemaElement{{Name: "root", NumChildren: &numChildren}},
+		NumRows: 5,
+	}
+	var columns []*thrift.ColumnChunk
+	for _, name := range []string{"a", "b"} {
+		meta.Schema = append(meta.Schema, &thrift.SchemaElement{
+			Name:           name,
+			Type:           thrift.TypePtr(thrift.Type_INT64),
+			RepetitionType: thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED),
+		})
+		columns = append(columns, &thrift.ColumnChunk{MetaData: &thrift.ColumnMetaData{
+			Type:         thrift.Type_INT64,
+			Encodings:    []thrift.Encoding{thrift.Encoding_PLAIN},
+			PathInSchema: []string{name},
+			NumValues:    5,
+			Statistics:   &thrift.Statistics{MinValue: min, MaxValue: max},
+			SizeStatistics: &thrift.SizeStatistics{
+				DefinitionLevelHistogram: []int64{0, 5},
+			},
+			GeospatialStatistics: &thrift.GeospatialStatistics{
+				Bbox: &thrift.BoundingBox{Xmin: 12.5, Xmax: 13.75, Ymin: 47.25, Ymax: 48.5},
+			},
+		}})
+	}
+	meta.RowGroups = []*thrift.RowGroup{{Columns: columns, NumRows: 5}}
+
+	var b bytes.Buffer
+	b.Write(parquetMagic)
+	if err := writeEncryptedFileMetadata(&b, meta, e); err != nil {
+		t.Fatal(err)
+	}
+	data := b.Bytes()
+
+	// a catalog inspects the file without the keys
+	f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Encrypted() {
+		t.Errorf("file not encrypted")
+	}
+	got := f.Schema().Columns()
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("got columns %v", got)
+	}
+	rg := f.RowGroups()[0]
+	if rg.NumRows() != 5 {
+		t.Errorf("got %d rows, want 5", rg.NumRows())
+	}
+	a, c := rg.ColumnChunk("a"), rg.ColumnChunk("b")
+	if a.Encrypted() || a.CryptoMetadata() != nil || !bytes.Equal(a.Statistics().GetMinValue(), min) {
+		t.Errorf("got plaintext column encrypted %t with statistics %s", a.Encrypted(), a.Statistics())
+	}
+	if !c.Encrypted() || c.NumValues() != 5 || c.Statistics() != nil {
+		t.Errorf("got encrypted column encrypted %t with %d values and statistics %s", c.Encrypted(), c.NumValues(), c.Statistics())
+	}
+	if c.GeospatialStatistics() != nil || c.meta.GetMetaData().GetSizeStatistics() != nil {
+		t.Errorf("got geospatial statistics %s and size statistics %s of the encrypted column",
+			c.GeospatialStatistics(), c.meta.GetMetaData().GetSizeStatistics())
+	}
+	if key := c.CryptoMetadata().GetENCRYPTION_WITH_COLUMN_KEY(); string(key.GetKeyMetadata()) != "kb" {
+		t.Errorf("got crypto metadata %s", c.CryptoMetadata())
+	}
+
+	// the keys restore the statistics of the encrypted column
+	options := ReaderOptions{Decryption: &encryption.DecryptionProperties{FooterKey: footerKey, ColumnKeys: map[string][]byte{"b": columnKey}}}
+	if f, err = OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err != nil {
+		t.Fatal(err)
+	}
+	c = f.RowGroups()[0].ColumnChunk("b")
+	if stats := c.Statistics(); !bytes.Equal(stats.GetMaxValue(), max) {
+		t.Errorf("got statistics %s of the decrypted column", stats)
+	}
+	if c.GeospatialStatistics().GetBbox() == nil || c.meta.GetMetaData().GetSizeStatistics() == nil {
+		t.Errorf("got geospatial statistics %s and size statistics %s of the decrypted column",
+			c.GeospatialStatistics(), c.meta.GetMetaData().GetSizeStatistics())
+	}
+
+	if f := (&File{meta: &thrift.FileMetaData{}}); f.Encrypted() {
+		t.Errorf("plaintext file encrypted")
+	}
+}
+
+// pathVerifier accepts the AAD prefix of the files written to path.
+type pathVerifier string
+
+func (v pathVerifier) VerifyAADPrefix(prefix []byte) error {
+	if string(prefix) != string(v) {
+		return fmt.Errorf("file written to %s, not %s", prefix, string(v))
+	}
+	return nil
+}
+
+func TestOpenFileAADPrefix(t *testing.T) {
+	key := []byte("0123456789012345")
+	values := []int32{1, 2, 3}
+	for _, stored := range []bool{true, false} {
+		builder := encryption.NewFileEncryptionPropertiesBuilder(key).WithAADPrefix([]byte("dataset/part-0.parquet"))
+		if !stored {
+			builder.WithoutAADPrefixStorage()
+		}
+		properties, err := builder.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := encryption.NewFileEncryptor(properties)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := writePageIndexTestFile(t, values, 64, e)
+
+		for _, tt := range []struct {
+			name     string
+			prefix   string
+			verifier encryption.AADPrefixVerifier
+			ok       bool
+		}{
+			{"supplied", "dataset/part-0.parquet", nil, true},
+			{"another supplied", "dataset/part-1.parquet", nil, false},
+			{"not supplied", "", nil, stored},
+			{"verified", "", pathVerifier("dataset/part-0.parquet"), stored},
+			{"supplied and verified", "dataset/part-0.parquet", pathVerifier("dataset/part-0.parquet"), true},
+			{"moved", "", pathVerifier("dataset/part-1.parquet"), false},
+		} {
+			decryption := &encryption.DecryptionProperties{FooterKey: key, AADPrefixVerifier: tt.verifier}
+			if tt.prefix != "" {
+				decryption.AADPrefix = []byte(tt.prefix)
+			}
+			_, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), ReaderOptions{Decryption: decryption})
+			if tt.ok && err != nil {
+				t.Errorf("stored %t, %s: %s", stored, tt.name, err)
+			} else if !tt.ok && err == nil {
+				t.Errorf("stored %t, %s: no error", stored, tt.name)
+			}
+		}
+	}
+}
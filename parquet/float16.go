@@ -0,0 +1,108 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// Float16 is an IEEE 754 half-precision floating point number, the value of
+// a FLOAT16 column, a FIXED_LEN_BYTE_ARRAY of 2 bytes storing it in little
+// endian order. The values of the FLOAT16 columns are read as float32, the
+// Writer takes float32 and Float16.
+type Float16 uint16
+
+// NewFloat16 returns the half-precision number nearest to f, rounded to
+// even: ±Inf if f is out of its range, NaN if f is NaN.
+func NewFloat16(f float32) Float16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+	if exp == 0xff {
+		if mant != 0 {
+			return Float16(sign | 0x7e00) // NaN
+		}
+		return Float16(sign | 0x7c00)
+	}
+
+	e := exp - 127 + 15
+	if e >= 0x1f {
+		return Float16(sign | 0x7c00)
+	}
+	var h, shift uint32
+	if e <= 0 {
+		// a subnormal half, a multiple of 2^-24
+		if e < -10 {
+			return Float16(sign)
+		}
+		mant |= 0x800000
+		shift = uint32(14 - e)
+	} else {
+		mant |= uint32(e) << 23
+		shift = 13
+	}
+	h = mant >> shift
+	rem, half := mant&(1<<shift-1), uint32(1)<<(shift-1)
+	if rem > half || (rem == half && h&1 == 1) {
+		h++ // may carry into the exponent, up to Inf
+	}
+	return Float16(sign | uint16(h))
+}
+
+// Float32 returns h as a float32, exactly.
+func (h Float16) Float32() float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0:
+		f := float32(mant) / (1 << 24)
+		if sign != 0 {
+			f = -f
+		}
+		return f
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// String returns h formatted as a float32.
+func (h Float16) String() string {
+	return fmt.Sprint(h.Float32())
+}
+
+// isFloat16 returns whether element is a FLOAT16 column.
+func isFloat16(element *thrift.SchemaElement) bool {
+	lt := element.GetLogicalType()
+	return lt != nil && lt.IsSetFLOAT16()
+}
+
+// checkFloat16 returns an error if the FLOAT16 column element is not a
+// FIXED_LEN_BYTE_ARRAY of 2 bytes.
+func checkFloat16(element *thrift.SchemaElement) error {
+	if element.GetType() != thrift.Type_FIXED_LEN_BYTE_ARRAY || element.GetTypeLength() != 2 {
+		return fmt.Errorf("FLOAT16 of type %s(%d)", element.GetType(), element.GetTypeLength())
+	}
+	return nil
+}
+
+// float16Value returns the float32 of the value v of a FLOAT16 column, v
+// itself if it is not 2 bytes.
+func float16Value(v interface{}) interface{} {
+	b, ok := v.([]byte)
+	if !ok || len(b) != 2 {
+		return v
+	}
+	return Float16(binary.LittleEndian.Uint16(b)).Float32()
+}
+
+// bytes returns the value of h in a FLOAT16 column.
+func (h Float16) bytes() []byte {
+	b := make([]byte, 2)
+	binary.LittleEndian.PutUint16(b, uint16(h))
+	return b
+}
@@ -0,0 +1,109 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func TestFloat16Conversions(t *testing.T) {
+	for _, test := range []struct {
+		f float32
+		h Float16
+	}{
+		{0, 0x0000},
+		{float32(math.Copysign(0, -1)), 0x8000},
+		{1, 0x3c00},
+		{-2, 0xc000},
+		{0.5, 0x3800},
+		{65504, 0x7bff}, // the largest half
+		{65520, 0x7c00}, // rounded up to Inf
+		{1e10, 0x7c00},  // out of range
+		{float32(math.Inf(-1)), 0xfc00},
+		{1.0 / (1 << 24), 0x0001},               // the smallest subnormal
+		{1.0 / (1 << 25), 0x0000},               // rounded to even
+		{3.0 / (1 << 25), 0x0002},               // rounded to even
+		{1.0 / (1 << 14), 0x0400},               // the smallest normal
+		{1 + 1.0/(1<<11), 0x3c00},               // rounded to even
+		{1 + 3.0/(1<<11), 0x3c02},               // rounded to even
+		{1 + 1.0/(1<<11) + 1.0/(1<<20), 0x3c01}, // rounded up
+	} {
+		if got := NewFloat16(test.f); got != test.h {
+			t.Errorf("NewFloat16(%g): got %#04x, want %#04x", test.f, uint16(got), uint16(test.h))
+		}
+	}
+	if f := NewFloat16(float32(math.NaN())).Float32(); f == f {
+		t.Errorf("got %g for NaN", f)
+	}
+	// every half is a float32
+	for i := 0; i < 1<<16; i++ {
+		h := Float16(i)
+		f := h.Float32()
+		if f != f {
+			continue
+		}
+		if got := NewFloat16(f); got != h {
+			t.Errorf("%#04x: got %#04x from %g", i, uint16(got), f)
+		}
+	}
+}
+
+func TestFloat16RoundTrip(t *testing.T) {
+	type record struct {
+		F Float16
+	}
+	s, err := SchemaOf(record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.String(), "message root {\n  required fixed_len_byte_array(2) F (FLOAT16);\n}\n"; got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	values := []interface{}{float32(1.5), float32(-2), NewFloat16(0.25), float32(math.NaN()), float32(math.Copysign(0, -1)), []byte{0x00, 0x3c}}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range values {
+		if err := w.WriteRow(map[string]interface{}{"F": v}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.WriteRow(map[string]interface{}{"F": 1.5}); err == nil {
+		t.Error("no error for a float64")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readTestRows(t, f)
+	for i, want := range []float32{1.5, -2, 0.25, float32(math.NaN()), 0, 1} {
+		got, ok := rows[i]["F"].(float32)
+		if !ok || (got != want && want == want) || (want != want && got == got) {
+			t.Errorf("row %d: got %v, want %g", i, rows[i]["F"], want)
+		}
+	}
+
+	// the statistics compare the numbers, not the bytes
+	stats := f.RowGroups()[0].ColumnChunks()[0].Statistics()
+	min := Float16(binary.LittleEndian.Uint16(stats.MinValue)).Float32()
+	max := Float16(binary.LittleEndian.Uint16(stats.MaxValue)).Float32()
+	if min != -2 || max != 1.5 {
+		t.Errorf("got min %g and max %g", min, max)
+	}
+
+	s, err = ParseSchema("message m { required fixed_len_byte_array(4) f (FLOAT16); }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&b, s, WriterOptions{}); err == nil {
+		t.Error("no error for a FLOAT16 of 4 bytes")
+	}
+}
@@ -0,0 +1,260 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The values of the GEOMETRY and the GEOGRAPHY columns, BYTE_ARRAY, are the
+// geometries in the Well-Known Binary format (WKB), read and written as
+// []byte, as they are. The Writer computes the GeospatialStatistics of their
+// column chunks instead of the min and the max, which are undefined: the
+// types of the geometries, and the bounding box of their coordinates for a
+// GEOMETRY column. The chunks with a value that is not valid WKB have no
+// GeospatialStatistics.
+
+// defaultCRS is the coordinate reference system of the GEOMETRY and the
+// GEOGRAPHY columns without one: the longitudes and the latitudes of WGS84.
+const defaultCRS = "OGC:CRS84"
+
+// isGeometry returns whether element is a GEOMETRY column.
+func isGeometry(element *thrift.SchemaElement) bool {
+	lt := element.GetLogicalType()
+	return lt != nil && lt.IsSetGEOMETRY()
+}
+
+// isGeography returns whether element is a GEOGRAPHY column.
+func isGeography(element *thrift.SchemaElement) bool {
+	lt := element.GetLogicalType()
+	return lt != nil && lt.IsSetGEOGRAPHY()
+}
+
+// checkGeospatial returns an error if the GEOMETRY or GEOGRAPHY column
+// element is not a BYTE_ARRAY or has an unknown edge interpolation
+// algorithm.
+func checkGeospatial(element *thrift.SchemaElement) error {
+	if t := element.GetType(); t != thrift.Type_BYTE_ARRAY {
+		return fmt.Errorf("%s of type %s", logicalTypeAnnotation(element), t)
+	}
+	if g := element.GetLogicalType().GetGEOGRAPHY(); g != nil && g.IsSetAlgorithm() && g.GetAlgorithm().String() == "<UNSET>" {
+		return fmt.Errorf("GEOGRAPHY: unknown edge interpolation algorithm %d", g.GetAlgorithm())
+	}
+	return nil
+}
+
+// CRS returns the coordinate reference system of the GEOMETRY or GEOGRAPHY
+// column, "OGC:CRS84" if it has none, "" for the other columns. A CRS
+// "projjson:<key>" is the PROJJSON of the key of the KeyValueMetadata of the
+// file.
+func (cd *ColumnDescriptor) CRS() string {
+	var crs *string
+	switch lt := cd.SchemaElement.GetLogicalType(); {
+	case isGeometry(cd.SchemaElement):
+		crs = lt.GEOMETRY.Crs
+	case isGeography(cd.SchemaElement):
+		crs = lt.GEOGRAPHY.Crs
+	default:
+		return ""
+	}
+	if crs == nil || *crs == "" {
+		return defaultCRS
+	}
+	return *crs
+}
+
+// errInvalidWKB is returned for the values that are not valid WKB.
+var errInvalidWKB = errors.New("invalid WKB")
+
+// The flags of the extended WKB of PostGIS in the type of a geometry.
+const (
+	ewkbZ    = 0x80000000
+	ewkbM    = 0x40000000
+	ewkbSRID = 0x20000000
+)
+
+// geospatialStatistics accumulates the GeospatialStatistics of a column
+// chunk of a GEOMETRY or a GEOGRAPHY column.
+type geospatialStatistics struct {
+	bbox     bool       // whether the bounding box is computed, for GEOMETRY
+	min, max [4]float64 // the bounds of x, y, z and m
+	types    map[int32]bool
+	invalid  bool // whether a value is not valid WKB
+}
+
+// newGeospatialStatistics returns the statistics of the column element, nil
+// if it is not a GEOMETRY or a GEOGRAPHY column.
+func newGeospatialStatistics(element *thrift.SchemaElement) *geospatialStatistics {
+	if !isGeometry(element) && !isGeography(element) {
+		return nil
+	}
+	s := &geospatialStatistics{bbox: isGeometry(element), types: make(map[int32]bool)}
+	for i := range s.min {
+		s.min[i], s.max[i] = math.Inf(1), math.Inf(-1)
+	}
+	return s
+}
+
+// add adds the geometry wkb to the statistics.
+func (s *geospatialStatistics) add(wkb []byte) {
+	if s.invalid {
+		return
+	}
+	code, rest, err := s.addGeometry(wkb)
+	if err != nil || len(rest) > 0 {
+		s.invalid = true
+		return
+	}
+	s.types[code] = true
+}
+
+// addGeometry adds the coordinates of the geometry at the start of b and
+// returns its ISO WKB type code, e.g. 1001 for a point with a z, and the
+// bytes that follow it.
+func (s *geospatialStatistics) addGeometry(b []byte) (int32, []byte, error) {
+	if len(b) < 5 {
+		return 0, nil, errInvalidWKB
+	}
+	var order binary.ByteOrder
+	switch b[0] {
+	case 0:
+		order = binary.BigEndian
+	case 1:
+		order = binary.LittleEndian
+	default:
+		return 0, nil, errInvalidWKB
+	}
+	t := order.Uint32(b[1:5])
+	b = b[5:]
+	hasZ, hasM := t&ewkbZ != 0, t&ewkbM != 0
+	if t&ewkbSRID != 0 {
+		if len(b) < 4 {
+			return 0, nil, errInvalidWKB
+		}
+		b = b[4:]
+	}
+	t &^= ewkbZ | ewkbM | ewkbSRID
+	switch t / 1000 {
+	case 0:
+	case 1:
+		hasZ = true
+	case 2:
+		hasM = true
+	case 3:
+		hasZ, hasM = true, true
+	default:
+		return 0, nil, errInvalidWKB
+	}
+	kind := t % 1000
+	code := int32(kind)
+	if hasZ {
+		code += 1000
+	}
+	if hasM {
+		code += 2000
+	}
+
+	count := func(b []byte) (int, []byte, error) {
+		if len(b) < 4 {
+			return 0, nil, errInvalidWKB
+		}
+		return int(order.Uint32(b)), b[4:], nil
+	}
+	points := func(b []byte, n int) ([]byte, error) {
+		for i := 0; i < n; i++ {
+			var err error
+			if b, err = s.addPoint(b, order, hasZ, hasM); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	var err error
+	switch kind {
+	case 1:
+		b, err = points(b, 1)
+	case 2:
+		var n int
+		if n, b, err = count(b); err == nil {
+			b, err = points(b, n)
+		}
+	case 3:
+		var rings int
+		rings, b, err = count(b)
+		for i := 0; i < rings && err == nil; i++ {
+			var n int
+			if n, b, err = count(b); err == nil {
+				b, err = points(b, n)
+			}
+		}
+	case 4, 5, 6, 7:
+		var n int
+		n, b, err = count(b)
+		for i := 0; i < n && err == nil; i++ {
+			_, b, err = s.addGeometry(b)
+		}
+	default:
+		err = errInvalidWKB
+	}
+	return code, b, err
+}
+
+// addPoint adds the coordinates of the point at the start of b, x and y,
+// then z and m if the point has them, and returns the bytes that follow it.
+// The coordinates NaN, of the empty points, are not added.
+func (s *geospatialStatistics) addPoint(b []byte, order binary.ByteOrder, hasZ, hasM bool) ([]byte, error) {
+	axes := []int{0, 1}
+	if hasZ {
+		axes = append(axes, 2)
+	}
+	if hasM {
+		axes = append(axes, 3)
+	}
+	if len(b) < 8*len(axes) {
+		return nil, errInvalidWKB
+	}
+	for i, axis := range axes {
+		v := math.Float64frombits(order.Uint64(b[8*i:]))
+		if math.IsNaN(v) {
+			continue
+		}
+		if v < s.min[axis] {
+			s.min[axis] = v
+		}
+		if v > s.max[axis] {
+			s.max[axis] = v
+		}
+	}
+	return b[8*len(axes):], nil
+}
+
+// thrift returns the GeospatialStatistics of the values added, nil if there
+// are none or a value is not valid WKB. The bounding box has the z and the
+// m of the values that have them.
+func (s *geospatialStatistics) thrift() *thrift.GeospatialStatistics {
+	if s == nil || s.invalid || len(s.types) == 0 {
+		return nil
+	}
+	stats := thrift.NewGeospatialStatistics()
+	for t := range s.types {
+		stats.GeospatialTypes = append(stats.GeospatialTypes, t)
+	}
+	sort.Slice(stats.GeospatialTypes, func(i, j int) bool { return stats.GeospatialTypes[i] < stats.GeospatialTypes[j] })
+	if !s.bbox || s.min[0] > s.max[0] || s.min[1] > s.max[1] {
+		// a GEOGRAPHY column, or only empty geometries
+		return stats
+	}
+	low, high := s.min, s.max
+	stats.Bbox = &thrift.BoundingBox{Xmin: low[0], Xmax: high[0], Ymin: low[1], Ymax: high[1]}
+	if low[2] <= high[2] {
+		stats.Bbox.Zmin, stats.Bbox.Zmax = &low[2], &high[2]
+	}
+	if low[3] <= high[3] {
+		stats.Bbox.Mmin, stats.Bbox.Mmax = &low[3], &high[3]
+	}
+	return stats
+}
@@ -0,0 +1,137 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// wkb returns the little-endian WKB of the geometry of type t, followed by
+// the counts and the coordinates values.
+func wkb(t uint32, values ...float64) []byte {
+	b := make([]byte, 5+8*len(values))
+	b[0] = 1
+	binary.LittleEndian.PutUint32(b[1:], t)
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(b[5+8*i:], math.Float64bits(v))
+	}
+	return b
+}
+
+// wkbCount returns the 4 bytes of the count n, to append to a WKB.
+func wkbCount(n uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, n)
+	return b
+}
+
+func TestGeospatialRoundTrip(t *testing.T) {
+	const schema = `message root {
+  optional binary geometry (GEOMETRY);
+  required binary geography (GEOGRAPHY(OGC:CRS84,KARNEY));
+  optional binary z (GEOMETRY(srid:4326));
+}`
+	line := append(wkb(2), wkbCount(2)...)
+	line = append(line, wkb(0, -5, 7, 3, -1)[5:]...)
+	polygonZ := append(wkb(1003), wkbCount(1)...)
+	polygonZ = append(polygonZ, wkbCount(2)...)
+	polygonZ = append(polygonZ, wkb(0, 1, 2, 10, 3, 4, -10)[5:]...)
+	rows := []map[string]interface{}{
+		{"geometry": wkb(1, 1, 2), "geography": wkb(1, 170, 10), "z": polygonZ},
+		{"geometry": line, "geography": string(wkb(1, -170, -10))},
+		{"geography": wkb(1, math.NaN(), math.NaN()), "z": wkb(1, math.NaN(), math.NaN())},
+	}
+	f := writeListTestFile(t, schema, WriterOptions{}, rows)
+	got := readTestRows(t, f)
+	want := []map[string]interface{}{
+		{"geometry": wkb(1, 1, 2), "geography": wkb(1, 170, 10), "z": polygonZ},
+		{"geometry": line, "geography": wkb(1, -170, -10), "z": nil},
+		{"geometry": nil, "geography": rows[2]["geography"], "z": rows[2]["z"]},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	s := f.Schema()
+	if got := s.String(); got != schema+"\n" {
+		t.Errorf("schema %s", got)
+	}
+	for name, crs := range map[string]string{"geometry": "OGC:CRS84", "geography": "OGC:CRS84", "z": "srid:4326"} {
+		if got := s.ColumnByName(name).CRS(); got != crs {
+			t.Errorf("CRS of %s: got %q, want %q", name, got, crs)
+		}
+	}
+
+	z1, z2 := -10.0, 10.0
+	for name, want := range map[string]*thrift.GeospatialStatistics{
+		"geometry": {
+			Bbox:            &thrift.BoundingBox{Xmin: -5, Xmax: 3, Ymin: -1, Ymax: 7},
+			GeospatialTypes: []int32{1, 2},
+		},
+		"geography": {GeospatialTypes: []int32{1}},
+		"z": {
+			Bbox:            &thrift.BoundingBox{Xmin: 1, Xmax: 3, Ymin: 2, Ymax: 4, Zmin: &z1, Zmax: &z2},
+			GeospatialTypes: []int32{1, 1003},
+		},
+	} {
+		cc := f.RowGroups()[0].ColumnChunk(name)
+		if got := cc.GeospatialStatistics(); !reflect.DeepEqual(got, want) {
+			t.Errorf("statistics of %s: got %v, want %v", name, got, want)
+		}
+		if stats := cc.Statistics(); stats.Min != nil || stats.Max != nil || stats.MinValue != nil || stats.MaxValue != nil {
+			t.Errorf("min and max of %s: %v", name, stats)
+		}
+	}
+}
+
+func TestGeospatialInvalidWKB(t *testing.T) {
+	const schema = `message root {
+  required binary geometry (GEOMETRY);
+}`
+	for _, value := range [][]byte{
+		{},
+		{2, 1, 0, 0, 0},
+		wkb(1, 1),
+		wkb(8),
+		wkb(4001),
+		append(wkb(1, 1, 2), 0),
+		append(wkb(2), wkbCount(3)...),
+	} {
+		rows := []map[string]interface{}{{"geometry": wkb(1, 1, 2)}, {"geometry": value}}
+		f := writeListTestFile(t, schema, WriterOptions{}, rows)
+		if stats := f.RowGroups()[0].ColumnChunk("geometry").GeospatialStatistics(); stats != nil {
+			t.Errorf("%v: statistics %v", value, stats)
+		}
+		if got := readTestRows(t, f); !bytes.Equal(got[1]["geometry"].([]byte), value) {
+			t.Errorf("got %v, want %v", got[1]["geometry"], value)
+		}
+	}
+}
+
+func TestGeospatialSchemaErrors(t *testing.T) {
+	s, err := ParseSchema(`message root {
+  required int32 geometry (GEOMETRY);
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&bytes.Buffer{}, s, WriterOptions{}); err == nil {
+		t.Error("no error for a GEOMETRY int32 column")
+	}
+	for _, schema := range []string{
+		`message root {
+  required binary g (GEOGRAPHY(OGC:CRS84,CUBIC));
+}`,
+		`message root {
+  required binary g (GEOMETRY(a,b));
+}`,
+	} {
+		if _, err := ParseSchema(schema); err == nil {
+			t.Errorf("no error for schema %s", schema)
+		}
+	}
+}
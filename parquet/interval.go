@@ -0,0 +1,59 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// Interval is a value of an INTERVAL column, a duration in months, days and
+// milliseconds, each independent of the others: a month has no fixed
+// number of days and a day no fixed number of milliseconds.
+type Interval struct {
+	Months       uint32
+	Days         uint32
+	Milliseconds uint32
+}
+
+// String returns the interval in the format "1 months 2 days 3 ms".
+func (i Interval) String() string {
+	return fmt.Sprintf("%d months %d days %d ms", i.Months, i.Days, i.Milliseconds)
+}
+
+// isInterval returns whether element is an INTERVAL column.
+func isInterval(element *thrift.SchemaElement) bool {
+	return element.GetConvertedType() == thrift.ConvertedType_INTERVAL
+}
+
+// checkInterval returns an error if the INTERVAL column element is not a
+// FIXED_LEN_BYTE_ARRAY of 12 bytes.
+func checkInterval(element *thrift.SchemaElement) error {
+	if element.GetType() != thrift.Type_FIXED_LEN_BYTE_ARRAY || element.GetTypeLength() != 12 {
+		return fmt.Errorf("INTERVAL of type %s(%d)", element.GetType(), element.GetTypeLength())
+	}
+	return nil
+}
+
+// intervalValue returns the Interval of the value v of an INTERVAL column,
+// three little-endian unsigned integers, v itself if it is not 12 bytes.
+func intervalValue(v interface{}) interface{} {
+	b, ok := v.([]byte)
+	if !ok || len(b) != 12 {
+		return v
+	}
+	return Interval{
+		Months:       binary.LittleEndian.Uint32(b[0:]),
+		Days:         binary.LittleEndian.Uint32(b[4:]),
+		Milliseconds: binary.LittleEndian.Uint32(b[8:]),
+	}
+}
+
+// bytes returns the value of i in an INTERVAL column.
+func (i Interval) bytes() []byte {
+	b := make([]byte, 12)
+	binary.LittleEndian.PutUint32(b[0:], i.Months)
+	binary.LittleEndian.PutUint32(b[4:], i.Days)
+	binary.LittleEndian.PutUint32(b[8:], i.Milliseconds)
+	return b
+}
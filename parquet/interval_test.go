@@ -0,0 +1,76 @@
+package parquet
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestIntervalRoundTrip(t *testing.T) {
+	type record struct {
+		Period Interval
+		Grace  *Interval
+	}
+	s, err := SchemaOf(record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `message root {
+  required fixed_len_byte_array(12) Period (INTERVAL);
+  optional fixed_len_byte_array(12) Grace (INTERVAL);
+}
+`
+	if got := s.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	intervals := []Interval{{}, {Months: 1, Days: 2, Milliseconds: 3}, {Months: 1<<32 - 1, Days: 30, Milliseconds: 86400000}}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range intervals {
+		if err := w.WriteRow(map[string]interface{}{"Period": i, "Grace": i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the bytes are written as they are
+	raw := []byte{1, 0, 0, 0, 2, 0, 0, 0, 3, 0, 0, 0}
+	if err := w.WriteRow(map[string]interface{}{"Period": raw}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(map[string]interface{}{"Period": raw[:8]}); err == nil {
+		t.Error("no error for 8 bytes")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readTestRows(t, f)
+	if len(rows) != len(intervals)+1 {
+		t.Fatalf("got %d rows", len(rows))
+	}
+	for i, want := range intervals {
+		if rows[i]["Period"] != want || rows[i]["Grace"] != want {
+			t.Errorf("row %d: got %v and %v, want %v", i, rows[i]["Period"], rows[i]["Grace"], want)
+		}
+	}
+	if got, want := rows[len(intervals)]["Period"], (Interval{1, 2, 3}); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := intervals[1].String(); got != "1 months 2 days 3 ms" {
+		t.Errorf("got %q", got)
+	}
+
+	s, err = ParseSchema("message m { required fixed_len_byte_array(8) i (INTERVAL); }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&b, s, WriterOptions{}); err == nil {
+		t.Error("no error for an INTERVAL of 8 bytes")
+	}
+}
@@ -1,6 +1,9 @@
 package parquet
 
-import "io"
+import (
+	"io"
+	"os"
+)
 
 // // CountingWriter counts the number of bytes written to it.
 // type CountingWriter struct {
@@ -37,6 +40,18 @@ func (r *countingReader) Read(p []byte) (n int, err error) {
 	return
 }
 
+// readSeekerAt implements io.ReaderAt on top of an io.ReadSeeker.
+type readSeekerAt struct {
+	rs io.ReadSeeker
+}
+
+func (r *readSeekerAt) ReadAt(p []byte, off int64) (int, error) {
+	if _, err := r.rs.Seek(off, os.SEEK_SET); err != nil {
+		return 0, err
+	}
+	return io.ReadFull(r.rs, p)
+}
+
 type nopCloser struct {
 	io.Writer
 }
@@ -0,0 +1,303 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func writeListTestFile(t *testing.T, schema string, options WriterOptions, rows []map[string]interface{}) *File {
+	s, err := ParseSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestListRoundTrip(t *testing.T) {
+	const schema = `message root {
+  required int64 id;
+  optional group tags (LIST) {
+    repeated group list {
+      optional binary element (STRING);
+    }
+  }
+  required group scores (LIST) {
+    repeated group list {
+      required int32 element;
+    }
+  }
+  optional group matrix (LIST) {
+    repeated group list {
+      required group element (LIST) {
+        repeated group list {
+          required double element;
+        }
+      }
+    }
+  }
+}`
+	var rows, want []map[string]interface{}
+	for i := 0; i < 100; i++ {
+		row := map[string]interface{}{"id": int64(i)}
+		expected := map[string]interface{}{"id": int64(i), "tags": nil, "scores": []interface{}{}, "matrix": nil}
+		switch i % 4 {
+		case 0:
+			row["tags"] = []string{"a", "b"}
+			expected["tags"] = []interface{}{[]byte("a"), []byte("b")}
+		case 1:
+			row["tags"] = []interface{}{nil, "c", nil}
+			expected["tags"] = []interface{}{nil, []byte("c"), nil}
+		case 2:
+			row["tags"] = []string{}
+			expected["tags"] = []interface{}{}
+		}
+		var scores []interface{}
+		for k := 0; k < i%6; k++ {
+			scores = append(scores, int32(i*k))
+		}
+		if scores != nil {
+			row["scores"] = scores
+			expected["scores"] = scores
+		}
+		if i%3 == 0 {
+			row["matrix"] = [][]float64{{1, 2}, {}, {float64(i)}}
+			expected["matrix"] = []interface{}{
+				[]interface{}{float64(1), float64(2)},
+				[]interface{}{},
+				[]interface{}{float64(i)},
+			}
+		}
+		rows = append(rows, row)
+		want = append(want, expected)
+	}
+
+	for _, options := range []WriterOptions{
+		{},
+		{ColumnOptions: ColumnOptions{PageValues: 3, DataPageVersion: 2}},
+		{ColumnOptions: ColumnOptions{DisableDictionary: true, PageValues: 5}},
+		{ColumnOptions: ColumnOptions{PageSize: 16}, Columns: map[string]ColumnOptions{"tags.list.element": {Encoding: "delta_byte_array"}}, RowGroupRows: 30},
+	} {
+		f := writeListTestFile(t, schema, options, rows)
+		got := readTestRows(t, f)
+		if len(got) != len(want) {
+			t.Fatalf("%+v: read %d rows, want %d", options, len(got), len(want))
+		}
+		for i := range want {
+			if !reflect.DeepEqual(got[i], want[i]) {
+				t.Errorf("%+v: row %d: got %v, want %v", options, i, got[i], want[i])
+			}
+		}
+
+		// the data pages start with a row
+		for _, rg := range f.RowGroups() {
+			for _, cc := range rg.ColumnChunks() {
+				pages, err := cc.Pages()
+				if err != nil {
+					t.Fatal(err)
+				}
+				for pages.Scan() {
+					p, ok := pages.DataPage()
+					if !ok {
+						continue
+					}
+					triples, err := p.Triples()
+					if err != nil {
+						t.Fatal(err)
+					}
+					if len(triples) == 0 || triples[0].R != 0 {
+						t.Errorf("%+v: column %s: a data page does not start with a row", options, cc.Name())
+					}
+				}
+				if err := pages.Err(); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+}
+
+func TestLegacyLists(t *testing.T) {
+	// the layouts of the old writers
+	const schema = `message root {
+  optional group a (LIST) {
+    repeated int32 array;
+  }
+  optional group b (LIST) {
+    repeated group array {
+      required binary s (STRING);
+    }
+  }
+  optional group c (LIST) {
+    repeated group c_tuple {
+      required int32 x;
+    }
+  }
+  optional group d (LIST) {
+    repeated group item {
+      required int32 x;
+      optional int32 y;
+    }
+  }
+  repeated int32 e;
+  repeated group f {
+    required int32 x;
+  }
+}`
+	rows := []map[string]interface{}{
+		{
+			"a": []int32{1, 2},
+			"b": []interface{}{map[string]interface{}{"s": "s"}},
+			"c": []interface{}{map[string]interface{}{"x": int32(3)}, map[string]interface{}{"x": int32(4)}},
+			"d": []interface{}{map[string]interface{}{"x": int32(5), "y": int32(6)}, map[string]interface{}{"x": int32(7)}},
+			"e": []int32{8, 9, 10},
+			"f": []interface{}{map[string]interface{}{"x": int32(11)}},
+		},
+		{},
+		{"a": []int32{}, "e": []int32{}},
+	}
+	f := writeListTestFile(t, schema, WriterOptions{}, rows)
+	for name, levels := range map[string]Levels{
+		"a.array":     {D: 2, R: 1},
+		"b.array.s":   {D: 2, R: 1},
+		"c.c_tuple.x": {D: 2, R: 1},
+		"d.item.y":    {D: 3, R: 1},
+		"e":           {D: 1, R: 1},
+		"f.x":         {D: 1, R: 1},
+	} {
+		if got := f.Schema().ColumnByName(name).MaxLevels; got != levels {
+			t.Errorf("column %s: got levels %+v, want %+v", name, got, levels)
+		}
+	}
+
+	got := readTestRows(t, f)
+	want := []map[string]interface{}{
+		{
+			"a": []interface{}{int32(1), int32(2)},
+			"b": []interface{}{map[string]interface{}{"s": []byte("s")}},
+			"c": []interface{}{map[string]interface{}{"x": int32(3)}, map[string]interface{}{"x": int32(4)}},
+			"d": []interface{}{map[string]interface{}{"x": int32(5), "y": int32(6)}, map[string]interface{}{"x": int32(7), "y": nil}},
+			"e": []interface{}{int32(8), int32(9), int32(10)},
+			"f": []interface{}{map[string]interface{}{"x": int32(11)}},
+		},
+		{"a": nil, "b": nil, "c": nil, "d": nil, "e": []interface{}{}, "f": []interface{}{}},
+		{"a": []interface{}{}, "b": nil, "c": nil, "d": nil, "e": []interface{}{}, "f": []interface{}{}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestRepeatedColumn(t *testing.T) {
+	s := NewSchema()
+	if err := s.AddColumnFromSpec("a: INT32 REPEATED"); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []map[string]interface{}{{"a": []int32{1, 2}}, {}, {"a": []int32{3}}} {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readTestRows(t, f)
+	want := []map[string]interface{}{
+		{"a": []interface{}{int32(1), int32(2)}},
+		{"a": []interface{}{}},
+		{"a": []interface{}{int32(3)}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestListErrors(t *testing.T) {
+	s, err := ParseSchema(`message root {
+  optional group tags (LIST) {
+    repeated group list {
+      required binary element (STRING);
+    }
+  }
+  repeated group points {
+    required int32 x;
+  }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []map[string]interface{}{
+		{"tags": "a"},
+		{"tags": []interface{}{"a", nil}},
+		{"tags": []int{1}},
+		{"points": []interface{}{map[string]interface{}{"x": int32(1), "y": int32(2)}}},
+		{"points": []interface{}{map[string]interface{}{}}},
+		{"points": []int32{1}},
+	} {
+		if err := w.WriteRow(row); err == nil {
+			t.Errorf("no error for row %v", row)
+		}
+	}
+	// the invalid rows are not written
+	if err := w.WriteRow(map[string]interface{}{"tags": []string{"a"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []map[string]interface{}{{"tags": []interface{}{[]byte("a")}, "points": []interface{}{}}}
+	if got := readTestRows(t, f); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if err := TransformColumns(&bytes.Buffer{}, f, map[string]Transform{"tags.list.element": nil}, WriterOptions{}); err == nil {
+		t.Errorf("no error transforming a nested column")
+	}
+
+	// a LIST group has one repeated field
+	s, err = ParseSchema(`message root {
+  optional group tags (LIST) {
+    optional binary element (STRING);
+  }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&bytes.Buffer{}, s, WriterOptions{}); err == nil {
+		t.Errorf("no error for an invalid LIST group")
+	}
+}
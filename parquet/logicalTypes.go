@@ -0,0 +1,228 @@
+package parquet
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The values of the columns of some logical types are read as Go values
+// rather than as the values of their physical type:
+//
+//	DECIMAL    *big.Rat, see decimalValue
+//	TIMESTAMP  time.Time, see timestampValue
+//	TIME       time.Duration, the time since midnight
+//	DATE       time.Time, the midnight UTC of the date
+//	UUID       [16]byte
+//	INTERVAL   Interval
+//	FLOAT16    float32
+//	UINT_N     uint8, uint16, uint32 or uint64
+//	JSON       see ReaderOptions.UnmarshalJSON
+//	BSON       see ReaderOptions.UnmarshalBSON
+//
+// The values of the GEOMETRY and the GEOGRAPHY columns are their WKB []byte,
+// see geospatial.go.
+//
+// The Writer takes both the Go values and the physical values, and the
+// values of the Go string types for the ENUM columns.
+
+// readValueFunc returns the function converting the values read from the
+// column name, of element, to the values of the rows, with options, nil if
+// the values are read as they are. The values are not nil.
+func readValueFunc(name string, element *thrift.SchemaElement, options ReaderOptions) func(v interface{}) (interface{}, error) {
+	convert := func(f func(v interface{}) interface{}) func(v interface{}) (interface{}, error) {
+		return func(v interface{}) (interface{}, error) {
+			return f(v), nil
+		}
+	}
+	if isDecimal(element) {
+		return convert(func(v interface{}) interface{} {
+			return decimalValue(element, v)
+		})
+	}
+	if unit, utc, ok := timestampOf(element); ok {
+		return convert(func(v interface{}) interface{} {
+			if ticks, ok := v.(int64); ok {
+				return timestampValue(ticks, unit, utc, options.Location)
+			}
+			return v
+		})
+	}
+	if unit, _, ok := timeOf(element); ok {
+		return convert(func(v interface{}) interface{} {
+			return timeValue(v, unit)
+		})
+	}
+	if element.GetType() == thrift.Type_INT96 {
+		return convert(int96Value)
+	}
+	if isDate(element) {
+		return convert(dateValue)
+	}
+	if isUUID(element) {
+		return convert(uuidValue)
+	}
+	if isInterval(element) {
+		return convert(intervalValue)
+	}
+	if isFloat16(element) {
+		return convert(float16Value)
+	}
+	if width := unsignedWidth(element); width > 0 {
+		return convert(func(v interface{}) interface{} {
+			return unsignedValue(v, width)
+		})
+	}
+	unmarshal := options.UnmarshalJSON
+	if isBSON(element) {
+		unmarshal = options.UnmarshalBSON
+	} else if !isJSON(element) {
+		unmarshal = nil
+	}
+	if unmarshal != nil {
+		return func(v interface{}) (interface{}, error) {
+			data, ok := v.([]byte)
+			if !ok {
+				return v, nil
+			}
+			u, err := unmarshal(name, data)
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %s", name, err)
+			}
+			return u, nil
+		}
+	}
+	return nil
+}
+
+// isJSON returns whether element is a JSON column.
+func isJSON(element *thrift.SchemaElement) bool {
+	if lt := element.GetLogicalType(); lt != nil {
+		return lt.IsSetJSON()
+	}
+	return element.GetConvertedType() == thrift.ConvertedType_JSON
+}
+
+// isBSON returns whether element is a BSON column.
+func isBSON(element *thrift.SchemaElement) bool {
+	if lt := element.GetLogicalType(); lt != nil {
+		return lt.IsSetBSON()
+	}
+	return element.GetConvertedType() == thrift.ConvertedType_BSON
+}
+
+// isEnum returns whether element is an ENUM column.
+func isEnum(element *thrift.SchemaElement) bool {
+	if lt := element.GetLogicalType(); lt != nil {
+		return lt.IsSetENUM()
+	}
+	return element.GetConvertedType() == thrift.ConvertedType_ENUM
+}
+
+// checkEnumValue returns an error if the value v of an ENUM column, a
+// []byte or a string, is not one of values.
+func checkEnumValue(v interface{}, values []string) error {
+	var s string
+	switch v := v.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	}
+	for _, value := range values {
+		if s == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("%q is not a value of the enum", s)
+}
+
+// checkLogicalType returns an error if the logical type of the column
+// element is not valid for its physical type.
+func checkLogicalType(element *thrift.SchemaElement) error {
+	switch {
+	case isDecimal(element):
+		return checkDecimal(element)
+	case isUUID(element):
+		return checkUUID(element)
+	case isInterval(element):
+		return checkInterval(element)
+	case isFloat16(element):
+		return checkFloat16(element)
+	case isUnsigned(element):
+		return checkUnsigned(element)
+	case isGeometry(element) || isGeography(element):
+		return checkGeospatial(element)
+	case isJSON(element) || isBSON(element) || isEnum(element):
+		if t := element.GetType(); t != thrift.Type_BYTE_ARRAY {
+			return fmt.Errorf("%s of type %s", logicalTypeAnnotation(element), t)
+		}
+	}
+	return nil
+}
+
+// physicalValue returns the value of the physical type of the column for
+// v, a Go value of its logical type, v itself otherwise.
+func (c *columnWriter) physicalValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case *big.Rat:
+		if v != nil && isDecimal(c.element) {
+			return unscaledValue(c.element, v)
+		}
+	case time.Time:
+		if unit, utc, ok := timestampOf(c.element); ok {
+			return timestampTicks(v, unit, utc, c.location)
+		}
+		if isDate(c.element) {
+			return dateDays(v)
+		}
+		if c.element.GetType() == thrift.Type_INT96 {
+			return int96Bytes(v), nil
+		}
+	case datatypes.Int96:
+		if c.element.GetType() == thrift.Type_INT96 {
+			return int96RawBytes(v), nil
+		}
+	case time.Duration:
+		if unit, _, ok := timeOf(c.element); ok {
+			return timeTicks(v, unit, c.element.GetType())
+		}
+		if c.element.GetType() == thrift.Type_INT64 && c.element.ConvertedType == nil && c.element.LogicalType == nil {
+			// the nanoseconds, e.g. of the fields of SchemaOf
+			return int64(v), nil
+		}
+	case Interval:
+		if isInterval(c.element) {
+			return v.bytes(), nil
+		}
+	case float32:
+		if isFloat16(c.element) {
+			return NewFloat16(v).bytes(), nil
+		}
+	case Float16:
+		if isFloat16(c.element) {
+			return v.bytes(), nil
+		}
+	}
+	if isUUID(c.element) {
+		if b, ok, err := uuidBytes(v); ok {
+			return b, err
+		}
+	}
+	if width := unsignedWidth(c.element); width > 0 {
+		if p, ok, err := unsignedPhysical(v, width, c.element.GetType()); ok {
+			return p, err
+		}
+	}
+	if isEnum(c.element) {
+		// the string types of the enums
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+			return rv.String(), nil
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,193 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestJSONColumns(t *testing.T) {
+	s, err := ParseSchema(`message m {
+  required binary doc (JSON);
+  optional binary raw (BSON);
+  required binary name (STRING);
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]string{"doc": "JSON", "raw": "BSON", "name": "STRING"} {
+		if got := s.ColumnByName(name).LogicalType(); got != want {
+			t.Errorf("column %s: got logical type %q, want %q", name, got, want)
+		}
+	}
+
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []map[string]interface{}{
+		{"doc": `{"a":1,"b":["x"]}`, "raw": []byte{5, 0, 0, 0, 0}, "name": "first"},
+		{"doc": []byte(`{"a":2}`), "name": "second"},
+	} {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// without hooks the values are the bytes
+	rows := readTestRows(t, f)
+	if got, ok := rows[0]["doc"].([]byte); !ok || string(got) != `{"a":1,"b":["x"]}` {
+		t.Errorf("got %v", rows[0]["doc"])
+	}
+
+	type document struct {
+		A int
+		B []string
+	}
+	var columns []string
+	f.options.UnmarshalJSON = func(column string, data []byte) (interface{}, error) {
+		columns = append(columns, column)
+		var d document
+		err := json.Unmarshal(data, &d)
+		return d, err
+	}
+	f.options.UnmarshalBSON = func(column string, data []byte) (interface{}, error) {
+		return len(data), nil
+	}
+	rows = readTestRows(t, f)
+	if got, want := fmt.Sprint(rows[0]["doc"], rows[1]["doc"]), "{1 [x]} {2 []}"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if rows[0]["raw"] != 5 || rows[1]["raw"] != nil {
+		t.Errorf("got %v", rows)
+	}
+	if len(columns) != 2 || columns[0] != "doc" {
+		t.Errorf("UnmarshalJSON called for %v", columns)
+	}
+
+	// the errors of the hooks stop the scanner
+	f.options.UnmarshalJSON = func(column string, data []byte) (interface{}, error) {
+		var m map[string]string
+		err := json.Unmarshal(data, &m)
+		return m, err
+	}
+	scanner := f.RowGroups()[0].Rows()
+	if scanner.Scan() || scanner.Row() != nil {
+		t.Error("scanned a row that cannot be unmarshaled")
+	}
+	if err := scanner.Err(); err == nil {
+		t.Error("no error")
+	} else {
+		t.Log(err)
+	}
+
+	s, err = ParseSchema("message m { required int32 doc (JSON); }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&b, s, WriterOptions{}); err == nil {
+		t.Error("no error for an INT32 JSON column")
+	}
+}
+
+type enumColor string
+
+func (enumColor) EnumValues() []string {
+	return []string{"red", "green", "blue"}
+}
+
+type enumRecord struct {
+	Color enumColor
+	Size  string   `parquet:"size,enum,optional"`
+	Tags  []string `parquet:",enum"`
+}
+
+func TestEnumColumns(t *testing.T) {
+	type flat struct {
+		Color *enumColor
+		Size  []byte `parquet:"size,enum"`
+	}
+	s, err := SchemaOf(flat{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `message root {
+  optional binary Color (ENUM);
+  required binary size (ENUM);
+}
+`
+	if got := s.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	columns, err := ColumnOptionsOf(flat{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(columns["Color"].EnumValues, len(columns["size"].EnumValues)); got != "[red green blue] 0" {
+		t.Errorf("got enum values %s", got)
+	}
+	if s, err := SchemaOf(enumRecord{}); err != nil {
+		t.Error(err)
+	} else if got := s.ColumnByName("Tags.list.element").LogicalType(); got != "ENUM" {
+		t.Errorf("got logical type %q for the elements", got)
+	}
+	if _, err := SchemaOf(struct {
+		N int `parquet:",enum"`
+	}{}); err == nil {
+		t.Error("no error for an int enum")
+	}
+
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{Columns: columns})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []map[string]interface{}{
+		{"Color": enumColor("red"), "size": "XL"},
+		{"Color": "blue", "size": []byte("S")},
+		{"size": "M"},
+	} {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, invalid := range []map[string]interface{}{
+		{"Color": enumColor("purple"), "size": "XL"},
+		{"Color": []byte("Red"), "size": "XL"},
+		{"Color": 1, "size": "XL"},
+	} {
+		if err := w.WriteRow(invalid); err == nil {
+			t.Errorf("no error for %v", invalid)
+		} else {
+			t.Log(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readTestRows(t, f)
+	if got := fmt.Sprintf("%s %s %v", rows[0]["Color"], rows[1]["Color"], rows[2]["Color"]); got != "red blue <nil>" {
+		t.Errorf("got colors %s", got)
+	}
+
+	s, err = ParseSchema("message m { required int64 e (ENUM); }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&b, s, WriterOptions{}); err == nil {
+		t.Error("no error for an INT64 ENUM column")
+	}
+}
@@ -0,0 +1,164 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestMapRoundTrip(t *testing.T) {
+	const schema = `message root {
+  required int64 id;
+  optional group scores (MAP) {
+    repeated group key_value {
+      required binary key (STRING);
+      optional int32 value;
+    }
+  }
+  required group tags (MAP) {
+    repeated group key_value {
+      required int32 key;
+      required group value (LIST) {
+        repeated group list {
+          required binary element (STRING);
+        }
+      }
+    }
+  }
+}`
+	var rows, want []map[string]interface{}
+	for i := 0; i < 50; i++ {
+		row := map[string]interface{}{"id": int64(i)}
+		expected := map[string]interface{}{"id": int64(i), "scores": nil, "tags": map[interface{}]interface{}{}}
+		switch i % 3 {
+		case 0:
+			row["scores"] = map[string]int32{"a": int32(i), "b": 2}
+			expected["scores"] = map[interface{}]interface{}{"a": int32(i), "b": int32(2)}
+		case 1:
+			row["scores"] = map[string]interface{}{"c": nil}
+			expected["scores"] = map[interface{}]interface{}{"c": nil}
+		}
+		if i%2 == 0 {
+			row["tags"] = map[int32][]string{int32(i): {"x", "y"}, -1: {}}
+			expected["tags"] = map[interface{}]interface{}{
+				int32(i):  []interface{}{[]byte("x"), []byte("y")},
+				int32(-1): []interface{}{},
+			}
+		}
+		rows = append(rows, row)
+		want = append(want, expected)
+	}
+
+	for _, options := range []WriterOptions{
+		{},
+		{ColumnOptions: ColumnOptions{PageValues: 3, DataPageVersion: 2}},
+	} {
+		f := writeListTestFile(t, schema, options, rows)
+		got := readTestRows(t, f)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: got %v, want %v", options, got, want)
+		}
+	}
+}
+
+func TestLegacyMaps(t *testing.T) {
+	// MAP_KEY_VALUE, on the repeated group or in place of MAP, other names
+	// and a map without values
+	const schema = `message root {
+  optional group a (MAP) {
+    repeated group map (MAP_KEY_VALUE) {
+      required binary k (STRING);
+      optional int64 v;
+    }
+  }
+  optional group b (MAP_KEY_VALUE) {
+    repeated group map {
+      required int32 key;
+      required double value;
+    }
+  }
+  optional group c (MAP) {
+    repeated group key_value {
+      required binary key (STRING);
+    }
+  }
+}`
+	rows := []map[string]interface{}{
+		{"a": map[string]int64{"x": 1}, "b": map[int32]float64{2: 3}, "c": map[string]bool{"y": true}},
+		{},
+	}
+	f := writeListTestFile(t, schema, WriterOptions{}, rows)
+	got := readTestRows(t, f)
+	want := []map[string]interface{}{
+		{
+			"a": map[interface{}]interface{}{"x": int64(1)},
+			"b": map[interface{}]interface{}{int32(2): float64(3)},
+			"c": map[interface{}]interface{}{"y": nil},
+		},
+		{"a": nil, "b": nil, "c": nil},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestMapErrors(t *testing.T) {
+	s, err := ParseSchema(`message root {
+  optional group m (MAP) {
+    repeated group key_value {
+      required binary key (STRING);
+      required int32 value;
+    }
+  }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWriter(&bytes.Buffer{}, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []map[string]interface{}{
+		{"m": []string{"a"}},
+		{"m": map[string]interface{}{"a": nil}},
+		{"m": map[interface{}]int32{nil: 1}},
+		{"m": map[string]string{"a": "b"}},
+	} {
+		if err := w.WriteRow(row); err == nil {
+			t.Errorf("no error for row %v", row)
+		}
+	}
+
+	for _, schema := range []string{
+		`message root {
+  optional group m (MAP) {
+    required binary key (STRING);
+  }
+}`,
+		`message root {
+  optional group m (MAP) {
+    repeated group key_value {
+      optional binary key (STRING);
+      required int32 value;
+    }
+  }
+}`,
+		`message root {
+  optional group m (MAP) {
+    repeated group key_value {
+      required binary key (STRING);
+      required int32 value;
+      required int32 other;
+    }
+  }
+}`,
+	} {
+		s, err := ParseSchema(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewWriter(&bytes.Buffer{}, s, WriterOptions{}); err == nil {
+			t.Errorf("no error for schema %s", schema)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package memory
 import (
 	"fmt"
 
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
 	"github.com/kostya-sh/parquet-go/parquet/encoding"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
@@ -22,7 +23,7 @@ func NewSimpleAccumulator(e *thrift.SchemaElement) Accumulator {
 	case thrift.Type_INT64:
 		return new(int64Accumulator)
 	case thrift.Type_INT96:
-		return new(int64Accumulator)
+		return new(int96Accumulator)
 	case thrift.Type_FLOAT:
 		return new(float32Accumulator)
 	case thrift.Type_DOUBLE:
@@ -44,7 +45,7 @@ type boolAccumulator struct {
 func (b *boolAccumulator) Accumulate(d encoding.Decoder, nullmask []bool, count uint) error {
 	buff := make([]bool, count)
 
-	read, err := d.DecodeBool(b.buff)
+	read, err := d.DecodeBool(buff)
 	if err != nil {
 		return fmt.Errorf("%v:%s", d, err)
 	}
@@ -101,6 +102,40 @@ func (b *int64Accumulator) Get(i int) (interface{}, bool) {
 	return nil, false
 }
 
+type int96Accumulator struct {
+	buff     []datatypes.Int96
+	nullmask []bool
+}
+
+func (b *int96Accumulator) Accumulate(d encoding.Decoder, nullmask []bool, count uint) error {
+	buff := make([]datatypes.Int96, count)
+
+	read, err := d.DecodeInt96(buff)
+	if err != nil {
+		return fmt.Errorf("%s.DecodeInt96:%s", d, err)
+	}
+
+	if read != count {
+		return fmt.Errorf("could not read all the expected values (%d) only %d", count, read)
+	}
+
+	b.buff = append(b.buff, buff...)
+	b.nullmask = append(b.nullmask, nullmask...)
+
+	return nil
+}
+
+func (b *int96Accumulator) Get(i int) (interface{}, bool) {
+	if i < len(b.buff) {
+		if b.nullmask != nil && i < len(b.nullmask) && !b.nullmask[i] {
+			return nil, true
+		}
+
+		return b.buff[i], true
+	}
+	return nil, false
+}
+
 type int32Accumulator struct {
 	buff     []int32
 	nullmask []bool
@@ -0,0 +1,342 @@
+package parquet
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// MergeFiles writes to w a parquet file with the row groups of files, one
+// after the other, e.g. to compact many small files. The files must have
+// the same schema: the column chunks are copied without decoding their
+// pages, and keep their statistics, their page indexes and their Bloom
+// filters. The key/value metadata of the files are merged, the last file
+// setting a key wins. The merged file has the created_by of the files if
+// they all have the same, none otherwise: the statistics of the byte arrays
+// of a file without created_by are not trusted. The encrypted files are not
+// supported.
+func MergeFiles(w io.Writer, files ...*File) error {
+	if len(files) == 0 {
+		return fmt.Errorf("merge: no files")
+	}
+	first := files[0].meta
+	meta := thrift.FileMetaData{
+		Version:      first.Version,
+		Schema:       first.Schema,
+		RowGroups:    []*thrift.RowGroup{},
+		CreatedBy:    first.CreatedBy,
+		ColumnOrders: first.ColumnOrders,
+	}
+	keyValues := make(map[string]string)
+	for i, f := range files {
+		if f.Encrypted() {
+			return fmt.Errorf("merge: file %d is encrypted", i)
+		}
+		if !sameSchema(f.meta.Schema, first.Schema) {
+			return fmt.Errorf("merge: the schema of file %d is not the schema of file 0", i)
+		}
+		if f.meta.GetCreatedBy() != first.GetCreatedBy() {
+			meta.CreatedBy = nil
+		}
+		if !reflect.DeepEqual(f.meta.ColumnOrders, first.ColumnOrders) {
+			// the min and max values of the chunks are not used
+			meta.ColumnOrders = nil
+		}
+		if f.meta.Version > meta.Version {
+			meta.Version = f.meta.Version
+		}
+		for k, v := range f.KeyValueMetadata() {
+			keyValues[k] = v
+		}
+	}
+	meta.KeyValueMetadata = thrift.KeyValues(keyValues)
+
+	c, err := newCopiedFile(w, meta)
+	if err != nil {
+		return err
+	}
+	for i, f := range files {
+		for _, rg := range f.RowGroups() {
+			if err := c.copyRowGroup(rg); err != nil {
+				return fmt.Errorf("merge: file %d: %s", i, err)
+			}
+		}
+	}
+	if err := c.close(); err != nil {
+		return fmt.Errorf("merge: %s", err)
+	}
+	return nil
+}
+
+// copiedFile is a file written by MergeFiles or SplitFile, its row groups
+// being copied from other files without decoding their pages.
+type copiedFile struct {
+	w       *thrift.CountingWriter
+	meta    thrift.FileMetaData
+	filters [][]*bloom.Filter
+	indexes [][]pageIndex
+}
+
+// newCopiedFile writes the magic of a file to w, with the metadata meta
+// without its row groups, and returns it.
+func newCopiedFile(w io.Writer, meta thrift.FileMetaData) (*copiedFile, error) {
+	c := &copiedFile{w: thrift.NewCountingWriter(w), meta: meta}
+	c.meta.RowGroups, c.meta.NumRows = []*thrift.RowGroup{}, 0
+	if err := writeHeader(c.w); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// copyRowGroup copies the column chunks of rg to the file.
+func (c *copiedFile) copyRowGroup(rg *RowGroup) error {
+	rowGroup := *rg.meta
+	rowGroup.Columns = make([]*thrift.ColumnChunk, len(rg.meta.Columns))
+	filters := make([]*bloom.Filter, len(rowGroup.Columns))
+	indexes := make([]pageIndex, len(rowGroup.Columns))
+	for j, cc := range rg.ColumnChunks() {
+		chunk, err := copyColumnChunk(c.w, cc)
+		if err != nil {
+			return fmt.Errorf("column %s of row group %d: %s", cc.Name(), rg.index, err)
+		}
+		rowGroup.Columns[j] = chunk.meta
+		filters[j], indexes[j] = chunk.filter, chunk.index
+	}
+	c.meta.RowGroups = append(c.meta.RowGroups, &rowGroup)
+	c.meta.NumRows += rowGroup.NumRows
+	c.filters = append(c.filters, filters)
+	c.indexes = append(c.indexes, indexes)
+	return nil
+}
+
+// close writes the Bloom filters and the page indexes of the column chunks
+// copied, then the footer.
+func (c *copiedFile) close() error {
+	if _, err := writeBloomFilters(c.w, c.w.N, c.meta.RowGroups, c.filters, nil); err != nil {
+		return err
+	}
+	if _, err := writePageIndexes(c.w, c.w.N, c.meta.RowGroups, c.indexes, nil); err != nil {
+		return err
+	}
+	return writeFileMetadata(c.w, &c.meta)
+}
+
+// sameSchema returns whether the schema elements a and b describe the same
+// columns, whatever the name of their root.
+func sameSchema(a, b []*thrift.SchemaElement) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	return a[0].GetNumChildren() == b[0].GetNumChildren() && reflect.DeepEqual(a[1:], b[1:])
+}
+
+// copyColumnChunk copies the pages of cc to w as they are and returns the
+// chunk copied, like rewriteColumnChunk.
+func copyColumnChunk(w *thrift.CountingWriter, cc *ColumnChunk) (*rewrittenColumnChunk, error) {
+	if cc.meta.IsSetFilePath() {
+		return nil, fmt.Errorf("column chunk stored in file %s", cc.meta.GetFilePath())
+	}
+
+	meta := *cc.Metadata()
+	meta.IndexPageOffset, meta.BloomFilterOffset, meta.BloomFilterLength = nil, nil, nil
+	if meta.GetDictionaryPageOffset() <= 0 {
+		// not moved with the pages, see pagesOffset
+		meta.DictionaryPageOffset = nil
+	}
+	chunk := &rewrittenColumnChunk{meta: &thrift.ColumnChunk{FileOffset: cc.meta.FileOffset, MetaData: &meta}}
+
+	offsetIndex, err := cc.OffsetIndex()
+	if err != nil {
+		return nil, err
+	}
+	if chunk.index.columnIndex, err = cc.ColumnIndex(); err != nil {
+		return nil, err
+	}
+	if chunk.filter, err = cc.BloomFilter(); err != nil {
+		return nil, err
+	}
+
+	offset := cc.pagesOffset()
+	r := io.NewSectionReader(cc.rowGroup.file.r, offset, meta.GetTotalCompressedSize())
+	n, err := io.Copy(w, r)
+	if err != nil {
+		return nil, err
+	}
+	if n != meta.GetTotalCompressedSize() {
+		return nil, fmt.Errorf("%d bytes in the chunk, expected %d", n, meta.GetTotalCompressedSize())
+	}
+
+	if offsetIndex == nil {
+		moveChunk(chunk.meta, &thrift.OffsetIndex{}, w.N-n-offset)
+	} else {
+		moveChunk(chunk.meta, offsetIndex, w.N-n-offset)
+		chunk.index.offsetIndex = offsetIndex
+	}
+	return chunk, nil
+}
+
+// MergeSortedFiles writes to w, with options, the rows of files sorted by
+// the same columns, merged into a file sorted by these columns. The rows are
+// merged one at a time: only a row group of each file is decoded at a time.
+// The columns are options.SortingColumns, or the sorting columns of the
+// first row group of the first file if there are none. All the row groups of
+// the files must declare them, ahead of any other, and the files must be
+// sorted by them from their first row to their last, which is verified. The
+// rows with equal values are written in the order of the files.
+func MergeSortedFiles(w io.Writer, options WriterOptions, files ...*File) error {
+	if len(files) == 0 {
+		return fmt.Errorf("merge: no files")
+	}
+	schema := files[0].Schema()
+	if len(options.SortingColumns) == 0 {
+		if rowGroups := files[0].RowGroups(); len(rowGroups) > 0 {
+			columns, err := rowGroups[0].SortingColumns()
+			if err != nil {
+				return fmt.Errorf("merge: file 0: %s", err)
+			}
+			options.SortingColumns = columns
+		}
+		if len(options.SortingColumns) == 0 {
+			return fmt.Errorf("merge: no sorting columns")
+		}
+	}
+
+	runs := make([]*sortedRun, 0, len(files))
+	for i, f := range files {
+		if !sameSchema(f.meta.Schema, files[0].meta.Schema) {
+			return fmt.Errorf("merge: the schema of file %d is not the schema of file 0", i)
+		}
+		for k, rg := range f.RowGroups() {
+			columns, err := rg.SortingColumns()
+			if err != nil {
+				return fmt.Errorf("merge: file %d: %s", i, err)
+			}
+			if !sortedBy(columns, options.SortingColumns) {
+				return fmt.Errorf("merge: row group %d of file %d is not sorted by %v", k, i, options.SortingColumns)
+			}
+		}
+		runs = append(runs, &sortedRun{
+			index:     i,
+			rowGroups: f.RowGroups(),
+			verifier:  newSortingVerifier(schema, options.SortingColumns),
+		})
+	}
+
+	writer, err := NewWriter(w, schema, options)
+	if err != nil {
+		return err
+	}
+	h := &runHeap{order: newSortingVerifier(schema, options.SortingColumns)}
+	for _, run := range runs {
+		ok, err := run.next()
+		if err != nil {
+			return fmt.Errorf("merge: file %d: %s", run.index, err)
+		}
+		if ok {
+			h.runs = append(h.runs, run)
+		}
+	}
+	heap.Init(h)
+	for len(h.runs) > 0 && h.err == nil {
+		run := h.runs[0]
+		if err := writer.WriteRow(run.row); err != nil {
+			return err
+		}
+		ok, err := run.next()
+		if err != nil {
+			return fmt.Errorf("merge: file %d: %s", run.index, err)
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	if h.err != nil {
+		return fmt.Errorf("merge: %s", h.err)
+	}
+	return writer.Close()
+}
+
+// sortedBy returns whether the rows sorted by declared are sorted by
+// columns.
+func sortedBy(declared, columns []SortingColumn) bool {
+	if len(declared) < len(columns) {
+		return false
+	}
+	for i, c := range columns {
+		if declared[i] != c {
+			return false
+		}
+	}
+	return true
+}
+
+// sortedRun is a file merged by MergeSortedFiles, positioned on its next
+// row.
+type sortedRun struct {
+	index     int         // index of the file
+	rowGroups []*RowGroup // the row groups not read yet
+	scanner   *RowScanner // nil before the first row group
+	verifier  *sortingVerifier
+	row       map[string]interface{}
+}
+
+// next moves the run to its next row and reports whether there is one. It
+// fails if the row is before the previous row.
+func (r *sortedRun) next() (bool, error) {
+	for r.scanner == nil || !r.scanner.Scan() {
+		if r.scanner != nil {
+			if err := r.scanner.Err(); err != nil {
+				return false, err
+			}
+		}
+		if len(r.rowGroups) == 0 {
+			r.row = nil
+			return false, nil
+		}
+		r.scanner = r.rowGroups[0].Rows()
+		r.rowGroups = r.rowGroups[1:]
+	}
+	r.row = r.scanner.Row()
+	if err := r.verifier.verify(r.row); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// runHeap is a heap of the runs by their next row. The first error of a
+// comparison is kept in err.
+type runHeap struct {
+	runs  []*sortedRun
+	order *sortingVerifier
+	err   error
+}
+
+func (h *runHeap) Len() int { return len(h.runs) }
+
+func (h *runHeap) Less(i, j int) bool {
+	a, b := h.runs[i], h.runs[j]
+	cmp, err := h.order.compare(a.row, b.row)
+	if err != nil && h.err == nil {
+		h.err = err
+	}
+	if cmp == 0 {
+		return a.index < b.index
+	}
+	return cmp < 0
+}
+
+func (h *runHeap) Swap(i, j int) { h.runs[i], h.runs[j] = h.runs[j], h.runs[i] }
+
+func (h *runHeap) Push(x interface{}) { h.runs = append(h.runs, x.(*sortedRun)) }
+
+func (h *runHeap) Pop() interface{} {
+	run := h.runs[len(h.runs)-1]
+	h.runs = h.runs[:len(h.runs)-1]
+	return run
+}
@@ -0,0 +1,142 @@
+package parquet
+
+import (
+	"fmt"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// MergeSchemas returns the union of the fields of schemas, e.g. the schema
+// of the files of a table scanned as one. The fields are matched by name,
+// group by group, and are in the order of the first schema that has them.
+// The fields that some schemas do not have are optional, as are the fields
+// required in some schemas and optional in others. The columns of
+// different types are promoted to the wider type if one widens the other,
+// see TypeWidened: INT32 to INT64, FLOAT to DOUBLE, etc. A field repeated
+// in a schema and not in another, a group and a column of the same name,
+// or columns of types that cannot be promoted are an error. A field keeps
+// its field_id if all the schemas that have it have the same.
+func MergeSchemas(schemas ...*Schema) (*Schema, error) {
+	if len(schemas) == 0 {
+		return nil, fmt.Errorf("merge schemas: no schemas")
+	}
+	var fields []*mergedField
+	for i, s := range schemas {
+		var err error
+		if fields, err = mergeFields("", fields, schemaNodes(s), i == 0); err != nil {
+			return nil, fmt.Errorf("merge schemas: schema %d: %s", i, err)
+		}
+	}
+
+	name := "root"
+	if root := schemas[0].root.schemaElement; root != nil {
+		name = root.Name
+	}
+	numChildren := int32(len(fields))
+	elements := []*thrift.SchemaElement{{Name: name, NumChildren: &numChildren}}
+	var walk func(fields []*mergedField)
+	walk = func(fields []*mergedField) {
+		for _, f := range fields {
+			elements = append(elements, f.element)
+			if f.group {
+				walk(f.children)
+			}
+		}
+	}
+	walk(fields)
+	return schemaFromFileMetaData(&thrift.FileMetaData{Schema: elements})
+}
+
+// mergedField is a field of the schema merged by MergeSchemas.
+type mergedField struct {
+	element  *thrift.SchemaElement // a copy, NumChildren is set for groups
+	group    bool
+	children []*mergedField
+}
+
+// mergeFields merges the fields nodes of a schema, of the group of path
+// prefix, followed by ".", into the fields merged of the schemas before it,
+// and returns the fields merged. first is whether the schema is the first
+// one, whose fields are not made optional.
+func mergeFields(prefix string, merged []*mergedField, nodes []schemaElement, first bool) ([]*mergedField, error) {
+	byName := make(map[string]*mergedField, len(merged))
+	for _, f := range merged {
+		byName[f.element.Name] = f
+	}
+	seen := make(map[*mergedField]bool, len(nodes))
+	for _, n := range nodes {
+		e := elementOf(n)
+		g, isGroup := n.(*group)
+		f, ok := byName[e.Name]
+		if !ok {
+			element := *e
+			f = &mergedField{element: &element, group: isGroup}
+			if !first {
+				makeOptional(f.element)
+			}
+			merged = append(merged, f)
+		} else if err := mergeField(prefix+e.Name, f, e, isGroup); err != nil {
+			return nil, err
+		}
+		seen[f] = true
+		if isGroup {
+			var err error
+			if f.children, err = mergeFields(prefix+e.Name+".", f.children, g.children, first || !ok); err != nil {
+				return nil, err
+			}
+			numChildren := int32(len(f.children))
+			f.element.NumChildren = &numChildren
+		}
+	}
+	for _, f := range merged {
+		if !seen[f] {
+			makeOptional(f.element)
+		}
+	}
+	return merged, nil
+}
+
+// mergeField merges the field e of path into the field f, but not their
+// children.
+func mergeField(path string, f *mergedField, e *thrift.SchemaElement, isGroup bool) error {
+	if f.group != isGroup {
+		return fmt.Errorf("field %s is a group and a column", path)
+	}
+
+	switch r, o := e.GetRepetitionType(), f.element.GetRepetitionType(); {
+	case r == o:
+	case r == thrift.FieldRepetitionType_REPEATED || o == thrift.FieldRepetitionType_REPEATED:
+		return fmt.Errorf("field %s is %s and %s", path, o, r)
+	default:
+		makeOptional(f.element)
+	}
+
+	if f.element.FieldID != nil && (e.FieldID == nil || *e.FieldID != *f.element.FieldID) {
+		f.element.FieldID = nil
+	}
+
+	switch {
+	case isGroup:
+		if !sameAnnotation(f.element, e) {
+			return fmt.Errorf("group %s is %s and %s", path, logicalTypeAnnotation(f.element), logicalTypeAnnotation(e))
+		}
+	case sameColumnType(f.element, e), widens(e, f.element):
+	case widens(f.element, e):
+		// the type of e, with the repetition and the field_id merged
+		element := *e
+		element.RepetitionType, element.FieldID = f.element.RepetitionType, f.element.FieldID
+		f.element = &element
+	default:
+		return fmt.Errorf("column %s is %s and %s", path,
+			fieldTypeName(&primitive{schemaElement: f.element}), fieldTypeName(&primitive{schemaElement: e}))
+	}
+	return nil
+}
+
+// makeOptional makes the required field element optional.
+func makeOptional(element *thrift.SchemaElement) {
+	if element.GetRepetitionType() == thrift.FieldRepetitionType_REQUIRED {
+		optional := thrift.FieldRepetitionType_OPTIONAL
+		element.RepetitionType = &optional
+	}
+}
@@ -0,0 +1,113 @@
+package parquet
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMergeSchemas(t *testing.T) {
+	var schemas []*Schema
+	for _, schema := range []string{
+		`message root {
+  required int32 id = 1;
+  required float ratio;
+  required group address {
+    required binary zip (STRING);
+  }
+  optional group tags (LIST) {
+    repeated group list {
+      required binary element (STRING);
+    }
+  }
+}`,
+		`message root {
+  required int64 id = 1;
+  optional double ratio;
+  required binary name (STRING);
+  required group address {
+    required binary zip (STRING);
+    required binary street (STRING);
+  }
+  required group extra {
+    required int32 n;
+  }
+}`,
+		`message root {
+  required int32 id = 2;
+  required float ratio;
+  optional group address {
+    required binary zip (STRING);
+  }
+}`,
+	} {
+		s, err := ParseSchema(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		schemas = append(schemas, s)
+	}
+
+	s, err := MergeSchemas(schemas...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `message root {
+  required int64 id;
+  optional double ratio;
+  optional group address {
+    required binary zip (STRING);
+    optional binary street (STRING);
+  }
+  optional group tags (LIST) {
+    repeated group list {
+      required binary element (STRING);
+    }
+  }
+  optional binary name (STRING);
+  optional group extra {
+    required int32 n;
+  }
+}
+`
+	if got := s.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if got := schemas[0].String(); !strings.Contains(got, "required float ratio;") {
+		t.Errorf("merged schema modified: %s", got)
+	}
+
+	s, err = MergeSchemas(schemas[0], schemas[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ColumnByName("id").SchemaElement.GetFieldID(); got != 1 {
+		t.Errorf("field id %d, want 1", got)
+	}
+
+	for _, schema := range []string{
+		`message root {
+  required binary id;
+}`,
+		`message root {
+  repeated int32 id;
+}`,
+		`message root {
+  required int32 address;
+}`,
+		`message root {
+  optional group tags (MAP) {
+    repeated group list {
+      required binary element (STRING);
+    }
+  }
+}`,
+	} {
+		other, err := ParseSchema(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := MergeSchemas(schemas[0], other); err == nil {
+			t.Errorf("no error for schema %s", schema)
+		}
+	}
+}
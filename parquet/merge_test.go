@@ -0,0 +1,184 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// writeMergeTestFile writes rows with writerTestSchema and returns the file.
+func writeMergeTestFile(t *testing.T, rows []map[string]interface{}, options WriterOptions) *File {
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestMergeFiles(t *testing.T) {
+	rows := writerTestRows(300)
+	options := WriterOptions{ColumnOptions: ColumnOptions{Compression: "snappy", BloomFilter: true}}
+	var files []*File
+	for i := 0; i < 3; i++ {
+		options.KeyValueMetadata = map[string]string{"first": "0", "last": string('0' + rune(i))}
+		if i == 0 {
+			options.RowGroupRows = 40
+		} else {
+			options.RowGroupRows = 0
+		}
+		files = append(files, writeMergeTestFile(t, rows[100*i:100*(i+1)], options))
+	}
+
+	var b bytes.Buffer
+	if err := MergeFiles(&b, files...); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readTestRows(t, f)
+	if len(got) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), len(rows))
+	}
+	for i, row := range got {
+		if row["id"] != int64(i) || row["ratio"] != rows[i]["ratio"] {
+			t.Fatalf("row %d: got %v", i, row)
+		}
+	}
+	if n := len(f.RowGroups()); n != 5 {
+		t.Errorf("got %d row groups, want 5", n)
+	}
+	if kv := f.KeyValueMetadata(); len(kv) != 2 || kv["first"] != "0" || kv["last"] != "2" {
+		t.Errorf("got key/value metadata %v", kv)
+	}
+	if f.Metadata().GetCreatedBy() != createdBy {
+		t.Errorf("got created_by %q", f.Metadata().GetCreatedBy())
+	}
+
+	first := f.RowGroups()[0].ColumnChunk("id").Statistics()
+	if want := files[0].RowGroups()[0].ColumnChunk("id").Statistics(); !reflect.DeepEqual(first, want) {
+		t.Errorf("got statistics %v, want %v", first, want)
+	}
+	var firstID int64
+	for i, rg := range f.RowGroups() {
+		id := rg.ColumnChunk("id")
+		filter, err := id.BloomFilter()
+		if err != nil || filter == nil || !filter.Check(firstID) {
+			t.Errorf("row group %d: got bloom filter %v, %v", i, filter, err)
+		}
+		offsetIndex, err := id.OffsetIndex()
+		if err != nil || len(offsetIndex.GetPageLocations()) == 0 {
+			t.Fatalf("row group %d: got offset index %v, %v", i, offsetIndex, err)
+		}
+		if offset := offsetIndex.PageLocations[0].Offset; offset != id.Metadata().GetDataPageOffset() {
+			t.Errorf("row group %d: page at %d, data page offset %d", i, offset, id.Metadata().GetDataPageOffset())
+		}
+		firstID += rg.NumRows()
+	}
+}
+
+func TestMergeFilesErrors(t *testing.T) {
+	f := writeMergeTestFile(t, writerTestRows(10), WriterOptions{})
+
+	schema := NewSchema()
+	if err := schema.AddColumnFromSpec("id: INT64 REQUIRED"); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, schema, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(map[string]interface{}{"id": int64(1)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	other, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, files := range [][]*File{nil, {f, other}} {
+		if err := MergeFiles(&bytes.Buffer{}, files...); err == nil {
+			t.Errorf("MergeFiles of %d files succeeded", len(files))
+		}
+	}
+}
+
+func TestMergeSortedFiles(t *testing.T) {
+	sorting := []SortingColumn{{Column: "id", Descending: true}}
+	rows := writerTestRows(300)
+	var files []*File
+	for i := 0; i < 3; i++ {
+		// the ids of file i are i modulo 3, in descending order
+		var fileRows []map[string]interface{}
+		for j := len(rows) - 3 + i; j >= 0; j -= 3 {
+			fileRows = append(fileRows, rows[j])
+		}
+		files = append(files, writeMergeTestFile(t, fileRows, WriterOptions{SortingColumns: sorting, RowGroupRows: 30}))
+	}
+
+	var b bytes.Buffer
+	if err := MergeSortedFiles(&b, WriterOptions{RowGroupRows: 100}, files...); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readTestRows(t, f)
+	if len(got) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), len(rows))
+	}
+	for i, row := range got {
+		want := rows[len(rows)-1-i]
+		if row["id"] != want["id"] || row["ratio"] != want["ratio"] {
+			t.Fatalf("row %d: got %v, want %v", i, row, want)
+		}
+	}
+	for i, rg := range f.RowGroups() {
+		if columns, err := rg.SortingColumns(); err != nil || !reflect.DeepEqual(columns, sorting) {
+			t.Errorf("row group %d: got sorting columns %v, %v", i, columns, err)
+		}
+	}
+}
+
+func TestMergeSortedFilesErrors(t *testing.T) {
+	sorting := []SortingColumn{{Column: "id"}}
+	rows := writerTestRows(20)
+	sorted := writeMergeTestFile(t, rows, WriterOptions{SortingColumns: sorting})
+	unsorted := writeMergeTestFile(t, rows, WriterOptions{})
+	// the row groups are sorted, not the file
+	shuffled := writeMergeTestFile(t, append(append([]map[string]interface{}{}, rows[10:]...), rows[:10]...), WriterOptions{SortingColumns: sorting, RowGroupRows: 10})
+
+	for _, tc := range []struct {
+		options WriterOptions
+		files   []*File
+	}{
+		{files: nil},
+		{files: []*File{unsorted, sorted}},
+		{files: []*File{sorted, unsorted}},
+		{files: []*File{sorted, shuffled}},
+		{options: WriterOptions{SortingColumns: []SortingColumn{{Column: "name"}}}, files: []*File{sorted}},
+	} {
+		if err := MergeSortedFiles(&bytes.Buffer{}, tc.options, tc.files...); err == nil {
+			t.Errorf("%+v: MergeSortedFiles of %d files succeeded", tc.options, len(tc.files))
+		}
+	}
+}
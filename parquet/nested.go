@@ -0,0 +1,661 @@
+package parquet
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The fields of the rows are the fields of the top level of the schema,
+// their values are:
+//
+//	column                  the value of the column
+//	group                   map[string]interface{} of the values of its fields
+//	group (LIST)            []interface{} of the elements
+//	group (MAP)             map[interface{}]interface{} of the keys and values
+//	group (VARIANT)         the value of the variant, see variant.go
+//	repeated field          []interface{} of the values of the field
+//
+// The elements of the lists are found with the backward compatibility rules
+// of the parquet spec for the layouts of the old writers: the element is
+// the repeated field when it is not a group, when it is a group of more
+// than one field or when it is named "array" or after the list followed by
+// "_tuple", otherwise it is the field of the repeated group, the three
+// levels of the spec:
+//
+//	optional group tags (LIST) {
+//	  repeated group list {
+//	    optional binary element (STRING);
+//	  }
+//	}
+//
+// The keys of a MAP group are the first field of its repeated group, the
+// values the second one, if any, whatever their names and whether the groups
+// are annotated with MAP or with the MAP_KEY_VALUE of the old writers:
+//
+//	optional group scores (MAP) {
+//	  repeated group key_value {
+//	    required binary key (STRING);
+//	    optional int32 value;
+//	  }
+//	}
+//
+// The keys of the BYTE_ARRAY and FIXED_LEN_BYTE_ARRAY columns are strings in
+// the rows, the []byte are not comparable.
+//
+// A null value of an optional field has the definition level of its
+// parent, an empty list or map the definition level of the list or map.
+
+// fieldKind is how the values of a field are represented in the rows.
+type fieldKind int
+
+const (
+	columnField  fieldKind = iota // the values of a column
+	groupField                    // map[string]interface{}
+	listField                     // []interface{}
+	mapField                      // map[interface{}]interface{}
+	variantField                  // the value of a variant
+)
+
+// field is a field of a schema, and of the rows.
+type field struct {
+	name     string                // the name of the field in its group
+	path     []string              // the path of the element of the field in the schema
+	element  *thrift.SchemaElement // the element of the field in the schema
+	kind     fieldKind
+	optional bool   // whether the values of the field can be null
+	levels   Levels // the levels of the values, not null from the definition level D
+
+	children []*field // the fields of a group
+	elem     *field   // the field of the elements of a list, of the key_value group of a map
+	repeated Levels   // the levels of the elements of a list or map, not empty from D
+	column   int      // the index of the column of a column field
+	columns  []int    // the indexes of the columns of the field
+
+	// widened is the element of ReaderOptions.FieldIDSchema of a column
+	// field of a type that widens the type of the column, see TypeWidened.
+	widened *thrift.SchemaElement
+}
+
+// String returns the path of the field, as in the errors.
+func (f *field) String() string {
+	return strings.Join(f.path, ".")
+}
+
+// isList returns whether element is a LIST group.
+func isList(element *thrift.SchemaElement) bool {
+	if lt := element.GetLogicalType(); lt != nil && lt.IsSetLIST() {
+		return true
+	}
+	return element.GetConvertedType() == thrift.ConvertedType_LIST
+}
+
+// isMap returns whether element is a MAP group, or a group annotated with
+// MAP_KEY_VALUE in place of MAP by the old writers.
+func isMap(element *thrift.SchemaElement) bool {
+	if lt := element.GetLogicalType(); lt != nil && lt.IsSetMAP() {
+		return true
+	}
+	ct := element.GetConvertedType()
+	return ct == thrift.ConvertedType_MAP || ct == thrift.ConvertedType_MAP_KEY_VALUE
+}
+
+// fields returns the fields of the top level of s. The columns added to a
+// schema without groups, e.g. with AddColumnFromSpec, are its fields.
+func (s *Schema) fields() ([]*field, error) {
+	b := &fieldBuilder{columns: make(map[string]int)}
+	for j, name := range s.columnsSequence {
+		b.columns[name] = j
+	}
+	var fields []*field
+	if s.root.schemaElement == nil {
+		for _, name := range s.columnsSequence {
+			if strings.Contains(name, ".") {
+				return nil, fmt.Errorf("column %s: the nested columns are the columns of the groups of a schema", name)
+			}
+			f, err := b.field(&primitive{schemaElement: s.columns[name].SchemaElement}, Levels{})
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, f)
+		}
+		return fields, nil
+	}
+	for _, child := range s.root.children {
+		f, err := b.field(child, Levels{})
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// schemaElements returns the elements of s in the metadata of a file, depth
+// first, with a root named "root".
+func schemaElements(s *Schema) []*thrift.SchemaElement {
+	numChildren := int32(len(s.columnsSequence))
+	if s.root.schemaElement != nil {
+		numChildren = int32(len(s.root.children))
+	}
+	elements := []*thrift.SchemaElement{{Name: "root", NumChildren: &numChildren}}
+	add := func(e *thrift.SchemaElement) {
+		element := *e
+		repetition := e.GetRepetitionType()
+		element.RepetitionType = &repetition
+		elements = append(elements, &element)
+	}
+	if s.root.schemaElement == nil {
+		for _, name := range s.columnsSequence {
+			add(s.columns[name].SchemaElement)
+		}
+		return elements
+	}
+	var walk func(children []schemaElement)
+	walk = func(children []schemaElement) {
+		for _, child := range children {
+			add(elementOf(child))
+			if g, ok := child.(*group); ok {
+				walk(g.children)
+			}
+		}
+	}
+	walk(s.root.children)
+	return elements
+}
+
+// columnFields returns the column fields of fields, by column index.
+func columnFields(fields []*field, n int) []*field {
+	columns := make([]*field, n)
+	var add func(f *field)
+	add = func(f *field) {
+		switch f.kind {
+		case columnField:
+			columns[f.column] = f
+		case groupField, variantField:
+			for _, child := range f.children {
+				add(child)
+			}
+		case listField, mapField:
+			add(f.elem)
+		}
+	}
+	for _, f := range fields {
+		add(f)
+	}
+	return columns
+}
+
+// fieldBuilder builds the fields of a schema.
+type fieldBuilder struct {
+	columns map[string]int // the indexes of the columns by name
+	path    []string
+}
+
+func elementOf(node schemaElement) *thrift.SchemaElement {
+	switch n := node.(type) {
+	case *primitive:
+		return n.schemaElement
+	case *group:
+		return n.schemaElement
+	}
+	panic("unexpected child type")
+}
+
+// field returns the field of node, a child of a field of the given levels.
+// A repeated field is a list of its values, unless it is the repeated field
+// of a LIST.
+func (b *fieldBuilder) field(node schemaElement, parent Levels) (*field, error) {
+	element := elementOf(node)
+	levels := parent
+	switch element.GetRepetitionType() {
+	case thrift.FieldRepetitionType_OPTIONAL:
+		levels.D++
+	case thrift.FieldRepetitionType_REPEATED:
+		levels.D++
+		levels.R++
+	}
+	b.path = append(b.path, element.Name)
+	defer func() {
+		b.path = b.path[:len(b.path)-1]
+	}()
+
+	if element.GetRepetitionType() == thrift.FieldRepetitionType_REPEATED {
+		elem, err := b.value(node, levels)
+		if err != nil {
+			return nil, err
+		}
+		return b.list(element, parent, levels, elem), nil
+	}
+	f, err := b.value(node, levels)
+	if err != nil {
+		return nil, err
+	}
+	f.optional = element.GetRepetitionType() == thrift.FieldRepetitionType_OPTIONAL
+	return f, nil
+}
+
+// value returns the field of the values of node, not null from levels.
+func (b *fieldBuilder) value(node schemaElement, levels Levels) (*field, error) {
+	element := elementOf(node)
+	f := &field{
+		name:    element.Name,
+		path:    append([]string(nil), b.path...),
+		element: element,
+		levels:  levels,
+	}
+	switch n := node.(type) {
+	case *primitive:
+		j, ok := b.columns[f.String()]
+		if !ok {
+			return nil, fmt.Errorf("no column %s in the schema", f)
+		}
+		f.kind, f.column, f.columns = columnField, j, []int{j}
+		return f, nil
+	case *group:
+		switch {
+		case isVariant(element):
+			return b.variantOf(f, n)
+		case isMap(element):
+			return b.mapOf(n, levels)
+		case isList(element):
+			return b.listOf(n, levels)
+		}
+		return b.group(f, n)
+	}
+	panic("unexpected child type")
+}
+
+// group returns f, the field of the group g, with the fields of g.
+func (b *fieldBuilder) group(f *field, g *group) (*field, error) {
+	f.kind = groupField
+	for _, child := range g.children {
+		c, err := b.field(child, f.levels)
+		if err != nil {
+			return nil, err
+		}
+		f.children = append(f.children, c)
+		f.columns = append(f.columns, c.columns...)
+	}
+	return f, nil
+}
+
+// list returns the list field of element, not null from levels, of the
+// elements elem, not empty from repeated.
+func (b *fieldBuilder) list(element *thrift.SchemaElement, levels, repeated Levels, elem *field) *field {
+	return &field{
+		name:     element.Name,
+		path:     append([]string(nil), b.path...),
+		element:  element,
+		kind:     listField,
+		levels:   levels,
+		elem:     elem,
+		repeated: repeated,
+		columns:  elem.columns,
+	}
+}
+
+// listOf returns the field of the LIST group g, not null from levels.
+func (b *fieldBuilder) listOf(g *group, levels Levels) (*field, error) {
+	if len(g.children) != 1 || elementOf(g.children[0]).GetRepetitionType() != thrift.FieldRepetitionType_REPEATED {
+		return nil, fmt.Errorf("LIST group %s does not have one repeated field", strings.Join(b.path, "."))
+	}
+	repeated := Levels{D: levels.D + 1, R: levels.R + 1}
+	node := g.children[0]
+	element := elementOf(node)
+	b.path = append(b.path, element.Name)
+	var elem *field
+	var err error
+	if r, ok := node.(*group); ok && len(r.children) == 1 &&
+		element.Name != "array" && element.Name != g.schemaElement.Name+"_tuple" {
+		// the three levels of the spec: the repeated group has one field,
+		// the element
+		elem, err = b.field(r.children[0], repeated)
+	} else {
+		// the two levels of the old writers: the repeated field is the
+		// element
+		elem, err = b.value(node, repeated)
+	}
+	b.path = b.path[:len(b.path)-1]
+	if err != nil {
+		return nil, err
+	}
+	return b.list(g.schemaElement, levels, repeated, elem), nil
+}
+
+// variantOf returns f, the field of the VARIANT group g, of a binary
+// metadata, a binary value and the typed_value of the shredded values.
+func (b *fieldBuilder) variantOf(f *field, g *group) (*field, error) {
+	if _, err := b.group(f, g); err != nil {
+		return nil, err
+	}
+	f.kind = variantField
+	metadata := f.child("metadata")
+	if metadata == nil || metadata.kind != columnField || metadata.optional || metadata.element.GetType() != thrift.Type_BYTE_ARRAY {
+		return nil, fmt.Errorf("VARIANT group %s does not have a required binary metadata", f)
+	}
+	for _, c := range f.children {
+		switch {
+		case c.name == "value" && c.kind == columnField && c.element.GetType() == thrift.Type_BYTE_ARRAY:
+		case c.name == "typed_value" && c.optional:
+		case c == metadata:
+		default:
+			return nil, fmt.Errorf("VARIANT group %s: unexpected field %s", f, c.name)
+		}
+	}
+	if f.child("value") == nil && f.child("typed_value") == nil {
+		return nil, fmt.Errorf("VARIANT group %s does not have a value", f)
+	}
+	return f, nil
+}
+
+// child returns the field name of the group f, nil if there is none.
+func (f *field) child(name string) *field {
+	for _, c := range f.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// mapOf returns the field of the MAP group g, not null from levels.
+func (b *fieldBuilder) mapOf(g *group, levels Levels) (*field, error) {
+	name := strings.Join(b.path, ".")
+	if len(g.children) != 1 || elementOf(g.children[0]).GetRepetitionType() != thrift.FieldRepetitionType_REPEATED {
+		return nil, fmt.Errorf("MAP group %s does not have one repeated field", name)
+	}
+	r, ok := g.children[0].(*group)
+	if !ok || len(r.children) == 0 || len(r.children) > 2 {
+		return nil, fmt.Errorf("MAP group %s does not have a repeated group of a key and a value", name)
+	}
+	if key, ok := r.children[0].(*primitive); !ok || key.schemaElement.GetRepetitionType() != thrift.FieldRepetitionType_REQUIRED {
+		return nil, fmt.Errorf("MAP group %s: the key is not a required column", name)
+	}
+	repeated := Levels{D: levels.D + 1, R: levels.R + 1}
+	b.path = append(b.path, r.schemaElement.Name)
+	elem, err := b.group(&field{
+		name:    r.schemaElement.Name,
+		path:    append([]string(nil), b.path...),
+		element: r.schemaElement,
+		levels:  repeated,
+	}, r)
+	b.path = b.path[:len(b.path)-1]
+	if err != nil {
+		return nil, err
+	}
+	f := b.list(g.schemaElement, levels, repeated, elem)
+	f.kind = mapField
+	return f, nil
+}
+
+// shredder splits the values of the fields of rows into the triples of their
+// columns, the values of the columns with their levels.
+type shredder struct {
+	fields  []*field
+	triples [][]page.Triple // the triples of the row by column index
+}
+
+func newShredder(fields []*field, numColumns int) *shredder {
+	return &shredder{fields: fields, triples: make([][]page.Triple, numColumns)}
+}
+
+// shred splits the values of row, a map from the names of the fields to
+// their values, into triples.
+func (s *shredder) shred(row map[string]interface{}) error {
+	for j := range s.triples {
+		s.triples[j] = s.triples[j][:0]
+	}
+	n := 0
+	for _, f := range s.fields {
+		v, ok := row[f.name]
+		if ok {
+			n++
+		}
+		if err := s.shredValue(f, v, 0); err != nil {
+			return err
+		}
+	}
+	if n != len(row) {
+		return unknownField(s.fields, row, "no column %s in the schema")
+	}
+	return nil
+}
+
+// unknownField returns the error, of format, of a key of m that is not the
+// name of one of fields.
+func unknownField(fields []*field, m map[string]interface{}, format string) error {
+	var unknown []string
+	for name := range m {
+		found := false
+		for _, f := range fields {
+			if f.name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf(format, unknown[0])
+}
+
+// shredValue adds the triples of v, a value of f whose first triple has the
+// repetition level r.
+func (s *shredder) shredValue(f *field, v interface{}, r int) error {
+	// a required variant has the null of the variants
+	if v == nil && (f.kind != variantField || f.optional) || (f.kind == listField || f.kind == mapField) && isNil(v) {
+		switch {
+		case f.optional:
+			s.nulls(f, r, f.levels.D-1)
+			return nil
+		case f.kind == listField || f.kind == mapField:
+			// the repeated fields have no null
+			s.nulls(f, r, f.repeated.D-1)
+			return nil
+		case f.kind == columnField:
+			return fmt.Errorf("column %s: null value in a required column", f)
+		default:
+			return fmt.Errorf("field %s: null value in a required field", f)
+		}
+	}
+
+	switch f.kind {
+	case columnField:
+		s.triples[f.column] = append(s.triples[f.column], page.Triple{R: r, D: f.levels.D, Value: v})
+	case groupField:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("field %s: value %v of type %T for a group", f, v, v)
+		}
+		return s.shredGroup(f, m, r)
+	case variantField:
+		m, err := variantGroup(f, v)
+		if err != nil {
+			return fmt.Errorf("field %s: %s", f, err)
+		}
+		return s.shredGroup(f, m, r)
+	case listField:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return fmt.Errorf("field %s: value %v of type %T for a list", f, v, v)
+		}
+		if rv.Len() == 0 {
+			s.nulls(f, r, f.repeated.D-1)
+			return nil
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if i > 0 {
+				r = f.repeated.R
+			}
+			if err := s.shredValue(f.elem, rv.Index(i).Interface(), r); err != nil {
+				return err
+			}
+		}
+	case mapField:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Map {
+			return fmt.Errorf("field %s: value %v of type %T for a map", f, v, v)
+		}
+		if rv.Len() == 0 {
+			s.nulls(f, r, f.repeated.D-1)
+			return nil
+		}
+		// the entries in the order of the keys, for the same files of the
+		// same rows
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for i, k := range keys {
+			if i > 0 {
+				r = f.repeated.R
+			}
+			entry := map[string]interface{}{f.elem.children[0].name: k.Interface()}
+			if len(f.elem.children) > 1 {
+				entry[f.elem.children[1].name] = rv.MapIndex(k).Interface()
+			}
+			if err := s.shredValue(f.elem, entry, r); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// shredGroup adds the triples of m, the values of the fields of the group f.
+func (s *shredder) shredGroup(f *field, m map[string]interface{}, r int) error {
+	n := 0
+	for _, child := range f.children {
+		v, ok := m[child.name]
+		if ok {
+			n++
+		}
+		if err := s.shredValue(child, v, r); err != nil {
+			return err
+		}
+	}
+	if n != len(m) {
+		return unknownField(f.children, m, "field "+f.String()+": no field %s in the group")
+	}
+	return nil
+}
+
+// isNil returns whether v is a nil slice or map.
+func isNil(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map) && rv.IsNil()
+}
+
+// nulls adds a null of definition level d and repetition level r to the
+// columns of f.
+func (s *shredder) nulls(f *field, r, d int) {
+	for _, j := range f.columns {
+		s.triples[j] = append(s.triples[j], page.Triple{R: r, D: d})
+	}
+}
+
+// assemble returns the value of f from triples, the triples of one value of
+// f by column index.
+func (s *RowScanner) assemble(f *field, triples [][]page.Triple) (interface{}, error) {
+	if len(f.columns) == 0 {
+		// a field of ReaderOptions.FieldIDSchema not in the file
+		return nil, nil
+	}
+	first := triples[f.columns[0]]
+	if len(first) == 0 {
+		return nil, fmt.Errorf("field %s: missing values", f)
+	}
+	if first[0].D < f.levels.D {
+		return nil, nil
+	}
+
+	switch f.kind {
+	case columnField:
+		v := first[0].Value
+		if v == nil || s.convert[f.column] == nil {
+			return v, nil
+		}
+		return s.convert[f.column](v)
+	case groupField, variantField:
+		m := make(map[string]interface{}, len(f.children))
+		for _, child := range f.children {
+			v, err := s.assemble(child, triples)
+			if err != nil {
+				return nil, err
+			}
+			m[child.name] = v
+		}
+		if f.kind == variantField {
+			v, err := variantValue(f, m)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %s", f, err)
+			}
+			return v, nil
+		}
+		return m, nil
+	}
+
+	list, err := s.assembleElements(f, triples)
+	if err != nil || f.kind == listField {
+		return list, err
+	}
+	m := make(map[interface{}]interface{}, len(list))
+	for _, e := range list {
+		entry := e.(map[string]interface{})
+		k := entry[f.elem.children[0].name]
+		if b, ok := k.([]byte); ok {
+			k = string(b)
+		}
+		if len(f.elem.children) > 1 {
+			m[k] = entry[f.elem.children[1].name]
+		} else {
+			m[k] = nil
+		}
+	}
+	return m, nil
+}
+
+// assembleElements returns the elements of the list or map f, not null, from
+// triples.
+func (s *RowScanner) assembleElements(f *field, triples [][]page.Triple) ([]interface{}, error) {
+	if triples[f.columns[0]][0].D < f.repeated.D {
+		return []interface{}{}, nil
+	}
+	// the elements start at the repetition level of the list
+	var list []interface{}
+	elements := make([][]page.Triple, len(triples))
+	starts := make([]int, len(triples))
+	for {
+		n := 0
+		for _, j := range f.columns {
+			if starts[j] == len(triples[j]) {
+				continue
+			}
+			n++
+			end := starts[j] + 1
+			for end < len(triples[j]) && triples[j][end].R > f.repeated.R {
+				end++
+			}
+			elements[j] = triples[j][starts[j]:end]
+			starts[j] = end
+		}
+		if n == 0 {
+			return list, nil
+		}
+		if n != len(f.columns) {
+			return nil, fmt.Errorf("field %s: the columns have different numbers of elements", f)
+		}
+		v, err := s.assemble(f.elem, elements)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+}
@@ -0,0 +1,253 @@
+package parquet
+
+import (
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+)
+
+// WriterOptions configure how the columns of a file are written. The zero
+// value uses the defaults.
+type WriterOptions struct {
+	ColumnOptions
+
+	// Columns overrides the options of some columns, by column name. The
+	// zero fields of an override are taken from the file options.
+	Columns map[string]ColumnOptions
+
+	// SortingColumns are the columns the records are sorted by, first by
+	// the first column, then by the second one for equal values, etc. They
+	// are written in the metadata of the row groups, for the readers that
+	// merge sorted files or skip row groups. With VerifySorting,
+	// WriteRecords fails on a record that is not in this order.
+	SortingColumns []SortingColumn
+	VerifySorting  bool
+
+	// RowGroupSize is the size in bytes of the values buffered by a Writer,
+	// encoded but not compressed, above which they are written as a row
+	// group, DefaultRowGroupSize by default. RowGroupRows is the maximum
+	// number of rows of a row group, 0 for no limit. Larger row groups
+	// compress better and are read with fewer seeks, smaller ones use less
+	// memory to write and let readers skip more data.
+	RowGroupSize int64
+	RowGroupRows int64
+
+	// Concurrency is the number of column chunks encoded and compressed
+	// concurrently when a row group is written, 0 or 1 for one at a time.
+	// The pages of the columns are then compressed when the row group is
+	// written rather than as the rows are, which uses more memory: with
+	// many columns, the compression is what limits the speed of a Writer.
+	// The Writers of NewWriterAt also write the chunks concurrently.
+	Concurrency int
+
+	// Atomic makes the Writers of CreateFile write to a temporary file of
+	// the directory of the file, synced and renamed to the file by Close:
+	// the readers never see a partial file, even after a crash, and the
+	// file is left unchanged if the writing fails. See WriteFileAtomic.
+	Atomic bool
+
+	// KeyValueMetadata is the key/value metadata of the file, e.g. the
+	// schema of the model the records come from for the readers to restore
+	// it. See ColumnOptions.ChunkKeyValueMetadata for the metadata of the
+	// column chunks.
+	KeyValueMetadata map[string]string
+
+	// Encryption are how the file is encrypted, nil if it is not. Build them
+	// with encryption.NewFileEncryptionPropertiesBuilder.
+	Encryption *encryption.FileEncryptionProperties
+
+	// Location is where the times written to the TIMESTAMP columns that are
+	// not adjusted to UTC are, e.g. time.Local: their wall clock in Location
+	// is written. The wall clock of the times in their own location is
+	// written if it is nil.
+	Location *time.Location
+
+	// Int96Timestamps writes the INT96 columns of the schema, the legacy
+	// timestamps of Impala and Spark, for the readers that still require
+	// them. Their values are time.Time, written in UTC. The INT96 type is
+	// deprecated: NewWriter rejects the INT96 columns without this option.
+	Int96Timestamps bool
+}
+
+// ColumnOptions are the options of a single column.
+type ColumnOptions struct {
+	// DataPageVersion is the format of the data pages: 1, the default, or 2
+	// to write data pages v2. The levels of a data page v2 are stored out of
+	// the compressed values and its header declares the number of nulls and
+	// rows of the page, which some readers use to skip pages.
+	DataPageVersion int
+
+	// Encoding is the encoding of the values: "dictionary", the default,
+	// "plain", "rle" for booleans, "delta_binary_packed" for INT32 and
+	// INT64, "delta_length_byte_array" and "delta_byte_array" for byte
+	// arrays or "byte_stream_split" for INT32, INT64, FLOAT and DOUBLE. A
+	// dictionary larger than page.DefaultDictionaryPageSize falls back to
+	// the plain encoding, booleans are always plain by default. The delta
+	// encodings suit sorted or close values, e.g. timestamps, and
+	// byte_stream_split helps the codecs compress floating point values.
+	Encoding string
+	// DisableDictionary writes the values of the columns without an
+	// Encoding with the plain encoding rather than a dictionary, e.g. for
+	// the identifiers or the other columns with few repeated values where a
+	// dictionary only costs memory and time.
+	DisableDictionary bool
+	// DictionaryPageSize is the maximum size in bytes of the dictionary of a
+	// column chunk, page.DefaultDictionaryPageSize by default. The values
+	// written after the dictionary reached this size are plain encoded.
+	DictionaryPageSize int
+
+	// Compression is the compression codec of the pages: "snappy", "gzip",
+	// "zstd", "lz4_raw", "brotli" or a codec registered with
+	// page.RegisterCodec. The empty name does not compress the pages of the
+	// file and keeps the codec of the file for a column, "uncompressed" does
+	// not compress the pages of a column whatever the codec of the file.
+	Compression string
+	// CompressionLevel is the level of the codec, 0 for its default level.
+	// The level of the file is only used by the columns that keep its codec.
+	CompressionLevel int
+
+	// PageSize is the size in bytes of the encoded values above which a new
+	// data page is started, page.DefaultPageSize by default. PageValues is
+	// the maximum number of values of a data page, nulls included, 0 for no
+	// limit. Small pages let the readers skip more values with the page
+	// index, large pages compress better.
+	PageSize   int
+	PageValues int
+
+	// ZstdDictionarySize is the size in bytes of a zstd dictionary trained
+	// on the pages of each column chunk compressed with zstd, 0 for none.
+	// It improves the compression of many small pages, e.g. of short
+	// strings, but the dictionary is stored in the metadata of the chunk
+	// under a key that is not part of the parquet format: other readers
+	// cannot decompress the pages.
+	ZstdDictionarySize int
+
+	// StatisticsTruncateLength is the maximum length in bytes of the min and
+	// max statistics of a BYTE_ARRAY column, 0 for no limit. The statistics
+	// are written in every page header and in the footer: a limit keeps a
+	// few long values from bloating them. A truncated max is the prefix of
+	// the max with its last byte incremented, a larger value, so that the
+	// statistics can still be used to skip pages.
+	StatisticsTruncateLength int
+
+	// BloomFilter writes a split block Bloom filter of the values of each
+	// column chunk, after the row groups. Readers looking for a value can
+	// skip the row groups whose filter does not contain it, it is mostly
+	// useful for the columns with many distinct values, e.g. identifiers,
+	// that the min and max statistics cannot exclude. BOOLEAN columns have
+	// no filter.
+	BloomFilter bool
+	// BloomFilterNDV is the expected number of distinct values in a column
+	// chunk and BloomFilterFPP the false positive probability of its filter
+	// with that many values, from 0 to 1. They set the size of the filter,
+	// the defaults are bloom.DefaultNDV and bloom.DefaultFPP: a filter of
+	// 1MB per chunk.
+	BloomFilterNDV int64
+	BloomFilterFPP float64
+
+	// ChunkKeyValueMetadata is the key/value metadata of every column chunk
+	// of the column. The metadata of a column replaces the metadata set for
+	// all the columns, it is not merged with it.
+	ChunkKeyValueMetadata map[string]string
+
+	// EnumValues are the values of an ENUM column: the rows with another
+	// value are not written. The values are not checked if it is empty, and
+	// it is ignored by the other columns.
+	EnumValues []string
+}
+
+// column returns the options of the column name.
+func (o WriterOptions) column(name string) ColumnOptions {
+	options := o.ColumnOptions
+	if c, ok := o.Columns[name]; ok {
+		if c.DataPageVersion != 0 {
+			options.DataPageVersion = c.DataPageVersion
+		}
+		if c.Encoding != "" {
+			options.Encoding = c.Encoding
+		}
+		if c.DisableDictionary {
+			options.DisableDictionary = true
+		}
+		if c.DictionaryPageSize != 0 {
+			options.DictionaryPageSize = c.DictionaryPageSize
+		}
+		if c.Compression != "" {
+			options.Compression = c.Compression
+			options.CompressionLevel = c.CompressionLevel
+		} else if c.CompressionLevel != 0 {
+			options.CompressionLevel = c.CompressionLevel
+		}
+		if c.PageSize != 0 {
+			options.PageSize = c.PageSize
+		}
+		if c.PageValues != 0 {
+			options.PageValues = c.PageValues
+		}
+		if c.ZstdDictionarySize != 0 {
+			options.ZstdDictionarySize = c.ZstdDictionarySize
+		}
+		if c.StatisticsTruncateLength != 0 {
+			options.StatisticsTruncateLength = c.StatisticsTruncateLength
+		}
+		if c.BloomFilter {
+			options.BloomFilter = true
+		}
+		if c.BloomFilterNDV != 0 {
+			options.BloomFilterNDV = c.BloomFilterNDV
+		}
+		if c.BloomFilterFPP != 0 {
+			options.BloomFilterFPP = c.BloomFilterFPP
+		}
+		if c.ChunkKeyValueMetadata != nil {
+			options.ChunkKeyValueMetadata = c.ChunkKeyValueMetadata
+		}
+		if c.EnumValues != nil {
+			options.EnumValues = c.EnumValues
+		}
+	}
+	return options
+}
+
+// ReaderOptions configure how the pages of a file are read. The zero value
+// uses the defaults.
+type ReaderOptions struct {
+	// SkipChecksums disables the verification of the CRC32 stored in the
+	// page headers. Pages with a wrong checksum are an error by default.
+	SkipChecksums bool
+
+	// Decryption are the keys of an encrypted file, nil if the file is not
+	// encrypted. Without them only the plaintext columns of a file with a
+	// plaintext footer can be read.
+	Decryption *encryption.DecryptionProperties
+	// MaskColumnsWithoutKeys reads the encrypted columns whose key is
+	// missing from Decryption as null values instead of failing: the
+	// readers given the keys of some columns only can still read the rows
+	// of the file. See ColumnChunk.Masked.
+	MaskColumnsWithoutKeys bool
+
+	// Location is where the values of the TIMESTAMP columns that are not
+	// adjusted to UTC, wall clock times, are read as happening, UTC if it
+	// is nil. The values adjusted to UTC are read in UTC.
+	Location *time.Location
+
+	// UnmarshalJSON, if not nil, decodes the values of the JSON columns,
+	// read as the values it returns rather than as []byte, e.g. a function
+	// calling json.Unmarshal with a struct or a map chosen by column. The
+	// rows are not read after it returns an error.
+	UnmarshalJSON func(column string, data []byte) (interface{}, error)
+
+	// UnmarshalBSON is UnmarshalJSON for the values of the BSON columns.
+	UnmarshalBSON func(column string, data []byte) (interface{}, error)
+
+	// FieldIDSchema, if not nil, is the schema the rows are read with, its
+	// fields resolved to the fields of the file by field_id rather than by
+	// name, e.g. to read the files of an Iceberg table written before some
+	// of its columns were renamed: the rows have the fields of FieldIDSchema,
+	// null if the file has no field with their field_id, and not the other
+	// fields of the file. Its fields must all have a field_id. Its columns
+	// have the type of the columns of the file, or a wider type whose values
+	// they are read as, e.g. int64 for an INT32 column read as INT64, see
+	// TypeWidened.
+	FieldIDSchema *Schema
+}
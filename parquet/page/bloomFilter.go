@@ -0,0 +1,64 @@
+package page
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+)
+
+// The insert functions insert the hashes of values in the Bloom filter f of
+// a column chunk, if not nil. The booleans are never inserted.
+
+func insertInt32(f *bloom.Filter, values []int32) {
+	if f == nil {
+		return
+	}
+	var b [4]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint32(b[:], uint32(v))
+		f.InsertHash(bloom.HashBytes(b[:]))
+	}
+}
+
+func insertInt64(f *bloom.Filter, values []int64) {
+	if f == nil {
+		return
+	}
+	var b [8]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint64(b[:], uint64(v))
+		f.InsertHash(bloom.HashBytes(b[:]))
+	}
+}
+
+func insertFloat32(f *bloom.Filter, values []float32) {
+	if f == nil {
+		return
+	}
+	var b [4]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(v))
+		f.InsertHash(bloom.HashBytes(b[:]))
+	}
+}
+
+func insertFloat64(f *bloom.Filter, values []float64) {
+	if f == nil {
+		return
+	}
+	var b [8]byte
+	for _, v := range values {
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+		f.InsertHash(bloom.HashBytes(b[:]))
+	}
+}
+
+func insertByteArray(f *bloom.Filter, values [][]byte) {
+	if f == nil {
+		return
+	}
+	for _, v := range values {
+		f.InsertHash(bloom.HashBytes(v))
+	}
+}
@@ -0,0 +1,130 @@
+package page
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// Codec compresses and decompresses the pages of a compression codec.
+//
+// The methods of a Codec may be called concurrently.
+type Codec interface {
+	// Encode returns the compressed src.
+	Encode(src []byte) ([]byte, error)
+	// Decode returns the decompressed src. uncompressedSize is the size
+	// declared in the page header: it comes from the file and must be
+	// checked before it is used to allocate the result.
+	Decode(src []byte, uncompressedSize int) ([]byte, error)
+}
+
+var registeredCodecs struct {
+	sync.RWMutex
+	m map[thrift.CompressionCodec]Codec
+}
+
+// RegisterCodec sets the implementation of codec used to read and write
+// pages, e.g. to use a cgo implementation or to support LZO. It replaces the
+// built-in implementation of the codec, if any, and its compression level
+// and window size are not used. A nil impl restores the built-in
+// implementation.
+//
+// RegisterCodec is meant to be called from an init function, the pages
+// being read and written concurrently keep the previous implementation.
+func RegisterCodec(codec thrift.CompressionCodec, impl Codec) {
+	if codec == thrift.CompressionCodec_UNCOMPRESSED {
+		panic("page: cannot register the UNCOMPRESSED codec")
+	}
+
+	registeredCodecs.Lock()
+	defer registeredCodecs.Unlock()
+	if impl == nil {
+		delete(registeredCodecs.m, codec)
+		return
+	}
+	if registeredCodecs.m == nil {
+		registeredCodecs.m = make(map[thrift.CompressionCodec]Codec)
+	}
+	registeredCodecs.m[codec] = impl
+}
+
+func registeredCodec(codec thrift.CompressionCodec) (Codec, bool) {
+	registeredCodecs.RLock()
+	defer registeredCodecs.RUnlock()
+	impl, ok := registeredCodecs.m[codec]
+	return impl, ok
+}
+
+// codecNames are the names of the codecs in EncodingPreferences.
+var codecNames = map[string]thrift.CompressionCodec{
+	"":             thrift.CompressionCodec_UNCOMPRESSED,
+	"uncompressed": thrift.CompressionCodec_UNCOMPRESSED,
+	"snappy":       thrift.CompressionCodec_SNAPPY,
+	"gzip":         thrift.CompressionCodec_GZIP,
+	"lzo":          thrift.CompressionCodec_LZO,
+	"brotli":       thrift.CompressionCodec_BROTLI,
+	"lz4":          thrift.CompressionCodec_LZ4,
+	"zstd":         thrift.CompressionCodec_ZSTD,
+	"lz4_raw":      thrift.CompressionCodec_LZ4_RAW,
+}
+
+// CodecByName returns the codec of name, a lower case codec name such as
+// "zstd" or "lz4_raw". The empty name is the UNCOMPRESSED codec.
+func CodecByName(name string) (thrift.CompressionCodec, error) {
+	codec, ok := codecNames[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported compression codec %s", name)
+	}
+	return codec, nil
+}
+
+// codecFor returns the implementation of codec, the registered one if any or
+// the built-in one with the given level and window size.
+func codecFor(codec thrift.CompressionCodec, level int, window int) (Codec, error) {
+	if impl, ok := registeredCodec(codec); ok {
+		return impl, nil
+	}
+
+	switch codec {
+	case thrift.CompressionCodec_SNAPPY:
+		return snappyCodec{}, nil
+	case thrift.CompressionCodec_GZIP:
+		return newGzipCodec(level)
+	case thrift.CompressionCodec_BROTLI:
+		return newBrotliCodec(level)
+	case thrift.CompressionCodec_LZ4:
+		return lz4Codec{hadoop: true}, nil
+	case thrift.CompressionCodec_ZSTD:
+		return newZstdCodec(level, window)
+	case thrift.CompressionCodec_LZ4_RAW:
+		return lz4Codec{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %s", codec)
+	}
+}
+
+// decompress returns the decompressed page data src.
+func decompress(codec thrift.CompressionCodec, src []byte, uncompressedSize int32) ([]byte, error) {
+	impl, err := codecFor(codec, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	return decodePage(codec, impl, src, uncompressedSize)
+}
+
+// decodePage returns the page data src decompressed with impl, the
+// implementation of codec.
+func decodePage(codec thrift.CompressionCodec, impl Codec, src []byte, uncompressedSize int32) ([]byte, error) {
+	if uncompressedSize < 0 {
+		return nil, fmt.Errorf("invalid uncompressed size %d", uncompressedSize)
+	}
+	out, err := impl.Decode(src, int(uncompressedSize))
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %s page: %s", codec, err)
+	}
+	if len(out) != int(uncompressedSize) {
+		return nil, fmt.Errorf("%s page of %d bytes, expected %d", codec, len(out), uncompressedSize)
+	}
+	return out, nil
+}
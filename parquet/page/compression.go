@@ -0,0 +1,360 @@
+package page
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+	"github.com/pierrec/lz4/v4"
+)
+
+// compressor compresses the pages of a column with its codec. The zero value
+// does not compress.
+type compressor struct {
+	codec thrift.CompressionCodec
+	impl  Codec
+
+	level          int
+	window         int
+	dictionarySize int  // size of the zstd dictionary to train, 0 for none
+	lazy           bool // compress the pages in Pages, see DeferCompression
+}
+
+// CheckCompression returns an error if the compression of preferences is
+// not supported: NewPageEncoder panics with such preferences.
+func CheckCompression(preferences EncodingPreferences) error {
+	_, err := newCompressor(preferences)
+	return err
+}
+
+func newCompressor(preferences EncodingPreferences) (compressor, error) {
+	codec, err := CodecByName(preferences.CompressionCodec)
+	if err != nil {
+		return compressor{}, err
+	}
+	if codec == thrift.CompressionCodec_UNCOMPRESSED {
+		return compressor{}, nil
+	}
+	if _, ok := registeredCodec(codec); !ok && codec == thrift.CompressionCodec_LZ4 {
+		// the framing of the LZ4 codec is not the same in all the
+		// implementations
+		return compressor{}, fmt.Errorf("the LZ4 codec is deprecated, use lz4_raw")
+	}
+
+	impl, err := codecFor(codec, preferences.CompressionLevel, preferences.CompressionWindowSize)
+	if err != nil {
+		return compressor{}, err
+	}
+	return compressor{
+		codec:          codec,
+		impl:           impl,
+		level:          preferences.CompressionLevel,
+		window:         preferences.CompressionWindowSize,
+		dictionarySize: preferences.ZstdDictionarySize,
+		lazy:           preferences.DeferCompression,
+	}, nil
+}
+
+func (c compressor) compress(p []byte) ([]byte, error) {
+	if c.impl == nil {
+		return p, nil
+	}
+	return c.impl.Encode(p)
+}
+
+// pageSize returns uncompressedSize if it is not larger than a page, the
+// size of a page otherwise, to allocate the buffer of a decompressed page.
+func pageSize(uncompressedSize int) int {
+	if uncompressedSize > DefaultPageSize {
+		return DefaultPageSize
+	}
+	return uncompressedSize
+}
+
+// snappyCodec compresses the pages with the snappy block format, not the
+// framed format of snappy.Writer.
+type snappyCodec struct{}
+
+func (snappyCodec) Encode(src []byte) ([]byte, error) {
+	return snappy.Encode(nil, src), nil
+}
+
+func (snappyCodec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	n, err := snappy.DecodedLen(src)
+	if err != nil {
+		return nil, err
+	}
+	if n != uncompressedSize {
+		return nil, fmt.Errorf("snappy page of %d bytes, expected %d", n, uncompressedSize)
+	}
+	return snappy.Decode(make([]byte, n), src)
+}
+
+// gzipWriters pools the gzip writers by level, from gzip.HuffmanOnly to
+// gzip.BestCompression: a writer allocates several hundred KB of state.
+var gzipWriters [gzip.BestCompression - gzip.HuffmanOnly + 1]sync.Pool
+
+var gzipReaders sync.Pool
+
+type gzipCodec struct {
+	level int
+}
+
+// newGzipCodec returns the gzip codec of level, 0 for the default level:
+// gzip.NoCompression cannot be selected.
+func newGzipCodec(level int) (Codec, error) {
+	if level == 0 {
+		level = gzip.DefaultCompression
+	}
+	if level < gzip.HuffmanOnly || level > gzip.BestCompression {
+		return nil, fmt.Errorf("invalid gzip compression level %d", level)
+	}
+	return gzipCodec{level: level}, nil
+}
+
+func (c gzipCodec) Encode(src []byte) ([]byte, error) {
+	pool := &gzipWriters[c.level-gzip.HuffmanOnly]
+
+	var b bytes.Buffer
+	w, ok := pool.Get().(*gzip.Writer)
+	if ok {
+		w.Reset(&b)
+	} else {
+		var err error
+		if w, err = gzip.NewWriterLevel(&b, c.level); err != nil {
+			return nil, err
+		}
+	}
+	defer pool.Put(w)
+
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (c gzipCodec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	var err error
+	zr, ok := gzipReaders.Get().(*gzip.Reader)
+	if ok {
+		err = zr.Reset(bytes.NewReader(src))
+	} else {
+		zr, err = gzip.NewReader(bytes.NewReader(src))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReaders.Put(zr)
+
+	b := bytes.NewBuffer(make([]byte, 0, pageSize(uncompressedSize)))
+	if _, err := b.ReadFrom(zr); err != nil {
+		return nil, err
+	}
+	if err := zr.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// brotliWriters pools the brotli writers by level.
+var brotliWriters [brotli.BestCompression + 1]sync.Pool
+
+var brotliReaders sync.Pool
+
+type brotliCodec struct {
+	level int
+}
+
+// newBrotliCodec returns the brotli codec of level, 0 for the default level:
+// brotli.BestSpeed cannot be selected.
+func newBrotliCodec(level int) (Codec, error) {
+	if level == 0 {
+		level = brotli.DefaultCompression
+	}
+	if level < 0 || level > brotli.BestCompression {
+		return nil, fmt.Errorf("invalid brotli compression level %d", level)
+	}
+	return brotliCodec{level: level}, nil
+}
+
+func (c brotliCodec) Encode(src []byte) ([]byte, error) {
+	pool := &brotliWriters[c.level]
+
+	var b bytes.Buffer
+	w, ok := pool.Get().(*brotli.Writer)
+	if ok {
+		w.Reset(&b)
+	} else {
+		w = brotli.NewWriterLevel(&b, c.level)
+	}
+	defer pool.Put(w)
+
+	if _, err := w.Write(src); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+func (c brotliCodec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	br, ok := brotliReaders.Get().(*brotli.Reader)
+	if ok {
+		if err := br.Reset(bytes.NewReader(src)); err != nil {
+			return nil, err
+		}
+	} else {
+		br = brotli.NewReader(bytes.NewReader(src))
+	}
+	defer brotliReaders.Put(br)
+
+	b := bytes.NewBuffer(make([]byte, 0, pageSize(uncompressedSize)))
+	if _, err := b.ReadFrom(br); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+type zstdOptions struct {
+	level  int
+	window int
+}
+
+// zstdEncoders caches the zstd encoders by options: an encoder can compress
+// several pages concurrently with EncodeAll.
+var zstdEncoders sync.Map // zstdOptions -> *zstd.Encoder
+
+var (
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+)
+
+type zstdCodec struct {
+	enc *zstd.Encoder
+}
+
+// newZstdCodec returns the zstd codec of level, 0 for the default level, with
+// the window size window, 0 for the default window of the level.
+func newZstdCodec(level int, window int) (Codec, error) {
+	key := zstdOptions{level: level, window: window}
+	if enc, ok := zstdEncoders.Load(key); ok {
+		return zstdCodec{enc: enc.(*zstd.Encoder)}, nil
+	}
+
+	enc, err := newZstdEncoder(level, window)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := zstdEncoders.LoadOrStore(key, enc)
+	return zstdCodec{enc: actual.(*zstd.Encoder)}, nil
+}
+
+func newZstdEncoder(level int, window int, options ...zstd.EOption) (*zstd.Encoder, error) {
+	options = append(options, zstd.WithEncoderConcurrency(1))
+	if level != 0 {
+		if level < 1 || level > 22 {
+			return nil, fmt.Errorf("invalid zstd compression level %d", level)
+		}
+		options = append(options, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	}
+	if window != 0 {
+		options = append(options, zstd.WithWindowSize(window))
+	}
+	enc, err := zstd.NewWriter(nil, options...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid zstd options: %s", err)
+	}
+	return enc, nil
+}
+
+func (c zstdCodec) Encode(src []byte) ([]byte, error) {
+	return c.enc.EncodeAll(src, nil), nil
+}
+
+func (c zstdCodec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	zstdDecoderOnce.Do(func() {
+		// DecodeAll is safe for concurrent use, a decoder is shared by all
+		// the scanners
+		zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdDecoder.DecodeAll(src, make([]byte, 0, pageSize(uncompressedSize)))
+}
+
+var lz4Compressors = sync.Pool{New: func() interface{} { return new(lz4.Compressor) }}
+
+// lz4Codec compresses the pages as a single LZ4 block, the format of LZ4_RAW.
+//
+// The pages of the deprecated LZ4 codec were written by parquet-mr with the
+// framing of the Hadoop Lz4Codec and by older parquet-cpp as a single block:
+// when hadoop is true the framing is detected on read.
+type lz4Codec struct {
+	hadoop bool
+}
+
+func (c lz4Codec) Encode(src []byte) ([]byte, error) {
+	lc := lz4Compressors.Get().(*lz4.Compressor)
+	defer lz4Compressors.Put(lc)
+
+	// the compression always succeeds in a buffer of the bound size, even
+	// for incompressible data
+	out := make([]byte, lz4.CompressBlockBound(len(src)))
+	n, err := lc.CompressBlock(src, out)
+	if err != nil {
+		return nil, err
+	}
+	return out[:n], nil
+}
+
+func (c lz4Codec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	// a LZ4 block expands at most 255 times, the size is not trusted to
+	// allocate more
+	if uncompressedSize > 255*len(src)+16 {
+		return nil, fmt.Errorf("invalid uncompressed size %d for %d bytes", uncompressedSize, len(src))
+	}
+	out := make([]byte, uncompressedSize)
+
+	if c.hadoop && lz4HadoopDecompress(src, out) {
+		return out, nil
+	}
+
+	n, err := lz4.UncompressBlock(src, out)
+	if err != nil {
+		return nil, err
+	}
+	return out[:n], nil
+}
+
+// lz4HadoopDecompress decodes src as LZ4 blocks framed by the Hadoop Lz4Codec
+// into out and reports whether it succeeded. Each block is prefixed with its
+// uncompressed and compressed sizes, as 4 bytes big endian.
+func lz4HadoopDecompress(src []byte, out []byte) bool {
+	pos := 0
+	for len(src) > 0 {
+		if len(src) < 8 {
+			return false
+		}
+		ulen := int(binary.BigEndian.Uint32(src[0:4]))
+		clen := int(binary.BigEndian.Uint32(src[4:8]))
+		src = src[8:]
+		if clen > len(src) || ulen > len(out)-pos {
+			return false
+		}
+		n, err := lz4.UncompressBlock(src[:clen], out[pos:pos+ulen])
+		if err != nil || n != ulen {
+			return false
+		}
+		src = src[clen:]
+		pos += ulen
+	}
+	return pos == len(out)
+}
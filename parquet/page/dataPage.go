@@ -7,8 +7,9 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
+	"math/bits"
 
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
 	"github.com/kostya-sh/parquet-go/parquet/encoding"
 	"github.com/kostya-sh/parquet-go/parquet/encoding/bitpacking"
 	"github.com/kostya-sh/parquet-go/parquet/encoding/rle"
@@ -16,156 +17,398 @@ import (
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
+// Triple is a value of a column with its repetition and definition levels,
+// from which the records are assembled.
+type Triple struct {
+	R     int
+	D     int
+	Value interface{} // nil if D is lower than the maximum definition level
+}
+
 //DataPage represents one data page inside a column chunk
 type DataPage struct {
-	schema              *thrift.SchemaElement
-	header              *thrift.DataPageHeader
-	meta                *thrift.ColumnMetaData
-	maxDefinitionLevels uint32
-	rb                  *bufio.Reader
-	DefinitionLevels    []bool
-	repetitionLevels    []uint32
+	schema             *thrift.SchemaElement
+	header             *thrift.DataPageHeader
+	headerV2           *thrift.DataPageHeaderV2
+	meta               *thrift.ColumnMetaData
+	maxRepetitionLevel uint
+	maxDefinitionLevel uint
+	rb                 *bufio.Reader
+	dictionary         *DictionaryPage
+	levelsRead         bool
+	DefinitionLevels   []bool // true for the values that are not null
+	definitionLevels   []uint32
+	repetitionLevels   []uint32
 	// debug
 	Debug []byte
 }
 
-// NewDataPage
+// NewDataPage creates a DataPage for a column described by schema only: the
+// maximum levels are those of a column at the top level of the schema. Use
+// NewNestedDataPage for the columns nested in groups.
 func NewDataPage(schema *thrift.SchemaElement, header *thrift.DataPageHeader) *DataPage {
-	return &DataPage{schema: schema, header: header}
+	maxRepetitionLevel, maxDefinitionLevel := topLevelMaxLevels(schema)
+	return NewNestedDataPage(schema, maxRepetitionLevel, maxDefinitionLevel, header)
 }
 
-func (p *DataPage) ReadAll(r io.Reader) error {
-	// r = dump(r)
-	b, err := ioutil.ReadAll(r)
-	if err != nil {
-		panic(err)
+// topLevelMaxLevels returns the maximum levels of a column at the top level
+// of the schema.
+func topLevelMaxLevels(schema *thrift.SchemaElement) (repetition, definition uint) {
+	switch schema.GetRepetitionType() {
+	case thrift.FieldRepetitionType_OPTIONAL:
+		return 0, 1
+	case thrift.FieldRepetitionType_REPEATED:
+		return 1, 1
 	}
-	p.Debug = b
-	r = bytes.NewReader(b)
-	p.rb = bufio.NewReader(r)
-	return nil
+	return 0, 0
 }
 
-func min(a, b uint) int {
-	if a < b {
-		return int(b)
+// NewNestedDataPage creates a DataPage for a column with the given maximum
+// repetition and definition levels.
+func NewNestedDataPage(schema *thrift.SchemaElement, maxRepetitionLevel, maxDefinitionLevel uint, header *thrift.DataPageHeader) *DataPage {
+	return &DataPage{
+		schema:             schema,
+		header:             header,
+		maxRepetitionLevel: maxRepetitionLevel,
+		maxDefinitionLevel: maxDefinitionLevel,
 	}
-	return int(a)
 }
 
-func (p *DataPage) readDefinitionAndRepetitionLevels(rb *bufio.Reader) (repetition []uint64, defintion []uint64, err error) {
-
-	// Repetition Levels
-	// only levels that are repeated need a Repetition level:
-	// optional or required fields are never repeated
-	// and can be skipped while attributing repetition levels.
-	if p.schema.GetRepetitionType() == thrift.FieldRepetitionType_REPEATED {
-		repEnc := p.header.GetRepetitionLevelEncoding()
-		switch repEnc {
-		case thrift.Encoding_BIT_PACKED:
-			dec := bitpacking.NewDecoder(1)
-			runs, err := dec.ReadLength(rb)
-			if err != nil {
-				return nil, nil, fmt.Errorf("bitpacking.ReadLength:%s", err)
+// NewDataPageV2 creates a DataPage for a page stored in the format of the
+// data pages v2 for a column with the given maximum levels.
+func NewDataPageV2(schema *thrift.SchemaElement, maxRepetitionLevel, maxDefinitionLevel uint, header *thrift.DataPageHeaderV2) *DataPage {
+	return &DataPage{
+		schema:             schema,
+		headerV2:           header,
+		maxRepetitionLevel: maxRepetitionLevel,
+		maxDefinitionLevel: maxDefinitionLevel,
+	}
+}
+
+// Header returns the header of the page, nil for a data page v2.
+func (p *DataPage) Header() *thrift.DataPageHeader {
+	return p.header
+}
+
+// HeaderV2 returns the header of a data page v2, nil for a data page v1.
+func (p *DataPage) HeaderV2() *thrift.DataPageHeaderV2 {
+	return p.headerV2
+}
+
+// NumValues returns the number of values in the page, nulls included.
+func (p *DataPage) NumValues() int32 {
+	if p.headerV2 != nil {
+		return p.headerV2.GetNumValues()
+	}
+	return p.header.GetNumValues()
+}
+
+func (p *DataPage) encoding() thrift.Encoding {
+	if p.headerV2 != nil {
+		return p.headerV2.GetEncoding()
+	}
+	return p.header.GetEncoding()
+}
+
+// Dictionary returns the dictionary of the column chunk the page belongs to
+// or nil if the chunk has no dictionary page.
+func (p *DataPage) Dictionary() *DictionaryPage {
+	return p.dictionary
+}
+
+// Levels returns the repetition and definition levels of the page, nil when
+// the column is not repeated or required.
+func (p *DataPage) Levels() (repetition []uint32, definition []uint32, err error) {
+	if err := p.readLevels(); err != nil {
+		return nil, nil, err
+	}
+	return p.repetitionLevels, p.definitionLevels, nil
+}
+
+// Values decodes the values of the page into accumulator, using the
+// dictionary of the column chunk if the values are dictionary encoded.
+func (p *DataPage) Values(accumulator memory.Accumulator) error {
+	return p.Decode(p.dictionary, accumulator)
+}
+
+// Triples decodes the levels and the values of the page. The values are
+// decoded with the encoding of the page, using the dictionary of the column
+// chunk if the values are dictionary encoded.
+func (p *DataPage) Triples() ([]Triple, error) {
+	if err := p.readLevels(); err != nil {
+		return nil, err
+	}
+
+	count := int(p.NumValues())
+	numValues := count
+	if p.definitionLevels != nil {
+		numValues = 0
+		for _, d := range p.definitionLevels {
+			if d == uint32(p.maxDefinitionLevel) {
+				numValues++
 			}
-			out := make([]int32, min(uint(p.header.GetNumValues()), runs*8))
-			if err := dec.Read(rb, out); err != nil {
-				return nil, nil, fmt.Errorf("bitpacking cannot read:%s", err)
+		}
+	}
+	if p.headerV2 != nil && int(p.headerV2.GetNumNulls()) != count-numValues {
+		return nil, fmt.Errorf("%d null values but the header declares %d", count-numValues, p.headerV2.GetNumNulls())
+	}
+
+	d, err := p.createDecoder(p.rb, p.dictionary, uint(numValues))
+	if err != nil {
+		return nil, fmt.Errorf("could not create decoder: %s", err)
+	}
+	values, err := decodeValues(d, p.schema, numValues)
+	if err != nil {
+		return nil, err
+	}
+
+	triples := make([]Triple, count)
+	next := 0
+	for i := range triples {
+		if p.repetitionLevels != nil {
+			triples[i].R = int(p.repetitionLevels[i])
+		}
+		if p.definitionLevels != nil {
+			triples[i].D = int(p.definitionLevels[i])
+			if p.definitionLevels[i] != uint32(p.maxDefinitionLevel) {
+				continue
 			}
+		}
+		triples[i].Value = values[next]
+		next++
+	}
 
-			log.Println("WARNING GOT REPETITION:", len(out), p.header.GetNumValues())
-
-		// 	result := make([]int32, 0, int(runs*8))
-		// finish:
-		// 	for i := 0; i < int(runs); i++ {
-		// 		if err := dec.Read(rb, out); err != nil {
-		// 			return nil, nil, fmt.Errorf("bitpacking cannot read after %d blocks:%s", i, err)
-		// 		}
-
-		// 		for j := 0; j < 8; j++ {
-		// 			if len(result)+1 > int(p.header.GetNumValues()) {
-		// 				break finish
-		// 			}
-		// 			result = append(result, out[j])
-		// 		}
-		// 	}
-
-		default:
-			return nil, nil, fmt.Errorf("WARNING could not handle %s", repEnc)
+	return triples, nil
+}
+
+// decodeValues decodes count values of the type of schema with d.
+func decodeValues(d encoding.Decoder, schema *thrift.SchemaElement, count int) ([]interface{}, error) {
+	values := make([]interface{}, count)
+	var (
+		n   uint
+		err error
+	)
+
+	switch t := schema.GetType(); t {
+	case thrift.Type_BOOLEAN:
+		out := make([]bool, count)
+		n, err = d.DecodeBool(out)
+		for i, v := range out[:n] {
+			values[i] = v
+		}
+	case thrift.Type_INT32:
+		out := make([]int32, count)
+		n, err = d.DecodeInt32(out)
+		for i, v := range out[:n] {
+			values[i] = v
+		}
+	case thrift.Type_INT64:
+		out := make([]int64, count)
+		n, err = d.DecodeInt64(out)
+		for i, v := range out[:n] {
+			values[i] = v
+		}
+	case thrift.Type_INT96:
+		out := make([]datatypes.Int96, count)
+		n, err = d.DecodeInt96(out)
+		for i, v := range out[:n] {
+			values[i] = v
+		}
+	case thrift.Type_FLOAT:
+		out := make([]float32, count)
+		n, err = d.DecodeFloat32(out)
+		for i, v := range out[:n] {
+			values[i] = v
+		}
+	case thrift.Type_DOUBLE:
+		out := make([]float64, count)
+		n, err = d.DecodeFloat64(out)
+		for i, v := range out[:n] {
+			values[i] = v
+		}
+	case thrift.Type_BYTE_ARRAY:
+		out := make([][]byte, count)
+		n, err = d.DecodeByteArray(out)
+		for i, v := range out[:n] {
+			values[i] = v
+		}
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		out := make([][]byte, count)
+		n, err = d.DecodeFixedByteArray(out, uint(schema.GetTypeLength()))
+		for i, v := range out[:n] {
+			values[i] = v
 		}
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
 	}
 
-	// Definition Levels
-	// For data that is required, the definition levels are skipped.
-	// If encoded, it will always have the value of the max definition level.
-	if p.schema.GetRepetitionType() != thrift.FieldRepetitionType_REQUIRED {
-		defEnc := p.header.GetDefinitionLevelEncoding()
-		switch defEnc {
-		case thrift.Encoding_RLE:
-			p.maxDefinitionLevels = 0
-			// length of the <encoded-data> in bytes stored as 4 bytes little endian
-			var length uint32
-
-			if err := binary.Read(rb, binary.LittleEndian, &length); err != nil {
-				return nil, nil, err
-			}
+	if err != nil {
+		return nil, fmt.Errorf("could not decode values: %s", err)
+	}
+	if int(n) != count {
+		return nil, fmt.Errorf("could not decode all the values: expected %d got %d", count, n)
+	}
+	return values, nil
+}
 
-			lr := io.LimitReader(rb, int64(length))
+func (p *DataPage) readLevels() error {
+	if p.levelsRead {
+		return nil
+	}
+	if p.rb == nil {
+		return fmt.Errorf("data page was not read")
+	}
+	if err := p.readDefinitionAndRepetitionLevels(p.rb); err != nil {
+		return fmt.Errorf("could not read levels: %s", err)
+	}
+	p.levelsRead = true
+	return nil
+}
 
-			values, err := rle.ReadBool(lr, uint(p.header.GetNumValues()))
-			if err != nil {
-				return nil, nil, err
-			}
+func (p *DataPage) ReadAll(r io.Reader) error {
+	// r = dump(r)
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("could not read data page: %s", err)
+	}
+	p.Debug = b
+	r = bytes.NewReader(b)
+	p.rb = bufio.NewReader(r)
+	return nil
+}
 
-			p.DefinitionLevels = values
+// readAllV2 reads a data page v2: the repetition and definition levels are
+// RLE encoded without a length prefix, r contains the uncompressed values.
+func (p *DataPage) readAllV2(repetition, definition []byte, r io.Reader) error {
+	count := int(p.NumValues())
 
-			if n, _ := io.Copy(ioutil.Discard, lr); n > 0 {
-				log.Println("WARNING not all data was consumed in RLE encoder")
-			}
+	if p.maxRepetitionLevel > 0 {
+		levels, err := readRLELevels(repetition, p.maxRepetitionLevel, count)
+		if err != nil {
+			return fmt.Errorf("repetition levels: %s", err)
+		}
+		p.repetitionLevels = levels
+	}
 
-		default:
-			return nil, nil, fmt.Errorf("WARNING could not handle %s", defEnc)
+	if p.maxDefinitionLevel > 0 {
+		levels, err := readRLELevels(definition, p.maxDefinitionLevel, count)
+		if err != nil {
+			return fmt.Errorf("definition levels: %s", err)
 		}
+		p.setDefinitionLevels(levels)
 	}
+	p.levelsRead = true
 
-	return []uint64{}, []uint64{}, nil
+	return p.ReadAll(r)
 }
 
-func (p *DataPage) createDecoder(rb *bufio.Reader, page *DictionaryPage) (encoding.Decoder, error) {
+// readDefinitionAndRepetitionLevels reads the repetition levels and then the
+// definition levels at the start of the page. The levels are only stored
+// when the maximum level is not 0: required fields at the top level have no
+// definition levels and only repeated fields have repetition levels.
+func (p *DataPage) readDefinitionAndRepetitionLevels(rb *bufio.Reader) error {
+	count := int(p.NumValues())
+
+	if p.maxRepetitionLevel > 0 {
+		levels, err := readLevels(rb, p.header.GetRepetitionLevelEncoding(), p.maxRepetitionLevel, count)
+		if err != nil {
+			return fmt.Errorf("repetition levels: %s", err)
+		}
+		p.repetitionLevels = levels
+	}
+
+	if p.maxDefinitionLevel > 0 {
+		levels, err := readLevels(rb, p.header.GetDefinitionLevelEncoding(), p.maxDefinitionLevel, count)
+		if err != nil {
+			return fmt.Errorf("definition levels: %s", err)
+		}
+		p.setDefinitionLevels(levels)
+	}
 
-	numValues := uint(p.header.NumValues)
+	return nil
+}
 
-	switch p.header.Encoding {
-	case thrift.Encoding_BIT_PACKED:
-	case thrift.Encoding_DELTA_BINARY_PACKED:
-	case thrift.Encoding_DELTA_BYTE_ARRAY:
-	case thrift.Encoding_DELTA_LENGTH_BYTE_ARRAY:
-	case thrift.Encoding_PLAIN:
-		return encoding.NewPlainDecoder(rb, numValues), nil
+func (p *DataPage) setDefinitionLevels(levels []uint32) {
+	p.definitionLevels = levels
+	p.DefinitionLevels = make([]bool, len(levels))
+	for i, d := range levels {
+		p.DefinitionLevels[i] = d == uint32(p.maxDefinitionLevel)
+	}
+}
+
+// readLevels reads count levels encoded with enc. The bit width of the
+// levels is the number of bits needed to store maxLevel.
+func readLevels(r io.Reader, enc thrift.Encoding, maxLevel uint, count int) ([]uint32, error) {
+	switch enc {
 	case thrift.Encoding_RLE:
+		// length of the <encoded-data> in bytes stored as 4 bytes little endian
+		var length uint32
+		if err := binary.Read(r, binary.LittleEndian, &length); err != nil {
+			return nil, fmt.Errorf("could not read length: %s", err)
+		}
+		// the length is not trusted to allocate the buffer
+		data, err := ioutil.ReadAll(io.LimitReader(r, int64(length)))
+		if err != nil {
+			return nil, err
+		}
+		if len(data) != int(length) {
+			return nil, fmt.Errorf("short read: expected %d bytes but got %d", length, len(data))
+		}
+		return readRLELevels(data, maxLevel, count)
 
-	case thrift.Encoding_RLE_DICTIONARY:
-		fallthrough
-	case thrift.Encoding_PLAIN_DICTIONARY:
-		if page == nil {
-			return nil, fmt.Errorf("data page in dictionary page format but no dictionary was defined")
+	case thrift.Encoding_BIT_PACKED:
+		// deprecated encoding, only found in files written by old versions
+		// of parquet-mr
+		values, err := bitpacking.ReadBitPacked(r, uint(bits.Len(maxLevel)), count)
+		if err != nil {
+			return nil, err
 		}
-		return encoding.NewPlainDictionaryDecoder(rb, page, numValues), nil
+		return checkLevels(values, maxLevel)
+
 	default:
-		panic("Not supported type for " + p.header.GetEncoding().String())
+		return nil, fmt.Errorf("unsupported encoding %s", enc)
 	}
+}
 
-	panic("NYI")
+// readRLELevels reads count levels RLE encoded in data.
+func readRLELevels(data []byte, maxLevel uint, count int) ([]uint32, error) {
+	values, err := rle.ReadInt32(bytes.NewReader(data), uint(bits.Len(maxLevel)), uint(count))
+	if err != nil {
+		return nil, err
+	}
+	return checkLevels(values, maxLevel)
+}
+
+// checkLevels checks that the levels are not greater than maxLevel.
+func checkLevels(values []int32, maxLevel uint) ([]uint32, error) {
+	levels := make([]uint32, len(values))
+	for i, v := range values {
+		if uint32(v) > uint32(maxLevel) {
+			return nil, fmt.Errorf("level %d greater than the maximum %d", v, maxLevel)
+		}
+		levels[i] = uint32(v)
+	}
+	return levels, nil
+}
+
+func (p *DataPage) createDecoder(rb *bufio.Reader, page *DictionaryPage, numValues uint) (encoding.Decoder, error) {
+	var dictionary encoding.Dictionary
+	if page != nil {
+		dictionary = page
+	}
+	return encoding.NewDecoder(p.encoding(), rb, numValues, dictionary)
 }
 
 func (p *DataPage) Decode(page *DictionaryPage, accumulator memory.Accumulator) error {
 
-	p.readDefinitionAndRepetitionLevels(p.rb)
-	d, err := p.createDecoder(p.rb, page)
+	if err := p.readLevels(); err != nil {
+		return err
+	}
+	d, err := p.createDecoder(p.rb, page, uint(p.NumValues()))
 	if err != nil {
 		return fmt.Errorf("could not create decoder: %s", err)
 	}
-	return accumulator.Accumulate(d, p.DefinitionLevels, uint(p.header.GetNumValues()))
+	return accumulator.Accumulate(d, p.DefinitionLevels, uint(p.NumValues()))
 }
 
 // // Decode using the given reader
@@ -0,0 +1,496 @@
+package page
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/encoding"
+	"github.com/kostya-sh/parquet-go/parquet/encoding/rle"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+const (
+	// DefaultDictionaryPageSize is the maximum size of a dictionary before
+	// falling back to the PLAIN encoding, the same as parquet-mr.
+	DefaultDictionaryPageSize = 1024 * 1024
+
+	// DefaultPageSize is the size above which a new data page is started.
+	DefaultPageSize = 1024 * 1024
+)
+
+// encodedDictionaryPage is the dictionary page written by the
+// dictionaryPageEncoder.
+type encodedDictionaryPage struct {
+	header  *thrift.PageHeader
+	data    []byte
+	payload []byte // uncompressed data
+}
+
+// compress sets the data of the page to its payload compressed with c.
+func (page *encodedDictionaryPage) compress(c compressor) error {
+	compressed, err := c.compress(page.payload)
+	if err != nil {
+		return fmt.Errorf("could not compress dictionary page: %s", err)
+	}
+	page.data = append([]byte(nil), compressed...)
+	page.header.CompressedPageSize = int32(len(page.data))
+	page.header.Crc = checksum(page.data)
+	return nil
+}
+
+func (page *encodedDictionaryPage) Type() thrift.PageType {
+	return thrift.PageType_DICTIONARY_PAGE
+}
+
+func (page *encodedDictionaryPage) NumValues() int32 {
+	return page.header.DictionaryPageHeader.NumValues
+}
+
+// dictionaryPageEncoder stores the distinct values of a column in a
+// dictionary page and the values themselves as indices in the dictionary.
+//
+// As parquet-mr does, it falls back to the PLAIN encoding once the
+// dictionary grows over maxDictionarySize: the data pages already written
+// keep using the dictionary, the following ones are PLAIN encoded. When no
+// data page was written yet the dictionary is dropped altogether.
+type dictionaryPageEncoder struct {
+	compression       compressor
+	maxDictionarySize int
+	pageSize          int
+	maxPageValues     int // 0 for no limit
+	pageValues        int // number of values of the current data page, nulls included
+	dataPageVersion   int
+
+	dictionary map[string]int32 // PLAIN encoded value -> index
+	entries    [][]byte         // PLAIN encoded values in index order
+	size       int              // size of the PLAIN encoded dictionary
+	indices    []int32          // indices of the current data page
+
+	fallback bool
+	plain    bytes.Buffer // PLAIN encoded values of the current data page
+	numPlain int
+	bools    []bool // booleans are never dictionary encoded
+
+	pages           []Page
+	dictionaryPages int // number of pages using the dictionary
+	zstdDictionary  []byte
+
+	pageStats   statistics // statistics of the current data page
+	chunkStats  statistics
+	numNulls    int64
+	sizeStats   sizeStatistics
+	bloomFilter *bloom.Filter
+	levels      pageLevels
+	pagesSize   int64 // uncompressed size of the data pages
+}
+
+func newDictionaryPageEncoder(preferences EncodingPreferences, compression compressor) *dictionaryPageEncoder {
+	e := &dictionaryPageEncoder{
+		compression:       compression,
+		maxDictionarySize: preferences.DictionaryPageSize,
+		pageSize:          preferences.PageSize,
+		maxPageValues:     preferences.PageValues,
+		dataPageVersion:   preferences.DataPageVersion,
+		dictionary:        make(map[string]int32),
+		pageStats:         newStatistics(preferences),
+		chunkStats:        newStatistics(preferences),
+		bloomFilter:       preferences.BloomFilter,
+		levels: pageLevels{
+			maxRepetition: preferences.MaxRepetitionLevel,
+			maxDefinition: preferences.MaxDefinitionLevel,
+		},
+	}
+	if e.maxDictionarySize <= 0 {
+		e.maxDictionarySize = DefaultDictionaryPageSize
+	}
+	if e.pageSize <= 0 {
+		e.pageSize = DefaultPageSize
+	}
+	return e
+}
+
+// bitWidth returns the bit width of the indices in the dictionary.
+func (e *dictionaryPageEncoder) bitWidth() uint {
+	if len(e.entries) == 0 {
+		return 0
+	}
+	return uint(bits.Len32(uint32(len(e.entries) - 1)))
+}
+
+// add adds a PLAIN encoded value of type typ. The value of levels written
+// with WriteLevels was counted with its levels.
+func (e *dictionaryPageEncoder) add(typ thrift.Type, value []byte) error {
+	v := value
+	if typ == thrift.Type_BYTE_ARRAY {
+		v = value[4:]
+	}
+	if !e.levels.midRow() {
+		e.pageValues++
+	}
+	if err := e.levels.addValues(1); err != nil {
+		return fmt.Errorf("dictionaryPageEncoder: %s", err)
+	}
+	e.pageStats.add(typ, v)
+	e.chunkStats.add(typ, v)
+	if e.bloomFilter != nil {
+		e.bloomFilter.InsertHash(bloom.HashBytes(v))
+	}
+
+	if e.fallback {
+		e.plain.Write(value)
+		e.numPlain++
+		if (e.plain.Len() >= e.pageSize || e.pageFull()) && !e.levels.midRow() {
+			return e.flushPlain()
+		}
+		return nil
+	}
+
+	index, ok := e.dictionary[string(value)]
+	if !ok {
+		index = int32(len(e.entries))
+		e.dictionary[string(value)] = index
+		e.entries = append(e.entries, value)
+		e.size += len(value)
+	}
+	e.indices = append(e.indices, index)
+
+	if e.size > e.maxDictionarySize {
+		return e.fallBack()
+	}
+	if (len(e.indices)*int(e.bitWidth())/8 >= e.pageSize || e.pageFull()) && !e.levels.midRow() {
+		return e.flushIndices()
+	}
+	return nil
+}
+
+// pageFull returns whether the current data page has the maximum number of
+// values of a page.
+func (e *dictionaryPageEncoder) pageFull() bool {
+	return e.maxPageValues > 0 && e.pageValues >= e.maxPageValues
+}
+
+// flushPage writes the current data page, of indices unless the encoder
+// fell back to the PLAIN encoding or the page has nulls only.
+func (e *dictionaryPageEncoder) flushPage() error {
+	if e.fallback || len(e.indices) == 0 {
+		return e.flushPlain()
+	}
+	return e.flushIndices()
+}
+
+// fallBack switches to the PLAIN encoding. The values of the current data
+// page are PLAIN encoded again.
+func (e *dictionaryPageEncoder) fallBack() error {
+	e.fallback = true
+	for _, index := range e.indices {
+		e.plain.Write(e.entries[index])
+		e.numPlain++
+	}
+	e.indices = nil
+
+	if e.dictionaryPages == 0 {
+		e.dictionary = nil
+		e.entries = nil
+		e.size = 0
+	}
+
+	if (e.plain.Len() >= e.pageSize || e.pageFull()) && !e.levels.midRow() {
+		return e.flushPlain()
+	}
+	return nil
+}
+
+// flushIndices writes the indices of the current data page.
+func (e *dictionaryPageEncoder) flushIndices() error {
+	if len(e.indices) == 0 {
+		return nil
+	}
+
+	bitWidth := e.bitWidth()
+	var b bytes.Buffer
+	b.WriteByte(byte(bitWidth))
+	if bitWidth > 0 {
+		if _, err := rle.WriteInt32(&b, bitWidth, e.indices); err != nil {
+			return fmt.Errorf("dictionaryPageEncoder: could not write indices: %s", err)
+		}
+	}
+
+	if err := e.addDataPage(thrift.Encoding_PLAIN_DICTIONARY, b.Bytes(), len(e.indices)); err != nil {
+		return err
+	}
+	e.dictionaryPages++
+	e.indices = e.indices[:0]
+	return nil
+}
+
+// flushPlain writes the PLAIN encoded values of the current data page.
+func (e *dictionaryPageEncoder) flushPlain() error {
+	if len(e.bools) > 0 {
+		if _, err := encoding.NewPlainEncoder().WriteBool(&e.plain, e.bools); err != nil {
+			return fmt.Errorf("dictionaryPageEncoder: could not write bool: %s", err)
+		}
+		e.numPlain += len(e.bools)
+		e.bools = e.bools[:0]
+	}
+	if e.numPlain == 0 && len(e.levels.definition) == 0 {
+		return nil
+	}
+
+	if err := e.addDataPage(thrift.Encoding_PLAIN, e.plain.Bytes(), e.numPlain); err != nil {
+		return err
+	}
+	e.plain.Reset()
+	e.numPlain = 0
+	return nil
+}
+
+func (e *dictionaryPageEncoder) addDataPage(enc thrift.Encoding, p []byte, numValues int) error {
+	levels, numValues := e.levels.page(numValues)
+	page, err := encodeDataPage(e.dataPageVersion, e.compression.deferred(), enc, levels, p, numValues, &e.pageStats)
+	if err != nil {
+		return fmt.Errorf("dictionaryPageEncoder: %s", err)
+	}
+
+	e.pages = append(e.pages, page)
+	e.pageValues = 0
+	e.pagesSize += int64(page.UncompressedSize())
+	e.numNulls += page.numNulls()
+	e.sizeStats.addPage(page)
+	e.pageStats.reset()
+	return nil
+}
+
+// Pages returns the dictionary page, if any, followed by the data pages.
+func (e *dictionaryPageEncoder) Pages() ([]Page, error) {
+	if err := e.flushIndices(); err != nil {
+		return nil, err
+	}
+	if err := e.flushPlain(); err != nil {
+		return nil, err
+	}
+	pages := e.pages
+	if e.dictionaryPages > 0 {
+		var b bytes.Buffer
+		for _, entry := range e.entries {
+			b.Write(entry)
+		}
+
+		header := thrift.NewPageHeader()
+		header.Type = thrift.PageType_DICTIONARY_PAGE
+		header.DictionaryPageHeader = thrift.NewDictionaryPageHeader()
+		header.DictionaryPageHeader.NumValues = int32(len(e.entries))
+		header.DictionaryPageHeader.Encoding = thrift.Encoding_PLAIN_DICTIONARY
+		header.UncompressedPageSize = int32(b.Len())
+
+		page := &encodedDictionaryPage{header: header, payload: b.Bytes()}
+		if err := page.compress(e.compression.deferred()); err != nil {
+			return nil, fmt.Errorf("dictionaryPageEncoder: %s", err)
+		}
+		pages = append([]Page{page}, e.pages...)
+	}
+
+	dict, err := compressPages(pages, e.compression)
+	if err != nil {
+		return nil, err
+	}
+	e.zstdDictionary = dict
+	return pages, nil
+}
+
+func (e *dictionaryPageEncoder) ZstdDictionary() []byte {
+	return e.zstdDictionary
+}
+
+func (e *dictionaryPageEncoder) ColumnIndex() *thrift.ColumnIndex {
+	return columnIndex(e.pages, &e.chunkStats)
+}
+
+func (e *dictionaryPageEncoder) SizeStatistics() *thrift.SizeStatistics {
+	return e.sizeStats.thrift()
+}
+
+// Statistics returns the statistics of the values, with their number of
+// distinct values while they are all in the dictionary.
+func (e *dictionaryPageEncoder) Statistics() *thrift.Statistics {
+	stats := e.chunkStats.thrift(e.numNulls)
+	if !e.fallback {
+		distinct := int64(len(e.entries))
+		stats.DistinctCount = &distinct
+	}
+	return stats
+}
+
+func (e *dictionaryPageEncoder) WriteBool(values []bool) error {
+	if !e.fallback {
+		if err := e.fallBack(); err != nil {
+			return err
+		}
+	}
+	if e.levels.midRow() {
+		// the values were counted with their levels
+		if err := e.levels.addValues(len(values)); err != nil {
+			return fmt.Errorf("dictionaryPageEncoder: %s", err)
+		}
+		e.bools = append(e.bools, values...)
+		e.pageStats.addBool(values)
+		e.chunkStats.addBool(values)
+		if e.pageFull() && !e.levels.midRow() {
+			return e.flushPlain()
+		}
+		return nil
+	}
+	for len(values) > 0 {
+		n := len(values)
+		if e.maxPageValues > 0 && n > e.maxPageValues-e.pageValues {
+			n = e.maxPageValues - e.pageValues
+		}
+		if err := e.levels.addValues(n); err != nil {
+			return fmt.Errorf("dictionaryPageEncoder: %s", err)
+		}
+		e.bools = append(e.bools, values[:n]...)
+		e.pageValues += n
+		e.pageStats.addBool(values[:n])
+		e.chunkStats.addBool(values[:n])
+		if e.pageFull() {
+			if err := e.flushPlain(); err != nil {
+				return err
+			}
+		}
+		values = values[n:]
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) WriteInt32(values []int32) error {
+	for _, v := range values {
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, uint32(v))
+		if err := e.add(thrift.Type_INT32, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) WriteInt64(values []int64) error {
+	for _, v := range values {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(v))
+		if err := e.add(thrift.Type_INT64, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) WriteFloat32(values []float32) error {
+	for _, v := range values {
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, math.Float32bits(v))
+		if err := e.add(thrift.Type_FLOAT, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) WriteFloat64(values []float64) error {
+	for _, v := range values {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+		if err := e.add(thrift.Type_DOUBLE, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) WriteByteArray(values [][]byte) error {
+	e.sizeStats.addByteArray(values)
+	for _, v := range values {
+		b := make([]byte, 4+len(v))
+		binary.LittleEndian.PutUint32(b, uint32(len(v)))
+		copy(b[4:], v)
+		if err := e.add(thrift.Type_BYTE_ARRAY, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) WriteFixedByteArray(values [][]byte) error {
+	for _, v := range values {
+		if err := e.add(thrift.Type_FIXED_LEN_BYTE_ARRAY, append([]byte(nil), v...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) BufferedSize() int64 {
+	current := len(e.indices)*int(e.bitWidth())/8 + e.plain.Len() + len(e.bools)/8 + e.levels.size()
+	return e.pagesSize + int64(e.size+current)
+}
+
+func (e *dictionaryPageEncoder) Reset(filter *bloom.Filter) {
+	if e.dictionary == nil {
+		e.dictionary = make(map[string]int32)
+	}
+	for value := range e.dictionary {
+		delete(e.dictionary, value)
+	}
+	for i := range e.entries {
+		e.entries[i] = nil
+	}
+	e.entries = e.entries[:0]
+	e.size = 0
+	e.indices = e.indices[:0]
+	e.fallback = false
+	e.plain.Reset()
+	e.numPlain = 0
+	e.bools = e.bools[:0]
+	for i := range e.pages {
+		e.pages[i] = nil
+	}
+	e.pages = e.pages[:0]
+	e.dictionaryPages = 0
+	e.zstdDictionary = nil
+	e.pageValues = 0
+	e.pageStats.reset()
+	e.chunkStats.reset()
+	e.numNulls = 0
+	e.sizeStats = sizeStatistics{}
+	e.bloomFilter = filter
+	e.levels.reset()
+	e.pagesSize = 0
+}
+
+func (e *dictionaryPageEncoder) WriteNulls(n int) error {
+	for i := 0; i < n; i++ {
+		if err := e.levels.addNulls(1); err != nil {
+			return fmt.Errorf("dictionaryPageEncoder: %s", err)
+		}
+		e.pageValues++
+		if e.pageFull() {
+			if err := e.flushPage(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (e *dictionaryPageEncoder) WriteLevels(repetition, definition []int32) error {
+	if err := e.levels.addLevels(repetition, definition); err != nil {
+		return fmt.Errorf("dictionaryPageEncoder: %s", err)
+	}
+	e.pageValues += len(definition)
+	if e.pageFull() && !e.levels.midRow() {
+		return e.flushPage()
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package page
 import (
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/kostya-sh/parquet-go/parquet/datatypes"
 	"github.com/kostya-sh/parquet-go/parquet/encoding"
@@ -22,50 +23,57 @@ type DictionaryPage struct {
 	valuesFloat64   []float64
 	count           uint
 	typeLength      uint
+
+	// source returns the reader of the values of a page decoded on first
+	// use, nil if the page is decoded with Decode
+	source func() (io.Reader, error)
+	once   sync.Once
+	err    error
 }
 
 // NewDictionaryPage
 func NewDictionaryPage(schema *thrift.SchemaElement, header *thrift.DictionaryPageHeader) *DictionaryPage {
-	count := uint(header.NumValues)
-
 	t := schema.GetType()
 	switch t {
-	case thrift.Type_BOOLEAN:
-		return &DictionaryPage{
-			t:          t,
-			header:     header,
-			valuesBool: make([]bool, count),
-			count:      count,
-		}
-	case thrift.Type_INT32:
-		return &DictionaryPage{
-			t:           t,
-			header:      header,
-			valuesInt32: make([]int32, count),
-			count:       count,
-		}
-	case thrift.Type_INT64:
-		return &DictionaryPage{t: t, header: header, valuesInt64: make([]int64, count), count: count}
-	case thrift.Type_BYTE_ARRAY:
-		return &DictionaryPage{t: t, header: header, valuesByteArray: make([][]byte, count), count: count}
-	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
-		return &DictionaryPage{t: t, header: header, valuesByteArray: make([][]byte, count), typeLength: uint(schema.GetTypeLength()), count: count}
-	case thrift.Type_FLOAT:
-		return &DictionaryPage{t: t, header: header, valuesFloat32: make([]float32, count), count: count}
-	case thrift.Type_DOUBLE:
-		return &DictionaryPage{t: t, header: header, valuesFloat64: make([]float64, count), count: count}
-	case thrift.Type_INT96:
-		return &DictionaryPage{
-			t:           t,
-			header:      header,
-			valuesInt96: make([]datatypes.Int96, count),
-			count:       count,
-		}
+	case thrift.Type_BOOLEAN, thrift.Type_INT32, thrift.Type_INT64, thrift.Type_BYTE_ARRAY,
+		thrift.Type_FIXED_LEN_BYTE_ARRAY, thrift.Type_FLOAT, thrift.Type_DOUBLE, thrift.Type_INT96:
 	default:
 		panic("Warning: not supported type " + t.String() + " in plain encoding dictionaryPage")
 	}
 
-	return nil
+	return &DictionaryPage{
+		t:          t,
+		header:     header,
+		count:      uint(header.NumValues),
+		typeLength: uint(schema.GetTypeLength()),
+	}
+}
+
+// newLazyDictionaryPage returns a dictionary page decoded the first time one
+// of its values is mapped, from the reader returned by source. The
+// dictionary is shared by all the data pages of a column chunk: it is
+// decoded once, and never for the chunks whose values are not read.
+func newLazyDictionaryPage(schema *thrift.SchemaElement, header *thrift.DictionaryPageHeader, source func() (io.Reader, error)) *DictionaryPage {
+	p := NewDictionaryPage(schema, header)
+	p.source = source
+	return p
+}
+
+// load decodes a lazy dictionary page on first use.
+func (p *DictionaryPage) load() error {
+	if p.source == nil {
+		return nil
+	}
+	p.once.Do(func() {
+		r, err := p.source()
+		if err == nil {
+			err = p.Decode(r)
+		}
+		if err != nil {
+			p.err = fmt.Errorf("could not decode dictionary page: %s", err)
+		}
+	})
+	return p.err
 }
 
 func (p *DictionaryPage) NumValues() int32 {
@@ -80,11 +88,30 @@ func (p *DictionaryPage) Decode(r io.Reader) error {
 	count := p.count
 	_type := p.t
 
+	switch _type {
+	case thrift.Type_BOOLEAN:
+		p.valuesBool = make([]bool, count)
+	case thrift.Type_INT32:
+		p.valuesInt32 = make([]int32, count)
+	case thrift.Type_INT64:
+		p.valuesInt64 = make([]int64, count)
+	case thrift.Type_INT96:
+		p.valuesInt96 = make([]datatypes.Int96, count)
+	case thrift.Type_BYTE_ARRAY, thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		p.valuesByteArray = make([][]byte, count)
+	case thrift.Type_FLOAT:
+		p.valuesFloat32 = make([]float32, count)
+	case thrift.Type_DOUBLE:
+		p.valuesFloat64 = make([]float64, count)
+	}
+
 	//log.Println("dictionaryPage.Decode:", p.header.GetEncoding(), p.t, count)
 
 	switch p.header.GetEncoding() {
 
-	case thrift.Encoding_PLAIN_DICTIONARY:
+	// parquet 1.0 writers use PLAIN_DICTIONARY, later ones PLAIN: the values
+	// are PLAIN encoded either way
+	case thrift.Encoding_PLAIN_DICTIONARY, thrift.Encoding_PLAIN:
 		decoder := encoding.NewPlainDecoder(r, count)
 		switch _type {
 		case thrift.Type_BOOLEAN:
@@ -139,6 +166,9 @@ func (p *DictionaryPage) Decode(r io.Reader) error {
 }
 
 func (p *DictionaryPage) MapBool(keys []uint32, out []bool) error {
+	if err := p.load(); err != nil {
+		return err
+	}
 	for i := 0; i < len(out); i++ {
 		k := keys[i]
 		if k >= uint32(len(p.valuesBool)) {
@@ -151,6 +181,9 @@ func (p *DictionaryPage) MapBool(keys []uint32, out []bool) error {
 }
 
 func (p *DictionaryPage) MapInt32(keys []uint32, out []int32) error {
+	if err := p.load(); err != nil {
+		return err
+	}
 	for i := 0; i < len(out); i++ {
 		k := keys[i]
 		if k >= uint32(len(p.valuesInt32)) {
@@ -163,6 +196,9 @@ func (p *DictionaryPage) MapInt32(keys []uint32, out []int32) error {
 }
 
 func (p *DictionaryPage) MapInt64(keys []uint32, out []int64) error {
+	if err := p.load(); err != nil {
+		return err
+	}
 	for i := 0; i < len(out); i++ {
 		k := keys[i]
 		if k >= uint32(len(p.valuesInt64)) {
@@ -175,6 +211,9 @@ func (p *DictionaryPage) MapInt64(keys []uint32, out []int64) error {
 }
 
 func (p *DictionaryPage) MapInt96(keys []uint32, out []datatypes.Int96) error {
+	if err := p.load(); err != nil {
+		return err
+	}
 	for i := 0; i < len(out); i++ {
 		k := keys[i]
 		if k >= uint32(len(p.valuesInt96)) {
@@ -187,6 +226,9 @@ func (p *DictionaryPage) MapInt96(keys []uint32, out []datatypes.Int96) error {
 }
 
 func (p *DictionaryPage) MapFloat32(keys []uint32, out []float32) error {
+	if err := p.load(); err != nil {
+		return err
+	}
 	for i := 0; i < len(out); i++ {
 		k := keys[i]
 		if k >= uint32(len(p.valuesFloat32)) {
@@ -199,6 +241,9 @@ func (p *DictionaryPage) MapFloat32(keys []uint32, out []float32) error {
 }
 
 func (p *DictionaryPage) MapFloat64(keys []uint32, out []float64) error {
+	if err := p.load(); err != nil {
+		return err
+	}
 	for i := 0; i < len(out); i++ {
 		k := keys[i]
 		if k >= uint32(len(p.valuesFloat64)) {
@@ -211,6 +256,9 @@ func (p *DictionaryPage) MapFloat64(keys []uint32, out []float64) error {
 }
 
 func (p *DictionaryPage) MapByteArray(keys []uint32, out [][]byte) error {
+	if err := p.load(); err != nil {
+		return err
+	}
 	for i := 0; i < len(out); i++ {
 		k := keys[i]
 		if k >= uint32(len(p.valuesByteArray)) {
@@ -0,0 +1,199 @@
+package page
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/kostya-sh/parquet-go/parquet/encoding"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// encodingNames are the names of the encodings of the values.
+var encodingNames = map[string]thrift.Encoding{
+	"plain":                   thrift.Encoding_PLAIN,
+	"dictionary":              thrift.Encoding_RLE_DICTIONARY,
+	"rle":                     thrift.Encoding_RLE,
+	"delta_binary_packed":     thrift.Encoding_DELTA_BINARY_PACKED,
+	"delta_length_byte_array": thrift.Encoding_DELTA_LENGTH_BYTE_ARRAY,
+	"delta_byte_array":        thrift.Encoding_DELTA_BYTE_ARRAY,
+	"byte_stream_split":       thrift.Encoding_BYTE_STREAM_SPLIT,
+}
+
+// EncodingByName returns the encoding of name, a lower case encoding name
+// such as "plain" or "delta_binary_packed". "dictionary" is the
+// RLE_DICTIONARY encoding, written by the "dictionary" strategy.
+func EncodingByName(name string) (thrift.Encoding, error) {
+	enc, ok := encodingNames[name]
+	if !ok {
+		return 0, fmt.Errorf("unsupported encoding %s", name)
+	}
+	return enc, nil
+}
+
+// CheckEncoding returns an error if the values of physical type t cannot be
+// written with the encoding enc.
+func CheckEncoding(enc thrift.Encoding, t thrift.Type) error {
+	var ok bool
+	switch enc {
+	case thrift.Encoding_PLAIN:
+		ok = true
+	case thrift.Encoding_PLAIN_DICTIONARY, thrift.Encoding_RLE_DICTIONARY:
+		ok = t != thrift.Type_BOOLEAN
+	case thrift.Encoding_RLE:
+		ok = t == thrift.Type_BOOLEAN
+	case thrift.Encoding_DELTA_BINARY_PACKED:
+		ok = t == thrift.Type_INT32 || t == thrift.Type_INT64
+	case thrift.Encoding_DELTA_LENGTH_BYTE_ARRAY:
+		ok = t == thrift.Type_BYTE_ARRAY
+	case thrift.Encoding_DELTA_BYTE_ARRAY:
+		ok = t == thrift.Type_BYTE_ARRAY || t == thrift.Type_FIXED_LEN_BYTE_ARRAY
+	case thrift.Encoding_BYTE_STREAM_SPLIT:
+		switch t {
+		case thrift.Type_INT32, thrift.Type_INT64, thrift.Type_FLOAT, thrift.Type_DOUBLE, thrift.Type_FIXED_LEN_BYTE_ARRAY:
+			ok = true
+		}
+	}
+	if !ok {
+		return fmt.Errorf("encoding %s is not supported for type %s", enc, t)
+	}
+	return nil
+}
+
+// newValuesEncoder returns the encoder of the values of the data pages of
+// the "default" strategy.
+func newValuesEncoder(enc thrift.Encoding) (encoding.Encoder, error) {
+	switch enc {
+	case thrift.Encoding_PLAIN:
+		return encoding.NewPlainEncoder(), nil
+	case thrift.Encoding_RLE:
+		return encoding.NewRLEBooleanEncoder(), nil
+	case thrift.Encoding_DELTA_BINARY_PACKED:
+		return encoding.NewDeltaBinaryPackedEncoder(encoding.DefaultDeltaBlockSize, encoding.DefaultDeltaMiniBlocks), nil
+	case thrift.Encoding_DELTA_LENGTH_BYTE_ARRAY:
+		return encoding.NewDeltaLengthByteArrayEncoder(), nil
+	case thrift.Encoding_DELTA_BYTE_ARRAY:
+		return encoding.NewDeltaByteArrayEncoder(), nil
+	case thrift.Encoding_BYTE_STREAM_SPLIT:
+		return encoding.NewByteStreamSplitEncoder(), nil
+	default:
+		return nil, fmt.Errorf("encoding %s is not supported by the default strategy", enc)
+	}
+}
+
+// pageValues buffers the values of the current data page: the booleans of
+// the PLAIN encoding and the values of the other encodings have to be
+// encoded all at once. A page only has values of one type.
+type pageValues struct {
+	typ        thrift.Type // the type of the column, if typed
+	typed      bool        // whether typ is known, from the column or the values
+	bools      []bool
+	int32s     []int32
+	int64s     []int64
+	float32s   []float32
+	float64s   []float64
+	byteArrays [][]byte
+	fixed      [][]byte // the values of a FIXED_LEN_BYTE_ARRAY column
+	size       int      // size in bytes of the values
+}
+
+// copyBytes copies the byte arrays of v, the callers may reuse them.
+func copyBytes(v [][]byte) ([][]byte, int) {
+	size := 0
+	for _, b := range v {
+		size += len(b)
+	}
+	buf := make([]byte, 0, size)
+	c := make([][]byte, len(v))
+	for i, b := range v {
+		buf = append(buf, b...)
+		c[i] = buf[len(buf)-len(b):]
+	}
+	return c, size
+}
+
+func (p *pageValues) addBool(v []bool) {
+	p.typ, p.typed = thrift.Type_BOOLEAN, true
+	p.bools = append(p.bools, v...)
+	p.size += len(v)
+}
+
+func (p *pageValues) addInt32(v []int32) {
+	p.typ, p.typed = thrift.Type_INT32, true
+	p.int32s = append(p.int32s, v...)
+	p.size += 4 * len(v)
+}
+
+func (p *pageValues) addInt64(v []int64) {
+	p.typ, p.typed = thrift.Type_INT64, true
+	p.int64s = append(p.int64s, v...)
+	p.size += 8 * len(v)
+}
+
+func (p *pageValues) addFloat32(v []float32) {
+	p.typ, p.typed = thrift.Type_FLOAT, true
+	p.float32s = append(p.float32s, v...)
+	p.size += 4 * len(v)
+}
+
+func (p *pageValues) addFloat64(v []float64) {
+	p.typ, p.typed = thrift.Type_DOUBLE, true
+	p.float64s = append(p.float64s, v...)
+	p.size += 8 * len(v)
+}
+
+func (p *pageValues) addByteArray(v [][]byte) {
+	p.typ, p.typed = thrift.Type_BYTE_ARRAY, true
+	c, size := copyBytes(v)
+	p.byteArrays = append(p.byteArrays, c...)
+	p.size += 4*len(v) + size
+}
+
+func (p *pageValues) addFixedByteArray(v [][]byte) {
+	p.typ, p.typed = thrift.Type_FIXED_LEN_BYTE_ARRAY, true
+	c, size := copyBytes(v)
+	p.fixed = append(p.fixed, c...)
+	p.size += size
+}
+
+// encode writes the values with e to w and empties p. The values of a page
+// of nulls are written too: the delta and RLE encodings of no values are
+// not empty. Nothing is written while the type is not known.
+func (p *pageValues) encode(w io.Writer, e encoding.Encoder) error {
+	var err error
+	if !p.typed {
+		return nil
+	}
+	switch p.typ {
+	case thrift.Type_BOOLEAN:
+		_, err = e.WriteBool(w, p.bools)
+	case thrift.Type_INT32:
+		err = e.WriteInt32(w, p.int32s)
+	case thrift.Type_INT64:
+		err = e.WriteInt64(w, p.int64s)
+	case thrift.Type_FLOAT:
+		err = e.WriteFloat32(w, p.float32s)
+	case thrift.Type_DOUBLE:
+		err = e.WriteFloat64(w, p.float64s)
+	case thrift.Type_BYTE_ARRAY:
+		err = e.WriteByteArray(w, p.byteArrays)
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		err = e.WriteFixedByteArray(w, p.fixed)
+	}
+	p.reset()
+	return err
+}
+
+// reset empties p, keeping its buffers and its type.
+func (p *pageValues) reset() {
+	*p = pageValues{
+		typ:        p.typ,
+		typed:      p.typed,
+		bools:      p.bools[:0],
+		int32s:     p.int32s[:0],
+		int64s:     p.int64s[:0],
+		float32s:   p.float32s[:0],
+		float64s:   p.float64s[:0],
+		byteArrays: p.byteArrays[:0],
+		fixed:      p.fixed[:0],
+	}
+}
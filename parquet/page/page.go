@@ -3,13 +3,14 @@ package page
 import (
 	"bufio"
 	"bytes"
-	"compress/gzip"
+	"encoding/binary"
 	"fmt"
-	"io"
-	"log"
+	"hash/crc32"
+	"math/bits"
 
-	"github.com/golang/snappy"
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
 	"github.com/kostya-sh/parquet-go/parquet/encoding"
+	"github.com/kostya-sh/parquet-go/parquet/encoding/rle"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
@@ -21,14 +22,44 @@ type DataEncoder interface {
 	WriteFloat32([]float32) error
 	WriteFloat64([]float64) error
 	WriteByteArray([][]byte) error
+	// WriteFixedByteArray writes the values of a FIXED_LEN_BYTE_ARRAY
+	// column, which all have the length of the column.
+	WriteFixedByteArray([][]byte) error
 }
 
 type dataPage struct {
-	header *thrift.PageHeader
+	header  *thrift.PageHeader
+	data    []byte
+	levels  []byte // levels of a data page v2, stored uncompressed
+	payload []byte // uncompressed data
+	numRows int
+
+	// repetitionHistogram and definitionHistogram count the values of the
+	// page at each level, nil when the maximum level is 0.
+	repetitionHistogram []int64
+	definitionHistogram []int64
+}
+
+// compress sets the data of the page to its payload compressed with c.
+func (page *dataPage) compress(c compressor) error {
+	compressed, err := c.compress(page.payload)
+	if err != nil {
+		return fmt.Errorf("could not compress page: %s", err)
+	}
+	page.data = make([]byte, 0, len(page.levels)+len(compressed))
+	page.data = append(page.data, page.levels...)
+	page.data = append(page.data, compressed...)
+
+	if page.header.DataPageHeaderV2 != nil {
+		page.header.DataPageHeaderV2.IsCompressed = c.impl != nil
+	}
+	page.header.CompressedPageSize = int32(len(page.data))
+	page.header.Crc = checksum(page.data)
+	return nil
 }
 
 func (page *dataPage) Type() thrift.PageType {
-	return thrift.PageType_DATA_PAGE
+	return page.header.Type
 }
 
 func (page *dataPage) CompressedSize() int32 {
@@ -39,27 +70,266 @@ func (page *dataPage) UncompressedSize() int32 {
 	return page.header.UncompressedPageSize
 }
 
+// numNulls returns the number of nulls in the page, from its statistics.
+func (page *dataPage) numNulls() int64 {
+	if page.header.DataPageHeaderV2 != nil {
+		return page.header.DataPageHeaderV2.GetStatistics().GetNullCount()
+	}
+	return page.header.DataPageHeader.GetStatistics().GetNullCount()
+}
+
 func (page *dataPage) NumValues() int32 {
+	if page.header.DataPageHeaderV2 != nil {
+		return page.header.DataPageHeaderV2.NumValues
+	}
 	return page.header.DataPageHeader.NumValues
 }
 
-// encoder should provide this
-func newDataPage() *dataPage {
+// Levels are the repetition and definition levels of the values of a data
+// page. The levels are nil when the maximum level is 0.
+type Levels struct {
+	Repetition    []int32
+	Definition    []int32
+	MaxRepetition uint
+	MaxDefinition uint
+}
+
+// encodeLevels RLE encodes levels with the bit width of maxLevel.
+func encodeLevels(levels []int32, maxLevel uint) ([]byte, error) {
+	if maxLevel == 0 {
+		return nil, nil
+	}
+	var b bytes.Buffer
+	if _, err := rle.WriteInt32(&b, uint(bits.Len(maxLevel)), levels); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// pageLevels are the repetition and definition levels of the values and the
+// nulls of the current data page, none for a required column whose maximum
+// levels are 0. The levels of the values of a flat column are added with
+// the values, the levels of the rows of a nested column with addLevels,
+// before their values.
+type pageLevels struct {
+	maxRepetition uint
+	maxDefinition uint
+	repetition    []int32
+	definition    []int32
+	pending       int // number of values of the levels added not written yet
+}
+
+// addLevels adds the levels of the values and the nulls of whole rows.
+func (l *pageLevels) addLevels(repetition, definition []int32) error {
+	if l.pending > 0 {
+		return fmt.Errorf("levels written before the %d values of the previous levels", l.pending)
+	}
+	if len(repetition) != len(definition) && (l.maxRepetition > 0 || repetition != nil) {
+		return fmt.Errorf("%d repetition levels for %d definition levels", len(repetition), len(definition))
+	}
+	if l.maxRepetition > 0 && len(repetition) > 0 && repetition[0] != 0 {
+		return fmt.Errorf("the levels of a row start at the repetition level %d", repetition[0])
+	}
+	for i, d := range definition {
+		if d < 0 || uint(d) > l.maxDefinition {
+			return fmt.Errorf("definition level %d of a column of maximum level %d", d, l.maxDefinition)
+		}
+		if repetition != nil && (repetition[i] < 0 || uint(repetition[i]) > l.maxRepetition) {
+			return fmt.Errorf("repetition level %d of a column of maximum level %d", repetition[i], l.maxRepetition)
+		}
+	}
+
+	for _, d := range definition {
+		if uint(d) == l.maxDefinition {
+			l.pending++
+		}
+	}
+	if l.maxRepetition > 0 {
+		l.repetition = append(l.repetition, repetition...)
+	}
+	if l.maxDefinition > 0 {
+		l.definition = append(l.definition, definition...)
+	}
+	return nil
+}
+
+// midRow returns whether values of the levels added are not written yet:
+// a data page does not end in the middle of a row.
+func (l *pageLevels) midRow() bool {
+	return l.pending > 0
+}
 
-	datapage := thrift.NewDataPageHeader()
-	datapage.NumValues = 0
-	datapage.Encoding = thrift.Encoding_PLAIN
-	datapage.DefinitionLevelEncoding = thrift.Encoding_RLE
-	datapage.RepetitionLevelEncoding = thrift.Encoding_RLE
-	datapage.Statistics = nil /** Optional statistics for the data in this page**/
+// addValues adds n values, whose levels were added with addLevels, or at the
+// maximum definition level of a flat column.
+func (l *pageLevels) addValues(n int) error {
+	if l.pending > 0 || l.maxRepetition > 0 {
+		if n > l.pending {
+			return fmt.Errorf("%d values written for the levels of %d values", n, l.pending)
+		}
+		l.pending -= n
+		return nil
+	}
+	if l.maxDefinition == 0 {
+		return nil
+	}
+	for i := 0; i < n; i++ {
+		l.definition = append(l.definition, int32(l.maxDefinition))
+	}
+	return nil
+}
+
+// addNulls adds the levels of n nulls of a flat column.
+func (l *pageLevels) addNulls(n int) error {
+	switch {
+	case l.maxDefinition == 0:
+		return fmt.Errorf("null values in a required column")
+	case l.maxRepetition > 0:
+		return fmt.Errorf("the nulls of a repeated column are written with their levels")
+	case l.pending > 0:
+		return fmt.Errorf("nulls written before the %d values of the levels", l.pending)
+	}
+	for i := 0; i < n; i++ {
+		l.definition = append(l.definition, 0)
+	}
+	return nil
+}
+
+// page returns the levels of a data page of numValues non null values and
+// its number of values, nulls included, then starts a new page.
+func (l *pageLevels) page(numValues int) (Levels, int) {
+	if l.maxDefinition == 0 {
+		return Levels{}, numValues
+	}
+	levels := Levels{Definition: l.definition, MaxDefinition: l.maxDefinition}
+	if l.maxRepetition > 0 {
+		levels.Repetition, levels.MaxRepetition = l.repetition, l.maxRepetition
+	}
+	l.repetition, l.definition = nil, nil
+	return levels, len(levels.Definition)
+}
+
+// size returns an estimate of the size in bytes of the levels.
+func (l *pageLevels) size() int {
+	return (len(l.repetition) + len(l.definition)) / 8
+}
+
+// reset discards the levels.
+func (l *pageLevels) reset() {
+	l.repetition, l.definition = l.repetition[:0], l.definition[:0]
+	l.pending = 0
+}
+
+// countNulls returns the number of null values in levels.
+func countNulls(levels Levels) int {
+	numNulls := 0
+	if levels.MaxDefinition > 0 {
+		for _, d := range levels.Definition {
+			if d < int32(levels.MaxDefinition) {
+				numNulls++
+			}
+		}
+	}
+	return numNulls
+}
+
+// levelHistogram returns the number of levels at each level from 0 to
+// maxLevel, nil if maxLevel is 0.
+func levelHistogram(levels []int32, maxLevel uint) []int64 {
+	if maxLevel == 0 {
+		return nil
+	}
+	histogram := make([]int64, maxLevel+1)
+	for _, l := range levels {
+		histogram[l]++
+	}
+	return histogram
+}
+
+// encodeDataPage creates a data page in the format of the given version, 1
+// or 2, from the levels and the encoded values, with the min and max of
+// stats. The levels of a data page v1 are compressed with the values and
+// prefixed with their length, the levels of a data page v2 are stored
+// uncompressed before the compressed values.
+func encodeDataPage(version int, c compressor, enc thrift.Encoding, levels Levels, values []byte, numValues int, stats *statistics) (*dataPage, error) {
+	repetition, err := encodeLevels(levels.Repetition, levels.MaxRepetition)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode repetition levels: %s", err)
+	}
+	definition, err := encodeLevels(levels.Definition, levels.MaxDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode definition levels: %s", err)
+	}
 
 	header := thrift.NewPageHeader()
-	header.Type = thrift.PageType_DATA_PAGE
-	header.DataPageHeader = datapage
-	header.CompressedPageSize = 0
-	header.UncompressedPageSize = 0
+	page := &dataPage{
+		header:              header,
+		numRows:             numValues,
+		repetitionHistogram: levelHistogram(levels.Repetition, levels.MaxRepetition),
+		definitionHistogram: levelHistogram(levels.Definition, levels.MaxDefinition),
+	}
+	numNulls := countNulls(levels)
+	if levels.MaxRepetition > 0 {
+		page.numRows = 0
+		for _, r := range levels.Repetition {
+			if r == 0 {
+				page.numRows++
+			}
+		}
+	}
+
+	switch version {
+	case 0, 1:
+		var b bytes.Buffer
+		for _, l := range [][]byte{repetition, definition} {
+			if l != nil {
+				binary.Write(&b, binary.LittleEndian, uint32(len(l)))
+				b.Write(l)
+			}
+		}
+		b.Write(values)
+		page.payload = b.Bytes()
+
+		header.Type = thrift.PageType_DATA_PAGE
+		header.DataPageHeader = thrift.NewDataPageHeader()
+		header.DataPageHeader.NumValues = int32(numValues)
+		header.DataPageHeader.Encoding = enc
+		header.DataPageHeader.DefinitionLevelEncoding = thrift.Encoding_RLE
+		header.DataPageHeader.RepetitionLevelEncoding = thrift.Encoding_RLE
+		header.DataPageHeader.Statistics = stats.thrift(int64(numNulls))
+		header.UncompressedPageSize = int32(b.Len())
+
+	case 2:
+		page.levels = make([]byte, 0, len(repetition)+len(definition))
+		page.levels = append(page.levels, repetition...)
+		page.levels = append(page.levels, definition...)
+		page.payload = append([]byte(nil), values...)
+
+		header.Type = thrift.PageType_DATA_PAGE_V2
+		header.DataPageHeaderV2 = thrift.NewDataPageHeaderV2()
+		header.DataPageHeaderV2.NumValues = int32(numValues)
+		header.DataPageHeaderV2.NumNulls = int32(numNulls)
+		header.DataPageHeaderV2.NumRows = int32(page.numRows)
+		header.DataPageHeaderV2.Encoding = enc
+		header.DataPageHeaderV2.RepetitionLevelsByteLength = int32(len(repetition))
+		header.DataPageHeaderV2.DefinitionLevelsByteLength = int32(len(definition))
+		header.DataPageHeaderV2.Statistics = stats.thrift(int64(numNulls))
+		header.UncompressedPageSize = int32(len(repetition) + len(definition) + len(values))
+
+	default:
+		return nil, fmt.Errorf("unsupported data page version %d", version)
+	}
 
-	return &dataPage{header}
+	if err := page.compress(c); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// checksum returns the CRC32 of the data of a page, as stored in the Crc
+// field of its header.
+func checksum(data []byte) *int32 {
+	crc := int32(crc32.ChecksumIEEE(data))
+	return &crc
 }
 
 type Page interface {
@@ -72,108 +342,228 @@ type Page interface {
 // PageEncoder encodes a stream of values into a set of pages
 type PageEncoder interface {
 	DataEncoder
-	Pages() []Page
+
+	// Pages returns the pages of the values written, compressed, or the
+	// error of the encoding or the compression of the last ones.
+	Pages() ([]Page, error)
+
+	// ZstdDictionary returns the zstd dictionary the pages returned by Pages
+	// were compressed with, nil if none. It is stored in the metadata of the
+	// column chunk with SetZstdDictionary.
+	ZstdDictionary() []byte
+
+	// Statistics returns the statistics of the values written so far, for
+	// the metadata of the column chunk. The data pages have the statistics
+	// of their own values.
+	Statistics() *thrift.Statistics
+
+	// ColumnIndex returns the column index of the data pages returned by
+	// Pages, built from their statistics, for the page index of the column
+	// chunk. It is nil when the values have no order, see SortOrderUndefined.
+	ColumnIndex() *thrift.ColumnIndex
+
+	// SizeStatistics returns the size of the byte arrays written so far and
+	// the histograms of the levels of the data pages, for the metadata of
+	// the column chunk, nil if there is neither.
+	SizeStatistics() *thrift.SizeStatistics
+
+	// WriteNulls writes n null values, which requires a maximum definition
+	// level above 0. Their definition level is 0.
+	WriteNulls(n int) error
+
+	// WriteLevels writes the repetition and definition levels of the values
+	// and the nulls of whole rows of a nested column, whose first repetition
+	// level is 0, before their values: the values at the maximum definition
+	// level are then written with the other methods. A data page does not
+	// end in the middle of a row. The levels of the values of a repeated
+	// column are always written with WriteLevels, nor are its nulls written
+	// with WriteNulls.
+	WriteLevels(repetition, definition []int32) error
+
+	// BufferedSize returns an estimate of the size in bytes of the values
+	// written so far, encoded but not compressed: the uncompressed size of
+	// the data pages and of the current page, and of the dictionary.
+	// Writers use it to bound the memory buffered in a row group.
+	BufferedSize() int64
+
+	// Reset discards the pages and the values written, to encode another
+	// column chunk with the same preferences but the Bloom filter filter,
+	// nil for none. The buffers and the dictionary of the encoder are
+	// reused: the pages returned by Pages must not be used after Reset.
+	Reset(filter *bloom.Filter)
 }
 
 // EncodingPreferences specify how to encode
 type EncodingPreferences struct {
 	CompressionCodec string // specify compression codec
-	Strategy         string // Strategy is the name of the strategy to use to compress the data.
+	// CompressionLevel is the level of the codecs that have one, 0 for the
+	// default level of the codec.
+	CompressionLevel int
+	// CompressionWindowSize is the window size in bytes of the zstd codec, a
+	// power of 2 from 1KB to 512MB. 0 uses the default window of the level.
+	// Readers have to buffer a window while decoding a page.
+	CompressionWindowSize int
+	// DeferCompression compresses the pages of a column chunk when Pages is
+	// called rather than as they are written, e.g. to compress the chunks
+	// of several columns concurrently. The pages are buffered uncompressed
+	// until then.
+	DeferCompression bool
+
+	Strategy string // Strategy is the name of the strategy to use to compress the data.
+	// Type is the physical type of the column, nil if unknown. The
+	// "default" strategy encodes the values of the pages of nulls, that
+	// are not empty for some encodings, with it.
+	Type *thrift.Type
+	// Encoding is the encoding of the values of the "default" strategy,
+	// PLAIN by default. The values of a data page are buffered and encoded
+	// when the page is complete. See CheckEncoding for the encodings of
+	// each type.
+	Encoding thrift.Encoding
+
+	// DictionaryPageSize is the maximum size in bytes of the dictionary of
+	// the "dictionary" strategy before it falls back to the PLAIN encoding.
+	// The default is DefaultDictionaryPageSize.
+	DictionaryPageSize int
+	// PageSize is the size in bytes of the values of a data page above which
+	// a new data page is started: the size of the dictionary indices or of
+	// the PLAIN values for the "dictionary" strategy, the size of the PLAIN
+	// values buffered for the "default" strategy, whatever their encoding.
+	// The default is DefaultPageSize.
+	PageSize int
+	// PageValues is the maximum number of values of a data page, nulls
+	// included, 0 for no limit.
+	PageValues int
+	// DataPageVersion is the format of the data pages: 1, the default, or 2
+	// to write data pages v2 that keep the levels out of the compressed
+	// values and declare the number of nulls and rows of each page.
+	DataPageVersion int
+	// ZstdDictionarySize is the size in bytes of the zstd dictionary trained
+	// on the pages of a column chunk and used to compress them, 0 for none.
+	// A dictionary improves the compression of small pages but is not part
+	// of the parquet format, see ZstdDictionaryKey.
+	ZstdDictionarySize int
+	// SortOrder is the order of the min and max statistics of the pages,
+	// see SortOrderOf.
+	SortOrder SortOrder
+	// StatisticsTruncateLength is the maximum length in bytes of the min and
+	// max statistics of byte arrays, 0 for no limit. A longer min is
+	// truncated and a longer max is replaced by a larger value of this
+	// length, so that they remain bounds of the values.
+	StatisticsTruncateLength int
+	// BloomFilter, if not nil, is the Bloom filter of the column chunk the
+	// values written are inserted in. The booleans are not inserted.
+	BloomFilter *bloom.Filter
+	// MaxDefinitionLevel is the maximum definition level of the column, 0
+	// for a required column. The values written have this level, the
+	// nulls written with WriteNulls level 0, unless their levels are
+	// written with WriteLevels.
+	MaxDefinitionLevel uint
+	// MaxRepetitionLevel is the maximum repetition level of the column, 0
+	// unless the column is in a repeated field. The levels of its values
+	// are written with WriteLevels.
+	MaxRepetitionLevel uint
 }
 
 // NewPageEncoder creates a default encoder.
 func NewPageEncoder(preferences EncodingPreferences) PageEncoder {
-	switch preferences.CompressionCodec {
-	case "lzo":
-		panic("lzo not yet supported")
-	case "gzip":
-		// supported see below
-	case "snappy":
-		// supported see below
-	case "":
-		// supported
-	default:
-		panic("compression codec " + preferences.CompressionCodec + " not supported")
+	compression, err := newCompressor(preferences)
+	if err != nil {
+		panic(err)
 	}
 
 	var encoder PageEncoder
 
 	switch preferences.Strategy {
+	case "dictionary":
+		encoder = newDictionaryPageEncoder(preferences, compression)
 	case "default":
 		fallthrough
 	default:
-		encoder = newDefaultPageEncoder(preferences.CompressionCodec)
+		values, err := newValuesEncoder(preferences.Encoding)
+		if err != nil {
+			panic(err)
+		}
+		encoder = newDefaultPageEncoder(preferences, compression, values)
 	}
 
 	return encoder
 }
 
 type defaultPageEncoder struct {
-	buffer        bytes.Buffer
-	pages         []Page
-	currentWriter *bufio.Writer
-	encoder       encoding.Encoder
-	encoderType   thrift.Encoding
-	compression   string
+	buffer          bytes.Buffer
+	pages           []Page
+	currentWriter   *bufio.Writer
+	encoder         encoding.Encoder
+	values          pageValues // values of the current page
+	encoderType     thrift.Encoding
+	compression     compressor
+	dataPageVersion int
+	numValues       int // number of values in the current page
+	pageValues      int // number of values in the current page, nulls included
+	maxPageValues   int // 0 for no limit
+	pageSize        int // size of the values above which a page is started
+	zstdDictionary  []byte
+
+	pageStats   statistics // statistics of the current page
+	chunkStats  statistics
+	numNulls    int64
+	sizeStats   sizeStatistics
+	bloomFilter *bloom.Filter
+	levels      pageLevels
+	pagesSize   int64 // uncompressed size of the pages
 }
 
-func newDefaultPageEncoder(compressionCodec string) *defaultPageEncoder {
+func newDefaultPageEncoder(preferences EncodingPreferences, compression compressor, values encoding.Encoder) *defaultPageEncoder {
 	encoder := &defaultPageEncoder{
-		compression: compressionCodec,
-		encoderType: thrift.Encoding_PLAIN,
-		encoder:     encoding.NewPlainEncoder(),
+		compression:     compression,
+		encoderType:     preferences.Encoding,
+		encoder:         values,
+		dataPageVersion: preferences.DataPageVersion,
+		maxPageValues:   preferences.PageValues,
+		pageSize:        preferences.PageSize,
+		pageStats:       newStatistics(preferences),
+		chunkStats:      newStatistics(preferences),
+		bloomFilter:     preferences.BloomFilter,
+		levels: pageLevels{
+			maxRepetition: preferences.MaxRepetitionLevel,
+			maxDefinition: preferences.MaxDefinitionLevel,
+		},
+	}
+	if encoder.pageSize <= 0 {
+		encoder.pageSize = DefaultPageSize
+	}
+	if preferences.Type != nil {
+		encoder.values.typ, encoder.values.typed = *preferences.Type, true
 	}
 	encoder.addPage()
 	return encoder
 }
 
-func (e *defaultPageEncoder) mempool() io.Writer {
-	return new(bytes.Buffer)
-}
-
 func (e *defaultPageEncoder) addPage() error {
 	if e.currentWriter != nil {
-
-		// create DataPage
-		b := e.mempool()
-
-		page := newDataPage()
-		err := e.currentWriter.Flush()
-		if err != nil {
-			return err
+		if err := e.values.encode(e.currentWriter, e.encoder); err != nil {
+			return fmt.Errorf("defaultPageEncoder: could not write the values: %s", err)
 		}
-
-		compressed, err := e.compress(e.buffer.Bytes())
-		if err != nil {
+		if err := e.currentWriter.Flush(); err != nil {
 			return err
 		}
-
-		uncompressedSize := e.buffer.Len()
-		compressedSize := len(compressed)
-
-		page.header.DataPageHeader.Encoding = e.encoderType
-		page.header.UncompressedPageSize = int32(uncompressedSize)
-		page.header.CompressedPageSize = int32(compressedSize)
-
-		// Write header
-		_, err = page.header.Write(b)
-
-		if err != nil {
-			return fmt.Errorf("could not write data page header to buffer:%s", err)
+		if e.numValues == 0 && len(e.levels.definition) == 0 {
+			return nil
 		}
-		// Write repetition levels
 
-		// Write definition levels
-
-		// Write values
-		_, err = io.Copy(b, &e.buffer)
+		levels, numValues := e.levels.page(e.numValues)
+		page, err := encodeDataPage(e.dataPageVersion, e.compression.deferred(), e.encoderType, levels, e.buffer.Bytes(), numValues, &e.pageStats)
 		if err != nil {
-			return fmt.Errorf("could not write data page value to buffer:%s", err)
+			return fmt.Errorf("could not create data page: %s", err)
 		}
-
-		//TODO: ?page.buffer = b
-
 		e.pages = append(e.pages, page)
+		e.pagesSize += int64(page.UncompressedSize())
+		e.numNulls += page.numNulls()
+		e.sizeStats.addPage(page)
+		e.buffer.Reset()
+		e.numValues = 0
+		e.pageValues = 0
+		e.pageStats.reset()
 	}
 
 	e.currentWriter = bufio.NewWriter(&e.buffer)
@@ -181,83 +571,237 @@ func (e *defaultPageEncoder) addPage() error {
 	return nil
 }
 
-func (e *defaultPageEncoder) compress(p []byte) ([]byte, error) {
-	var compressed bytes.Buffer // TODO get from a buffer pool
-	switch e.compression {
-	case "gzip":
-		w := gzip.NewWriter(&compressed)
-		if _, err := w.Write(p); err != nil {
-			return nil, err
+// Pages return all the pages written by this encoder
+func (e *defaultPageEncoder) Pages() ([]Page, error) {
+	if err := e.addPage(); err != nil {
+		return nil, err
+	}
+	dict, err := compressPages(e.pages, e.compression)
+	if err != nil {
+		return nil, err
+	}
+	e.zstdDictionary = dict
+	return e.pages, nil
+}
+
+func (e *defaultPageEncoder) ZstdDictionary() []byte {
+	return e.zstdDictionary
+}
+
+func (e *defaultPageEncoder) Statistics() *thrift.Statistics {
+	return e.chunkStats.thrift(e.numNulls)
+}
+
+func (e *defaultPageEncoder) ColumnIndex() *thrift.ColumnIndex {
+	return columnIndex(e.pages, &e.chunkStats)
+}
+
+func (e *defaultPageEncoder) SizeStatistics() *thrift.SizeStatistics {
+	return e.sizeStats.thrift()
+}
+
+// split writes n values with write, a range of them at a time, starting a
+// new page whenever the current page has the maximum number of values or
+// its values reach the page size, size(i) being the size of the value i,
+// nil for nulls. The values of the levels written with WriteLevels are
+// written at once, they were counted with their levels.
+func (e *defaultPageEncoder) split(n int, size func(i int) int, write func(i, j int) error) error {
+	if e.levels.midRow() {
+		if err := write(0, n); err != nil {
+			return err
 		}
-	case "snappy":
-		wc := snappy.NewWriter(&compressed)
-		if _, err := wc.Write(p); err != nil {
-			return nil, err
+		return e.endRow()
+	}
+	for i := 0; i < n; {
+		j := n
+		if e.maxPageValues > 0 && j-i > e.maxPageValues-e.pageValues {
+			j = i + e.maxPageValues - e.pageValues
 		}
-	case "":
-		return p, nil
-	default:
-		log.Println("defaultPageEncoder: warning unknown compression codec.")
+		if size != nil {
+			pageSize := e.values.size
+			for k := i; k < j; k++ {
+				if pageSize += size(k); pageSize >= e.pageSize {
+					j = k + 1
+					break
+				}
+			}
+		}
+		if err := write(i, j); err != nil {
+			return err
+		}
+		e.pageValues += j - i
+		if e.pageFull() {
+			if err := e.addPage(); err != nil {
+				return err
+			}
+		}
+		i = j
 	}
+	return nil
+}
 
-	return compressed.Bytes(), nil
+// pageFull returns whether the current page has the maximum number of
+// values of a page or values of the page size.
+func (e *defaultPageEncoder) pageFull() bool {
+	return (e.maxPageValues > 0 && e.pageValues >= e.maxPageValues) || e.values.size >= e.pageSize
 }
 
-// Pages return all the pages written by this encoder
-func (e *defaultPageEncoder) Pages() []Page {
-	return e.pages
+// fixedSize returns the size function of split for the values of width
+// bytes.
+func fixedSize(width int) func(i int) int {
+	return func(int) int { return width }
 }
 
-func (e *defaultPageEncoder) WriteBool(values []bool) error {
-	_, err := e.encoder.WriteBool(e.currentWriter, values)
-	if err != nil {
-		return fmt.Errorf("defaultPageEncoder: could not write bool: %s", err)
+// endRow starts a new page if the current page is full, unless it is in the
+// middle of a row.
+func (e *defaultPageEncoder) endRow() error {
+	if e.levels.midRow() || !e.pageFull() {
+		return nil
 	}
+	return e.addPage()
+}
 
-	return nil
+func (e *defaultPageEncoder) WriteBool(values []bool) error {
+	return e.split(len(values), fixedSize(1), func(i, j int) error {
+		v := values[i:j]
+		if err := e.levels.addValues(len(v)); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		e.values.addBool(v)
+		e.numValues += len(v)
+		e.pageStats.addBool(v)
+		e.chunkStats.addBool(v)
+		return nil
+	})
 }
 
 func (e *defaultPageEncoder) WriteInt32(values []int32) error {
-	err := e.encoder.WriteInt32(e.currentWriter, values)
-	if err != nil {
-		return fmt.Errorf("defaultPageEncoder: could not write int32: %s", err)
-	}
-
-	return nil
+	return e.split(len(values), fixedSize(4), func(i, j int) error {
+		v := values[i:j]
+		if err := e.levels.addValues(len(v)); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		e.values.addInt32(v)
+		e.numValues += len(v)
+		e.pageStats.addInt32(v)
+		e.chunkStats.addInt32(v)
+		insertInt32(e.bloomFilter, v)
+		return nil
+	})
 }
 
 func (e *defaultPageEncoder) WriteInt64(values []int64) error {
-	err := e.encoder.WriteInt64(e.currentWriter, values)
-	if err != nil {
-		return fmt.Errorf("defaultPageEncoder: could not write int64: %s", err)
-	}
-
-	return nil
+	return e.split(len(values), fixedSize(8), func(i, j int) error {
+		v := values[i:j]
+		if err := e.levels.addValues(len(v)); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		e.values.addInt64(v)
+		e.numValues += len(v)
+		e.pageStats.addInt64(v)
+		e.chunkStats.addInt64(v)
+		insertInt64(e.bloomFilter, v)
+		return nil
+	})
 }
 
 func (e *defaultPageEncoder) WriteFloat32(values []float32) error {
-	err := e.encoder.WriteFloat32(e.currentWriter, values)
-	if err != nil {
-		return fmt.Errorf("defaultPageEncoder: could not write float32: %s", err)
-	}
-
-	return nil
+	return e.split(len(values), fixedSize(4), func(i, j int) error {
+		v := values[i:j]
+		if err := e.levels.addValues(len(v)); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		e.values.addFloat32(v)
+		e.numValues += len(v)
+		e.pageStats.addFloat32(v)
+		e.chunkStats.addFloat32(v)
+		insertFloat32(e.bloomFilter, v)
+		return nil
+	})
 }
 
 func (e *defaultPageEncoder) WriteFloat64(values []float64) error {
-	err := e.encoder.WriteFloat64(e.currentWriter, values)
-	if err != nil {
-		return fmt.Errorf("defaultPageEncoder: could not write float64: %s", err)
-	}
-
-	return nil
+	return e.split(len(values), fixedSize(8), func(i, j int) error {
+		v := values[i:j]
+		if err := e.levels.addValues(len(v)); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		e.values.addFloat64(v)
+		e.numValues += len(v)
+		e.pageStats.addFloat64(v)
+		e.chunkStats.addFloat64(v)
+		insertFloat64(e.bloomFilter, v)
+		return nil
+	})
 }
 
 func (e *defaultPageEncoder) WriteByteArray(values [][]byte) error {
-	err := e.encoder.WriteByteArray(e.currentWriter, values)
-	if err != nil {
-		return fmt.Errorf("defaultPageEncoder: could not write byteArray: %s", err)
+	return e.split(len(values), func(i int) int { return 4 + len(values[i]) }, func(i, j int) error {
+		v := values[i:j]
+		if err := e.levels.addValues(len(v)); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		e.values.addByteArray(v)
+		e.numValues += len(v)
+		e.pageStats.addByteArray(v)
+		e.chunkStats.addByteArray(v)
+		e.sizeStats.addByteArray(v)
+		insertByteArray(e.bloomFilter, v)
+		return nil
+	})
+}
+
+func (e *defaultPageEncoder) WriteFixedByteArray(values [][]byte) error {
+	return e.split(len(values), func(i int) int { return len(values[i]) }, func(i, j int) error {
+		v := values[i:j]
+		if err := e.levels.addValues(len(v)); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		e.values.addFixedByteArray(v)
+		e.numValues += len(v)
+		e.pageStats.addFixedByteArray(v)
+		e.chunkStats.addFixedByteArray(v)
+		insertByteArray(e.bloomFilter, v)
+		return nil
+	})
+}
+
+func (e *defaultPageEncoder) BufferedSize() int64 {
+	return e.pagesSize + int64(e.buffer.Len()+e.currentWriter.Buffered()+e.values.size+e.levels.size())
+}
+
+func (e *defaultPageEncoder) Reset(filter *bloom.Filter) {
+	for i := range e.pages {
+		e.pages[i] = nil
 	}
+	e.pages = e.pages[:0]
+	e.buffer.Reset()
+	e.currentWriter.Reset(&e.buffer)
+	e.values.reset()
+	e.numValues, e.pageValues = 0, 0
+	e.zstdDictionary = nil
+	e.pageStats.reset()
+	e.chunkStats.reset()
+	e.numNulls = 0
+	e.sizeStats = sizeStatistics{}
+	e.bloomFilter = filter
+	e.levels.reset()
+	e.pagesSize = 0
+}
 
-	return nil
+func (e *defaultPageEncoder) WriteNulls(n int) error {
+	return e.split(n, nil, func(i, j int) error {
+		if err := e.levels.addNulls(j - i); err != nil {
+			return fmt.Errorf("defaultPageEncoder: %s", err)
+		}
+		return nil
+	})
+}
+
+func (e *defaultPageEncoder) WriteLevels(repetition, definition []int32) error {
+	if err := e.levels.addLevels(repetition, definition); err != nil {
+		return fmt.Errorf("defaultPageEncoder: %s", err)
+	}
+	e.pageValues += len(definition)
+	return e.endRow()
 }
@@ -0,0 +1,244 @@
+package page
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// encodedPage returns the header and the data of a page returned by
+// PageEncoder.Pages.
+func encodedPage(p Page) (*thrift.PageHeader, []byte, error) {
+	switch p := p.(type) {
+	case *dataPage:
+		return p.header, p.data, nil
+	case *encodedDictionaryPage:
+		return p.header, p.data, nil
+	default:
+		return nil, nil, fmt.Errorf("page %T was not encoded", p)
+	}
+}
+
+// WritePages writes the pages returned by PageEncoder.Pages to w, each
+// preceded by its header, starting at offset in the file. It returns the
+// number of bytes written and the offset index of the data pages, for the
+// page index of the column chunk.
+func WritePages(w io.Writer, offset int64, pages []Page) (int64, *thrift.OffsetIndex, error) {
+	return WriteEncryptedPages(w, offset, pages, nil)
+}
+
+// WriteEncryptedPages is like WritePages but the pages and their headers are
+// encrypted with e, nil if the column chunk is not encrypted. The size and
+// the checksum in the headers are those of the encrypted pages.
+func WriteEncryptedPages(w io.Writer, offset int64, pages []Page, e *encryption.ColumnEncryptor) (int64, *thrift.OffsetIndex, error) {
+	index := thrift.NewOffsetIndex()
+	index.PageLocations = []*thrift.PageLocation{}
+
+	var n, firstRow int64
+	for _, p := range pages {
+		header, data, err := encodedPage(p)
+		if err != nil {
+			return n, nil, err
+		}
+		var hn int
+		if e == nil {
+			hn, err = header.Write(w)
+		} else {
+			ordinal := len(index.PageLocations)
+			if data, err = encryptPage(header, data, e, ordinal); err == nil {
+				hn, err = writeEncryptedHeader(w, header, data, e, ordinal)
+			}
+		}
+		if err != nil {
+			return n, nil, fmt.Errorf("could not write page header: %s", err)
+		}
+		dn, err := w.Write(data)
+		if err != nil {
+			return n, nil, fmt.Errorf("could not write page: %s", err)
+		}
+
+		if dp, ok := p.(*dataPage); ok {
+			index.PageLocations = append(index.PageLocations, &thrift.PageLocation{
+				Offset:             offset + n,
+				CompressedPageSize: int32(hn + dn),
+				FirstRowIndex:      firstRow,
+			})
+			firstRow += int64(dp.numRows)
+		}
+		n += int64(hn + dn)
+	}
+	return n, index, nil
+}
+
+// encryptPage returns the data of the page of header encrypted as a module,
+// the ordinal-th data page of the column chunk or its dictionary page.
+func encryptPage(header *thrift.PageHeader, data []byte, e *encryption.ColumnEncryptor, ordinal int) ([]byte, error) {
+	t := encryption.DataPage
+	if header.GetType() == thrift.PageType_DICTIONARY_PAGE {
+		t = encryption.DictionaryPage
+	}
+	module, err := e.Encrypt(data, t, ordinal)
+	if err != nil {
+		return nil, fmt.Errorf("could not encrypt page: %s", err)
+	}
+	return module, nil
+}
+
+// writeEncryptedHeader writes a copy of header, the header of the encrypted
+// page module, encrypted as a module to w. It returns the number of bytes
+// written.
+func writeEncryptedHeader(w io.Writer, header *thrift.PageHeader, module []byte, e *encryption.ColumnEncryptor, ordinal int) (int, error) {
+	h := *header
+	h.CompressedPageSize = int32(len(module))
+	if h.IsSetCrc() {
+		h.Crc = checksum(module)
+	}
+	t := encryption.DataPageHeader
+	if h.GetType() == thrift.PageType_DICTIONARY_PAGE {
+		t = encryption.DictionaryPageHeader
+	}
+
+	var b bytes.Buffer
+	if _, err := h.Write(&b); err != nil {
+		return 0, err
+	}
+	module, err := e.Encrypt(b.Bytes(), t, ordinal)
+	if err != nil {
+		return 0, err
+	}
+	return w.Write(module)
+}
+
+// EncodingStats returns the number of pages returned by PageEncoder.Pages of
+// each page type and encoding, in the order they first appear, for the
+// encoding_stats of the metadata of the column chunk. Readers use them to
+// know whether all the data pages are dictionary encoded.
+func EncodingStats(pages []Page) ([]*thrift.PageEncodingStats, error) {
+	stats := []*thrift.PageEncodingStats{}
+	for _, p := range pages {
+		header, _, err := encodedPage(p)
+		if err != nil {
+			return nil, err
+		}
+		var enc thrift.Encoding
+		switch {
+		case header.DictionaryPageHeader != nil:
+			enc = header.DictionaryPageHeader.Encoding
+		case header.DataPageHeaderV2 != nil:
+			enc = header.DataPageHeaderV2.Encoding
+		case header.DataPageHeader != nil:
+			enc = header.DataPageHeader.Encoding
+		default:
+			return nil, fmt.Errorf("page of type %s has no header", header.Type)
+		}
+
+		found := false
+		for _, s := range stats {
+			if s.PageType == header.Type && s.Encoding == enc {
+				s.Count++
+				found = true
+				break
+			}
+		}
+		if !found {
+			stats = append(stats, &thrift.PageEncodingStats{PageType: header.Type, Encoding: enc, Count: 1})
+		}
+	}
+	return stats, nil
+}
+
+// PageSizes returns the size of the pages returned by PageEncoder.Pages,
+// compressed and uncompressed, without their headers. The total uncompressed
+// size of the column chunk is the size written by WritePages with the
+// difference between the two.
+func PageSizes(pages []Page) (compressed, uncompressed int64, err error) {
+	for _, p := range pages {
+		header, _, err := encodedPage(p)
+		if err != nil {
+			return 0, 0, err
+		}
+		compressed += int64(header.CompressedPageSize)
+		uncompressed += int64(header.UncompressedPageSize)
+	}
+	return compressed, uncompressed, nil
+}
+
+// NumValues returns the number of values of the data pages returned by
+// PageEncoder.Pages, nulls included, for the num_values of the metadata of
+// the column chunk.
+func NumValues(pages []Page) int64 {
+	var n int64
+	for _, p := range pages {
+		if _, ok := p.(*dataPage); ok {
+			n += int64(p.NumValues())
+		}
+	}
+	return n
+}
+
+// columnIndex returns the column index of the data pages, from their
+// statistics, nil if some pages have values but no min and max: their sort
+// order is undefined. stats are the statistics of the column chunk, for the
+// order of the values.
+func columnIndex(pages []Page, stats *statistics) *thrift.ColumnIndex {
+	index := thrift.NewColumnIndex()
+	index.NullPages = []bool{}
+	index.MinValues = [][]byte{}
+	index.MaxValues = [][]byte{}
+	index.NullCounts = []int64{}
+
+	var last *thrift.Statistics
+	ascending, descending := true, true
+	for _, p := range pages {
+		dp, ok := p.(*dataPage)
+		if !ok {
+			continue
+		}
+		var s *thrift.Statistics
+		if dp.header.DataPageHeaderV2 != nil {
+			s = dp.header.DataPageHeaderV2.Statistics
+		} else {
+			s = dp.header.DataPageHeader.Statistics
+		}
+		if s == nil {
+			return nil
+		}
+
+		nullPage := s.GetNullCount() == int64(dp.NumValues())
+		if !nullPage && (s.MinValue == nil || s.MaxValue == nil) {
+			return nil
+		}
+		index.NullPages = append(index.NullPages, nullPage)
+		index.NullCounts = append(index.NullCounts, s.GetNullCount())
+		if nullPage {
+			index.MinValues = append(index.MinValues, []byte{})
+			index.MaxValues = append(index.MaxValues, []byte{})
+			continue
+		}
+		index.MinValues = append(index.MinValues, s.MinValue)
+		index.MaxValues = append(index.MaxValues, s.MaxValue)
+
+		if last != nil {
+			if stats.less(s.MinValue, last.MinValue) || stats.less(s.MaxValue, last.MaxValue) {
+				ascending = false
+			}
+			if stats.less(last.MinValue, s.MinValue) || stats.less(last.MaxValue, s.MaxValue) {
+				descending = false
+			}
+		}
+		last = s
+	}
+
+	switch {
+	case ascending:
+		index.BoundaryOrder = thrift.BoundaryOrder_ASCENDING
+	case descending:
+		index.BoundaryOrder = thrift.BoundaryOrder_DESCENDING
+	default:
+		index.BoundaryOrder = thrift.BoundaryOrder_UNORDERED
+	}
+	return index
+}
@@ -1,6 +1,23 @@
 package page
 
-import "testing"
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/encoding"
+	"github.com/kostya-sh/parquet-go/parquet/encoding/rle"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
 
 func TestEncodeDataPageHeader(t *testing.T) {
 	values := make([]int32, 100)
@@ -106,3 +123,1774 @@ func TestEncodeDataPageHeader(t *testing.T) {
 	// }
 	// fd.Close()
 }
+
+func TestDictionaryPageEncoder(t *testing.T) {
+	values := make([]int32, 100)
+	for i := range values {
+		values[i] = int32(i%3) * 10
+	}
+
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "dictionary"})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatalf("could not WriteInt32 %s", err)
+	}
+
+	pages := encodedPages(t, enc)
+	if len(pages) != 2 {
+		t.Fatalf("expected a dictionary page and a data page, got %d pages", len(pages))
+	}
+
+	dictPage, ok := pages[0].(*encodedDictionaryPage)
+	if !ok {
+		t.Fatalf("expected a dictionary page first, got %T", pages[0])
+	}
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	dictionary := NewDictionaryPage(schema, dictPage.header.DictionaryPageHeader)
+	if err := dictionary.Decode(bytes.NewReader(dictPage.data)); err != nil {
+		t.Fatalf("could not decode dictionary: %s", err)
+	}
+	if dictionary.NumValues() != 3 {
+		t.Errorf("expected 3 values in the dictionary, got %d", dictionary.NumValues())
+	}
+
+	data := pages[1].(*dataPage)
+	if enc := data.header.DataPageHeader.Encoding; enc != thrift.Encoding_PLAIN_DICTIONARY {
+		t.Errorf("unexpected encoding %s", enc)
+	}
+	got := make([]int32, len(values))
+	d := encoding.NewPlainDictionaryDecoder(bytes.NewReader(data.data), dictionary, uint(data.NumValues()))
+	if n, err := d.DecodeInt32(got); err != nil || n != uint(len(values)) {
+		t.Fatalf("could not decode values: %d %v", n, err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("got %v, want %v", got, values)
+	}
+}
+
+func TestDictionaryPageEncoderFallback(t *testing.T) {
+	values := make([][]byte, 100)
+	for i := range values {
+		values[i] = []byte(fmt.Sprintf("value %d", i))
+	}
+
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "dictionary", DictionaryPageSize: 64})
+	if err := enc.WriteByteArray(values); err != nil {
+		t.Fatalf("could not WriteByteArray %s", err)
+	}
+
+	pages := encodedPages(t, enc)
+	if len(pages) != 1 {
+		t.Fatalf("expected a single PLAIN data page, got %d pages", len(pages))
+	}
+	data := pages[0].(*dataPage)
+	if enc := data.header.DataPageHeader.Encoding; enc != thrift.Encoding_PLAIN {
+		t.Errorf("unexpected encoding %s", enc)
+	}
+
+	got := make([][]byte, len(values))
+	d := encoding.NewPlainDecoder(bytes.NewReader(data.data), uint(data.NumValues()))
+	if n, err := d.DecodeByteArray(got); err != nil || n != uint(len(values)) {
+		t.Fatalf("could not decode values: %d %v", n, err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("got %q, want %q", got, values)
+	}
+}
+
+func TestDictionaryPageEncoderFallbackAfterPages(t *testing.T) {
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "dictionary", DictionaryPageSize: 64, PageSize: 8})
+
+	// 64 indices of 1 bit fill a page
+	small := make([]int64, 64)
+	for i := range small {
+		small[i] = int64(i % 2)
+	}
+	if err := enc.WriteInt64(small); err != nil {
+		t.Fatal(err)
+	}
+	large := make([]int64, 20)
+	for i := range large {
+		large[i] = int64(i + 100)
+	}
+	if err := enc.WriteInt64(large); err != nil {
+		t.Fatal(err)
+	}
+
+	var encodings []thrift.Encoding
+	for _, p := range encodedPages(t, enc) {
+		if data, ok := p.(*dataPage); ok {
+			encodings = append(encodings, data.header.DataPageHeader.Encoding)
+		} else if _, ok := p.(*encodedDictionaryPage); !ok {
+			t.Fatalf("unexpected page %T", p)
+		}
+	}
+	if encodings[0] != thrift.Encoding_PLAIN_DICTIONARY || encodings[len(encodings)-1] != thrift.Encoding_PLAIN {
+		t.Errorf("expected dictionary encoded pages followed by PLAIN pages, got %v", encodings)
+	}
+}
+
+func TestPageEncoderNulls(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_OPTIONAL)
+
+	var want []Triple
+	for i := 0; i < 200; i++ {
+		if i%3 == 0 || i >= 180 {
+			want = append(want, Triple{})
+		} else {
+			want = append(want, Triple{D: 1, Value: int32(i % 7)})
+		}
+	}
+
+	for _, strategy := range []string{"default", "dictionary"} {
+		for _, version := range []int{1, 2} {
+			enc := NewPageEncoder(EncodingPreferences{Strategy: strategy, DataPageVersion: version, PageSize: 8, MaxDefinitionLevel: 1})
+			for _, triple := range want {
+				var err error
+				if triple.Value == nil {
+					err = enc.WriteNulls(1)
+				} else {
+					err = enc.WriteInt32([]int32{triple.Value.(int32)})
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			pages := encodedPages(t, enc)
+			if stats := enc.Statistics(); stats.GetNullCount() != 80 {
+				t.Errorf("%s v%d: got %d nulls, want 80", strategy, version, stats.GetNullCount())
+			}
+
+			var b bytes.Buffer
+			if _, _, err := WritePages(&b, 0, pages); err != nil {
+				t.Fatal(err)
+			}
+			s := NewNestedScanner(schema, 0, 1, thrift.CompressionCodec_UNCOMPRESSED, bytes.NewReader(b.Bytes()))
+			var got []Triple
+			for s.Scan() {
+				p, ok := s.DataPage()
+				if !ok {
+					continue
+				}
+				triples, err := p.Triples()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, triples...)
+			}
+			if err := s.Err(); err != nil {
+				t.Fatalf("%s v%d: %s", strategy, version, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s v%d: got %v, want %v", strategy, version, got, want)
+			}
+		}
+	}
+
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "dictionary"})
+	if err := enc.WriteNulls(1); err == nil {
+		t.Errorf("no error for a null in a required column")
+	}
+}
+
+func TestPageEncoderLevels(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_OPTIONAL)
+
+	// rows of a list of optional elements: null, empty, or of i%5 elements
+	var rows [][]Triple
+	for i := 0; i < 100; i++ {
+		switch {
+		case i%7 == 0:
+			rows = append(rows, []Triple{{}})
+		case i%5 == 0:
+			rows = append(rows, []Triple{{D: 1}})
+		default:
+			var row []Triple
+			for k := 0; k < i%5; k++ {
+				t := Triple{R: 1, D: 3, Value: int32(i + k)}
+				if k == 0 {
+					t.R = 0
+				}
+				if (i+k)%4 == 0 {
+					t.D, t.Value = 2, nil
+				}
+				row = append(row, t)
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	for _, strategy := range []string{"default", "dictionary"} {
+		for _, version := range []int{1, 2} {
+			enc := NewPageEncoder(EncodingPreferences{Strategy: strategy, DataPageVersion: version, PageValues: 3, MaxDefinitionLevel: 3, MaxRepetitionLevel: 1})
+			var want []Triple
+			for _, row := range rows {
+				var repetition, definition []int32
+				var values []int32
+				for _, t := range row {
+					repetition = append(repetition, int32(t.R))
+					definition = append(definition, int32(t.D))
+					if t.Value != nil {
+						values = append(values, t.Value.(int32))
+					}
+				}
+				if err := enc.WriteLevels(repetition, definition); err != nil {
+					t.Fatal(err)
+				}
+				if err := enc.WriteInt32(values); err != nil {
+					t.Fatal(err)
+				}
+				want = append(want, row...)
+			}
+
+			var b bytes.Buffer
+			if _, _, err := WritePages(&b, 0, encodedPages(t, enc)); err != nil {
+				t.Fatal(err)
+			}
+			s := NewNestedScanner(schema, 1, 3, thrift.CompressionCodec_UNCOMPRESSED, bytes.NewReader(b.Bytes()))
+			var got []Triple
+			for s.Scan() {
+				p, ok := s.DataPage()
+				if !ok {
+					continue
+				}
+				triples, err := p.Triples()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if triples[0].R != 0 {
+					t.Errorf("%s v%d: a data page starts in the middle of a row", strategy, version)
+				}
+				got = append(got, triples...)
+			}
+			if err := s.Err(); err != nil {
+				t.Fatalf("%s v%d: %s", strategy, version, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s v%d: got %v, want %v", strategy, version, got, want)
+			}
+		}
+
+		preferences := EncodingPreferences{Strategy: strategy, MaxDefinitionLevel: 1, MaxRepetitionLevel: 1}
+		for _, write := range []func(enc PageEncoder) error{
+			func(enc PageEncoder) error { return enc.WriteInt32([]int32{1}) },
+			func(enc PageEncoder) error { return enc.WriteNulls(1) },
+			func(enc PageEncoder) error { return enc.WriteLevels([]int32{1}, []int32{1}) },
+			func(enc PageEncoder) error { return enc.WriteLevels([]int32{0}, []int32{2}) },
+			func(enc PageEncoder) error { return enc.WriteLevels([]int32{0, 1}, []int32{1}) },
+			func(enc PageEncoder) error {
+				if err := enc.WriteLevels([]int32{0}, []int32{1}); err != nil {
+					return nil
+				}
+				return enc.WriteInt32([]int32{1, 2})
+			},
+		} {
+			if err := write(NewPageEncoder(preferences)); err == nil {
+				t.Errorf("%s: no error", strategy)
+			}
+		}
+	}
+}
+
+func TestPageValues(t *testing.T) {
+	values := make([]int32, 250)
+	for i := range values {
+		values[i] = int32(i % 5)
+	}
+	bools := make([]bool, 250)
+	for _, strategy := range []string{"default", "dictionary"} {
+		for _, maxDefinition := range []uint{0, 1} {
+			preferences := EncodingPreferences{Strategy: strategy, PageValues: 100, MaxDefinitionLevel: maxDefinition}
+			enc := NewPageEncoder(preferences)
+			if err := enc.WriteInt32(values[:30]); err != nil {
+				t.Fatal(err)
+			}
+			if maxDefinition > 0 {
+				if err := enc.WriteNulls(90); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := enc.WriteInt32(values[30:]); err != nil {
+				t.Fatal(err)
+			}
+			want := []int32{100, 100, 50}
+			if maxDefinition > 0 {
+				want = []int32{100, 100, 100, 40}
+			}
+			var got []int32
+			for _, p := range encodedPages(t, enc) {
+				if p, ok := p.(*dataPage); ok {
+					got = append(got, p.NumValues())
+				}
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s, max definition %d: got pages of %v values, want %v", strategy, maxDefinition, got, want)
+			}
+
+			enc = NewPageEncoder(preferences)
+			if err := enc.WriteBool(bools); err != nil {
+				t.Fatal(err)
+			}
+			if n := len(encodedPages(t, enc)); n != 3 {
+				t.Errorf("%s, max definition %d: got %d pages of booleans, want 3", strategy, maxDefinition, n)
+			}
+		}
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	strings := make([][]byte, 20000)
+	for i := range strings {
+		strings[i] = []byte(fmt.Sprintf("value %d", i))
+	}
+	ints := make([]int64, 20000)
+	for i := range ints {
+		ints[i] = int64(i)
+	}
+	for _, encoding := range []thrift.Encoding{thrift.Encoding_PLAIN, thrift.Encoding_DELTA_BINARY_PACKED, thrift.Encoding_BYTE_STREAM_SPLIT} {
+		enc := NewPageEncoder(EncodingPreferences{Strategy: "default", Encoding: encoding, PageSize: 1024})
+		if err := enc.WriteInt64(ints); err != nil {
+			t.Fatal(err)
+		}
+		var values int32
+		pages := encodedPages(t, enc)
+		for _, p := range pages {
+			if n := p.(*dataPage).NumValues(); n > 128 {
+				t.Errorf("%s: page of %d values", encoding, n)
+			}
+			values += p.(*dataPage).NumValues()
+		}
+		if len(pages) != 157 || values != 20000 {
+			t.Errorf("%s: got %d pages of %d values, want 157 pages", encoding, len(pages), values)
+		}
+	}
+
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "default", PageSize: 1024})
+	if err := enc.WriteByteArray(strings); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(encodedPages(t, enc)); n < 200 {
+		t.Errorf("got %d pages of strings", n)
+	}
+
+	// the pages start at the rows
+	enc = NewPageEncoder(EncodingPreferences{Strategy: "default", PageSize: 16, MaxDefinitionLevel: 1, MaxRepetitionLevel: 1})
+	for i := 0; i < 3; i++ {
+		if err := enc.WriteLevels([]int32{0, 1, 1}, []int32{1, 1, 1}); err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.WriteInt64([]int64{1, 2, 3}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var got []int32
+	for _, p := range encodedPages(t, enc) {
+		got = append(got, p.(*dataPage).NumValues())
+	}
+	if want := []int32{3, 3, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got pages of %v values, want %v", got, want)
+	}
+}
+
+func TestPageEncoderReset(t *testing.T) {
+	values := make([]int32, 500)
+	for i := range values {
+		values[i] = int32(i % 50)
+	}
+	for _, strategy := range []string{"default", "dictionary"} {
+		preferences := EncodingPreferences{Strategy: strategy, CompressionCodec: "snappy", PageValues: 100, MaxDefinitionLevel: 1}
+		enc := NewPageEncoder(preferences)
+		if err := enc.WriteInt32([]int32{1000, 2000}); err != nil {
+			t.Fatal(err)
+		}
+		encodedPages(t, enc)
+		if err := enc.WriteNulls(3); err != nil {
+			t.Fatal(err)
+		}
+		enc.Reset(nil)
+
+		fresh := NewPageEncoder(preferences)
+		for _, e := range []PageEncoder{enc, fresh} {
+			if err := e.WriteInt32(values); err != nil {
+				t.Fatal(err)
+			}
+			if err := e.WriteNulls(10); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var data [2][]byte
+		for i, e := range []PageEncoder{enc, fresh} {
+			for _, p := range encodedPages(t, e) {
+				switch p := p.(type) {
+				case *dataPage:
+					data[i] = append(data[i], p.data...)
+				case *encodedDictionaryPage:
+					data[i] = append(data[i], p.data...)
+				}
+			}
+		}
+		if !bytes.Equal(data[0], data[1]) {
+			t.Errorf("%s: the pages after Reset differ from the pages of a new encoder", strategy)
+		}
+		if got, want := enc.Statistics(), fresh.Statistics(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got statistics %v after Reset, want %v", strategy, got, want)
+		}
+	}
+}
+
+func TestReadLevels(t *testing.T) {
+	levels := []int32{0, 1, 2, 3, 3, 3, 3, 3, 3, 3, 3, 2}
+	var data bytes.Buffer
+	if _, err := rle.WriteInt32(&data, 2, levels); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	binary.Write(&b, binary.LittleEndian, uint32(data.Len()))
+	b.Write(data.Bytes())
+	p := b.Bytes()
+
+	got, err := readLevels(bytes.NewReader(p), thrift.Encoding_RLE, 3, len(levels))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for i, v := range levels {
+		if got[i] != uint32(v) {
+			t.Fatalf("got %v, want %v", got, levels)
+		}
+	}
+
+	// levels greater than the maximum
+	if _, err := readLevels(bytes.NewReader(p), thrift.Encoding_RLE, 2, len(levels)); err == nil {
+		t.Errorf("expected an error for levels greater than the maximum")
+	}
+	if _, err := readLevels(bytes.NewReader(p[:len(p)-1]), thrift.Encoding_RLE, 3, len(levels)); err == nil {
+		t.Errorf("expected an error for truncated levels")
+	}
+}
+
+func TestScanDataPageV2(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_OPTIONAL)
+
+	var levels bytes.Buffer
+	if _, err := rle.WriteInt32(&levels, 1, []int32{1, 0, 1}); err != nil {
+		t.Fatal(err)
+	}
+	var values bytes.Buffer
+	if err := encoding.NewPlainEncoder().WriteInt32(&values, []int32{1, 3}); err != nil {
+		t.Fatal(err)
+	}
+	compressed := snappy.Encode(nil, values.Bytes())
+
+	header := thrift.NewPageHeader()
+	header.Type = thrift.PageType_DATA_PAGE_V2
+	header.DataPageHeaderV2 = thrift.NewDataPageHeaderV2()
+	header.DataPageHeaderV2.NumValues = 3
+	header.DataPageHeaderV2.NumNulls = 1
+	header.DataPageHeaderV2.NumRows = 3
+	header.DataPageHeaderV2.Encoding = thrift.Encoding_PLAIN
+	header.DataPageHeaderV2.DefinitionLevelsByteLength = int32(levels.Len())
+	header.UncompressedPageSize = int32(levels.Len() + values.Len())
+	header.CompressedPageSize = int32(levels.Len() + len(compressed))
+
+	var b bytes.Buffer
+	if _, err := header.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	b.Write(levels.Bytes())
+	b.Write(compressed)
+
+	s := NewScanner(schema, thrift.CompressionCodec_SNAPPY, &b)
+	if !s.Scan() {
+		t.Fatalf("could not scan the page: %v", s.Err())
+	}
+	p, ok := s.DataPage()
+	if !ok || p.HeaderV2() == nil {
+		t.Fatalf("expected a data page v2")
+	}
+	triples, err := p.Triples()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []Triple{{D: 1, Value: int32(1)}, {D: 0}, {D: 1, Value: int32(3)}}
+	if !reflect.DeepEqual(triples, want) {
+		t.Errorf("got %v, want %v", triples, want)
+	}
+	if s.Scan() || s.Err() != nil {
+		t.Errorf("expected the end of the pages, got %v", s.Err())
+	}
+}
+
+func TestEncodeDataPage(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REPEATED)
+
+	// [1 2] [] [3]
+	levels := Levels{
+		Repetition:    []int32{0, 1, 0, 0},
+		Definition:    []int32{2, 2, 0, 2},
+		MaxRepetition: 1,
+		MaxDefinition: 2,
+	}
+	var values bytes.Buffer
+	if err := encoding.NewPlainEncoder().WriteInt32(&values, []int32{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	want := []Triple{
+		{R: 0, D: 2, Value: int32(1)},
+		{R: 1, D: 2, Value: int32(2)},
+		{R: 0, D: 0},
+		{R: 0, D: 2, Value: int32(3)},
+	}
+
+	for _, version := range []int{1, 2} {
+		page, err := encodeDataPage(version, compressor{}, thrift.Encoding_PLAIN, levels, values.Bytes(), 4, &statistics{})
+		if err != nil {
+			t.Fatalf("v%d: unexpected error: %s", version, err)
+		}
+		if version == 2 {
+			h := page.header.DataPageHeaderV2
+			if h.NumNulls != 1 || h.NumRows != 3 {
+				t.Errorf("v2: got %d nulls and %d rows, want 1 and 3", h.NumNulls, h.NumRows)
+			}
+		}
+
+		var b bytes.Buffer
+		if _, err := page.header.Write(&b); err != nil {
+			t.Fatal(err)
+		}
+		b.Write(page.data)
+
+		s := NewNestedScanner(schema, 1, 2, thrift.CompressionCodec_UNCOMPRESSED, &b)
+		if !s.Scan() {
+			t.Fatalf("v%d: could not scan the page: %v", version, s.Err())
+		}
+		p, _ := s.DataPage()
+		triples, err := p.Triples()
+		if err != nil {
+			t.Fatalf("v%d: unexpected error: %s", version, err)
+		}
+		if !reflect.DeepEqual(triples, want) {
+			t.Errorf("v%d: got %v, want %v", version, triples, want)
+		}
+	}
+}
+
+func TestDefaultPageEncoderDataPageV2(t *testing.T) {
+	enc := NewPageEncoder(EncodingPreferences{DataPageVersion: 2})
+	if err := enc.WriteInt32([]int32{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	pages := encodedPages(t, enc)
+	if len(pages) != 1 {
+		t.Fatalf("expected a single page, got %d", len(pages))
+	}
+	p := pages[0].(*dataPage)
+	if p.Type() != thrift.PageType_DATA_PAGE_V2 || p.NumValues() != 3 {
+		t.Errorf("unexpected page %s with %d values", p.Type(), p.NumValues())
+	}
+}
+
+func TestDefaultPageEncoderEncodings(t *testing.T) {
+	bools := []bool{true, false, false, true, true}
+	ints := []int32{5, 7, 9, 8, 100}
+	for _, enc := range []thrift.Encoding{thrift.Encoding_PLAIN, thrift.Encoding_RLE, thrift.Encoding_DELTA_BINARY_PACKED, thrift.Encoding_BYTE_STREAM_SPLIT} {
+		e := NewPageEncoder(EncodingPreferences{Encoding: enc})
+		var want interface{}
+		// the values of a page are written with several calls
+		if CheckEncoding(enc, thrift.Type_BOOLEAN) == nil {
+			want = bools
+			for i := range bools {
+				if err := e.WriteBool(bools[i : i+1]); err != nil {
+					t.Fatal(err)
+				}
+			}
+		} else {
+			want = ints
+			for i := range ints {
+				if err := e.WriteInt32(ints[i : i+1]); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		pages := encodedPages(t, e)
+		if len(pages) != 1 {
+			t.Fatalf("%s: got %d pages, want 1", enc, len(pages))
+		}
+		data := pages[0].(*dataPage)
+		if got := data.header.DataPageHeader.Encoding; got != enc {
+			t.Errorf("%s: got a page encoded with %s", enc, got)
+		}
+		d, err := encoding.NewDecoder(enc, bytes.NewReader(data.data), 5, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got interface{}
+		var n uint
+		if _, ok := want.([]bool); ok {
+			b := make([]bool, 5)
+			n, err = d.DecodeBool(b)
+			got = b
+		} else {
+			v := make([]int32, 5)
+			n, err = d.DecodeInt32(v)
+			got = v
+		}
+		if err != nil || n != 5 || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: decoded %d values %v, %v, want %v", enc, n, got, err, want)
+		}
+	}
+
+	for _, test := range []struct {
+		enc thrift.Encoding
+		t   thrift.Type
+		ok  bool
+	}{
+		{thrift.Encoding_PLAIN, thrift.Type_BOOLEAN, true},
+		{thrift.Encoding_RLE_DICTIONARY, thrift.Type_BOOLEAN, false},
+		{thrift.Encoding_RLE, thrift.Type_INT32, false},
+		{thrift.Encoding_DELTA_BINARY_PACKED, thrift.Type_INT64, true},
+		{thrift.Encoding_DELTA_BINARY_PACKED, thrift.Type_FLOAT, false},
+		{thrift.Encoding_DELTA_LENGTH_BYTE_ARRAY, thrift.Type_FIXED_LEN_BYTE_ARRAY, false},
+		{thrift.Encoding_DELTA_BYTE_ARRAY, thrift.Type_FIXED_LEN_BYTE_ARRAY, true},
+		{thrift.Encoding_BYTE_STREAM_SPLIT, thrift.Type_DOUBLE, true},
+		{thrift.Encoding_BYTE_STREAM_SPLIT, thrift.Type_BYTE_ARRAY, false},
+	} {
+		if err := CheckEncoding(test.enc, test.t); (err == nil) != test.ok {
+			t.Errorf("%s %s: got error %v", test.enc, test.t, err)
+		}
+	}
+}
+
+func TestPageChecksum(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED)
+
+	var values bytes.Buffer
+	if err := encoding.NewPlainEncoder().WriteInt32(&values, []int32{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	page, err := encodeDataPage(1, compressor{}, thrift.Encoding_PLAIN, Levels{}, values.Bytes(), 3, &statistics{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !page.header.IsSetCrc() {
+		t.Fatal("the checksum of the page is not set")
+	}
+
+	var b bytes.Buffer
+	if _, err := page.header.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	b.Write(page.data)
+	stored := b.Bytes()
+
+	s := NewScanner(schema, thrift.CompressionCodec_UNCOMPRESSED, bytes.NewReader(stored))
+	if !s.Scan() {
+		t.Fatalf("could not scan the page: %v", s.Err())
+	}
+
+	// corrupt the last value
+	corrupted := append([]byte(nil), stored...)
+	corrupted[len(corrupted)-1] ^= 0xff
+
+	s = NewScanner(schema, thrift.CompressionCodec_UNCOMPRESSED, bytes.NewReader(corrupted))
+	if s.Scan() || s.Err() != ErrChecksum {
+		t.Errorf("got %v, want %v", s.Err(), ErrChecksum)
+	}
+
+	s = NewScanner(schema, thrift.CompressionCodec_UNCOMPRESSED, bytes.NewReader(corrupted))
+	s.VerifyChecksums(false)
+	if !s.Scan() {
+		t.Errorf("could not scan the page without verification: %v", s.Err())
+	}
+}
+
+func TestCompressedDataPage(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED)
+
+	in := make([]int32, 1000)
+	for i := range in {
+		in[i] = int32(i % 10)
+	}
+	var values bytes.Buffer
+	if err := encoding.NewPlainEncoder().WriteInt32(&values, in); err != nil {
+		t.Fatal(err)
+	}
+
+	codecs := []struct {
+		name  string
+		level int
+		codec thrift.CompressionCodec
+	}{
+		{"snappy", 0, thrift.CompressionCodec_SNAPPY},
+		{"gzip", 0, thrift.CompressionCodec_GZIP},
+		{"gzip", gzip.BestSpeed, thrift.CompressionCodec_GZIP},
+		{"gzip", gzip.BestCompression, thrift.CompressionCodec_GZIP},
+		{"gzip", gzip.HuffmanOnly, thrift.CompressionCodec_GZIP},
+		{"zstd", 0, thrift.CompressionCodec_ZSTD},
+		{"zstd", 19, thrift.CompressionCodec_ZSTD},
+		{"lz4_raw", 0, thrift.CompressionCodec_LZ4_RAW},
+		{"brotli", 0, thrift.CompressionCodec_BROTLI},
+		{"brotli", 11, thrift.CompressionCodec_BROTLI},
+	}
+	for _, c := range codecs {
+		for _, version := range []int{1, 2} {
+			compression, err := newCompressor(EncodingPreferences{CompressionCodec: c.name, CompressionLevel: c.level})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %s", c.name, err)
+			}
+			page, err := encodeDataPage(version, compression, thrift.Encoding_PLAIN, Levels{}, values.Bytes(), len(in), &statistics{})
+			if err != nil {
+				t.Fatalf("%s v%d: unexpected error: %s", c.name, version, err)
+			}
+			if len(page.data) >= values.Len() {
+				t.Errorf("%s v%d: page of %d bytes not compressed", c.name, version, len(page.data))
+			}
+
+			var b bytes.Buffer
+			if _, err := page.header.Write(&b); err != nil {
+				t.Fatal(err)
+			}
+			b.Write(page.data)
+
+			s := NewScanner(schema, c.codec, &b)
+			if !s.Scan() {
+				t.Fatalf("%s v%d: could not scan the page: %v", c.name, version, s.Err())
+			}
+			p, _ := s.DataPage()
+			triples, err := p.Triples()
+			if err != nil {
+				t.Fatalf("%s v%d: unexpected error: %s", c.name, version, err)
+			}
+			if len(triples) != len(in) {
+				t.Fatalf("%s v%d: got %d values, want %d", c.name, version, len(triples), len(in))
+			}
+			for i, v := range in {
+				if triples[i].Value != v {
+					t.Fatalf("%s v%d: value %d: got %v, want %d", c.name, version, i, triples[i].Value, v)
+				}
+			}
+		}
+	}
+}
+
+func TestGzipCompressionLevel(t *testing.T) {
+	if _, err := newGzipCodec(10); err == nil {
+		t.Errorf("expected an error for an invalid level")
+	}
+
+	p := bytes.Repeat([]byte("parquet"), 1000)
+	fast, err := gzipCodec{level: gzip.HuffmanOnly}.Encode(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	best, err := gzipCodec{level: gzip.BestCompression}.Encode(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(best) >= len(fast) {
+		t.Errorf("best compression of %d bytes, huffman only of %d bytes", len(best), len(fast))
+	}
+}
+
+func TestDeferCompression(t *testing.T) {
+	values := make([]int64, 1000)
+	for i := range values {
+		values[i] = int64(i * i)
+	}
+	var data [2][]byte
+	for i, deferred := range []bool{false, true} {
+		preferences := EncodingPreferences{CompressionCodec: "gzip", PageValues: 300, DeferCompression: deferred}
+		enc := NewPageEncoder(preferences)
+		if err := enc.WriteInt64(values); err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range enc.(*defaultPageEncoder).pages {
+			p := p.(*dataPage)
+			if compressed := p.CompressedSize() != p.UncompressedSize(); compressed == deferred {
+				t.Errorf("deferred %t: page compressed %t before Pages", deferred, compressed)
+			}
+		}
+		for _, p := range encodedPages(t, enc) {
+			data[i] = append(data[i], p.(*dataPage).data...)
+		}
+	}
+	if !bytes.Equal(data[0], data[1]) {
+		t.Errorf("the deferred compression of the pages differs")
+	}
+}
+
+func TestZstdOptions(t *testing.T) {
+	if _, err := newZstdCodec(23, 0); err == nil {
+		t.Errorf("expected an error for an invalid level")
+	}
+	if _, err := newZstdCodec(0, 1000); err == nil {
+		t.Errorf("expected an error for an invalid window size")
+	}
+
+	p := bytes.Repeat([]byte("parquet"), 1000)
+	c, err := newZstdCodec(3, 1<<10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressed, err := c.Encode(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := decompress(thrift.CompressionCodec_ZSTD, compressed, int32(len(p)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(out, p) {
+		t.Errorf("got %d bytes, want %d", len(out), len(p))
+	}
+	if _, err := decompress(thrift.CompressionCodec_ZSTD, compressed, int32(len(p)+1)); err == nil {
+		t.Errorf("expected an error for a wrong size")
+	}
+}
+
+func TestLZ4(t *testing.T) {
+	compressible := bytes.Repeat([]byte("parquet"), 1000)
+	random := make([]byte, 1000)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	for _, p := range [][]byte{compressible, random, []byte("short"), {}} {
+		block, err := lz4Codec{}.Encode(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// hadoop framing with the block split in two
+		half, err := lz4Codec{}.Encode(p[:len(p)/2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		rest, err := lz4Codec{}.Encode(p[len(p)/2:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var hadoop bytes.Buffer
+		for i, b := range [][]byte{half, rest} {
+			binary.Write(&hadoop, binary.BigEndian, uint32([]int{len(p) / 2, len(p) - len(p)/2}[i]))
+			binary.Write(&hadoop, binary.BigEndian, uint32(len(b)))
+			hadoop.Write(b)
+		}
+
+		for _, c := range []struct {
+			name  string
+			src   []byte
+			codec thrift.CompressionCodec
+		}{
+			{"LZ4_RAW", block, thrift.CompressionCodec_LZ4_RAW},
+			{"LZ4 block", block, thrift.CompressionCodec_LZ4},
+			{"LZ4 hadoop", hadoop.Bytes(), thrift.CompressionCodec_LZ4},
+		} {
+			out, err := decompress(c.codec, c.src, int32(len(p)))
+			if err != nil {
+				t.Errorf("%s of %d bytes: unexpected error: %s", c.name, len(p), err)
+				continue
+			}
+			if !bytes.Equal(out, p) {
+				t.Errorf("%s of %d bytes: got %d bytes", c.name, len(p), len(out))
+			}
+		}
+	}
+
+	if _, err := decompress(thrift.CompressionCodec_LZ4_RAW, []byte{0x10, 'a'}, 1<<20); err == nil {
+		t.Errorf("expected an error for an invalid uncompressed size")
+	}
+	if _, err := newCompressor(EncodingPreferences{CompressionCodec: "lz4"}); err == nil {
+		t.Errorf("expected an error for the deprecated LZ4 codec")
+	}
+}
+
+// reverseCodec "compresses" the pages by reversing their bytes.
+type reverseCodec struct{}
+
+func (reverseCodec) Encode(src []byte) ([]byte, error) {
+	out := make([]byte, len(src))
+	for i, b := range src {
+		out[len(src)-1-i] = b
+	}
+	return out, nil
+}
+
+func (c reverseCodec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	return c.Encode(src)
+}
+
+func TestRegisterCodec(t *testing.T) {
+	if _, err := newCompressor(EncodingPreferences{CompressionCodec: "lzo"}); err == nil {
+		t.Fatalf("expected an error for the LZO codec")
+	}
+
+	RegisterCodec(thrift.CompressionCodec_LZO, reverseCodec{})
+	RegisterCodec(thrift.CompressionCodec_SNAPPY, reverseCodec{})
+	defer RegisterCodec(thrift.CompressionCodec_LZO, nil)
+	defer RegisterCodec(thrift.CompressionCodec_SNAPPY, nil)
+
+	for _, name := range []string{"lzo", "snappy"} {
+		c, err := newCompressor(EncodingPreferences{CompressionCodec: name})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+		out, err := c.compress([]byte("abc"))
+		if err != nil || string(out) != "cba" {
+			t.Errorf("%s: got %q, %v, want \"cba\"", name, out, err)
+		}
+		out, err = decompress(c.codec, []byte("cba"), 3)
+		if err != nil || string(out) != "abc" {
+			t.Errorf("%s: got %q, %v, want \"abc\"", name, out, err)
+		}
+	}
+
+	RegisterCodec(thrift.CompressionCodec_SNAPPY, nil)
+	if _, ok := registeredCodec(thrift.CompressionCodec_SNAPPY); ok {
+		t.Errorf("the snappy codec is still registered")
+	}
+	if out, err := decompress(thrift.CompressionCodec_SNAPPY, snappy.Encode(nil, []byte("abc")), 3); err != nil || string(out) != "abc" {
+		t.Errorf("got %q, %v with the built-in snappy codec", out, err)
+	}
+}
+
+func TestZstdDictionary(t *testing.T) {
+	values := make([][]byte, 2000)
+	for i := range values {
+		values[i] = []byte(fmt.Sprintf("customer-%05d/address/%d", i, i%3))
+	}
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_BYTE_ARRAY)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED)
+
+	// the dictionary falls back to many small PLAIN pages
+	encode := func(dictionarySize int) ([]byte, []byte) {
+		enc := NewPageEncoder(EncodingPreferences{
+			CompressionCodec:   "zstd",
+			Strategy:           "dictionary",
+			DictionaryPageSize: 64,
+			PageSize:           512,
+			ZstdDictionarySize: dictionarySize,
+		})
+		if err := enc.WriteByteArray(values); err != nil {
+			t.Fatal(err)
+		}
+		var b bytes.Buffer
+		for _, p := range encodedPages(t, enc) {
+			page := p.(*dataPage)
+			if _, err := page.header.Write(&b); err != nil {
+				t.Fatal(err)
+			}
+			b.Write(page.data)
+		}
+		return b.Bytes(), enc.ZstdDictionary()
+	}
+
+	plain, dict := encode(0)
+	if dict != nil {
+		t.Fatalf("unexpected dictionary without ZstdDictionarySize")
+	}
+	data, dict := encode(4096)
+	if dict == nil {
+		t.Fatalf("no dictionary")
+	}
+	if len(data) >= len(plain) {
+		t.Errorf("%d bytes with a dictionary, %d bytes without", len(data), len(plain))
+	}
+
+	s := NewScanner(schema, thrift.CompressionCodec_ZSTD, bytes.NewReader(data))
+	if err := s.UseZstdDictionary(dict); err != nil {
+		t.Fatal(err)
+	}
+	var got [][]byte
+	for s.Scan() {
+		p, _ := s.DataPage()
+		triples, err := p.Triples()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, triple := range triples {
+			got = append(got, triple.Value.([]byte))
+		}
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("got %d values, want %d", len(got), len(values))
+	}
+
+	s = NewScanner(schema, thrift.CompressionCodec_ZSTD, bytes.NewReader(data))
+	if s.Scan() {
+		t.Errorf("pages decompressed without the dictionary")
+	}
+
+	meta := thrift.NewColumnMetaData()
+	SetZstdDictionary(meta, dict)
+	if stored, err := ZstdDictionary(meta); err != nil || !bytes.Equal(stored, dict) {
+		t.Errorf("got %d bytes, %v from the metadata", len(stored), err)
+	}
+}
+
+func TestZstdDictionaryUntrainable(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_BYTE_ARRAY)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED)
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name  string
+		value func(i int) []byte
+	}{
+		{"constant", func(i int) []byte { return []byte("0f8fad5b-d9cb-469f-a165-70867728950e") }},
+		{"zeros", func(i int) []byte { return make([]byte, 36) }},
+		{"one byte", func(i int) []byte { return []byte{'x'} }},
+		{"random", func(i int) []byte {
+			b := make([]byte, 36)
+			rnd.Read(b)
+			return b
+		}},
+	} {
+		for _, dictionarySize := range []int{1024, 16384} {
+			for _, n := range []int{1, 100, 1000} {
+				values := make([][]byte, n)
+				for i := range values {
+					values[i] = test.value(i)
+				}
+				// the training of the dictionary fails on these pages, they
+				// are compressed without one
+				enc := NewPageEncoder(EncodingPreferences{
+					CompressionCodec:   "zstd",
+					PageSize:           256,
+					ZstdDictionarySize: dictionarySize,
+				})
+				if err := enc.WriteByteArray(values); err != nil {
+					t.Fatal(err)
+				}
+				pages, err := enc.Pages()
+				if err != nil {
+					t.Errorf("%s, dictionary of %d bytes, %d values: %s", test.name, dictionarySize, n, err)
+					continue
+				}
+				var b bytes.Buffer
+				if _, _, err := WritePages(&b, 0, pages); err != nil {
+					t.Fatal(err)
+				}
+
+				s := NewScanner(schema, thrift.CompressionCodec_ZSTD, bytes.NewReader(b.Bytes()))
+				if dict := enc.ZstdDictionary(); dict != nil {
+					if err := s.UseZstdDictionary(dict); err != nil {
+						t.Fatal(err)
+					}
+				}
+				var got [][]byte
+				for s.Scan() {
+					p, _ := s.DataPage()
+					triples, err := p.Triples()
+					if err != nil {
+						t.Fatal(err)
+					}
+					for _, triple := range triples {
+						got = append(got, triple.Value.([]byte))
+					}
+				}
+				if err := s.Err(); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, values) {
+					t.Errorf("%s, dictionary of %d bytes: got %d values, want %d", test.name, dictionarySize, len(got), n)
+				}
+			}
+		}
+	}
+}
+
+func TestLazyDictionaryPage(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED)
+
+	// 64 indices of 2 bits fill a page
+	values := make([]int32, 256)
+	for i := range values {
+		values[i] = int32(i % 3)
+	}
+	enc := NewPageEncoder(EncodingPreferences{CompressionCodec: "snappy", Strategy: "dictionary", PageSize: 16})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	for _, p := range encodedPages(t, enc) {
+		var header *thrift.PageHeader
+		var data []byte
+		switch p := p.(type) {
+		case *encodedDictionaryPage:
+			header, data = p.header, p.data
+		case *dataPage:
+			header, data = p.header, p.data
+		}
+		if _, err := header.Write(&b); err != nil {
+			t.Fatal(err)
+		}
+		b.Write(data)
+	}
+	stored := b.Bytes()
+
+	s := NewScanner(schema, thrift.CompressionCodec_SNAPPY, bytes.NewReader(stored))
+	if !s.Scan() {
+		t.Fatalf("could not scan the dictionary page: %v", s.Err())
+	}
+	dictionary, ok := s.DictionaryPage()
+	if !ok {
+		t.Fatalf("expected a dictionary page")
+	}
+	if dictionary.valuesInt32 != nil {
+		t.Errorf("dictionary decoded by Scan")
+	}
+
+	var got []int32
+	pages := 0
+	for s.Scan() {
+		p, _ := s.DataPage()
+		if p.Dictionary() != dictionary {
+			t.Fatalf("data page %d does not share the dictionary of the chunk", pages)
+		}
+		triples, err := p.Triples()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, triple := range triples {
+			got = append(got, triple.Value.(int32))
+		}
+		pages++
+	}
+	if err := s.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if pages < 2 {
+		t.Errorf("expected several data pages, got %d", pages)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("got %v, want %v", got, values)
+	}
+
+	// a corrupted dictionary is only detected when it is used
+	var dictHeader thrift.PageHeader
+	r := bytes.NewReader(stored)
+	if err := dictHeader.Read(r); err != nil {
+		t.Fatal(err)
+	}
+	corrupted := append([]byte(nil), stored...)
+	offset := len(stored) - r.Len()
+	for i := 0; i < int(dictHeader.CompressedPageSize); i++ {
+		corrupted[offset+i] = 0xff
+	}
+	s = NewScanner(schema, thrift.CompressionCodec_SNAPPY, bytes.NewReader(corrupted))
+	s.VerifyChecksums(false)
+	if !s.Scan() || !s.Scan() {
+		t.Fatalf("could not scan the pages: %v", s.Err())
+	}
+	p, _ := s.DataPage()
+	if _, err := p.Triples(); err == nil {
+		t.Errorf("expected an error for a corrupted dictionary")
+	}
+}
+
+func TestPageStatistics(t *testing.T) {
+	le32 := func(v uint32) []byte {
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, v)
+		return b
+	}
+	le64 := func(v uint64) []byte {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, v)
+		return b
+	}
+	negZero := math.Copysign(0, -1)
+
+	tests := []struct {
+		name     string
+		order    SortOrder
+		write    func(PageEncoder) error
+		min, max []byte
+		legacy   bool // min and max in the deprecated fields
+	}{
+		{"int32", SortOrderSigned, func(e PageEncoder) error { return e.WriteInt32([]int32{3, -7, 12}) },
+			le32(uint32(0xfffffff9)), le32(12), true},
+		{"uint32", SortOrderUnsigned, func(e PageEncoder) error { return e.WriteInt32([]int32{3, -7, 12}) },
+			le32(3), le32(uint32(0xfffffff9)), false},
+		{"int64", SortOrderSigned, func(e PageEncoder) error { return e.WriteInt64([]int64{1 << 40, -2}) },
+			le64(uint64(0xfffffffffffffffe)), le64(1 << 40), true},
+		{"float", SortOrderSigned, func(e PageEncoder) error { return e.WriteFloat32([]float32{float32(math.NaN()), 0, 2.5}) },
+			le32(math.Float32bits(float32(negZero))), le32(math.Float32bits(2.5)), true},
+		{"double", SortOrderSigned, func(e PageEncoder) error { return e.WriteFloat64([]float64{-1.5, negZero, math.NaN()}) },
+			le64(math.Float64bits(-1.5)), le64(0), true},
+		{"bool", SortOrderSigned, func(e PageEncoder) error { return e.WriteBool([]bool{true, true}) },
+			[]byte{1}, []byte{1}, true},
+		{"byte array", SortOrderSigned, func(e PageEncoder) error { return e.WriteByteArray([][]byte{{'b'}, {0xff}, {'a', 'b'}}) },
+			[]byte("ab"), []byte("\xff"), false},
+		{"int96", SortOrderUndefined, func(e PageEncoder) error { return e.WriteByteArray([][]byte{[]byte("x")}) },
+			nil, nil, false},
+	}
+
+	for _, test := range tests {
+		for _, strategy := range []string{"default", "dictionary"} {
+			enc := NewPageEncoder(EncodingPreferences{Strategy: strategy, SortOrder: test.order})
+			if err := test.write(enc); err != nil {
+				t.Fatal(err)
+			}
+
+			var stats []*thrift.Statistics
+			for _, p := range encodedPages(t, enc) {
+				if p, ok := p.(*dataPage); ok {
+					stats = append(stats, p.header.DataPageHeader.Statistics)
+				}
+			}
+			stats = append(stats, enc.Statistics())
+
+			for _, s := range stats {
+				if !bytes.Equal(s.MinValue, test.min) || !bytes.Equal(s.MaxValue, test.max) {
+					t.Errorf("%s %s: got min %v max %v, want %v %v", test.name, strategy, s.MinValue, s.MaxValue, test.min, test.max)
+				}
+				if got := s.Min != nil; got != test.legacy {
+					t.Errorf("%s %s: deprecated min set: %t", test.name, strategy, got)
+				}
+				if s.GetNullCount() != 0 || !s.IsSetNullCount() {
+					t.Errorf("%s %s: got null count %v", test.name, strategy, s.NullCount)
+				}
+			}
+		}
+	}
+}
+
+func TestDictionaryPageStatistics(t *testing.T) {
+	// 64 indices of 2 bits fill a page
+	values := make([]int32, 130)
+	for i := range values {
+		values[i] = int32(i % 3)
+	}
+	values[129] = 7
+
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "dictionary", PageSize: 16})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatal(err)
+	}
+	var max []int32
+	for _, p := range encodedPages(t, enc) {
+		if p, ok := p.(*dataPage); ok {
+			max = append(max, int32(binary.LittleEndian.Uint32(p.header.DataPageHeader.Statistics.MaxValue)))
+		}
+	}
+	if !reflect.DeepEqual(max, []int32{2, 2, 7}) {
+		t.Errorf("got max %v for the pages", max)
+	}
+
+	stats := enc.Statistics()
+	if stats.GetDistinctCount() != 4 {
+		t.Errorf("got distinct count %v, want 4", stats.DistinctCount)
+	}
+	if int32(binary.LittleEndian.Uint32(stats.MaxValue)) != 7 {
+		t.Errorf("got max %v, want 7", stats.MaxValue)
+	}
+}
+
+func TestStatisticsTruncation(t *testing.T) {
+	tests := []struct {
+		values   [][]byte
+		min, max []byte
+	}{
+		{[][]byte{[]byte("abcdef"), []byte("abd")}, []byte("abc"), []byte("abd")},
+		{[][]byte{[]byte("ab"), []byte("xyz")}, []byte("ab"), []byte("xyz")},
+		{[][]byte{[]byte("a"), {'b', 0xff, 0xff, 'c'}}, []byte("a"), []byte("c")},
+		{[][]byte{[]byte("a"), {0xff, 0xff, 0xff, 1}}, []byte("a"), []byte{0xff, 0xff, 0xff, 1}},
+	}
+	for _, test := range tests {
+		enc := NewPageEncoder(EncodingPreferences{StatisticsTruncateLength: 3})
+		if err := enc.WriteByteArray(test.values); err != nil {
+			t.Fatal(err)
+		}
+		stats := enc.Statistics()
+		if !bytes.Equal(stats.MinValue, test.min) || !bytes.Equal(stats.MaxValue, test.max) {
+			t.Errorf("%q: got min %q max %q, want %q %q", test.values, stats.MinValue, stats.MaxValue, test.min, test.max)
+		}
+	}
+}
+
+func TestPageIndex(t *testing.T) {
+	values := make([]int32, 200)
+	for i := range values {
+		values[i] = int32(i / 2)
+	}
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "dictionary", PageSize: 64})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatal(err)
+	}
+	pages := encodedPages(t, enc)
+
+	var b bytes.Buffer
+	b.WriteString("PAR1")
+	n, offsetIndex, err := WritePages(&b, 4, pages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(b.Len()-4) {
+		t.Errorf("wrote %d bytes, reported %d", b.Len()-4, n)
+	}
+	columnIndex := enc.ColumnIndex()
+
+	locations := offsetIndex.PageLocations
+	if len(locations) < 2 || len(locations) != len(columnIndex.MinValues) {
+		t.Fatalf("got %d page locations and %d min values", len(locations), len(columnIndex.MinValues))
+	}
+	if columnIndex.BoundaryOrder != thrift.BoundaryOrder_ASCENDING {
+		t.Errorf("got boundary order %s", columnIndex.BoundaryOrder)
+	}
+
+	data := b.Bytes()
+	rows := int64(0)
+	for i, l := range locations {
+		if l.FirstRowIndex != rows {
+			t.Errorf("page %d: got first row %d, want %d", i, l.FirstRowIndex, rows)
+		}
+		var header thrift.PageHeader
+		if _, err := header.ReadFrom(bytes.NewReader(data[l.Offset : l.Offset+int64(l.CompressedPageSize)])); err != nil {
+			t.Fatalf("page %d: %s", i, err)
+		}
+		if header.Type != thrift.PageType_DATA_PAGE {
+			t.Errorf("page %d: got a %s page", i, header.Type)
+		}
+		rows += int64(header.DataPageHeader.NumValues)
+
+		min := int32(binary.LittleEndian.Uint32(columnIndex.MinValues[i]))
+		max := int32(binary.LittleEndian.Uint32(columnIndex.MaxValues[i]))
+		if min != values[l.FirstRowIndex] || max != values[rows-1] {
+			t.Errorf("page %d: got min %d max %d", i, min, max)
+		}
+		if columnIndex.NullPages[i] || columnIndex.NullCounts[i] != 0 {
+			t.Errorf("page %d: got null page %t with %d nulls", i, columnIndex.NullPages[i], columnIndex.NullCounts[i])
+		}
+	}
+	if rows != int64(len(values)) {
+		t.Errorf("got %d rows, want %d", rows, len(values))
+	}
+
+	enc = NewPageEncoder(EncodingPreferences{SortOrder: SortOrderUndefined})
+	if err := enc.WriteByteArray([][]byte{[]byte("x")}); err != nil {
+		t.Fatal(err)
+	}
+	encodedPages(t, enc)
+	if enc.ColumnIndex() != nil {
+		t.Errorf("got a column index for values with no order")
+	}
+}
+
+func TestPageBloomFilter(t *testing.T) {
+	for _, strategy := range []string{"default", "dictionary"} {
+		// a filter per column, the values of a column have a single type
+		preferences := EncodingPreferences{Strategy: strategy, BloomFilter: bloom.New(1024)}
+		if err := NewPageEncoder(preferences).WriteInt32([]int32{1, 2, 3, 2}); err != nil {
+			t.Fatal(err)
+		}
+		for _, v := range []int32{1, 2, 3} {
+			if !preferences.BloomFilter.Check(v) {
+				t.Errorf("%s: Check(%d) = false", strategy, v)
+			}
+		}
+		if preferences.BloomFilter.Check(int32(4)) || preferences.BloomFilter.Check(int64(1)) {
+			t.Errorf("%s: values not written may be in the filter", strategy)
+		}
+
+		preferences.BloomFilter = bloom.New(1024)
+		if err := NewPageEncoder(preferences).WriteByteArray([][]byte{[]byte("abc"), {}}); err != nil {
+			t.Fatal(err)
+		}
+		if !preferences.BloomFilter.Check("abc") || !preferences.BloomFilter.Check("") {
+			t.Errorf("%s: byte arrays not in the filter", strategy)
+		}
+		if preferences.BloomFilter.Check("abcd") {
+			t.Errorf("%s: Check(abcd) = true", strategy)
+		}
+	}
+}
+
+func TestSizeStatistics(t *testing.T) {
+	values := [][]byte{[]byte("a"), []byte("bc"), []byte("cde"), []byte("a")}
+	for _, strategy := range []string{"default", "dictionary"} {
+		enc := NewPageEncoder(EncodingPreferences{Strategy: strategy})
+		if err := enc.WriteByteArray(values); err != nil {
+			t.Fatal(err)
+		}
+		encodedPages(t, enc)
+		stats := enc.SizeStatistics()
+		if stats.GetUnencodedByteArrayDataBytes() != 7 {
+			t.Errorf("%s: got %d unencoded bytes, want 7", strategy, stats.GetUnencodedByteArrayDataBytes())
+		}
+		if stats.RepetitionLevelHistogram != nil || stats.DefinitionLevelHistogram != nil {
+			t.Errorf("%s: got level histograms for max levels of 0", strategy)
+		}
+
+		enc = NewPageEncoder(EncodingPreferences{Strategy: strategy})
+		if err := enc.WriteInt32([]int32{1, 2}); err != nil {
+			t.Fatal(err)
+		}
+		encodedPages(t, enc)
+		if stats := enc.SizeStatistics(); stats != nil {
+			t.Errorf("%s: got %v for int32 values", strategy, stats)
+		}
+	}
+
+	var s sizeStatistics
+	for _, levels := range []Levels{
+		{Repetition: []int32{0, 1, 0}, Definition: []int32{2, 2, 0}, MaxRepetition: 1, MaxDefinition: 2},
+		{Repetition: []int32{0, 1}, Definition: []int32{1, 2}, MaxRepetition: 1, MaxDefinition: 2},
+	} {
+		page, err := encodeDataPage(1, compressor{}, thrift.Encoding_PLAIN, levels, nil, len(levels.Definition), &statistics{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.addPage(page)
+	}
+	stats := s.thrift()
+	if !reflect.DeepEqual(stats.RepetitionLevelHistogram, []int64{3, 2}) {
+		t.Errorf("got repetition histogram %v", stats.RepetitionLevelHistogram)
+	}
+	if !reflect.DeepEqual(stats.DefinitionLevelHistogram, []int64{1, 1, 3}) {
+		t.Errorf("got definition histogram %v", stats.DefinitionLevelHistogram)
+	}
+	if stats.UnencodedByteArrayDataBytes != nil {
+		t.Errorf("got unencoded bytes without byte arrays")
+	}
+}
+
+func TestEncodingStats(t *testing.T) {
+	enc := NewPageEncoder(EncodingPreferences{Strategy: "dictionary", DictionaryPageSize: 64, PageSize: 8})
+	small := make([]int64, 64)
+	for i := range small {
+		small[i] = int64(i % 2)
+	}
+	if err := enc.WriteInt64(small); err != nil {
+		t.Fatal(err)
+	}
+	large := make([]int64, 20)
+	for i := range large {
+		large[i] = int64(i + 100)
+	}
+	if err := enc.WriteInt64(large); err != nil {
+		t.Fatal(err)
+	}
+	pages := encodedPages(t, enc)
+
+	stats, err := EncodingStats(pages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("got %v, want stats for the dictionary, dictionary encoded and PLAIN pages", stats)
+	}
+	want := []thrift.PageEncodingStats{
+		{PageType: thrift.PageType_DICTIONARY_PAGE, Encoding: thrift.Encoding_PLAIN_DICTIONARY, Count: 1},
+		{PageType: thrift.PageType_DATA_PAGE, Encoding: thrift.Encoding_PLAIN_DICTIONARY},
+		{PageType: thrift.PageType_DATA_PAGE, Encoding: thrift.Encoding_PLAIN},
+	}
+	count := int32(0)
+	for i, s := range stats {
+		if s.PageType != want[i].PageType || s.Encoding != want[i].Encoding {
+			t.Errorf("stats %d: got %v", i, s)
+		}
+		if want[i].Count != 0 && s.Count != want[i].Count {
+			t.Errorf("stats %d: got %d pages, want %d", i, s.Count, want[i].Count)
+		}
+		count += s.Count
+	}
+	if int(count) != len(pages) {
+		t.Errorf("got stats for %d pages, want %d", count, len(pages))
+	}
+}
+
+// encryptPages returns the pages encrypted with key as the modules of the
+// first column chunk of the first row group of a file.
+func encryptPages(t *testing.T, pages []Page, key, fileAAD []byte, ctr bool) []byte {
+	metadata, err := encryption.NewGCM(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := metadata
+	if ctr {
+		if data, err = encryption.NewCTR(key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var b bytes.Buffer
+	ordinal := 0
+	for _, p := range pages {
+		header, page, err := encodedPage(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		headerType, pageType := encryption.DataPageHeader, encryption.DataPage
+		if header.Type == thrift.PageType_DICTIONARY_PAGE {
+			headerType, pageType = encryption.DictionaryPageHeader, encryption.DictionaryPage
+		}
+
+		aad, err := encryption.ModuleAAD(fileAAD, pageType, 0, 0, ordinal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		module, err := data.Encrypt(page, aad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := *header
+		h.CompressedPageSize = int32(len(module))
+		if h.IsSetCrc() {
+			crc := int32(crc32.ChecksumIEEE(module))
+			h.Crc = &crc
+		}
+
+		var plain bytes.Buffer
+		if _, err := h.Write(&plain); err != nil {
+			t.Fatal(err)
+		}
+		if aad, err = encryption.ModuleAAD(fileAAD, headerType, 0, 0, ordinal); err != nil {
+			t.Fatal(err)
+		}
+		headerModule, err := metadata.Encrypt(plain.Bytes(), aad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Write(headerModule)
+		b.Write(module)
+		if pageType == encryption.DataPage {
+			ordinal++
+		}
+	}
+	return b.Bytes()
+}
+
+func TestScannerDecryption(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED)
+
+	values := make([]int32, 256)
+	for i := range values {
+		values[i] = int32(i % 3)
+	}
+	enc := NewPageEncoder(EncodingPreferences{CompressionCodec: "snappy", Strategy: "dictionary", PageSize: 16})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatal(err)
+	}
+	pages := encodedPages(t, enc)
+
+	key := []byte("0123456789012345")
+	fileAAD := []byte("file")
+	for _, ctr := range []bool{false, true} {
+		algorithm := &thrift.EncryptionAlgorithm{AES_GCM_V1: &thrift.AesGcmV1{AadFileUnique: fileAAD}}
+		if ctr {
+			algorithm = &thrift.EncryptionAlgorithm{AES_GCM_CTR_V1: &thrift.AesGcmCtrV1{AadFileUnique: fileAAD}}
+		}
+		fd, err := encryption.NewFileDecryptor(&encryption.DecryptionProperties{FooterKey: key}, algorithm, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decryptor, err := fd.ColumnDecryptor(&thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_FOOTER_KEY: thrift.NewEncryptionWithFooterKey()}, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stored := encryptPages(t, pages, key, fileAAD, ctr)
+
+		s := NewScanner(schema, thrift.CompressionCodec_SNAPPY, bytes.NewReader(stored))
+		s.UseDecryptor(decryptor, true)
+		var got []int32
+		for s.Scan() {
+			p, ok := s.DataPage()
+			if !ok {
+				continue
+			}
+			triples, err := p.Triples()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, triple := range triples {
+				got = append(got, triple.Value.(int32))
+			}
+		}
+		if err := s.Err(); err != nil {
+			t.Fatalf("ctr %t: %s", ctr, err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("ctr %t: got %v, want %v", ctr, got, values)
+		}
+
+		// the page headers are bound to the column chunk
+		decryptor, err = fd.ColumnDecryptor(&thrift.ColumnCryptoMetaData{ENCRYPTION_WITH_FOOTER_KEY: thrift.NewEncryptionWithFooterKey()}, 0, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s = NewScanner(schema, thrift.CompressionCodec_SNAPPY, bytes.NewReader(stored))
+		s.UseDecryptor(decryptor, true)
+		if s.Scan() || s.Err() == nil {
+			t.Errorf("ctr %t: no error for the pages of another column", ctr)
+		}
+	}
+}
+
+func TestWriteEncryptedPages(t *testing.T) {
+	schema := thrift.NewSchemaElement()
+	schema.Type = thrift.TypePtr(thrift.Type_INT32)
+	schema.RepetitionType = thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED)
+
+	values := make([]int32, 256)
+	for i := range values {
+		values[i] = int32(i % 3)
+	}
+	enc := NewPageEncoder(EncodingPreferences{CompressionCodec: "snappy", Strategy: "dictionary", PageSize: 16})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatal(err)
+	}
+	pages := encodedPages(t, enc)
+
+	key := []byte("0123456789012345")
+	for _, algorithm := range []encryption.Algorithm{encryption.AesGcmV1, encryption.AesGcmCtrV1} {
+		properties, err := encryption.NewFileEncryptionPropertiesBuilder(key).WithAlgorithm(algorithm).Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fe, err := encryption.NewFileEncryptor(properties)
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := fe.ColumnEncryptor([]string{"value"}, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var b bytes.Buffer
+		n, index, err := WriteEncryptedPages(&b, 4, pages, e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != int64(b.Len()) {
+			t.Errorf("algorithm %d: %d bytes written, reported %d", algorithm, b.Len(), n)
+		}
+		locations := index.GetPageLocations()
+		if len(locations) < 2 {
+			t.Fatalf("algorithm %d: %d data pages", algorithm, len(locations))
+		}
+		last := locations[len(locations)-1]
+		if end := last.Offset + int64(last.CompressedPageSize); end != 4+n {
+			t.Errorf("algorithm %d: last page ends at %d, want %d", algorithm, end, 4+n)
+		}
+
+		fd, err := encryption.NewFileDecryptor(&encryption.DecryptionProperties{FooterKey: key}, fe.Algorithm(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := fd.ColumnDecryptor(fe.ColumnCryptoMetaData([]string{"value"}), 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := NewScanner(schema, thrift.CompressionCodec_SNAPPY, bytes.NewReader(b.Bytes()))
+		s.UseDecryptor(d, true)
+		var got []int32
+		for s.Scan() {
+			p, ok := s.DataPage()
+			if !ok {
+				continue
+			}
+			triples, err := p.Triples()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, triple := range triples {
+				got = append(got, triple.Value.(int32))
+			}
+		}
+		if err := s.Err(); err != nil {
+			t.Fatalf("algorithm %d: %s", algorithm, err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("algorithm %d: got %v, want %v", algorithm, got, values)
+		}
+	}
+}
+
+func TestReencryptPages(t *testing.T) {
+	values := make([]int32, 256)
+	for i := range values {
+		values[i] = int32(i % 3)
+	}
+	enc := NewPageEncoder(EncodingPreferences{CompressionCodec: "snappy", Strategy: "dictionary", PageSize: 16})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatal(err)
+	}
+	pages := encodedPages(t, enc)
+	var plaintext bytes.Buffer
+	if _, _, err := WritePages(&plaintext, 4, pages); err != nil {
+		t.Fatal(err)
+	}
+
+	key := []byte("0123456789012345")
+	properties, err := encryption.NewFileEncryptionPropertiesBuilder(key).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fe, err := encryption.NewFileEncryptor(properties)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := fe.ColumnEncryptor([]string{"value"}, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var encrypted bytes.Buffer
+	n, index, err := ReencryptPages(&encrypted, 4, bytes.NewReader(plaintext.Bytes()), int64(plaintext.Len()), true, nil, e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(encrypted.Len()) || len(index.GetPageLocations()) < 2 {
+		t.Errorf("got %d bytes and %d data pages, %d bytes written", n, len(index.GetPageLocations()), encrypted.Len())
+	}
+
+	fd, err := encryption.NewFileDecryptor(&encryption.DecryptionProperties{FooterKey: key}, fe.Algorithm(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := fd.ColumnDecryptor(fe.ColumnCryptoMetaData([]string{"value"}), 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decrypted bytes.Buffer
+	if _, _, err := ReencryptPages(&decrypted, 4, bytes.NewReader(encrypted.Bytes()), int64(encrypted.Len()), true, d, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted.Bytes(), plaintext.Bytes()) {
+		t.Errorf("decrypted pages differ from the pages written")
+	}
+
+	// the size of the chunk is checked
+	if _, _, err := ReencryptPages(&bytes.Buffer{}, 4, bytes.NewReader(plaintext.Bytes()), int64(plaintext.Len()-1), true, nil, nil); err == nil {
+		t.Errorf("no error for a truncated chunk")
+	}
+}
+
+// encodedPages returns the pages of enc, failing t on error.
+func encodedPages(t testing.TB, enc PageEncoder) []Page {
+	t.Helper()
+	pages, err := enc.Pages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pages
+}
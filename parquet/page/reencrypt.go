@@ -0,0 +1,111 @@
+package page
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// ReencryptPages copies the pages of the column chunk of size bytes read
+// from r to w, starting at offset in the file, without decoding them. The
+// page headers and the pages are decrypted with d, nil if the chunk is not
+// encrypted, and encrypted with e, nil to write them in plaintext.
+// dictionary is whether the chunk starts with a dictionary page. The
+// checksums of the pages are verified and computed again for the pages
+// written. It returns the number of bytes written and the offset index of
+// the data pages, without their first row index: it is not stored in the
+// page headers.
+func ReencryptPages(w io.Writer, offset int64, r io.Reader, size int64, dictionary bool, d *encryption.ColumnDecryptor, e *encryption.ColumnEncryptor) (int64, *thrift.OffsetIndex, error) {
+	index := thrift.NewOffsetIndex()
+	index.PageLocations = []*thrift.PageLocation{}
+
+	rc := thrift.NewCountingReader(r)
+	var n int64
+	for i := 0; rc.N < size; i++ {
+		ordinal := len(index.PageLocations)
+		header, err := readPageHeader(rc, d, dictionary && i == 0, ordinal)
+		if err != nil {
+			return n, nil, err
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(rc, int64(header.CompressedPageSize)))
+		if err != nil {
+			return n, nil, fmt.Errorf("could not read page: %s", err)
+		}
+		if len(data) != int(header.CompressedPageSize) {
+			return n, nil, fmt.Errorf("could not read page: %d bytes of %d", len(data), header.CompressedPageSize)
+		}
+		if header.IsSetCrc() && int32(crc32.ChecksumIEEE(data)) != header.GetCrc() {
+			return n, nil, ErrChecksum
+		}
+		if d != nil {
+			t := encryption.DataPage
+			if header.GetType() == thrift.PageType_DICTIONARY_PAGE {
+				t = encryption.DictionaryPage
+			}
+			if data, err = d.Decrypt(data, t, ordinal); err != nil {
+				return n, nil, err
+			}
+		}
+
+		var hn int
+		if e == nil {
+			header.CompressedPageSize = int32(len(data))
+			if header.IsSetCrc() {
+				header.Crc = checksum(data)
+			}
+			hn, err = header.Write(w)
+		} else if data, err = encryptPage(header, data, e, ordinal); err == nil {
+			hn, err = writeEncryptedHeader(w, header, data, e, ordinal)
+		}
+		if err != nil {
+			return n, nil, fmt.Errorf("could not write page header: %s", err)
+		}
+		dn, err := w.Write(data)
+		if err != nil {
+			return n, nil, fmt.Errorf("could not write page: %s", err)
+		}
+
+		if t := header.GetType(); t == thrift.PageType_DATA_PAGE || t == thrift.PageType_DATA_PAGE_V2 {
+			index.PageLocations = append(index.PageLocations, &thrift.PageLocation{
+				Offset:             offset + n,
+				CompressedPageSize: int32(hn + dn),
+			})
+		}
+		n += int64(hn + dn)
+	}
+	if rc.N != size {
+		return n, nil, fmt.Errorf("pages of %d bytes in a column chunk of %d bytes", rc.N, size)
+	}
+	return n, index, nil
+}
+
+// readPageHeader reads a page header from r, decrypted with d unless d is
+// nil, the header of the dictionary page of the chunk or of its ordinal-th
+// data page.
+func readPageHeader(r io.Reader, d *encryption.ColumnDecryptor, dictionary bool, ordinal int) (*thrift.PageHeader, error) {
+	var header thrift.PageHeader
+	if d == nil {
+		if err := header.Read(r); err != nil {
+			return nil, fmt.Errorf("could not read page header: %s", err)
+		}
+		return &header, nil
+	}
+
+	t := encryption.DataPageHeader
+	if dictionary {
+		t = encryption.DictionaryPageHeader
+	}
+	data, err := d.ReadModule(r, t, ordinal)
+	if err != nil {
+		return nil, fmt.Errorf("could not read page header: %s", err)
+	}
+	if err := header.Read(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("could not read page header: %s", err)
+	}
+	return &header, nil
+}
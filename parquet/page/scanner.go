@@ -2,17 +2,21 @@ package page
 
 import (
 	"bytes"
-	"compress/gzip"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
-	"log"
 	"strings"
 
-	"github.com/golang/snappy"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
+// ErrChecksum is the error of a page whose data does not match the CRC32
+// stored in its header.
+var ErrChecksum = errors.New("page: checksum mismatch")
+
 // Scanner scans through pages inside a single column chunk
 type Scanner interface {
 	Scan() bool
@@ -20,21 +24,61 @@ type Scanner interface {
 	DictionaryPage() (*DictionaryPage, bool)
 	IndexPage() (*IndexPage, bool)
 	Err() error
+
+	// VerifyChecksums sets whether the CRC32 of the pages that have one is
+	// verified, true by default.
+	VerifyChecksums(verify bool)
+
+	// UseZstdDictionary sets the zstd dictionary the pages were compressed
+	// with, see ZstdDictionary.
+	UseZstdDictionary(dict []byte) error
+
+	// UseDecryptor sets the decryptor of the page headers and the pages of
+	// an encrypted column chunk. dictionary is whether the first page of
+	// the chunk is a dictionary page, the AAD of its header depends on it.
+	UseDecryptor(d *encryption.ColumnDecryptor, dictionary bool)
+
+	// SkipDataPages sets the data pages that Scan skips without reading or
+	// decompressing them: skip is called with the number of each data page
+	// in the column chunk, from 0, the index of the page in the page index.
+	SkipDataPages(skip func(i int) bool)
+	// SkippedDataPages returns the numbers of the data pages skipped so far.
+	SkippedDataPages() []int
 }
 
 type scanner struct {
 	schema     *thrift.SchemaElement
+	maxRep     uint // maximum repetition level
+	maxDef     uint // maximum definition level
 	r          io.Reader
 	dictionary *DictionaryPage
 	dataPage   *DataPage
 	indexPage  *IndexPage
+	chunkDict  *DictionaryPage // dictionary of the column chunk
 	codec      thrift.CompressionCodec
+	skipCRC    bool
+	codecImpl  Codec // set to decompress with a zstd dictionary
 	err        error
 	totalRead  int
+
+	decryptor     *encryption.ColumnDecryptor // set for an encrypted chunk
+	hasDictionary bool                        // whether the first page is a dictionary page
+	numPages      int                         // number of encrypted page headers read
+
+	skip         func(i int) bool
+	skipped      []int
+	numDataPages int // number of data pages read or skipped
 }
 
 func NewScanner(schema *thrift.SchemaElement, codec thrift.CompressionCodec, r io.Reader) Scanner {
-	return &scanner{schema: schema, r: r, codec: codec}
+	maxRep, maxDef := topLevelMaxLevels(schema)
+	return NewNestedScanner(schema, maxRep, maxDef, codec, r)
+}
+
+// NewNestedScanner is like NewScanner for a column with the given maximum
+// repetition and definition levels, e.g. a column nested in groups.
+func NewNestedScanner(schema *thrift.SchemaElement, maxRepetitionLevel, maxDefinitionLevel uint, codec thrift.CompressionCodec, r io.Reader) Scanner {
+	return &scanner{schema: schema, maxRep: maxRepetitionLevel, maxDef: maxDefinitionLevel, r: r, codec: codec}
 }
 
 // Scan reads the next page inside the column chunk. returns false if no more data pages
@@ -52,26 +96,68 @@ func (s *scanner) Scan() bool {
 	s.dataPage = nil
 	s.indexPage = nil
 
-	err := header.Read(s.r)
-	if err != nil {
-		if strings.HasSuffix(err.Error(), "EOF") { // FIXME: find a better way to detect io.EOF
+	for {
+		err := s.readHeader(&header)
+		if err == io.EOF {
 			s.setErr(io.EOF)
 			return false
 		}
-		s.setErr(fmt.Errorf("column scanner: could not read chunk header: %s", err))
-		return false
+		if err != nil {
+			if strings.HasSuffix(err.Error(), "EOF") { // FIXME: find a better way to detect io.EOF
+				s.setErr(io.EOF)
+				return false
+			}
+			s.setErr(fmt.Errorf("column scanner: could not read chunk header: %s", err))
+			return false
+		}
+
+		skipped, err := s.skipDataPage(&header)
+		if err != nil {
+			s.setErr(err)
+			return false
+		}
+		if !skipped {
+			break
+		}
+		header = thrift.PageHeader{}
 	}
 
 	// setup reader
+	var err error
 	r := io.LimitReader(s.r, int64(header.CompressedPageSize))
-	r, err = s.compressionReader(r, &header)
-	if err != nil {
-		s.setErr(err)
-		return false
+	if header.IsSetCrc() && !s.skipCRC {
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			s.setErr(fmt.Errorf("column scanner: could not read page: %s", err))
+			return false
+		}
+		if int32(crc32.ChecksumIEEE(data)) != header.GetCrc() {
+			s.setErr(ErrChecksum)
+			return false
+		}
+		r = bytes.NewReader(data)
 	}
-
-	// read the page
-	if err := s.readPage(r, &header); err != nil {
+	if s.decryptor != nil {
+		r, err = s.decryptPage(r, &header)
+		if err != nil {
+			s.setErr(err)
+			return false
+		}
+	}
+	switch header.GetType() {
+	case thrift.PageType_DATA_PAGE_V2:
+		// only the values of a data page v2 can be compressed
+		r, err = s.readDataPageV2(r, &header)
+	case thrift.PageType_DICTIONARY_PAGE:
+		err = s.readDictionaryPage(r, &header)
+	default:
+		r, err = s.compressionReader(r, header.GetUncompressedPageSize())
+		if err == nil {
+			// read the page
+			err = s.readPage(r, &header)
+		}
+	}
+	if err != nil {
 		s.setErr(err)
 		return false
 	}
@@ -92,48 +178,101 @@ func (s *scanner) Scan() bool {
 	return true
 }
 
-// returns a reader for the right compression
-func (s *scanner) compressionReader(r io.Reader, header *thrift.PageHeader) (io.Reader, error) {
-	switch s.codec {
-	case thrift.CompressionCodec_GZIP:
-		r, err := gzip.NewReader(r)
-		if err != nil {
-			return nil, fmt.Errorf("could not create gzip reader:%s", err)
-		}
-		b, err := ioutil.ReadAll(r)
-		if err != nil {
-			return nil, fmt.Errorf("could not read gzip reader:%s", err)
-		}
-		if err := r.Close(); err != nil {
-			log.Println("WARNING error closing gzip reader:%s", err)
-		}
-		return bytes.NewReader(b), nil
+// readHeader reads the next page header, decrypting it in an encrypted
+// chunk. It returns io.EOF at the end of the chunk.
+func (s *scanner) readHeader(header *thrift.PageHeader) error {
+	if s.decryptor == nil {
+		return header.Read(s.r)
+	}
 
-	case thrift.CompressionCodec_LZO:
-		// https://github.com/rasky/go-lzo/blob/master/decompress.go#L149			s.r = r
-		return nil, fmt.Errorf("NYI")
+	t, ordinal := encryption.DataPageHeader, s.numDataPages
+	if s.hasDictionary && s.numPages == 0 {
+		t = encryption.DictionaryPageHeader
+	}
+	data, err := s.decryptor.ReadModule(s.r, t, ordinal)
+	if err != nil {
+		return err
+	}
+	s.numPages++
+	return header.Read(bytes.NewReader(data))
+}
 
-	case thrift.CompressionCodec_SNAPPY:
-		src, err := ioutil.ReadAll(r)
-		if err != nil {
-			return nil, fmt.Errorf("could not create gzip reader:%s", err)
-		}
+// decryptPage returns the reader of the plaintext of the encrypted page of
+// header read from r.
+func (s *scanner) decryptPage(r io.Reader, header *thrift.PageHeader) (io.Reader, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("column scanner: could not read page: %s", err)
+	}
+	t, ordinal := encryption.DataPage, s.numDataPages-1
+	if header.GetType() == thrift.PageType_DICTIONARY_PAGE {
+		t = encryption.DictionaryPage
+	}
+	data, err = s.decryptor.Decrypt(data, t, ordinal)
+	if err != nil {
+		return nil, fmt.Errorf("column scanner: %s", err)
+	}
+	return bytes.NewReader(data), nil
+}
 
-		out := make([]byte, int(header.GetUncompressedPageSize()))
-		out, err = snappy.Decode(out, src)
-		if err != nil {
-			return nil, fmt.Errorf("could not create gzip reader:%s", err)
-		}
+// skipDataPage discards the data of the page of header if it is a data page
+// skipped by SkipDataPages and reports whether it did.
+func (s *scanner) skipDataPage(header *thrift.PageHeader) (bool, error) {
+	if t := header.GetType(); t != thrift.PageType_DATA_PAGE && t != thrift.PageType_DATA_PAGE_V2 {
+		return false, nil
+	}
+	i := s.numDataPages
+	s.numDataPages++
+	if s.skip == nil || !s.skip(i) {
+		return false, nil
+	}
+
+	n := int64(header.GetCompressedPageSize())
+	if n < 0 {
+		return false, fmt.Errorf("column scanner: invalid compressed page size %d", n)
+	}
+	var err error
+	if seeker, ok := s.r.(io.Seeker); ok {
+		_, err = seeker.Seek(n, io.SeekCurrent)
+	} else {
+		_, err = io.CopyN(ioutil.Discard, s.r, n)
+	}
+	if err != nil {
+		return false, fmt.Errorf("column scanner: could not skip page: %s", err)
+	}
+	s.skipped = append(s.skipped, i)
+	return true, nil
+}
+
+func (s *scanner) SkipDataPages(skip func(i int) bool) {
+	s.skip = skip
+}
 
-		return bytes.NewReader(out), nil
+func (s *scanner) SkippedDataPages() []int {
+	return s.skipped
+}
 
-	case thrift.CompressionCodec_UNCOMPRESSED:
+// returns a reader for the right compression
+func (s *scanner) compressionReader(r io.Reader, uncompressedSize int32) (io.Reader, error) {
+	if s.codec == thrift.CompressionCodec_UNCOMPRESSED {
 		// use the same reader
 		return r, nil
+	}
 
-	default:
-		return nil, fmt.Errorf("unknown compression format %s", s.codec)
+	src, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s page: %s", s.codec, err)
+	}
+	var out []byte
+	if s.codecImpl != nil {
+		out, err = decodePage(s.codec, s.codecImpl, src, uncompressedSize)
+	} else {
+		out, err = decompress(s.codec, src, uncompressedSize)
+	}
+	if err != nil {
+		return nil, err
 	}
+	return bytes.NewReader(out), nil
 }
 
 func (s *scanner) readPage(r io.Reader, header *thrift.PageHeader) error {
@@ -150,15 +289,10 @@ func (s *scanner) readPage(r io.Reader, header *thrift.PageHeader) error {
 		return nil
 
 	case thrift.PageType_DICTIONARY_PAGE:
-		if !header.IsSetDictionaryPageHeader() {
-			return fmt.Errorf("bad file format:DictionaryPageHeader flag was not set")
-		}
-		dictHeader := header.GetDictionaryPageHeader()
-		s.dictionary = NewDictionaryPage(s.schema, dictHeader)
-		return s.dictionary.Decode(r)
+		return fmt.Errorf("dictionary pages are read by readDictionaryPage")
 
 	case thrift.PageType_DATA_PAGE_V2:
-		panic("nyi")
+		return fmt.Errorf("data pages v2 are read by readDataPageV2")
 
 	case thrift.PageType_DATA_PAGE:
 		s.totalRead += int(header.GetDataPageHeader().GetNumValues())
@@ -166,7 +300,8 @@ func (s *scanner) readPage(r io.Reader, header *thrift.PageHeader) error {
 		if !header.IsSetDataPageHeader() {
 			return fmt.Errorf("bad file format: DataPageHeader flag was not set")
 		}
-		s.dataPage = NewDataPage(s.schema, header.GetDataPageHeader())
+		s.dataPage = NewNestedDataPage(s.schema, s.maxRep, s.maxDef, header.GetDataPageHeader())
+		s.dataPage.dictionary = s.chunkDict
 		return s.dataPage.ReadAll(r)
 
 	default:
@@ -174,6 +309,78 @@ func (s *scanner) readPage(r io.Reader, header *thrift.PageHeader) error {
 	}
 }
 
+// readDictionaryPage reads the dictionary page of the column chunk from r.
+// The page is only decompressed and decoded when a data page maps its first
+// value.
+func (s *scanner) readDictionaryPage(r io.Reader, header *thrift.PageHeader) error {
+	if !header.IsSetDictionaryPageHeader() {
+		return fmt.Errorf("bad file format:DictionaryPageHeader flag was not set")
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("could not read dictionary page: %s", err)
+	}
+
+	size := header.GetUncompressedPageSize()
+	s.dictionary = newLazyDictionaryPage(s.schema, header.GetDictionaryPageHeader(), func() (io.Reader, error) {
+		return s.compressionReader(bytes.NewReader(data), size)
+	})
+	s.chunkDict = s.dictionary
+	return nil
+}
+
+// readDataPageV2 reads a data page v2 from r and returns the reader of the
+// values.
+func (s *scanner) readDataPageV2(r io.Reader, header *thrift.PageHeader) (io.Reader, error) {
+	if !header.IsSetDataPageHeaderV2() {
+		return nil, fmt.Errorf("bad file format: DataPageHeaderV2 flag was not set")
+	}
+	h := header.GetDataPageHeaderV2()
+
+	repLength, defLength := h.GetRepetitionLevelsByteLength(), h.GetDefinitionLevelsByteLength()
+	if repLength < 0 || defLength < 0 || int64(repLength)+int64(defLength) > int64(header.GetCompressedPageSize()) {
+		return nil, fmt.Errorf("bad file format: invalid levels length %d, %d", repLength, defLength)
+	}
+	levels := make([]byte, repLength+defLength)
+	if _, err := io.ReadFull(r, levels); err != nil {
+		return nil, fmt.Errorf("could not read levels: %s", err)
+	}
+
+	values := r
+	if h.GetIsCompressed() {
+		var err error
+		values, err = s.compressionReader(r, header.GetUncompressedPageSize()-repLength-defLength)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.totalRead += int(h.GetNumValues())
+	s.dataPage = NewDataPageV2(s.schema, s.maxRep, s.maxDef, h)
+	s.dataPage.dictionary = s.chunkDict
+	return values, s.dataPage.readAllV2(levels[:repLength], levels[repLength:], values)
+}
+
+func (s *scanner) VerifyChecksums(verify bool) {
+	s.skipCRC = !verify
+}
+
+func (s *scanner) UseZstdDictionary(dict []byte) error {
+	if s.codec != thrift.CompressionCodec_ZSTD {
+		return fmt.Errorf("zstd dictionary for %s pages", s.codec)
+	}
+	impl, err := newZstdDictionaryDecoder(dict)
+	if err != nil {
+		return err
+	}
+	s.codecImpl = impl
+	return nil
+}
+
+func (s *scanner) UseDecryptor(d *encryption.ColumnDecryptor, dictionary bool) {
+	s.decryptor, s.hasDictionary = d, dictionary
+}
+
 func (s *scanner) DataPage() (*DataPage, bool) {
 	return s.dataPage, s.dataPage != nil
 }
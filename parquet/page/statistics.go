@@ -0,0 +1,418 @@
+package page
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// SortOrder is the order of the values of a column used for its min and max
+// statistics, the TypeDefinedOrder of the column.
+type SortOrder int
+
+const (
+	// SortOrderSigned compares the integers as signed and the floating point
+	// values numerically. The byte arrays are compared as unsigned bytes,
+	// lexicographically.
+	SortOrderSigned SortOrder = iota
+	// SortOrderUnsigned compares the integers as unsigned.
+	SortOrderUnsigned
+	// SortOrderUndefined does not compare the values: no min and max
+	// statistics are written.
+	SortOrderUndefined
+	// SortOrderFloat16 compares the FIXED_LEN_BYTE_ARRAY values of 2 bytes
+	// as little-endian IEEE 754 half-precision numbers, like FLOAT.
+	SortOrderFloat16
+)
+
+// SortOrderOf returns the sort order of the column of schema.
+func SortOrderOf(schema *thrift.SchemaElement) SortOrder {
+	if schema.GetType() == thrift.Type_INT96 {
+		return SortOrderUndefined
+	}
+	if lt := schema.GetLogicalType(); lt != nil {
+		switch {
+		case lt.IsSetFLOAT16() && schema.GetTypeLength() == 2:
+			return SortOrderFloat16
+		case lt.IsSetINTEGER() && !lt.INTEGER.IsSigned:
+			return SortOrderUnsigned
+		case lt.IsSetGEOMETRY() || lt.IsSetGEOGRAPHY():
+			// see the GeospatialStatistics
+			return SortOrderUndefined
+		}
+	}
+	if !schema.IsSetConvertedType() {
+		return SortOrderSigned
+	}
+	switch schema.GetConvertedType() {
+	case thrift.ConvertedType_UINT_8, thrift.ConvertedType_UINT_16, thrift.ConvertedType_UINT_32, thrift.ConvertedType_UINT_64:
+		return SortOrderUnsigned
+	case thrift.ConvertedType_INTERVAL:
+		return SortOrderUndefined
+	case thrift.ConvertedType_DECIMAL:
+		// decimals stored in byte arrays are compared as signed big endian
+		// integers
+		if t := schema.GetType(); t == thrift.Type_BYTE_ARRAY || t == thrift.Type_FIXED_LEN_BYTE_ARRAY {
+			return SortOrderUndefined
+		}
+	}
+	return SortOrderSigned
+}
+
+// statistics accumulates the min and max of the values written to a page or
+// a column chunk.
+type statistics struct {
+	order    SortOrder
+	truncate int // maximum length of the min and max of byte arrays, 0 for none
+	typ      thrift.Type
+	// min and max are PLAIN encoded, without the length of a byte array.
+	// They are nil until a value is added.
+	min, max []byte
+}
+
+func newStatistics(preferences EncodingPreferences) statistics {
+	return statistics{order: preferences.SortOrder, truncate: preferences.StatisticsTruncateLength}
+}
+
+func (s *statistics) reset() {
+	s.min, s.max = nil, nil
+}
+
+// less returns whether the PLAIN encoded value a is before b.
+func (s *statistics) less(a, b []byte) bool {
+	switch s.typ {
+	case thrift.Type_BOOLEAN:
+		return a[0] < b[0]
+	case thrift.Type_INT32:
+		x, y := binary.LittleEndian.Uint32(a), binary.LittleEndian.Uint32(b)
+		if s.order == SortOrderUnsigned {
+			return x < y
+		}
+		return int32(x) < int32(y)
+	case thrift.Type_INT64:
+		x, y := binary.LittleEndian.Uint64(a), binary.LittleEndian.Uint64(b)
+		if s.order == SortOrderUnsigned {
+			return x < y
+		}
+		return int64(x) < int64(y)
+	case thrift.Type_FLOAT:
+		return math.Float32frombits(binary.LittleEndian.Uint32(a)) < math.Float32frombits(binary.LittleEndian.Uint32(b))
+	case thrift.Type_DOUBLE:
+		return math.Float64frombits(binary.LittleEndian.Uint64(a)) < math.Float64frombits(binary.LittleEndian.Uint64(b))
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		if s.order == SortOrderFloat16 {
+			return float16Key(a) < float16Key(b)
+		}
+		return bytes.Compare(a, b) < 0
+	default:
+		return bytes.Compare(a, b) < 0
+	}
+}
+
+// add adds the PLAIN encoded value v of type typ, without the length of a
+// byte array. v is kept and must not be modified. NaN is not added: it has
+// no order.
+func (s *statistics) add(typ thrift.Type, v []byte) {
+	if s.order == SortOrderUndefined {
+		return
+	}
+	s.typ = typ
+	switch typ {
+	case thrift.Type_FLOAT:
+		if math.IsNaN(float64(math.Float32frombits(binary.LittleEndian.Uint32(v)))) {
+			return
+		}
+	case thrift.Type_DOUBLE:
+		if math.IsNaN(math.Float64frombits(binary.LittleEndian.Uint64(v))) {
+			return
+		}
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		if s.order == SortOrderFloat16 && binary.LittleEndian.Uint16(v)&0x7fff > 0x7c00 {
+			return // NaN
+		}
+	}
+	if s.min == nil || s.less(v, s.min) {
+		s.min = v
+	}
+	if s.max == nil || s.less(s.max, v) {
+		s.max = v
+	}
+}
+
+func (s *statistics) addBool(values []bool) {
+	for _, v := range values {
+		if v {
+			s.add(thrift.Type_BOOLEAN, []byte{1})
+		} else {
+			s.add(thrift.Type_BOOLEAN, []byte{0})
+		}
+	}
+}
+
+func (s *statistics) addInt32(values []int32) {
+	if len(values) == 0 {
+		return
+	}
+	min, max := values[0], values[0]
+	for _, v := range values[1:] {
+		if s.order == SortOrderUnsigned {
+			if uint32(v) < uint32(min) {
+				min = v
+			}
+			if uint32(v) > uint32(max) {
+				max = v
+			}
+		} else {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+	for _, v := range []int32{min, max} {
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, uint32(v))
+		s.add(thrift.Type_INT32, b)
+	}
+}
+
+func (s *statistics) addInt64(values []int64) {
+	if len(values) == 0 {
+		return
+	}
+	min, max := values[0], values[0]
+	for _, v := range values[1:] {
+		if s.order == SortOrderUnsigned {
+			if uint64(v) < uint64(min) {
+				min = v
+			}
+			if uint64(v) > uint64(max) {
+				max = v
+			}
+		} else {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+	for _, v := range []int64{min, max} {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, uint64(v))
+		s.add(thrift.Type_INT64, b)
+	}
+}
+
+func (s *statistics) addFloat32(values []float32) {
+	var min, max float32
+	found := false
+	for _, v := range values {
+		switch {
+		case v != v: // NaN
+		case !found:
+			min, max, found = v, v, true
+		case v < min:
+			min = v
+		case v > max:
+			max = v
+		}
+	}
+	if !found {
+		return
+	}
+	for _, v := range []float32{min, max} {
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, math.Float32bits(v))
+		s.add(thrift.Type_FLOAT, b)
+	}
+}
+
+func (s *statistics) addFloat64(values []float64) {
+	var min, max float64
+	found := false
+	for _, v := range values {
+		switch {
+		case v != v: // NaN
+		case !found:
+			min, max, found = v, v, true
+		case v < min:
+			min = v
+		case v > max:
+			max = v
+		}
+	}
+	if !found {
+		return
+	}
+	for _, v := range []float64{min, max} {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, math.Float64bits(v))
+		s.add(thrift.Type_DOUBLE, b)
+	}
+}
+
+func (s *statistics) addByteArray(values [][]byte) {
+	s.addBytes(thrift.Type_BYTE_ARRAY, values)
+}
+
+func (s *statistics) addFixedByteArray(values [][]byte) {
+	s.addBytes(thrift.Type_FIXED_LEN_BYTE_ARRAY, values)
+}
+
+// addBytes adds the values of type typ, a byte array type, copied if they
+// are a new min or max.
+func (s *statistics) addBytes(typ thrift.Type, values [][]byte) {
+	for _, v := range values {
+		if s.order == SortOrderFloat16 {
+			if s.min == nil || s.less(v, s.min) || s.less(s.max, v) {
+				s.add(typ, append([]byte(nil), v...))
+			}
+			continue
+		}
+		if s.min == nil || bytes.Compare(v, s.min) < 0 || bytes.Compare(s.max, v) < 0 {
+			s.add(typ, append([]byte(nil), v...))
+		}
+	}
+}
+
+// float16Key returns an integer in the order of the half-precision number
+// of the 2 bytes b, -0 and +0 being equal.
+func float16Key(b []byte) int32 {
+	h := binary.LittleEndian.Uint16(b)
+	if h&0x8000 != 0 {
+		return -int32(h & 0x7fff)
+	}
+	return int32(h)
+}
+
+// thrift returns the statistics with nullCount nulls. The min and max are
+// also written to the deprecated Min and Max fields when their order is the
+// signed order these fields were written with.
+func (s *statistics) thrift(nullCount int64) *thrift.Statistics {
+	stats := thrift.NewStatistics()
+	stats.NullCount = &nullCount
+	if s.min == nil {
+		return stats
+	}
+
+	stats.MinValue, stats.MaxValue = s.min, s.max
+	switch s.typ {
+	case thrift.Type_BYTE_ARRAY:
+		stats.MinValue = truncateMin(s.min, s.truncate)
+		stats.MaxValue = truncateMax(s.max, s.truncate)
+	case thrift.Type_FLOAT:
+		// -0.0 and +0.0 are equal, readers expect the min to be -0.0 and
+		// the max +0.0
+		if math.Float32frombits(binary.LittleEndian.Uint32(s.min)) == 0 {
+			stats.MinValue = make([]byte, 4)
+			binary.LittleEndian.PutUint32(stats.MinValue, math.Float32bits(float32(math.Copysign(0, -1))))
+		}
+		if math.Float32frombits(binary.LittleEndian.Uint32(s.max)) == 0 {
+			stats.MaxValue = make([]byte, 4)
+		}
+	case thrift.Type_DOUBLE:
+		if math.Float64frombits(binary.LittleEndian.Uint64(s.min)) == 0 {
+			stats.MinValue = make([]byte, 8)
+			binary.LittleEndian.PutUint64(stats.MinValue, math.Float64bits(math.Copysign(0, -1)))
+		}
+		if math.Float64frombits(binary.LittleEndian.Uint64(s.max)) == 0 {
+			stats.MaxValue = make([]byte, 8)
+		}
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		if s.order == SortOrderFloat16 {
+			if float16Key(s.min) == 0 {
+				stats.MinValue = []byte{0x00, 0x80}
+			}
+			if float16Key(s.max) == 0 {
+				stats.MaxValue = []byte{0x00, 0x00}
+			}
+		}
+	}
+
+	if s.order == SortOrderSigned && s.typ != thrift.Type_BYTE_ARRAY && s.typ != thrift.Type_FIXED_LEN_BYTE_ARRAY {
+		stats.Min, stats.Max = stats.MinValue, stats.MaxValue
+	}
+	return stats
+}
+
+// truncateMin returns the prefix of n bytes of the byte array min, a lower
+// bound of min, or min if it is not longer or n is 0.
+func truncateMin(min []byte, n int) []byte {
+	if n <= 0 || len(min) <= n {
+		return min
+	}
+	return min[:n]
+}
+
+// truncateMax returns an upper bound of the byte array max of at most n
+// bytes: its prefix of n bytes with the last byte incremented, after
+// dropping the trailing 0xff bytes that cannot be. max is returned when it
+// is not longer than n, n is 0 or its prefix only has 0xff bytes.
+func truncateMax(max []byte, n int) []byte {
+	if n <= 0 || len(max) <= n {
+		return max
+	}
+	for i := n - 1; i >= 0; i-- {
+		if max[i] != 0xff {
+			truncated := append([]byte(nil), max[:i+1]...)
+			truncated[i]++
+			return truncated
+		}
+	}
+	return max
+}
+
+// sizeStatistics accumulates the SizeStatistics of a column chunk: the size
+// of its byte arrays, as if they were not encoded, and the histograms of
+// the levels of its data pages.
+type sizeStatistics struct {
+	byteArrays     bool // whether byte arrays were written
+	byteArrayBytes int64
+	repetition     []int64
+	definition     []int64
+}
+
+func (s *sizeStatistics) addByteArray(values [][]byte) {
+	s.byteArrays = true
+	for _, v := range values {
+		s.byteArrayBytes += int64(len(v))
+	}
+}
+
+// addPage adds the level histograms of page.
+func (s *sizeStatistics) addPage(page *dataPage) {
+	s.repetition = addHistogram(s.repetition, page.repetitionHistogram)
+	s.definition = addHistogram(s.definition, page.definitionHistogram)
+}
+
+func addHistogram(sum, histogram []int64) []int64 {
+	for len(sum) < len(histogram) {
+		sum = append(sum, 0)
+	}
+	for i, n := range histogram {
+		sum[i] += n
+	}
+	return sum
+}
+
+// thrift returns the statistics, nil if no byte arrays or levels were
+// written.
+func (s *sizeStatistics) thrift() *thrift.SizeStatistics {
+	if !s.byteArrays && s.repetition == nil && s.definition == nil {
+		return nil
+	}
+	stats := thrift.NewSizeStatistics()
+	if s.byteArrays {
+		n := s.byteArrayBytes
+		stats.UnencodedByteArrayDataBytes = &n
+	}
+	stats.RepetitionLevelHistogram = append([]int64(nil), s.repetition...)
+	stats.DefinitionLevelHistogram = append([]int64(nil), s.definition...)
+	return stats
+}
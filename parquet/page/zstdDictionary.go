@@ -0,0 +1,208 @@
+package page
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// ZstdDictionaryKey is the key of the zstd dictionary of a column chunk in
+// the key value metadata of the chunk, encoded with base64.
+//
+// Storing a dictionary is not part of the parquet format: the pages
+// compressed with a dictionary can only be read by readers that know the
+// key.
+const ZstdDictionaryKey = "parquet-go.zstd.dictionary"
+
+// minZstdHistory is the minimum size of the content of a zstd dictionary.
+const minZstdHistory = 8
+
+// compressedPage is a page that keeps its uncompressed data to be compressed
+// again once all the pages of the chunk are known.
+type compressedPage interface {
+	compress(c compressor) error
+	uncompressed() []byte
+}
+
+func (page *dataPage) uncompressed() []byte {
+	return page.payload
+}
+
+func (page *encodedDictionaryPage) uncompressed() []byte {
+	return page.payload
+}
+
+// trainsDictionary returns whether c trains a zstd dictionary on the pages
+// of a chunk. A zstd codec registered with RegisterCodec does not.
+func (c compressor) trainsDictionary() bool {
+	if c.codec != thrift.CompressionCodec_ZSTD || c.dictionarySize <= 0 {
+		return false
+	}
+	_, registered := registeredCodec(c.codec)
+	return !registered
+}
+
+// deferred returns the compressor of the pages as they are written: when c
+// trains a dictionary or is lazy they are compressed later by compressPages.
+func (c compressor) deferred() compressor {
+	if c.trainsDictionary() || c.lazy {
+		return compressor{}
+	}
+	return c
+}
+
+// compressPages trains a zstd dictionary on the uncompressed data of pages
+// and compresses them with it, if c trains a dictionary. It returns the
+// dictionary, nil if none can be trained on the data: the pages are then
+// compressed without dictionary. The pages of a lazy c are compressed
+// without dictionary.
+func compressPages(pages []Page, c compressor) ([]byte, error) {
+	if !c.trainsDictionary() {
+		if c.lazy {
+			return nil, compressAll(pages, c)
+		}
+		return nil, nil
+	}
+
+	samples := make([][]byte, 0, len(pages))
+	for _, p := range pages {
+		samples = append(samples, p.(compressedPage).uncompressed())
+	}
+	dict := buildZstdDictionary(samples, c.dictionarySize, c.level)
+	if dict != nil {
+		enc, err := newZstdEncoder(c.level, c.window, zstd.WithEncoderDict(dict))
+		if err != nil {
+			return nil, err
+		}
+		c = compressor{codec: c.codec, impl: zstdDictionaryCodec{enc: enc}}
+	}
+	return dict, compressAll(pages, c)
+}
+
+// compressAll compresses pages with c.
+func compressAll(pages []Page, c compressor) error {
+	for _, p := range pages {
+		if err := p.(compressedPage).compress(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildZstdDictionary builds a zstd dictionary of about size bytes whose
+// content is taken evenly from the samples, nil if the samples are too small
+// or no dictionary can be trained on them.
+func buildZstdDictionary(samples [][]byte, size int, level int) []byte {
+	total := 0
+	for _, s := range samples {
+		total += len(s)
+	}
+	if total < minZstdHistory || len(samples) < 2 {
+		return nil
+	}
+
+	// the end of each sample, zstd looks for matches in the most recent
+	// content first
+	var history []byte
+	for i, s := range samples {
+		n := (size - len(history)) / (len(samples) - i)
+		if n > len(s) {
+			n = len(s)
+		}
+		history = append(history, s[len(s)-n:]...)
+	}
+	if len(history) < minZstdHistory {
+		return nil
+	}
+
+	options := zstd.BuildDictOptions{
+		// the dictionary ids below 32768 are reserved
+		ID:       32768 + crc32.ChecksumIEEE(history)%(1<<31-32768),
+		Contents: samples,
+		History:  history,
+		Offsets:  [3]int{1, 4, 8},
+		Level:    zstd.SpeedDefault,
+	}
+	if level != 0 {
+		options.Level = zstd.EncoderLevelFromZstd(level)
+	}
+	return trainZstdDictionary(options)
+}
+
+// trainZstdDictionary returns the dictionary built with options, nil if
+// zstd.BuildDict fails. It fails on some samples, without enough matches,
+// e.g. the pages of a constant or of random values, and may panic on them.
+func trainZstdDictionary(options zstd.BuildDictOptions) (dict []byte) {
+	defer func() {
+		if recover() != nil {
+			dict = nil
+		}
+	}()
+	dict, err := zstd.BuildDict(options)
+	if err != nil {
+		return nil
+	}
+	return dict
+}
+
+// zstdDictionaryCodec compresses or decompresses pages with a zstd
+// dictionary.
+type zstdDictionaryCodec struct {
+	enc *zstd.Encoder
+	dec *zstd.Decoder
+}
+
+// newZstdDictionaryDecoder returns the Codec that decompresses the pages
+// compressed with dict.
+func newZstdDictionaryDecoder(dict []byte) (Codec, error) {
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dict), zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zstd dictionary: %s", err)
+	}
+	return zstdDictionaryCodec{dec: dec}, nil
+}
+
+func (c zstdDictionaryCodec) Encode(src []byte) ([]byte, error) {
+	if c.enc == nil {
+		return nil, fmt.Errorf("zstd dictionary codec used to compress")
+	}
+	return c.enc.EncodeAll(src, nil), nil
+}
+
+func (c zstdDictionaryCodec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	if c.dec == nil {
+		return nil, fmt.Errorf("zstd dictionary codec used to decompress")
+	}
+	return c.dec.DecodeAll(src, make([]byte, 0, pageSize(uncompressedSize)))
+}
+
+// SetZstdDictionary stores dict in the key value metadata of a column chunk.
+func SetZstdDictionary(meta *thrift.ColumnMetaData, dict []byte) {
+	value := base64.StdEncoding.EncodeToString(dict)
+	for _, kv := range meta.KeyValueMetadata {
+		if kv.Key == ZstdDictionaryKey {
+			kv.Value = &value
+			return
+		}
+	}
+	meta.KeyValueMetadata = append(meta.KeyValueMetadata, &thrift.KeyValue{Key: ZstdDictionaryKey, Value: &value})
+}
+
+// ZstdDictionary returns the zstd dictionary stored in the key value metadata
+// of a column chunk, nil if there is none.
+func ZstdDictionary(meta *thrift.ColumnMetaData) ([]byte, error) {
+	for _, kv := range meta.GetKeyValueMetadata() {
+		if kv.Key != ZstdDictionaryKey {
+			continue
+		}
+		dict, err := base64.StdEncoding.DecodeString(kv.GetValue())
+		if err != nil {
+			return nil, fmt.Errorf("invalid zstd dictionary: %s", err)
+		}
+		return dict, nil
+	}
+	return nil, nil
+}
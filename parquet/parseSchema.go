@@ -0,0 +1,384 @@
+package parquet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// ParseSchema returns the schema described by text in the textual format of
+// DisplayString and of parquet-mr, e.g. the output of parquet-tools schema:
+//
+//	message root {
+//	  required int64 id = 1;
+//	  optional binary name (STRING);
+//	  required fixed_len_byte_array(16) amount (DECIMAL(38,2));
+//	  optional group tags (LIST) {
+//	    repeated group list {
+//	      required binary element (UTF8);
+//	    }
+//	  }
+//	}
+//
+// The types are the physical types, binary being byte_array. The annotations
+// are the converted types, or the logical types of parquet-mr that have a
+// converted type: STRING and INTEGER(bits,signed). UUID, FLOAT16,
+// GEOMETRY(crs), GEOGRAPHY(crs,algorithm) and, for a group, VARIANT(version)
+// set the logical type, TIMESTAMP(unit,utc) and TIME(unit,utc) set the
+// logical type and the converted type of the units MILLIS and MICROS adjusted
+// to UTC.
+func ParseSchema(text string) (*Schema, error) {
+	p := &schemaParser{tokens: tokenizeSchema(text)}
+	elements, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("parse schema: %s", err)
+	}
+	s, err := schemaFromFileMetaData(&thrift.FileMetaData{Schema: elements})
+	if err != nil {
+		return nil, fmt.Errorf("parse schema: %s", err)
+	}
+	return s, nil
+}
+
+// schemaToken is a word or a punctuation of the text of a schema.
+type schemaToken struct {
+	text string
+	line int
+}
+
+// tokenizeSchema splits text into words, separated by spaces, and the
+// punctuations of the schemas.
+func tokenizeSchema(text string) []schemaToken {
+	var tokens []schemaToken
+	line, start := 1, -1
+	for i, r := range text {
+		if !unicode.IsSpace(r) && !strings.ContainsRune("{}();,=", r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, schemaToken{text[start:i], line})
+			start = -1
+		}
+		if r == '\n' {
+			line++
+		} else if !unicode.IsSpace(r) {
+			tokens = append(tokens, schemaToken{string(r), line})
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, schemaToken{text[start:], line})
+	}
+	return tokens
+}
+
+// schemaParser parses the tokens of a schema into its elements, depth
+// first.
+type schemaParser struct {
+	tokens   []schemaToken
+	elements []*thrift.SchemaElement
+}
+
+// next returns the next token, "" after the last one.
+func (p *schemaParser) next() string {
+	t := p.peek()
+	if len(p.tokens) > 0 {
+		p.tokens = p.tokens[1:]
+	}
+	return t
+}
+
+// peek returns the next token without consuming it.
+func (p *schemaParser) peek() string {
+	if len(p.tokens) == 0 {
+		return ""
+	}
+	return p.tokens[0].text
+}
+
+// errorf returns an error at the line of the next token.
+func (p *schemaParser) errorf(format string, args ...interface{}) error {
+	if len(p.tokens) == 0 {
+		return fmt.Errorf("end of schema: "+format, args...)
+	}
+	return fmt.Errorf("line %d: "+format, append([]interface{}{p.tokens[0].line}, args...)...)
+}
+
+// expect consumes the token want.
+func (p *schemaParser) expect(want string) error {
+	if got := p.peek(); got != want {
+		return p.errorf("expected %q, got %q", want, got)
+	}
+	p.next()
+	return nil
+}
+
+// parse parses the message of the schema.
+func (p *schemaParser) parse() ([]*thrift.SchemaElement, error) {
+	if err := p.expect("message"); err != nil {
+		return nil, err
+	}
+	root := &thrift.SchemaElement{}
+	if t := p.peek(); t != "{" && t != "(" {
+		root.Name = p.next()
+	}
+	if err := p.parseAnnotation(root); err != nil {
+		return nil, err
+	}
+	if err := p.parseGroup(root); err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != "" {
+		return nil, p.errorf("unexpected %q after the message", t)
+	}
+	return p.elements, nil
+}
+
+// parseGroup parses the fields of the group element, between braces.
+func (p *schemaParser) parseGroup(element *thrift.SchemaElement) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	p.elements = append(p.elements, element)
+	var numChildren int32
+	for p.peek() != "}" {
+		if p.peek() == "" {
+			return p.errorf("expected %q", "}")
+		}
+		if err := p.parseField(); err != nil {
+			return err
+		}
+		numChildren++
+	}
+	p.next()
+	element.NumChildren = &numChildren
+	return nil
+}
+
+// parseField parses a group or a primitive field.
+func (p *schemaParser) parseField() error {
+	t := p.next()
+	repetition, err := thrift.FieldRepetitionTypeFromString(strings.ToUpper(t))
+	if err != nil {
+		return p.errorf("invalid repetition %q", t)
+	}
+	element := &thrift.SchemaElement{RepetitionType: &repetition}
+
+	t = p.next()
+	if t != "group" {
+		if strings.ToLower(t) == "binary" {
+			t = "byte_array"
+		}
+		typ, err := thrift.TypeFromString(strings.ToUpper(t))
+		if err != nil {
+			return p.errorf("invalid type %q", t)
+		}
+		element.Type = &typ
+		if typ == thrift.Type_FIXED_LEN_BYTE_ARRAY {
+			args, err := p.parseArguments()
+			if err != nil {
+				return err
+			}
+			if len(args) != 1 {
+				return p.errorf("expected the length of %s", t)
+			}
+			length, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil || length <= 0 {
+				return p.errorf("invalid length %q", args[0])
+			}
+			typeLength := int32(length)
+			element.TypeLength = &typeLength
+		}
+	}
+
+	if element.Name = p.next(); element.Name == "" || strings.ContainsAny(element.Name, "{}();,=") {
+		return p.errorf("invalid name %q", element.Name)
+	}
+	if err := p.parseAnnotation(element); err != nil {
+		return err
+	}
+	if p.peek() == "=" {
+		p.next()
+		id, err := strconv.ParseInt(p.peek(), 10, 32)
+		if err != nil {
+			return p.errorf("invalid field id %q", p.peek())
+		}
+		p.next()
+		fieldID := int32(id)
+		element.FieldID = &fieldID
+	}
+
+	if element.Type == nil {
+		return p.parseGroup(element)
+	}
+	p.elements = append(p.elements, element)
+	return p.expect(";")
+}
+
+// parseArguments parses the arguments between parentheses, if any.
+func (p *schemaParser) parseArguments() ([]string, error) {
+	if p.peek() != "(" {
+		return nil, nil
+	}
+	p.next()
+	var args []string
+	for {
+		t := p.next()
+		if t == "" || strings.ContainsAny(t, "{}();,=") {
+			return nil, p.errorf("invalid argument %q", t)
+		}
+		args = append(args, t)
+		switch t := p.next(); t {
+		case ")":
+			return args, nil
+		case ",":
+		default:
+			return nil, p.errorf("expected %q, got %q", ")", t)
+		}
+	}
+}
+
+// parseAnnotation parses the annotation of element between parentheses, if
+// any, and sets its converted type.
+func (p *schemaParser) parseAnnotation(element *thrift.SchemaElement) error {
+	if p.peek() != "(" {
+		return nil
+	}
+	p.next()
+	name := p.next()
+	args, err := p.parseArguments()
+	if err != nil {
+		return err
+	}
+	if err := setConvertedType(element, strings.ToUpper(name), args); err != nil {
+		return p.errorf("annotation %s: %s", name, err)
+	}
+	return p.expect(")")
+}
+
+// setConvertedType sets the converted type, or the logical type, of element
+// for the annotation name with the arguments args.
+func setConvertedType(element *thrift.SchemaElement, name string, args []string) error {
+	var converted string
+	switch name {
+	case "DECIMAL":
+		if len(args) != 2 {
+			return fmt.Errorf("expected the precision and the scale")
+		}
+		precision, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid precision %q", args[0])
+		}
+		scale, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid scale %q", args[1])
+		}
+		p, s := int32(precision), int32(scale)
+		element.Precision, element.Scale = &p, &s
+		converted = name
+	case "STRING":
+		converted = "UTF8"
+	case "UUID", "FLOAT16":
+		if len(args) > 0 {
+			return fmt.Errorf("unexpected arguments")
+		}
+		if name == "UUID" {
+			element.LogicalType = &thrift.LogicalType{UUID: &thrift.UUIDType{}}
+		} else {
+			element.LogicalType = &thrift.LogicalType{FLOAT16: &thrift.Float16Type{}}
+		}
+		return nil
+	case "VARIANT":
+		if len(args) > 1 {
+			return fmt.Errorf("expected the specification version")
+		}
+		variant := &thrift.VariantType{}
+		if len(args) == 1 {
+			version, err := strconv.ParseInt(args[0], 10, 8)
+			if err != nil {
+				return fmt.Errorf("invalid specification version %q", args[0])
+			}
+			v := int8(version)
+			variant.SpecificationVersion = &v
+		}
+		element.LogicalType = &thrift.LogicalType{VARIANT: variant}
+		return nil
+	case "GEOMETRY":
+		if len(args) > 1 {
+			return fmt.Errorf("expected the CRS")
+		}
+		geometry := &thrift.GeometryType{}
+		if len(args) == 1 {
+			geometry.Crs = &args[0]
+		}
+		element.LogicalType = &thrift.LogicalType{GEOMETRY: geometry}
+		return nil
+	case "GEOGRAPHY":
+		if len(args) > 2 {
+			return fmt.Errorf("expected the CRS and the edge interpolation algorithm")
+		}
+		geography := &thrift.GeographyType{}
+		if len(args) > 0 {
+			geography.Crs = &args[0]
+		}
+		if len(args) == 2 {
+			algorithm, err := thrift.EdgeInterpolationAlgorithmFromString(strings.ToUpper(args[1]))
+			if err != nil {
+				return fmt.Errorf("invalid edge interpolation algorithm %q", args[1])
+			}
+			geography.Algorithm = &algorithm
+		}
+		element.LogicalType = &thrift.LogicalType{GEOGRAPHY: geography}
+		return nil
+	case "INTEGER":
+		if len(args) != 2 || (args[1] != "true" && args[1] != "false") {
+			return fmt.Errorf("expected the bit width and the signedness")
+		}
+		converted = "INT_" + args[0]
+		if args[1] == "false" {
+			converted = "U" + converted
+		}
+	case "TIMESTAMP", "TIME":
+		if len(args) != 2 || (args[1] != "true" && args[1] != "false") {
+			return fmt.Errorf("expected the unit and utc")
+		}
+		unit := &thrift.TimeUnit{}
+		switch args[0] {
+		case "MILLIS":
+			unit.MILLIS = &thrift.MilliSeconds{}
+		case "MICROS":
+			unit.MICROS = &thrift.MicroSeconds{}
+		case "NANOS":
+			unit.NANOS = &thrift.NanoSeconds{}
+		default:
+			return fmt.Errorf("invalid unit %q", args[0])
+		}
+		utc := args[1] == "true"
+		if name == "TIME" {
+			element.LogicalType = &thrift.LogicalType{TIME: &thrift.TimeType{IsAdjustedToUTC: utc, Unit: unit}}
+		} else {
+			element.LogicalType = &thrift.LogicalType{TIMESTAMP: &thrift.TimestampType{IsAdjustedToUTC: utc, Unit: unit}}
+		}
+		if args[0] == "NANOS" || !utc {
+			// no converted type
+			return nil
+		}
+		converted = name + "_" + args[0]
+	default:
+		if len(args) > 0 {
+			return fmt.Errorf("unexpected arguments")
+		}
+		converted = name
+	}
+	ct, err := thrift.ConvertedTypeFromString(converted)
+	if err != nil {
+		return fmt.Errorf("no converted type %s", converted)
+	}
+	element.ConvertedType = &ct
+	return nil
+}
@@ -0,0 +1,95 @@
+package parquet
+
+import (
+	"testing"
+)
+
+func TestParseSchema(t *testing.T) {
+	// the output of parquet-tools schema
+	text := `message spark_schema {
+  required int64 id = 1;
+  optional binary name (STRING);
+  optional BINARY nickname (UTF8);
+  required int32 age (INTEGER(8,false));
+  required int64 created (TIMESTAMP(MICROS,true));
+  optional fixed_len_byte_array(16) amount (DECIMAL(38,2)) = 7;
+  optional int96 legacy;
+  optional group tags (LIST) = 2 {
+    repeated group list {
+      required binary element (UTF8);
+    }
+  }
+}
+`
+	s, err := ParseSchema(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `message spark_schema {
+  required int64 id = 1;
+  optional byte_array name (UTF8);
+  optional byte_array nickname (UTF8);
+  required int32 age (UINT_8);
+  required int64 created (TIMESTAMP_MICROS);
+  optional fixed_len_byte_array(16) amount (DECIMAL(38,2)) = 7;
+  optional int96 legacy;
+  optional group tags (LIST) = 2 {
+    repeated group list {
+      required byte_array element (UTF8);
+    }
+  }
+}`
+	if got := s.DisplayString(); got != want {
+		t.Errorf("DisplayString: got\n%s\nwant\n%s", got, want)
+	}
+	if col := s.ColumnByName("tags.list.element"); col == nil || col.MaxLevels != (Levels{D: 2, R: 1}) {
+		t.Errorf("got column %+v", col)
+	}
+
+	// the schemas written by DisplayString are parsed back
+	for _, v := range []interface{}{schemaOfRecord{}, schemaOfTagged{}} {
+		s, err := SchemaOf(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := ParseSchema(s.DisplayString())
+		if err != nil {
+			t.Fatalf("%T: %s", v, err)
+		}
+		if got, want := parsed.DisplayString(), s.DisplayString(); got != want {
+			t.Errorf("%T: got\n%s\nwant\n%s", v, got, want)
+		}
+	}
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"schema { required int32 a; }",
+		"message {}",
+		"message m { required int32 a; ",
+		"message m { required int32 a }",
+		"message m { required int32 a; } }",
+		"message m { sometimes int32 a; }",
+		"message m { required int33 a; }",
+		"message m { required int32; }",
+		"message m { required fixed_len_byte_array a; }",
+		"message m { required fixed_len_byte_array(0) a; }",
+		"message m { required int32 a (UNKNOWN); }",
+		"message m { required int32 a (UTF8(1)); }",
+		"message m { required int32 a (DECIMAL(9)); }",
+		"message m { required int32 a (INTEGER(8,maybe)); }",
+		"message m { required int64 a (TIMESTAMP(SECONDS,true)); }",
+		"message m { required int64 a (TIMESTAMP(NANOS)); }",
+		"message m { required int64 a (TIME(NANOS)); }",
+		"message m { required int32 a = b; }",
+		"message m { required group g { } }",
+		"message m { required group g; }",
+	} {
+		if _, err := ParseSchema(text); err == nil {
+			t.Errorf("no error for %q", text)
+		} else {
+			t.Log(err)
+		}
+	}
+}
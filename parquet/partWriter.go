@@ -0,0 +1,91 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errPartWriterClosed = errors.New("part writer closed")
+
+// PartWriter is an io.WriteCloser that cuts the bytes written into parts of
+// a fixed size, passed to an upload function as soon as they are complete,
+// e.g. to upload a file with the S3 multipart upload API without storing
+// it: only the current part is buffered. All the parts have the size of
+// the PartWriter but the last one, uploaded by Close, which is smaller.
+type PartWriter struct {
+	upload func(number int, part []byte) error
+	part   []byte
+	number int // number of the current part, from 1
+	err    error
+}
+
+// NewPartWriter returns a PartWriter of parts of size bytes. upload is
+// called with the number of each part, from 1, and its bytes, which are
+// reused once upload returns. An error of upload is returned by the Write
+// or Close call that completed the part, and by the following calls.
+func NewPartWriter(size int, upload func(number int, part []byte) error) (*PartWriter, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("invalid part size %d", size)
+	}
+	return &PartWriter{upload: upload, part: make([]byte, 0, size), number: 1}, nil
+}
+
+// Write implements the io.Writer interface.
+func (w *PartWriter) Write(p []byte) (int, error) {
+	n := 0
+	for w.err == nil && len(p) > 0 {
+		k := cap(w.part) - len(w.part)
+		if k > len(p) {
+			k = len(p)
+		}
+		w.part = append(w.part, p[:k]...)
+		p = p[k:]
+		n += k
+		if len(w.part) == cap(w.part) {
+			w.flush()
+		}
+	}
+	return n, w.err
+}
+
+// flush uploads the current part.
+func (w *PartWriter) flush() {
+	if err := w.upload(w.number, w.part); err != nil {
+		w.err = fmt.Errorf("could not upload part %d: %s", w.number, err)
+		return
+	}
+	w.number++
+	w.part = w.part[:0]
+}
+
+// Close uploads the last part, if it is not empty or if no part was
+// uploaded.
+func (w *PartWriter) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(w.part) > 0 || w.number == 1 {
+		w.flush()
+	}
+	if w.err == nil {
+		w.err = errPartWriterClosed
+		return nil
+	}
+	return w.err
+}
+
+// NewMultipartWriter returns a Writer of a file written in parts of
+// partSize bytes to upload, as with NewPartWriter. Close uploads the last
+// part, unless the Writer failed: the upload should then be aborted.
+func NewMultipartWriter(partSize int, upload func(number int, part []byte) error, schema *Schema, options WriterOptions) (*Writer, error) {
+	parts, err := NewPartWriter(partSize, upload)
+	if err != nil {
+		return nil, fmt.Errorf("writer: %s", err)
+	}
+	w, err := NewWriter(parts, schema, options)
+	if err != nil {
+		return nil, err
+	}
+	w.parts = parts
+	return w, nil
+}
@@ -0,0 +1,93 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPartWriter(t *testing.T) {
+	var parts [][]byte
+	var numbers []int
+	upload := func(number int, part []byte) error {
+		numbers = append(numbers, number)
+		parts = append(parts, append([]byte(nil), part...))
+		return nil
+	}
+	w, err := NewPartWriter(4, upload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"ab", "cdefghij", "", "k"} {
+		if n, err := w.Write([]byte(p)); n != len(p) || err != nil {
+			t.Fatalf("wrote %d bytes of %q: %v", n, p, err)
+		}
+	}
+	if len(parts) != 2 {
+		t.Errorf("got %d parts before Close, want 2", len(parts))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := [][]byte{[]byte("abcd"), []byte("efgh"), []byte("ijk")}
+	if !reflect.DeepEqual(parts, want) || !reflect.DeepEqual(numbers, []int{1, 2, 3}) {
+		t.Errorf("got parts %q %v, want %q", parts, numbers, want)
+	}
+	if _, err := w.Write([]byte("l")); err == nil {
+		t.Errorf("no error after Close")
+	}
+
+	failure := errors.New("failure")
+	w, err = NewPartWriter(4, func(number int, part []byte) error {
+		return failure
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := w.Write([]byte("abcdef")); n != 4 || err == nil {
+		t.Errorf("wrote %d bytes: %v", n, err)
+	}
+	if err := w.Close(); err == nil {
+		t.Errorf("no error on Close after a failed upload")
+	}
+
+	if _, err := NewPartWriter(0, upload); err == nil {
+		t.Errorf("no error for parts of 0 bytes")
+	}
+}
+
+func TestMultipartWriter(t *testing.T) {
+	var file bytes.Buffer
+	var sizes []int
+	upload := func(number int, part []byte) error {
+		file.Write(part)
+		sizes = append(sizes, len(part))
+		return nil
+	}
+	w, err := NewMultipartWriter(1024, upload, writerTestSchema(t), WriterOptions{RowGroupRows: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := writerTestRows(500)
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	for i, size := range sizes[:len(sizes)-1] {
+		if size != 1024 {
+			t.Errorf("part %d has %d bytes", i+1, size)
+		}
+	}
+	f, err := OpenFile(bytes.NewReader(file.Bytes()), int64(file.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readTestRows(t, f); len(got) != len(rows) {
+		t.Errorf("got %d rows, want %d", len(got), len(rows))
+	}
+}
@@ -0,0 +1,372 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// RewriteEncryption copies the parquet file r of size bytes to w with
+// another encryption, e.g. to rotate its keys. r is decrypted with
+// decryption, nil if it is not encrypted, and w is encrypted with
+// reencryption, nil to write it in plaintext. The pages are copied without
+// being decoded, only their modules are decrypted and encrypted again, as
+// are the page indexes and the Bloom filters. All the columns of r must be
+// decrypted.
+func RewriteEncryption(w io.Writer, r io.ReaderAt, size int64, decryption *encryption.DecryptionProperties, reencryption *encryption.FileEncryptionProperties) error {
+	f, err := OpenFileWithOptions(r, size, ReaderOptions{Decryption: decryption})
+	if err != nil {
+		return err
+	}
+	var e *encryption.FileEncryptor
+	magic := parquetMagic
+	if reencryption != nil {
+		for _, path := range reencryption.EncryptedColumns() {
+			if f.schema.ColumnByName(path) == nil {
+				return fmt.Errorf("rewrite: encrypted column %s is not in the schema", path)
+			}
+		}
+		if e, err = encryption.NewFileEncryptor(reencryption); err != nil {
+			return fmt.Errorf("rewrite: %s", err)
+		}
+		if e.FooterEncrypted() {
+			magic = encryptedMagic
+		}
+	}
+
+	cw := thrift.NewCountingWriter(w)
+	if err := writeMagic(cw, magic); err != nil {
+		return err
+	}
+
+	meta := *f.meta
+	meta.EncryptionAlgorithm, meta.FooterSigningKeyMetadata = nil, nil
+	meta.RowGroups = make([]*thrift.RowGroup, len(f.meta.RowGroups))
+	filters := make([][]*bloom.Filter, len(meta.RowGroups))
+	indexes := make([][]pageIndex, len(meta.RowGroups))
+	for i, rg := range f.RowGroups() {
+		rowGroup := *rg.meta
+		rowGroup.Columns = make([]*thrift.ColumnChunk, len(rg.meta.Columns))
+		filters[i] = make([]*bloom.Filter, len(rowGroup.Columns))
+		indexes[i] = make([]pageIndex, len(rowGroup.Columns))
+		for j, cc := range rg.ColumnChunks() {
+			chunk, err := rewriteColumnChunk(cw, cc, e)
+			if err != nil {
+				return fmt.Errorf("rewrite: column %s of row group %d: %s", cc.Name(), i, err)
+			}
+			rowGroup.Columns[j] = chunk.meta
+			filters[i][j], indexes[i][j] = chunk.filter, chunk.index
+		}
+		meta.RowGroups[i] = &rowGroup
+	}
+
+	if _, err := writeBloomFilters(cw, cw.N, meta.RowGroups, filters, e); err != nil {
+		return fmt.Errorf("rewrite: %s", err)
+	}
+	if _, err := writePageIndexes(cw, cw.N, meta.RowGroups, indexes, e); err != nil {
+		return fmt.Errorf("rewrite: %s", err)
+	}
+	if e != nil {
+		return writeEncryptedFileMetadata(cw, &meta, e)
+	}
+	return writeFileMetadata(cw, &meta)
+}
+
+// rewrittenColumnChunk is a column chunk copied by RewriteEncryption or
+// MergeFiles, with its Bloom filter and its page index to write after the
+// row groups.
+type rewrittenColumnChunk struct {
+	meta   *thrift.ColumnChunk
+	filter *bloom.Filter
+	index  pageIndex
+}
+
+// rewriteColumnChunk copies the pages of cc to w, encrypted with e unless
+// e is nil, and returns the chunk copied.
+func rewriteColumnChunk(w *thrift.CountingWriter, cc *ColumnChunk, e *encryption.FileEncryptor) (*rewrittenColumnChunk, error) {
+	if cc.meta.IsSetFilePath() {
+		return nil, fmt.Errorf("column chunk stored in file %s", cc.meta.GetFilePath())
+	}
+	d, err := cc.decryptor()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := *cc.Metadata()
+	meta.IndexPageOffset, meta.BloomFilterOffset, meta.BloomFilterLength = nil, nil, nil
+	offset := w.N
+	chunk := &rewrittenColumnChunk{meta: &thrift.ColumnChunk{FileOffset: offset, MetaData: &meta}}
+	ce, err := columnEncryptor(e, chunk.meta, cc.rowGroup.index, cc.index)
+	if err != nil {
+		return nil, err
+	}
+
+	f := cc.rowGroup.file
+	dictionary := meta.GetDictionaryPageOffset() > 0
+	r := io.NewSectionReader(f.r, cc.pagesOffset(), meta.GetTotalCompressedSize())
+	n, offsetIndex, err := page.ReencryptPages(w, offset, r, meta.GetTotalCompressedSize(), dictionary, d, ce)
+	if err != nil {
+		return nil, err
+	}
+	// the page headers are part of the compressed and uncompressed sizes
+	meta.TotalUncompressedSize += n - meta.TotalCompressedSize
+	meta.TotalCompressedSize = n
+	if dictionary {
+		meta.DictionaryPageOffset = &offset
+	}
+	if locations := offsetIndex.GetPageLocations(); len(locations) > 0 {
+		meta.DataPageOffset = locations[0].Offset
+	}
+
+	old, err := cc.OffsetIndex()
+	if err != nil {
+		return nil, err
+	}
+	if old != nil {
+		if len(old.GetPageLocations()) != len(offsetIndex.GetPageLocations()) {
+			return nil, fmt.Errorf("%d pages in the offset index, %d in the chunk", len(old.GetPageLocations()), len(offsetIndex.GetPageLocations()))
+		}
+		for k, l := range offsetIndex.PageLocations {
+			l.FirstRowIndex = old.PageLocations[k].FirstRowIndex
+		}
+		chunk.index.offsetIndex = offsetIndex
+	}
+	if chunk.index.columnIndex, err = cc.ColumnIndex(); err != nil {
+		return nil, err
+	}
+	if chunk.filter, err = cc.BloomFilter(); err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+// RewriteFile writes the rows of f to w with a Writer of options, e.g. to
+// convert a file to another codec, other encodings or data pages of another
+// version, or to change the size of its row groups and pages. Unlike
+// RewriteEncryption the pages are decoded and encoded again, one row group
+// at a time. The file keeps the key/value metadata of f, updated with
+// options.KeyValueMetadata.
+func RewriteFile(w io.Writer, f *File, options WriterOptions) error {
+	options.KeyValueMetadata = updateKeyValues(f.KeyValueMetadata(), options.KeyValueMetadata)
+	writer, err := NewWriter(w, f.Schema(), options)
+	if err != nil {
+		return err
+	}
+	for i, rg := range f.RowGroups() {
+		scanner := rg.Rows()
+		for scanner.Scan() {
+			if err := writer.WriteRow(scanner.Row()); err != nil {
+				return err
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("rewrite: row group %d: %s", i, err)
+		}
+	}
+	return writer.Close()
+}
+
+// updateKeyValues returns the key/value metadata keyValues, nil if there is
+// none, with the values of update.
+func updateKeyValues(keyValues, update map[string]string) map[string]string {
+	if keyValues == nil {
+		keyValues = make(map[string]string)
+	}
+	for k, v := range update {
+		keyValues[k] = v
+	}
+	return keyValues
+}
+
+// AddedColumn is a column added by ProjectFile, with the same value in all
+// the rows.
+type AddedColumn struct {
+	Spec  string      // the column, as in Schema.AddColumnFromSpec
+	Value interface{} // the value of the rows, nil for nulls, see WriteRow
+}
+
+// ProjectFile writes to w the rows of f without the columns drop, followed
+// by the columns add. The column chunks of the other columns are copied
+// without decoding their pages, as by MergeFiles: only the chunks of the
+// columns added are written, with options. The file has the row groups of
+// f, sorted by their sorting columns up to the first column dropped, and
+// keeps the created_by and the key/value metadata of f, updated with
+// options.KeyValueMetadata. Only flat schemas are supported, without groups,
+// and the encrypted files are not.
+func ProjectFile(w io.Writer, f *File, drop []string, add []AddedColumn, options WriterOptions) error {
+	if f.Encrypted() || options.Encryption != nil {
+		return fmt.Errorf("project: encrypted files are not supported")
+	}
+	for _, name := range f.schema.Columns() {
+		if strings.Contains(name, ".") {
+			return fmt.Errorf("project: column %s: the nested columns are not supported", name)
+		}
+	}
+	dropped := make(map[string]bool)
+	for _, name := range drop {
+		if f.schema.ColumnByName(name) == nil {
+			return fmt.Errorf("project: column %s is not in the schema", name)
+		}
+		dropped[name] = true
+	}
+	schema := NewSchema()
+	for _, name := range f.schema.Columns() {
+		if !dropped[name] {
+			schema.addColumn(f.schema.ColumnByName(name).SchemaElement)
+		}
+	}
+	kept := len(schema.Columns())
+	for _, c := range add {
+		n := len(schema.Columns())
+		if err := schema.AddColumnFromSpec(c.Spec); err != nil {
+			return fmt.Errorf("project: %s", err)
+		}
+		if len(schema.Columns()) == n {
+			return fmt.Errorf("project: the column of %q is already in the schema", c.Spec)
+		}
+	}
+
+	options.KeyValueMetadata = updateKeyValues(f.KeyValueMetadata(), options.KeyValueMetadata)
+	options.SortingColumns = nil
+	writer, err := NewWriter(w, schema, options)
+	if err != nil {
+		return err
+	}
+	writer.meta.CreatedBy = f.meta.CreatedBy
+	for k, c := range add {
+		if err := writer.columns[kept+k].check(c.Value); err != nil {
+			return fmt.Errorf("project: %s", err)
+		}
+	}
+
+	err = copyRowGroups(writer, schema, f, dropped, func(rg *RowGroup, j int) (columnValues, error) {
+		if j < kept {
+			return nil, nil
+		}
+		value := add[j-kept].Value
+		return func(int64) (interface{}, error) { return value, nil }, nil
+	})
+	if err != nil {
+		return fmt.Errorf("project: %s", err)
+	}
+	return writer.Close()
+}
+
+// copyRowGroups writes the row groups of f to writer, of the given schema,
+// sorted by their sorting columns up to the first column of changed. The
+// column j of a row group has the values returned by values, or is copied
+// from the chunk of the column of the same name if they are nil.
+func copyRowGroups(writer *Writer, schema *Schema, f *File, changed map[string]bool, values func(rg *RowGroup, j int) (columnValues, error)) error {
+	for i, rg := range f.RowGroups() {
+		chunks := make([]*ColumnChunk, len(writer.columns))
+		rowValues := make([]columnValues, len(writer.columns))
+		for j, c := range writer.columns {
+			var err error
+			if rowValues[j], err = values(rg, j); err != nil {
+				return fmt.Errorf("column %s of row group %d: %s", c.name, i, err)
+			}
+			if rowValues[j] != nil {
+				continue
+			}
+			if chunks[j] = rg.ColumnChunk(c.name); chunks[j] == nil {
+				return fmt.Errorf("no column chunk of column %s in row group %d", c.name, i)
+			}
+		}
+		columns, err := rg.SortingColumns()
+		if err != nil {
+			return fmt.Errorf("row group %d: %s", i, err)
+		}
+		for k, c := range columns {
+			if changed[c.Column] {
+				columns = columns[:k]
+				break
+			}
+		}
+		sorting, err := sortingColumns(schema, columns)
+		if err != nil {
+			return fmt.Errorf("row group %d: %s", i, err)
+		}
+		if err := writer.copyRowGroup(rg.NumRows(), chunks, rowValues, sorting); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Transform returns the value replacing the value v of a column, e.g. a hash
+// of v, nil for null. v has the type of the values of RowScanner.Row.
+type Transform func(v interface{}) (interface{}, error)
+
+// TransformColumns writes to w the rows of f with the values of the columns
+// of transforms replaced by their transform, e.g. to hash, redact or
+// tokenize personal data. Only the chunks of these columns are decoded and
+// encoded again, with options, and get new statistics, page indexes and
+// Bloom filters: the chunks of the other columns are copied without
+// decoding their pages, as by MergeFiles. The file has the row groups of f,
+// sorted by their sorting columns up to the first column transformed, and
+// keeps the created_by and the key/value metadata of f, updated with
+// options.KeyValueMetadata. The columns transformed cannot be nested, in a
+// group or repeated, and the encrypted files are not supported.
+func TransformColumns(w io.Writer, f *File, transforms map[string]Transform, options WriterOptions) error {
+	if f.Encrypted() || options.Encryption != nil {
+		return fmt.Errorf("transform: encrypted files are not supported")
+	}
+	changed := make(map[string]bool)
+	for name := range transforms {
+		col := f.schema.ColumnByName(name)
+		if col == nil {
+			return fmt.Errorf("transform: column %s is not in the schema", name)
+		}
+		if col.MaxLevels.R > 0 || strings.Contains(name, ".") {
+			return fmt.Errorf("transform: column %s: the nested columns are not supported", name)
+		}
+		changed[name] = true
+	}
+
+	options.KeyValueMetadata = updateKeyValues(f.KeyValueMetadata(), options.KeyValueMetadata)
+	options.SortingColumns = nil
+	writer, err := NewWriter(w, f.schema, options)
+	if err != nil {
+		return err
+	}
+	writer.meta.CreatedBy = f.meta.CreatedBy
+
+	err = copyRowGroups(writer, f.schema, f, changed, func(rg *RowGroup, j int) (columnValues, error) {
+		name := writer.columns[j].name
+		transform, ok := transforms[name]
+		if !ok {
+			return nil, nil
+		}
+		cc := rg.ColumnChunk(name)
+		if cc == nil {
+			return nil, fmt.Errorf("no column chunk")
+		}
+		triples, err := cc.Triples()
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(triples)) != rg.NumRows() {
+			return nil, fmt.Errorf("read %d values for %d rows", len(triples), rg.NumRows())
+		}
+		convert := readValueFunc(writer.columns[j].name, writer.columns[j].element, f.options)
+		return func(k int64) (interface{}, error) {
+			v := triples[k].Value
+			if v != nil && convert != nil {
+				var err error
+				if v, err = convert(v); err != nil {
+					return nil, err
+				}
+			}
+			return transform(v)
+		}, nil
+	})
+	if err != nil {
+		return fmt.Errorf("transform: %s", err)
+	}
+	return writer.Close()
+}
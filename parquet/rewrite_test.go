@@ -0,0 +1,269 @@
+package parquet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestRewriteEncryption(t *testing.T) {
+	values := make([]int32, 300)
+	for i := range values {
+		values[i] = int32(i)
+	}
+	plaintext := writePageIndexTestFile(t, values, 64, nil)
+
+	rewrite := func(data []byte, decryption *encryption.DecryptionProperties, reencryption *encryption.FileEncryptionProperties) []byte {
+		var b bytes.Buffer
+		if err := RewriteEncryption(&b, bytes.NewReader(data), int64(len(data)), decryption, reencryption); err != nil {
+			t.Fatal(err)
+		}
+		return b.Bytes()
+	}
+	if got := rewrite(plaintext, nil, nil); !bytes.Equal(got, plaintext) {
+		t.Errorf("plaintext file changed by its rewrite")
+	}
+
+	oldKey, newKey, columnKey := []byte("0123456789012345"), []byte("abcdefghijklmnop"), []byte("bcdefghijklmnopa")
+	oldProperties, err := encryption.NewFileEncryptionPropertiesBuilder(oldKey).WithPlaintextFooter().Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, err := encryption.NewColumnEncryptionPropertiesBuilder("value").WithKey(columnKey).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	newProperties, err := encryption.NewFileEncryptionPropertiesBuilder(newKey).WithEncryptedColumns(column).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldKeys := &encryption.DecryptionProperties{FooterKey: oldKey}
+	newKeys := &encryption.DecryptionProperties{FooterKey: newKey, ColumnKeys: map[string][]byte{"value": columnKey}}
+
+	encrypted := rewrite(plaintext, nil, oldProperties)
+	rotated := rewrite(encrypted, oldKeys, newProperties)
+	if _, err := OpenFileWithOptions(bytes.NewReader(rotated), int64(len(rotated)), ReaderOptions{Decryption: oldKeys}); err == nil {
+		t.Errorf("no error for the rotated file with the old keys")
+	}
+
+	f, err := OpenFileWithOptions(bytes.NewReader(rotated), int64(len(rotated)), ReaderOptions{Decryption: newKeys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc := f.RowGroups()[0].ColumnChunk("value")
+	triples, err := cc.Triples()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int32
+	for _, triple := range triples {
+		got = append(got, triple.Value.(int32))
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("got %v, want %v", got, values)
+	}
+	filter, err := cc.BloomFilter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Check(values[10]) {
+		t.Errorf("Check(%d) = false", values[10])
+	}
+
+	// the pages are copied as is
+	if got := rewrite(rotated, newKeys, nil); !bytes.Equal(got, plaintext) {
+		t.Errorf("decrypted file differs from the original file")
+	}
+
+	// all the columns must be decrypted
+	var b bytes.Buffer
+	partialKeys := &encryption.DecryptionProperties{FooterKey: newKey}
+	if err := RewriteEncryption(&b, bytes.NewReader(rotated), int64(len(rotated)), partialKeys, nil); err == nil {
+		t.Errorf("no error without the key of a column")
+	}
+}
+
+func TestRewriteFile(t *testing.T) {
+	rows := writerTestRows(500)
+	f := writeMergeTestFile(t, rows, WriterOptions{
+		ColumnOptions:    ColumnOptions{Compression: "snappy"},
+		KeyValueMetadata: map[string]string{"a": "1", "b": "1"},
+		RowGroupRows:     100,
+	})
+
+	var b bytes.Buffer
+	options := WriterOptions{
+		ColumnOptions:    ColumnOptions{Compression: "zstd", DataPageVersion: 2, PageValues: 50},
+		KeyValueMetadata: map[string]string{"b": "2"},
+		RowGroupRows:     250,
+	}
+	if err := RewriteFile(&b, f, options); err != nil {
+		t.Fatal(err)
+	}
+	rewritten, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := readTestRows(t, rewritten), readTestRows(t, f); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %d rows, want the %d rows of the file", len(got), len(want))
+	}
+	if kv := rewritten.KeyValueMetadata(); len(kv) != 2 || kv["a"] != "1" || kv["b"] != "2" {
+		t.Errorf("got key/value metadata %v", kv)
+	}
+	if n := len(rewritten.RowGroups()); n != 2 {
+		t.Errorf("got %d row groups, want 2", n)
+	}
+	id := rewritten.RowGroups()[0].ColumnChunk("id")
+	if codec := id.Metadata().Codec; codec != thrift.CompressionCodec_ZSTD {
+		t.Errorf("got codec %s", codec)
+	}
+	for _, s := range id.EncodingStats() {
+		if s.PageType != thrift.PageType_DICTIONARY_PAGE && s.PageType != thrift.PageType_DATA_PAGE_V2 {
+			t.Errorf("got %s pages", s.PageType)
+		}
+	}
+	if index, err := id.OffsetIndex(); err != nil || len(index.GetPageLocations()) != 5 {
+		t.Errorf("got offset index %v, %v", index, err)
+	}
+}
+
+func TestProjectFile(t *testing.T) {
+	rows := writerTestRows(300)
+	f := writeMergeTestFile(t, rows, WriterOptions{
+		ColumnOptions:  ColumnOptions{Compression: "snappy", BloomFilter: true},
+		SortingColumns: []SortingColumn{{Column: "id"}, {Column: "score"}, {Column: "ratio"}},
+		RowGroupRows:   100,
+	})
+
+	var b bytes.Buffer
+	add := []AddedColumn{
+		{Spec: "source: BYTE_ARRAY UTF8 REQUIRED", Value: "batch 1"},
+		{Spec: "extra: INT32 OPTIONAL"},
+	}
+	if err := ProjectFile(&b, f, []string{"score", "missing"}, add, WriterOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	projected, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"id", "name", "ratio", "count", "valid", "source", "extra"}
+	if got := projected.Schema().Columns(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got columns %v, want %v", got, want)
+	}
+	got := readTestRows(t, projected)
+	if len(got) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), len(rows))
+	}
+	for i, row := range got {
+		if row["id"] != rows[i]["id"] || row["count"] != rows[i]["count"] || string(row["source"].([]byte)) != "batch 1" || row["extra"] != nil {
+			t.Fatalf("row %d: got %v", i, row)
+		}
+		if _, ok := row["score"]; ok {
+			t.Fatalf("row %d: got dropped column score", i)
+		}
+	}
+	if n := len(projected.RowGroups()); n != 3 {
+		t.Fatalf("got %d row groups, want 3", n)
+	}
+	rg := projected.RowGroups()[1]
+	if columns, err := rg.SortingColumns(); err != nil || !reflect.DeepEqual(columns, []SortingColumn{{Column: "id"}}) {
+		t.Errorf("got sorting columns %v, %v", columns, err)
+	}
+	if codec := rg.ColumnChunk("id").Metadata().Codec; codec != thrift.CompressionCodec_SNAPPY {
+		t.Errorf("got codec %s of the copied chunk", codec)
+	}
+	if filter, err := rg.ColumnChunk("id").BloomFilter(); err != nil || filter == nil || !filter.Check(int64(150)) {
+		t.Errorf("got bloom filter %v, %v", filter, err)
+	}
+	if stats := rg.ColumnChunk("extra").Statistics(); stats.GetNullCount() != 100 {
+		t.Errorf("got statistics %v of extra", stats)
+	}
+
+	for _, tc := range []struct {
+		drop []string
+		add  []AddedColumn
+	}{
+		{drop: []string{"unknown"}},
+		{add: []AddedColumn{{Spec: "id: INT64 REQUIRED", Value: int64(1)}}},
+		{add: []AddedColumn{{Spec: "source: BYTE_ARRAY REQUIRED"}}},
+		{add: []AddedColumn{{Spec: "source: INT32 REQUIRED", Value: "a string"}}},
+	} {
+		if err := ProjectFile(&bytes.Buffer{}, f, tc.drop, tc.add, WriterOptions{}); err == nil {
+			t.Errorf("%+v: no error", tc)
+		}
+	}
+}
+
+func TestTransformColumns(t *testing.T) {
+	rows := writerTestRows(300)
+	f := writeMergeTestFile(t, rows, WriterOptions{
+		ColumnOptions:  ColumnOptions{Compression: "snappy", BloomFilter: true},
+		SortingColumns: []SortingColumn{{Column: "id"}, {Column: "name"}, {Column: "ratio"}},
+		RowGroupRows:   100,
+	})
+
+	hash := func(v interface{}) (interface{}, error) {
+		if v == nil {
+			return nil, nil
+		}
+		sum := sha256.Sum256(v.([]byte))
+		return hex.EncodeToString(sum[:8]), nil
+	}
+	redact := func(v interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	var b bytes.Buffer
+	transforms := map[string]Transform{"name": hash, "score": redact}
+	if err := TransformColumns(&b, f, transforms, WriterOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	transformed, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readTestRows(t, transformed)
+	if len(got) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), len(rows))
+	}
+	for i, row := range got {
+		name, _ := hash(rows[i]["name"])
+		if row["id"] != rows[i]["id"] || row["count"] != rows[i]["count"] || row["score"] != nil {
+			t.Fatalf("row %d: got %v", i, row)
+		}
+		if name != nil && string(row["name"].([]byte)) != name || name == nil && row["name"] != nil {
+			t.Fatalf("row %d: got name %v, want %v", i, row["name"], name)
+		}
+	}
+
+	rg := transformed.RowGroups()[2]
+	if columns, err := rg.SortingColumns(); err != nil || !reflect.DeepEqual(columns, []SortingColumn{{Column: "id"}}) {
+		t.Errorf("got sorting columns %v, %v", columns, err)
+	}
+	if codec := rg.ColumnChunk("id").Metadata().Codec; codec != thrift.CompressionCodec_SNAPPY {
+		t.Errorf("got codec %s of the copied chunk", codec)
+	}
+	if codec := rg.ColumnChunk("name").Metadata().Codec; codec != thrift.CompressionCodec_UNCOMPRESSED {
+		t.Errorf("got codec %s of the transformed chunk", codec)
+	}
+	if stats := rg.ColumnChunk("name").Statistics(); bytes.HasPrefix(stats.GetMaxValue(), []byte("name")) {
+		t.Errorf("got statistics %v of name", stats)
+	}
+	if stats := rg.ColumnChunk("score").Statistics(); stats.GetNullCount() != 100 || stats.MaxValue != nil {
+		t.Errorf("got statistics %v of score", stats)
+	}
+
+	invalid := func(v interface{}) (interface{}, error) {
+		return 1, nil
+	}
+	for _, transforms := range []map[string]Transform{{"unknown": redact}, {"id": redact}, {"name": invalid}} {
+		if err := TransformColumns(&bytes.Buffer{}, f, transforms, WriterOptions{}); err == nil {
+			t.Errorf("no error for %v", transforms)
+		}
+	}
+}
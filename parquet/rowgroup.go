@@ -1,14 +1,590 @@
 package parquet
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"reflect"
+	"strings"
 
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
 	"github.com/kostya-sh/parquet-go/parquet/column"
 	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/page"
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
 )
 
+// RowGroup is one of the row groups of a File.
+type RowGroup struct {
+	file  *File
+	index int
+	meta  *thrift.RowGroup
+}
+
+// NumRows returns the number of rows in the row group.
+func (rg *RowGroup) NumRows() int64 {
+	return rg.meta.GetNumRows()
+}
+
+// Metadata returns the metadata of the row group.
+func (rg *RowGroup) Metadata() *thrift.RowGroup {
+	return rg.meta
+}
+
+// ColumnChunks returns the column chunks of the row group in the order they
+// are stored in the file.
+func (rg *RowGroup) ColumnChunks() []*ColumnChunk {
+	chunks := make([]*ColumnChunk, len(rg.meta.GetColumns()))
+	for i, cc := range rg.meta.GetColumns() {
+		chunks[i] = &ColumnChunk{rowGroup: rg, index: i, meta: cc}
+	}
+	return chunks
+}
+
+// ColumnChunk returns the chunk of the column colname or nil if there is no
+// such column in the row group.
+func (rg *RowGroup) ColumnChunk(colname string) *ColumnChunk {
+	for _, cc := range rg.ColumnChunks() {
+		if cc.Name() == colname {
+			return cc
+		}
+	}
+	return nil
+}
+
+// Rows returns a RowScanner over the rows of the row group.
+func (rg *RowGroup) Rows() *RowScanner {
+	return &RowScanner{file: rg.file, chunks: rg.ColumnChunks(), numRows: rg.NumRows(), cursor: -1}
+}
+
+// ColumnChunk is the chunk of a column in a RowGroup.
+type ColumnChunk struct {
+	rowGroup *RowGroup
+	index    int // index of the chunk in the row group
+	meta     *thrift.ColumnChunk
+}
+
+// Name returns the name of the column, the elements of its path separated
+// with ".".
+func (cc *ColumnChunk) Name() string {
+	return strings.Join(cc.meta.GetMetaData().GetPathInSchema(), ".")
+}
+
+// NumValues returns the number of values in the chunk, nulls included.
+func (cc *ColumnChunk) NumValues() int64 {
+	return cc.meta.GetMetaData().GetNumValues()
+}
+
+// Metadata returns the metadata of the chunk.
+func (cc *ColumnChunk) Metadata() *thrift.ColumnMetaData {
+	return cc.meta.GetMetaData()
+}
+
+// KeyValueMetadata returns the key/value metadata of the chunk, nil if it
+// has none.
+func (cc *ColumnChunk) KeyValueMetadata() map[string]string {
+	return thrift.KeyValueMap(cc.meta.GetMetaData().GetKeyValueMetadata())
+}
+
+// Statistics returns the statistics of the chunk, nil if it has none. The
+// min and max are removed when the writer of the file is known to have
+// computed wrong ones for the type of the column, the row group cannot be
+// skipped from them.
+func (cc *ColumnChunk) Statistics() *thrift.Statistics {
+	meta := cc.meta.GetMetaData()
+	stats := meta.GetStatistics()
+	if stats == nil || !corruptStatistics(cc.rowGroup.file.meta.GetCreatedBy(), meta.GetType()) {
+		return stats
+	}
+	return &thrift.Statistics{NullCount: stats.NullCount, DistinctCount: stats.DistinctCount}
+}
+
+// GeospatialStatistics returns the statistics of the chunk of a GEOMETRY or
+// a GEOGRAPHY column, nil if it has none: the types of its geometries and
+// their bounding box.
+func (cc *ColumnChunk) GeospatialStatistics() *thrift.GeospatialStatistics {
+	return cc.meta.GetMetaData().GetGeospatialStatistics()
+}
+
+// EncodingStats returns the number of pages of the chunk of each page type
+// and encoding, nil if the writer of the file did not record them.
+func (cc *ColumnChunk) EncodingStats() []*thrift.PageEncodingStats {
+	return cc.meta.GetMetaData().GetEncodingStats()
+}
+
+// DictionaryEncoded returns whether all the data pages of the chunk are
+// dictionary encoded, from its EncodingStats, without reading the pages. ok
+// is false when the chunk has no encoding stats.
+func (cc *ColumnChunk) DictionaryEncoded() (encoded, ok bool) {
+	stats := cc.EncodingStats()
+	if stats == nil {
+		return false, false
+	}
+	for _, s := range stats {
+		if s.PageType != thrift.PageType_DATA_PAGE && s.PageType != thrift.PageType_DATA_PAGE_V2 {
+			continue
+		}
+		if s.Encoding != thrift.Encoding_PLAIN_DICTIONARY && s.Encoding != thrift.Encoding_RLE_DICTIONARY && s.Count > 0 {
+			return false, true
+		}
+	}
+	return true, true
+}
+
+// Encrypted returns whether the chunk is encrypted.
+func (cc *ColumnChunk) Encrypted() bool {
+	return cc.meta.IsSetCryptoMetadata()
+}
+
+// CryptoMetadata returns how the chunk is encrypted, nil if it is not:
+// with the footer key or with the key of its column, identified by its key
+// metadata.
+func (cc *ColumnChunk) CryptoMetadata() *thrift.ColumnCryptoMetaData {
+	return cc.meta.GetCryptoMetadata()
+}
+
+// Masked returns whether the chunk is encrypted with a key the reader does
+// not have and its values are read as nulls, with the
+// ReaderOptions.MaskColumnsWithoutKeys option.
+func (cc *ColumnChunk) Masked() bool {
+	f := cc.rowGroup.file
+	if !f.options.MaskColumnsWithoutKeys || !cc.Encrypted() {
+		return false
+	}
+	return f.decryptor == nil || !f.decryptor.CanDecrypt(cc.meta.GetCryptoMetadata())
+}
+
+// decryptor returns the decryptor of the modules of the chunk, nil if it is
+// not encrypted.
+func (cc *ColumnChunk) decryptor() (*encryption.ColumnDecryptor, error) {
+	if !cc.Encrypted() {
+		return nil, nil
+	}
+	f := cc.rowGroup.file
+	if f.decryptor == nil {
+		return nil, fmt.Errorf("column %s is encrypted, the keys of the file are required", cc.Name())
+	}
+	d, err := f.decryptor.ColumnDecryptor(cc.meta.GetCryptoMetadata(), cc.rowGroup.index, cc.index)
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %s", cc.Name(), err)
+	}
+	return d, nil
+}
+
+// Scanner returns a scanner over the values of the chunk. Encrypted chunks
+// are read with Pages.
+func (cc *ColumnChunk) Scanner() (*column.Scanner, error) {
+	if cc.Encrypted() {
+		return nil, fmt.Errorf("column %s is encrypted", cc.Name())
+	}
+	f := cc.rowGroup.file
+	col := f.schema.ColumnByName(cc.Name())
+	if col == nil {
+		return nil, fmt.Errorf("no column %s in the schema", cc.Name())
+	}
+	r := io.NewSectionReader(f.r, 0, f.size)
+	return column.NewScanner(r, col.SchemaElement, []*thrift.ColumnChunk{cc.meta}), nil
+}
+
+// Pages returns a scanner over the pages of the chunk: the dictionary page
+// if any, then the data pages. The data pages give access to their levels
+// and values.
+func (cc *ColumnChunk) Pages() (page.Scanner, error) {
+	f := cc.rowGroup.file
+	col := f.schema.ColumnByName(cc.Name())
+	if col == nil {
+		return nil, fmt.Errorf("no column %s in the schema", cc.Name())
+	}
+	decryptor, err := cc.decryptor()
+	if err != nil {
+		return nil, err
+	}
+
+	meta := cc.Metadata()
+	r := io.NewSectionReader(f.r, cc.pagesOffset(), meta.GetTotalCompressedSize())
+	maxRep, maxDef := uint(col.MaxLevels.R), uint(col.MaxLevels.D)
+	pages := page.NewNestedScanner(col.SchemaElement, maxRep, maxDef, meta.GetCodec(), r)
+	pages.VerifyChecksums(!f.options.SkipChecksums)
+	if decryptor != nil {
+		pages.UseDecryptor(decryptor, meta.GetDictionaryPageOffset() > 0)
+	}
+	dict, err := page.ZstdDictionary(meta)
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %s", cc.Name(), err)
+	}
+	if dict != nil {
+		if err := pages.UseZstdDictionary(dict); err != nil {
+			return nil, fmt.Errorf("column %s: %s", cc.Name(), err)
+		}
+	}
+	return pages, nil
+}
+
+// pagesOffset returns the offset of the first page of the chunk in the
+// file. Some writers set the dictionary_page_offset to 0 for the chunks
+// without a dictionary, the offset of the magic bytes: it is ignored.
+func (cc *ColumnChunk) pagesOffset() int64 {
+	meta := cc.Metadata()
+	offset := meta.GetDataPageOffset()
+	if meta.GetDictionaryPageOffset() > 0 && offset > meta.GetDictionaryPageOffset() {
+		offset = meta.GetDictionaryPageOffset()
+	}
+	if meta.GetIndexPageOffset() > 0 && offset > meta.GetIndexPageOffset() {
+		offset = meta.GetIndexPageOffset()
+	}
+	return offset
+}
+
+// ColumnIndex returns the column index of the chunk, the min and max of each
+// of its data pages, nil if the chunk has none.
+func (cc *ColumnChunk) ColumnIndex() (*thrift.ColumnIndex, error) {
+	if !cc.meta.IsSetColumnIndexOffset() {
+		return nil, nil
+	}
+	r, err := cc.indexReader(cc.meta.GetColumnIndexOffset(), cc.meta.GetColumnIndexLength())
+	if err != nil {
+		return nil, fmt.Errorf("column %s: invalid column index: %s", cc.Name(), err)
+	}
+	if r, err = cc.decryptModule(r, encryption.ColumnIndex); err != nil {
+		return nil, err
+	}
+	var index thrift.ColumnIndex
+	if err := index.Read(r); err != nil {
+		return nil, fmt.Errorf("column %s: could not read column index: %s", cc.Name(), err)
+	}
+	return &index, nil
+}
+
+// OffsetIndex returns the offset index of the chunk, the location and the
+// first row of each of its data pages, nil if the chunk has none.
+func (cc *ColumnChunk) OffsetIndex() (*thrift.OffsetIndex, error) {
+	if !cc.meta.IsSetOffsetIndexOffset() {
+		return nil, nil
+	}
+	r, err := cc.indexReader(cc.meta.GetOffsetIndexOffset(), cc.meta.GetOffsetIndexLength())
+	if err != nil {
+		return nil, fmt.Errorf("column %s: invalid offset index: %s", cc.Name(), err)
+	}
+	if r, err = cc.decryptModule(r, encryption.OffsetIndex); err != nil {
+		return nil, err
+	}
+	var index thrift.OffsetIndex
+	if err := index.Read(r); err != nil {
+		return nil, fmt.Errorf("column %s: could not read offset index: %s", cc.Name(), err)
+	}
+	return &index, nil
+}
+
+// BloomFilter returns the Bloom filter of the chunk, nil if the chunk has
+// none. The row group can be skipped when looking for a value the filter
+// does not Check.
+func (cc *ColumnChunk) BloomFilter() (*bloom.Filter, error) {
+	meta := cc.meta.GetMetaData()
+	if !meta.IsSetBloomFilterOffset() {
+		return nil, nil
+	}
+	offset := meta.GetBloomFilterOffset()
+	var r io.Reader
+	if meta.IsSetBloomFilterLength() {
+		var err error
+		r, err = cc.indexReader(offset, meta.GetBloomFilterLength())
+		if err != nil {
+			return nil, fmt.Errorf("column %s: invalid bloom filter: %s", cc.Name(), err)
+		}
+	} else {
+		// older writers only write the offset, the size of the bitset is in
+		// the header of the filter
+		f := cc.rowGroup.file
+		if offset < 0 || offset >= f.size {
+			return nil, fmt.Errorf("column %s: invalid bloom filter offset %d in a file of %d bytes", cc.Name(), offset, f.size)
+		}
+		r = io.NewSectionReader(f.r, offset, f.size-offset)
+	}
+	if cc.Encrypted() {
+		// the header and the bitset are two modules
+		header, err := cc.decryptModule(r, encryption.BloomFilterHeader)
+		if err != nil {
+			return nil, err
+		}
+		bitset, err := cc.decryptModule(r, encryption.BloomFilterBitset)
+		if err != nil {
+			return nil, err
+		}
+		r = io.MultiReader(header, bitset)
+	}
+	filter, err := bloom.Read(r)
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %s", cc.Name(), err)
+	}
+	return filter, nil
+}
+
+// decryptModule reads the module of type t of the chunk from r and returns
+// a reader of its plaintext, r itself if the chunk is not encrypted.
+func (cc *ColumnChunk) decryptModule(r io.Reader, t encryption.ModuleType) (io.Reader, error) {
+	decryptor, err := cc.decryptor()
+	if decryptor == nil || err != nil {
+		return r, err
+	}
+	data, err := decryptor.ReadModule(r, t, 0)
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %s", cc.Name(), err)
+	}
+	return bytes.NewReader(data), nil
+}
+
+// indexReader returns a reader of the length bytes of the file at offset.
+func (cc *ColumnChunk) indexReader(offset int64, length int32) (io.Reader, error) {
+	f := cc.rowGroup.file
+	if offset < 0 || length <= 0 || offset > f.size-int64(length) {
+		return nil, fmt.Errorf("%d bytes at offset %d in a file of %d bytes", length, offset, f.size)
+	}
+	return io.NewSectionReader(f.r, offset, int64(length)), nil
+}
+
+// PagesWhere is like Pages but skips the data pages that cannot contain
+// values matching a predicate, without reading them. keep is called with
+// the min and max of each data page in the column index of the chunk,
+// PLAIN encoded without the length of a byte array, and returns false if no
+// value between them matches. The pages with only nulls are skipped. No
+// page is skipped when the chunk has no page index.
+//
+// The SkippedDataPages of the scanner are the indexes of the skipped pages
+// in the page index, the rows of the pages read can be found with the
+// OffsetIndex.
+func (cc *ColumnChunk) PagesWhere(keep func(min, max []byte) bool) (page.Scanner, error) {
+	pages, err := cc.Pages()
+	if err != nil {
+		return nil, err
+	}
+	columnIndex, err := cc.ColumnIndex()
+	if err != nil {
+		return nil, err
+	}
+	offsetIndex, err := cc.OffsetIndex()
+	if err != nil {
+		return nil, err
+	}
+	if columnIndex == nil || offsetIndex == nil {
+		return pages, nil
+	}
+
+	n := len(offsetIndex.GetPageLocations())
+	if len(columnIndex.GetNullPages()) != n || len(columnIndex.GetMinValues()) != n || len(columnIndex.GetMaxValues()) != n {
+		return nil, fmt.Errorf("column %s: page index of %d pages with %d null pages, %d min and %d max", cc.Name(),
+			n, len(columnIndex.GetNullPages()), len(columnIndex.GetMinValues()), len(columnIndex.GetMaxValues()))
+	}
+	pages.SkipDataPages(func(i int) bool {
+		if i >= n {
+			return false
+		}
+		return columnIndex.NullPages[i] || !keep(columnIndex.MinValues[i], columnIndex.MaxValues[i])
+	})
+	return pages, nil
+}
+
+// Triples decodes the values of the chunk with their repetition and
+// definition levels. A masked chunk has a null value per row, whatever the
+// repetition of its column.
+func (cc *ColumnChunk) Triples() ([]page.Triple, error) {
+	if cc.Masked() {
+		return make([]page.Triple, cc.rowGroup.NumRows()), nil
+	}
+	pages, err := cc.Pages()
+	if err != nil {
+		return nil, err
+	}
+
+	var triples []page.Triple
+	for pages.Scan() {
+		p, ok := pages.DataPage()
+		if !ok {
+			continue
+		}
+		t, err := p.Triples()
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %s", cc.Name(), err)
+		}
+		triples = append(triples, t...)
+	}
+	if err := pages.Err(); err != nil {
+		return nil, fmt.Errorf("column %s: %s", cc.Name(), err)
+	}
+	return triples, nil
+}
+
+// RowScanner reads the rows of a RowGroup one at a time. All the column
+// chunks of the row group are decoded on the first call to Scan.
+type RowScanner struct {
+	file    *File
+	chunks  []*ColumnChunk
+	fields  []*field
+	values  [][]page.Triple                            // the triples of the columns by column index
+	next    []int                                      // the index of the triples of the next row by column index
+	triples [][]page.Triple                            // the triples of the row by column index
+	convert []func(v interface{}) (interface{}, error) // see readValueFunc
+	numRows int64
+	cursor  int64
+	row     map[string]interface{}
+	err     error
+}
+
+// Scan advances to the next row. It returns false at the end of the row
+// group or if an error occurred.
+func (s *RowScanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	if s.values == nil {
+		if s.err = s.decode(); s.err != nil {
+			return false
+		}
+	}
+
+	s.cursor++
+	s.row = nil
+	if s.cursor >= s.numRows {
+		return false
+	}
+	s.row, s.err = s.readRow()
+	return s.err == nil
+}
+
+// readRow returns the row at the cursor, the triples of each column up to
+// the next repetition level 0.
+func (s *RowScanner) readRow() (map[string]interface{}, error) {
+	for j, values := range s.values {
+		start := s.next[j]
+		end := start + 1
+		for end < len(values) && values[end].R > 0 {
+			end++
+		}
+		s.triples[j] = values[start:end]
+		s.next[j] = end
+	}
+	row := make(map[string]interface{}, len(s.fields))
+	for _, f := range s.fields {
+		v, err := s.assemble(f, s.triples)
+		if err != nil {
+			return nil, err
+		}
+		row[f.name] = v
+	}
+	return row, nil
+}
+
+func (s *RowScanner) decode() error {
+	schema := s.file.schema
+	fields, err := schema.fields()
+	if err != nil {
+		return err
+	}
+	s.fields = fields
+	if want := s.file.options.FieldIDSchema; want != nil {
+		wantFields, err := want.fields()
+		if err != nil {
+			return fmt.Errorf("field id schema: %s", err)
+		}
+		if s.fields, err = resolveFieldIDs(wantFields, fields); err != nil {
+			return fmt.Errorf("field id schema: %s", err)
+		}
+	}
+	s.values = make([][]page.Triple, len(schema.Columns()))
+	s.next = make([]int, len(schema.Columns()))
+	s.triples = make([][]page.Triple, len(schema.Columns()))
+	s.convert = make([]func(v interface{}) (interface{}, error), len(schema.Columns()))
+	for j, name := range schema.Columns() {
+		var cc *ColumnChunk
+		for _, chunk := range s.chunks {
+			if chunk.Name() == name {
+				cc = chunk
+				break
+			}
+		}
+		if cc == nil {
+			return fmt.Errorf("no column chunk of column %s", name)
+		}
+		col := schema.ColumnByName(name)
+		s.convert[j] = readValueFunc(name, col.SchemaElement, s.file.options)
+		repeated := col.MaxLevels.R > 0
+		if !repeated && !cc.Masked() && cc.NumValues() != s.numRows {
+			return fmt.Errorf("column %s: %d values for %d rows", name, cc.NumValues(), s.numRows)
+		}
+
+		triples, err := cc.Triples()
+		if err != nil {
+			return err
+		}
+		numRows := int64(0)
+		for _, t := range triples {
+			if t.R == 0 {
+				numRows++
+			}
+		}
+		switch {
+		case !repeated && int64(len(triples)) != s.numRows:
+			return fmt.Errorf("column %s: read %d values for %d rows", name, len(triples), s.numRows)
+		case numRows != s.numRows:
+			return fmt.Errorf("column %s: read %d rows for %d rows", name, numRows, s.numRows)
+		}
+		s.values[j] = triples
+	}
+	for j, f := range columnFields(s.fields, len(s.convert)) {
+		if f != nil && f.widened != nil {
+			s.convert[j] = widenValueFunc(s.convert[j], f.widened)
+		}
+	}
+	return nil
+}
+
+// Row returns the current row as a map from the names of the fields of the
+// top level of the schema to their values, nil for the null values. The
+// values of the groups are map[string]interface{} of the values of their
+// fields, the values of the LIST groups and of the repeated fields are
+// []interface{} of their elements and the values of the MAP groups are
+// map[interface{}]interface{} of their keys and values, the keys of the byte
+// array columns as strings, found with the backward compatibility rules of
+// the parquet spec for the layouts of the old writers. The values of the
+// VARIANT groups are the Go values of their variants, see variant.go. The
+// values of the DECIMAL columns are *big.Rat, the values of the TIMESTAMP
+// columns time.Time, see ReaderOptions.Location, the values of the INT96
+// columns the time.Time in UTC of their legacy timestamps, the values of the
+// TIME columns the time.Duration since midnight, the values of the DATE
+// columns the time.Time of their midnight UTC, the values of the UUID columns
+// [16]byte, the values of the INTERVAL columns Interval, the values of the
+// FLOAT16 columns float32, the values of the unsigned integer columns uint8,
+// uint16, uint32 or uint64, the values of the JSON and the BSON columns are
+// unmarshaled by the hooks of the ReaderOptions, if any, the values of the
+// other columns have the type of their column. See Decode for structs.
+func (s *RowScanner) Row() map[string]interface{} {
+	return s.row
+}
+
+// Decode stores the current row in v, a pointer to a struct, see SchemaOf:
+// the fields of the groups, lists and maps are set from the values of the
+// row, the null values are the nil pointers, slices and maps or the zero
+// values of the other types. The fields that are not in the schema are left
+// unchanged.
+func (s *RowScanner) Decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode: %T is not a pointer to a struct", v)
+	}
+	if s.row == nil {
+		return fmt.Errorf("decode: no row")
+	}
+	if err := setValue(rv.Elem(), s.row); err != nil {
+		return fmt.Errorf("decode: %s", err)
+	}
+	return nil
+}
+
+// Err returns the first error encountered.
+func (s *RowScanner) Err() error {
+	return s.err
+}
+
 // RowGroupScanner
 type RowGroupScanner struct {
 	r        io.ReadSeeker
@@ -31,11 +607,12 @@ func (rg *RowGroupScanner) NewColumnScanners() []*column.Scanner {
 type rowGroupEncoder struct {
 	encoders        map[string]*column.Encoder
 	columns         []string
+	sortingColumns  []*thrift.SortingColumn
 	rowGroups       []*thrift.RowGroup
 	currentRowGroup *thrift.RowGroup
 }
 
-func newRowGroupEncoder(s *Schema) *rowGroupEncoder {
+func newRowGroupEncoder(s *Schema, options WriterOptions) *rowGroupEncoder {
 	enc := &rowGroupEncoder{
 		encoders:  make(map[string]*column.Encoder),
 		rowGroups: []*thrift.RowGroup{},
@@ -44,8 +621,25 @@ func newRowGroupEncoder(s *Schema) *rowGroupEncoder {
 
 	for _, element := range s.Elements() {
 		enc.columns = append(enc.columns, element.Name)
-		enc.encoders[element.Name] = column.NewEncoder(element, column.DefaultPreferences())
+		preferences := column.DefaultPreferences()
+		columnOptions := options.column(element.Name)
+		preferences.DataPageVersion = columnOptions.DataPageVersion
+		preferences.Compression = columnOptions.Compression
+		preferences.CompressionLevel = columnOptions.CompressionLevel
+		preferences.ZstdDictionarySize = columnOptions.ZstdDictionarySize
+		preferences.StatisticsTruncateLength = columnOptions.StatisticsTruncateLength
+		preferences.BloomFilter = columnOptions.BloomFilter
+		preferences.BloomFilterNDV = columnOptions.BloomFilterNDV
+		preferences.BloomFilterFPP = columnOptions.BloomFilterFPP
+		preferences.KeyValueMetadata = columnOptions.ChunkKeyValueMetadata
+		enc.encoders[element.Name] = column.NewEncoder(element, preferences)
+	}
+
+	sorting, err := sortingColumns(s, options.SortingColumns)
+	if err != nil {
+		panic(err)
 	}
+	enc.sortingColumns = sorting
 
 	enc.addRowGroup(enc.newRowGroup())
 
@@ -59,7 +653,7 @@ func (enc *rowGroupEncoder) addRowGroup(rowGroup *thrift.RowGroup) {
 
 func (enc *rowGroupEncoder) newRowGroup() *thrift.RowGroup {
 	rowGroup := thrift.NewRowGroup()
-	rowGroup.SortingColumns = []*thrift.SortingColumn{} // Not supported yet
+	rowGroup.SortingColumns = append([]*thrift.SortingColumn{}, enc.sortingColumns...)
 	return rowGroup
 }
 
@@ -1,7 +1,648 @@
 package parquet
 
-import "testing"
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/memory"
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func openTestFile(t *testing.T, path string) *File {
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pf, err := OpenFile(f, info.Size())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return pf
+}
 
 func TestRowGroup(t *testing.T) {
+	f := openTestFile(t, "testdata/alltypes_plain.parquet")
+
+	rowGroups := f.RowGroups()
+	if len(rowGroups) != 1 {
+		t.Fatalf("RowGroups: was %d, expected 1", len(rowGroups))
+	}
+	rg := rowGroups[0]
+
+	chunks := rg.ColumnChunks()
+	if len(chunks) != 11 {
+		t.Fatalf("ColumnChunks: was %d, expected 11", len(chunks))
+	}
+	if chunks[0].Name() != "id" || chunks[0].NumValues() != 8 {
+		t.Errorf("unexpected first chunk %s with %d values", chunks[0].Name(), chunks[0].NumValues())
+	}
+	if cc := rg.ColumnChunk("int_col"); cc == nil || cc.Metadata().GetNumValues() != 8 {
+		t.Errorf("unexpected chunk for int_col: %v", cc)
+	}
+	if cc := rg.ColumnChunk("missing"); cc != nil {
+		t.Errorf("unexpected chunk for a missing column: %v", cc)
+	}
+
+	var ids []interface{}
+	rows := rg.Rows()
+	for rows.Scan() {
+		row := rows.Row()
+		if len(row) != 11 {
+			t.Fatalf("unexpected row %v", row)
+		}
+		ids = append(ids, row["id"])
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	want := []interface{}{int32(4), int32(5), int32(6), int32(7), int32(2), int32(3), int32(0), int32(1)}
+	if len(ids) != len(want) {
+		t.Fatalf("read %d rows, expected %d", len(ids), len(want))
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("id of row %d: was %v, expected %v", i, ids[i], want[i])
+		}
+	}
+}
+
+func TestColumnChunkPages(t *testing.T) {
+	f := openTestFile(t, "testdata/alltypes_plain.parquet")
+	cc := f.RowGroups()[0].ColumnChunk("int_col")
+
+	pages, err := cc.Pages()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	var dictionaries, numValues int
+	for pages.Scan() {
+		if _, ok := pages.DictionaryPage(); ok {
+			dictionaries++
+		}
+		p, ok := pages.DataPage()
+		if !ok {
+			continue
+		}
+		if p.Dictionary() == nil {
+			t.Errorf("data page without the dictionary of the chunk")
+		}
+		_, definition, err := p.Levels()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(definition) != int(p.NumValues()) {
+			t.Errorf("read %d definition levels, expected %d", len(definition), p.NumValues())
+		}
+		acc := memory.NewSimpleAccumulator(f.Schema().ColumnByName("int_col").SchemaElement)
+		if err := p.Values(acc); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if v, ok := acc.Get(0); !ok || v != int32(0) {
+			t.Errorf("first value: was %v, expected 0", v)
+		}
+		numValues += int(p.NumValues())
+	}
+	if err := pages.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if dictionaries != 1 || numValues != 8 {
+		t.Errorf("read %d dictionary pages and %d values", dictionaries, numValues)
+	}
+}
+
+func checkTriples(t *testing.T, path string, columnIdx int, expected []page.Triple) {
+	f := openTestFile(t, path)
+
+	var got []page.Triple
+	for _, rg := range f.RowGroups() {
+		triples, err := rg.ColumnChunks()[columnIdx].Triples()
+		if err != nil {
+			t.Fatalf("%s: column %d: unexpected error: %s", path, columnIdx, err)
+		}
+		got = append(got, triples...)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("%s: column %d: got %v, want %v", path, columnIdx, got, expected)
+	}
+}
+
+func TestColumnChunkTriples(t *testing.T) {
+	checkTriples(t, "testdata/Booleans.parquet", 0, []page.Triple{
+		{R: 0, D: 0, Value: true},
+		{R: 0, D: 0, Value: true},
+		{R: 0, D: 0, Value: false},
+		{R: 0, D: 0, Value: true},
+		{R: 0, D: 0, Value: false},
+		{R: 0, D: 0, Value: true},
+	})
+
+	checkTriples(t, "testdata/Booleans.parquet", 1, []page.Triple{
+		{R: 0, D: 0, Value: nil},
+		{R: 0, D: 1, Value: false},
+		{R: 0, D: 1, Value: true},
+		{R: 0, D: 1, Value: true},
+		{R: 0, D: 0, Value: nil},
+		{R: 0, D: 1, Value: true},
+	})
+
+	checkTriples(t, "testdata/ByteArrays.parquet", 2, []page.Triple{
+		{R: 0, D: 0, Value: nil},
+		{R: 0, D: 0, Value: nil},
+		{R: 0, D: 1, Value: []byte("p3_1")},
+		{R: 0, D: 1, Value: []byte("p4_1")},
+		{R: 1, D: 1, Value: []byte("p4_2")},
+		{R: 1, D: 1, Value: []byte("p4_3")},
+		{R: 0, D: 0, Value: nil},
+		{R: 0, D: 1, Value: []byte("p6_1")},
+	})
+}
+
+// pageIndexTestFile returns a file with a single INT32 column of values,
+// written in data pages of about pageSize bytes with a page index.
+func pageIndexTestFile(t *testing.T, values []int32, pageSize int) *File {
+	data := writePageIndexTestFile(t, values, pageSize, nil)
+	f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// writePageIndexTestFile returns the data of the file of pageIndexTestFile,
+// encrypted with e unless e is nil.
+func writePageIndexTestFile(t *testing.T, values []int32, pageSize int, e *encryption.FileEncryptor) []byte {
+	filter := bloom.New(64)
+	enc := page.NewPageEncoder(page.EncodingPreferences{Strategy: "dictionary", PageSize: pageSize, BloomFilter: filter})
+	if err := enc.WriteInt32(values); err != nil {
+		t.Fatal(err)
+	}
+	pages, err := enc.Pages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encodingStats, err := page.EncodingStats(pages)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b bytes.Buffer
+	magic := parquetMagic
+	if e != nil && e.FooterEncrypted() {
+		magic = encryptedMagic
+	}
+	if err := writeMagic(&b, magic); err != nil {
+		t.Fatal(err)
+	}
+	var ce *encryption.ColumnEncryptor
+	if e != nil {
+		if ce, err = e.ColumnEncryptor([]string{"value"}, 0, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	n, offsetIndex, err := page.WriteEncryptedPages(&b, magicSize, pages, ce)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dictionaryOffset := int64(magicSize)
+	meta := &thrift.ColumnMetaData{
+		Type:                  thrift.Type_INT32,
+		Encodings:             []thrift.Encoding{thrift.Encoding_PLAIN_DICTIONARY},
+		PathInSchema:          []string{"value"},
+		Codec:                 thrift.CompressionCodec_UNCOMPRESSED,
+		NumValues:             int64(len(values)),
+		TotalCompressedSize:   n,
+		TotalUncompressedSize: n,
+		DictionaryPageOffset:  &dictionaryOffset,
+		DataPageOffset:        offsetIndex.PageLocations[0].Offset,
+		EncodingStats:         encodingStats,
+	}
+	rowGroup := &thrift.RowGroup{
+		Columns:       []*thrift.ColumnChunk{{FileOffset: magicSize, MetaData: meta}},
+		TotalByteSize: n,
+		NumRows:       int64(len(values)),
+	}
+	if _, err := writeBloomFilters(&b, int64(b.Len()), []*thrift.RowGroup{rowGroup}, [][]*bloom.Filter{{filter}}, e); err != nil {
+		t.Fatal(err)
+	}
+	indexes := [][]pageIndex{{{columnIndex: enc.ColumnIndex(), offsetIndex: offsetIndex}}}
+	if _, err := writePageIndexes(&b, int64(b.Len()), []*thrift.RowGroup{rowGroup}, indexes, e); err != nil {
+		t.Fatal(err)
+	}
+
+	numChildren := int32(1)
+	fileMeta := &thrift.FileMetaData{
+		Schema: []*thrift.SchemaElement{
+			{Name: "root", NumChildren: &numChildren},
+			{
+				Name:           "value",
+				Type:           thrift.TypePtr(thrift.Type_INT32),
+				RepetitionType: thrift.FieldRepetitionTypePtr(thrift.FieldRepetitionType_REQUIRED),
+			},
+		},
+		NumRows:   int64(len(values)),
+		RowGroups: []*thrift.RowGroup{rowGroup},
+	}
+	if e != nil {
+		err = writeEncryptedFileMetadata(&b, fileMeta, e)
+	} else {
+		err = writeFileMetadata(&b, fileMeta)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b.Bytes()
+}
+
+func TestColumnChunkPagesWhere(t *testing.T) {
+	values := make([]int32, 300)
+	for i := range values {
+		values[i] = int32(i)
+	}
+	f := pageIndexTestFile(t, values, 64)
+	cc := f.RowGroups()[0].ColumnChunk("value")
+
+	offsetIndex, err := cc.OffsetIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	locations := offsetIndex.GetPageLocations()
+	if len(locations) < 3 {
+		t.Fatalf("got %d pages, want at least 3", len(locations))
+	}
+
+	// the values between 100 and 120
+	pages, err := cc.PagesWhere(func(min, max []byte) bool {
+		return int32(binary.LittleEndian.Uint32(min)) <= 120 && int32(binary.LittleEndian.Uint32(max)) >= 100
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []int32
+	for pages.Scan() {
+		p, ok := pages.DataPage()
+		if !ok {
+			continue
+		}
+		triples, err := p.Triples()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, triple := range triples {
+			got = append(got, triple.Value.(int32))
+		}
+	}
+	if err := pages.Err(); err != nil && err != io.EOF {
+		t.Fatal(err)
+	}
+
+	if len(got) == 0 {
+		t.Fatal("no value read")
+	}
+	if got[0] > 100 || got[len(got)-1] < 120 {
+		t.Fatalf("got values from %d to %d", got[0], got[len(got)-1])
+	}
+	if len(got) == len(values) {
+		t.Errorf("no page skipped")
+	}
+	skipped := pages.SkippedDataPages()
+	if len(skipped) == 0 {
+		t.Errorf("no skipped pages reported")
+	}
+	first := 0
+	for first < len(skipped) && skipped[first] == first {
+		first++
+	}
+	if int64(got[0]) != locations[first].FirstRowIndex {
+		t.Errorf("first value read %d, expected the first row %d of page %d", got[0], locations[first].FirstRowIndex, first)
+	}
+
+	columnIndex, err := cc.ColumnIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if columnIndex.GetBoundaryOrder() != thrift.BoundaryOrder_ASCENDING {
+		t.Errorf("got boundary order %s", columnIndex.GetBoundaryOrder())
+	}
+}
+
+func TestColumnChunkBloomFilter(t *testing.T) {
+	values := make([]int32, 100)
+	for i := range values {
+		values[i] = int32(i * 2)
+	}
+	f := pageIndexTestFile(t, values, 64)
+	cc := f.RowGroups()[0].ColumnChunks()[0]
+
+	filter, err := cc.BloomFilter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range values {
+		if !filter.Check(v) {
+			t.Errorf("Check(%d) = false", v)
+		}
+	}
+	missing := 0
+	for v := int32(1); v < 200; v += 2 {
+		if !filter.Check(v) {
+			missing++
+		}
+	}
+	if missing == 0 {
+		t.Errorf("all the odd values may be in the chunk")
+	}
+
+	// the length of the filter is optional
+	cc.Metadata().BloomFilterLength = nil
+	filter, err = cc.BloomFilter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Check(values[0]) {
+		t.Errorf("Check(%d) = false without the filter length", values[0])
+	}
+
+	cc.Metadata().BloomFilterOffset = nil
+	if filter, err = cc.BloomFilter(); filter != nil || err != nil {
+		t.Errorf("got %v, %v for a chunk without filter", filter, err)
+	}
+}
+
+func TestColumnChunkEncrypted(t *testing.T) {
+	values := make([]int32, 300)
+	for i := range values {
+		values[i] = int32(i)
+	}
+	footerKey, columnKey := []byte("0123456789012345"), []byte("abcdefghijklmnop")
+	column, err := encryption.NewColumnEncryptionPropertiesBuilder("value").WithKey(columnKey).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name    string
+		builder *encryption.FileEncryptionPropertiesBuilder
+	}{
+		{"footer key", encryption.NewFileEncryptionPropertiesBuilder(footerKey)},
+		{"column key", encryption.NewFileEncryptionPropertiesBuilder(footerKey).WithEncryptedColumns(column)},
+		{"plaintext footer", encryption.NewFileEncryptionPropertiesBuilder(footerKey).WithPlaintextFooter()},
+		{"plaintext footer and column key", encryption.NewFileEncryptionPropertiesBuilder(footerKey).WithPlaintextFooter().WithEncryptedColumns(column)},
+		{"ctr", encryption.NewFileEncryptionPropertiesBuilder(footerKey).WithAlgorithm(encryption.AesGcmCtrV1).WithAADPrefix([]byte("f"))},
+	} {
+		properties, err := test.builder.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := encryption.NewFileEncryptor(properties)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := writePageIndexTestFile(t, values, 64, e)
+
+		options := ReaderOptions{Decryption: &encryption.DecryptionProperties{
+			FooterKey:  footerKey,
+			ColumnKeys: map[string][]byte{"value": columnKey},
+		}}
+		f, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options)
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		cc := f.RowGroups()[0].ColumnChunk("value")
+		if !cc.Encrypted() {
+			t.Errorf("%s: chunk not encrypted", test.name)
+		}
+		if len(cc.EncodingStats()) == 0 {
+			t.Errorf("%s: no encoding stats", test.name)
+		}
+
+		pages, err := cc.Pages()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []int32
+		for pages.Scan() {
+			p, ok := pages.DataPage()
+			if !ok {
+				continue
+			}
+			triples, err := p.Triples()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, triple := range triples {
+				got = append(got, triple.Value.(int32))
+			}
+		}
+		if err := pages.Err(); err != nil && err != io.EOF {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if !reflect.DeepEqual(got, values) {
+			t.Errorf("%s: got %v, want %v", test.name, got, values)
+		}
+
+		columnIndex, err := cc.ColumnIndex()
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		offsetIndex, err := cc.OffsetIndex()
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if len(columnIndex.GetMinValues()) != len(offsetIndex.GetPageLocations()) || len(columnIndex.GetMinValues()) < 2 {
+			t.Errorf("%s: got %d pages in the column index and %d in the offset index", test.name, len(columnIndex.GetMinValues()), len(offsetIndex.GetPageLocations()))
+		}
+		filter, err := cc.BloomFilter()
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if !filter.Check(values[0]) {
+			t.Errorf("%s: Check(%d) = false", test.name, values[0])
+		}
+
+		// without the keys only the metadata of a plaintext footer is read
+		f, err = OpenFile(bytes.NewReader(data), int64(len(data)))
+		if e.FooterEncrypted() {
+			if err == nil {
+				t.Errorf("%s: no error without the footer key", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		cc = f.RowGroups()[0].ColumnChunk("value")
+		if cc.EncodingStats() != nil {
+			t.Errorf("%s: got encoding stats of an encrypted chunk without the keys", test.name)
+		}
+		if _, err := cc.Pages(); err == nil {
+			t.Errorf("%s: no error for the pages of an encrypted chunk without the keys", test.name)
+		}
+	}
+}
+
+func TestColumnChunkMasked(t *testing.T) {
+	values := []int32{1, 2, 3, 4, 5}
+	footerKey, columnKey := []byte("0123456789012345"), []byte("abcdefghijklmnop")
+	column, err := encryption.NewColumnEncryptionPropertiesBuilder("value").WithKey(columnKey).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, plaintextFooter := range []bool{false, true} {
+		builder := encryption.NewFileEncryptionPropertiesBuilder(footerKey).WithEncryptedColumns(column)
+		if plaintextFooter {
+			builder = builder.WithPlaintextFooter()
+		}
+		properties, err := builder.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		e, err := encryption.NewFileEncryptor(properties)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data := writePageIndexTestFile(t, values, 64, e)
+
+		// the footer key only
+		decryption := &encryption.DecryptionProperties{FooterKey: footerKey}
+		f, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), ReaderOptions{Decryption: decryption})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cc := f.RowGroups()[0].ColumnChunk("value")
+		if cc.Masked() {
+			t.Errorf("plaintext footer %t: chunk masked without the option", plaintextFooter)
+		}
+		if _, err := cc.Triples(); err == nil {
+			t.Errorf("plaintext footer %t: no error without the column key", plaintextFooter)
+		}
+
+		options := ReaderOptions{Decryption: decryption, MaskColumnsWithoutKeys: true}
+		if plaintextFooter {
+			// the plaintext columns of the file can be read without any key
+			options.Decryption = nil
+		}
+		if f, err = OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err != nil {
+			t.Fatal(err)
+		}
+		if cc = f.RowGroups()[0].ColumnChunk("value"); !cc.Masked() {
+			t.Errorf("plaintext footer %t: chunk not masked", plaintextFooter)
+		}
+		rows := f.RowGroups()[0].Rows()
+		n := 0
+		for ; rows.Scan(); n++ {
+			if v, ok := rows.Row()["value"]; !ok || v != nil {
+				t.Errorf("plaintext footer %t: got value %v, %t in row %d", plaintextFooter, v, ok, n)
+			}
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatalf("plaintext footer %t: %s", plaintextFooter, err)
+		}
+		if n != len(values) {
+			t.Errorf("plaintext footer %t: got %d rows, want %d", plaintextFooter, n, len(values))
+		}
+
+		// the chunks the reader has the key of are not masked
+		options.Decryption = &encryption.DecryptionProperties{FooterKey: footerKey, ColumnKeys: map[string][]byte{"value": columnKey}}
+		if f, err = OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options); err != nil {
+			t.Fatal(err)
+		}
+		triples, err := f.RowGroups()[0].ColumnChunk("value").Triples()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(triples) != len(values) || triples[0].Value != values[0] {
+			t.Errorf("plaintext footer %t: got %v", plaintextFooter, triples)
+		}
+	}
+}
+
+func TestColumnChunkDictionaryEncoded(t *testing.T) {
+	values := make([]int32, 100)
+	for i := range values {
+		values[i] = int32(i % 10)
+	}
+	f := pageIndexTestFile(t, values, 64)
+	cc := f.RowGroups()[0].ColumnChunks()[0]
+
+	if encoded, ok := cc.DictionaryEncoded(); !encoded || !ok {
+		t.Errorf("got %v, %v for a dictionary encoded chunk", encoded, ok)
+	}
+
+	stats := cc.EncodingStats()
+	stats = append(stats, &thrift.PageEncodingStats{PageType: thrift.PageType_DATA_PAGE_V2, Encoding: thrift.Encoding_PLAIN, Count: 1})
+	cc.Metadata().EncodingStats = stats
+	if encoded, ok := cc.DictionaryEncoded(); encoded || !ok {
+		t.Errorf("got %v, %v for a chunk with a PLAIN page", encoded, ok)
+	}
+
+	cc.Metadata().EncodingStats = nil
+	if _, ok := cc.DictionaryEncoded(); ok {
+		t.Errorf("got ok without encoding stats")
+	}
+}
+
+func TestColumnChunkZeroDictionaryOffset(t *testing.T) {
+	const schema = `message root {
+  required int64 id;
+  required binary name (STRING);
+}`
+	rows := []map[string]interface{}{{"id": int64(1), "name": "a"}, {"id": int64(2), "name": "b"}}
+	f := writeListTestFile(t, schema, WriterOptions{ColumnOptions: ColumnOptions{DisableDictionary: true}}, rows)
+	// the dictionary_page_offset of some writers for the chunks without a
+	// dictionary
+	var zero int64
+	for _, cc := range f.RowGroups()[0].ColumnChunks() {
+		cc.Metadata().DictionaryPageOffset = &zero
+	}
+	want := []map[string]interface{}{{"id": int64(1), "name": []byte("a")}, {"id": int64(2), "name": []byte("b")}}
+	if got := readTestRows(t, f); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	var b bytes.Buffer
+	if err := MergeFiles(&b, f, f); err != nil {
+		t.Fatal(err)
+	}
+	merged, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readTestRows(t, merged); !reflect.DeepEqual(got, append(want, want...)) {
+		t.Errorf("got merged rows %v, want %v", got, append(want, want...))
+	}
+}
+
+func TestRowGroupSortingColumns(t *testing.T) {
+	f := pageIndexTestFile(t, []int32{3, 2, 1}, 64)
+	rg := f.RowGroups()[0]
+	if columns, err := rg.SortingColumns(); columns != nil || err != nil {
+		t.Errorf("got %v, %v without sorting columns", columns, err)
+	}
+
+	rg.Metadata().SortingColumns = []*thrift.SortingColumn{{ColumnIdx: 0, Descending: true}}
+	columns, err := rg.SortingColumns()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []SortingColumn{{Column: "value", Descending: true}}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("got %v, want %v", columns, want)
+	}
 
+	rg.Metadata().SortingColumns[0].ColumnIdx = 1
+	if _, err := rg.SortingColumns(); err == nil {
+		t.Errorf("no error for an invalid column index")
+	}
 }
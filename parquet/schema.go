@@ -117,9 +117,7 @@ func (s *Schema) AddColumnFromSpec(format string) error {
 
 	}
 
-	s.columns[el.Name] = ColumnDescriptor{
-		SchemaElement: el,
-	}
+	s.addColumn(el)
 
 	return nil
 }
@@ -164,11 +162,21 @@ func (s *Schema) AddColumnFromThriftSchema(spec map[string]interface{}) error {
 		return fmt.Errorf("unsupported type: %s", type_)
 	}
 
+	s.addColumn(el)
+
+	return nil
+}
+
+// addColumn adds the column el, after the columns already added unless it
+// replaces one of them.
+func (s *Schema) addColumn(el *thrift.SchemaElement) {
+	normalizeAnnotations(el)
+	if _, ok := s.columns[el.Name]; !ok {
+		s.columnsSequence = append(s.columnsSequence, el.Name)
+	}
 	s.columns[el.Name] = ColumnDescriptor{
 		SchemaElement: el,
 	}
-
-	return nil
 }
 
 // ColumnDescriptor contains information about a single column in a parquet file.
@@ -181,6 +189,16 @@ type ColumnDescriptor struct {
 	index int
 }
 
+// LogicalType returns the logical type of the column in the annotation
+// format of String, e.g. "JSON", "BSON", "STRING" or "DECIMAL(9,2)", "" if
+// the column has none.
+func (cd *ColumnDescriptor) LogicalType() string {
+	if cd.SchemaElement.ConvertedType == nil && cd.SchemaElement.LogicalType == nil {
+		return ""
+	}
+	return logicalTypeAnnotation(cd.SchemaElement)
+}
+
 func (schema *Schema) createMetadata() *thrift.FileMetaData {
 	root_children := int32(1)
 
@@ -227,6 +245,13 @@ func (schema *Schema) createMetadata() *thrift.FileMetaData {
 	// 	schema = append(schema, columnDescriptor)
 	// }
 
+	// the min and max statistics of all the columns use the order of
+	// their type
+	columnOrders := make([]*thrift.ColumnOrder, 0, len(schema.Elements()))
+	for range schema.Elements() {
+		columnOrders = append(columnOrders, &thrift.ColumnOrder{TYPE_ORDER: thrift.NewTypeDefinedOrder()})
+	}
+
 	// write metadata at then end of the file in thrift format
 	meta := thrift.FileMetaData{
 		Version:          0,
@@ -234,6 +259,7 @@ func (schema *Schema) createMetadata() *thrift.FileMetaData {
 		RowGroups:        []*thrift.RowGroup{},
 		KeyValueMetadata: []*thrift.KeyValue{},
 		CreatedBy:        strptr("go-0.1"), // go-parquet version 1.0 (build 6cf94d29b2b7115df4de2c06e2ab4326d721eb55)
+		ColumnOrders:     columnOrders,
 	}
 
 	return &meta
@@ -241,6 +267,9 @@ func (schema *Schema) createMetadata() *thrift.FileMetaData {
 
 // schemaFromFileMetaData creates a Schema from meta.
 func schemaFromFileMetaData(meta *thrift.FileMetaData) (*Schema, error) {
+	for _, element := range meta.Schema {
+		normalizeAnnotations(element)
+	}
 	s := Schema{}
 	end, err := s.root.create(meta.Schema, 0)
 	if err != nil {
@@ -255,7 +284,10 @@ func schemaFromFileMetaData(meta *thrift.FileMetaData) (*Schema, error) {
 	maxLevels := s.root.calcMaxLevels()
 	schemaElements := s.root.makeSchemaElements()
 	s.columns = make(map[string]ColumnDescriptor)
-	for name, lvls := range maxLevels {
+	// the columns are in the order of the schema, the order of the column
+	// chunks of the row groups
+	for _, name := range s.root.columnNames() {
+		lvls := maxLevels[name]
 		se, ok := schemaElements[name]
 		if !ok {
 			panic("should not happen")
@@ -291,6 +323,141 @@ func (s *Schema) DisplayString() string {
 	return b.String()
 }
 
+// String returns the schema in the message format of parquet-mr, e.g. the
+// output of parquet-tools schema, with the logical type annotations of the
+// converted types: binary for byte_array, STRING for UTF8, INTEGER(8,true)
+// for INT_8, TIMESTAMP(MILLIS,true) for TIMESTAMP_MILLIS, etc. ParseSchema
+// parses it back.
+func (s *Schema) String() string {
+	var b bytes.Buffer
+	fmt.Fprint(&b, "message")
+	if name := s.root.schemaElement.GetName(); name != "" {
+		fmt.Fprintf(&b, " %s", name)
+	}
+	fmt.Fprintln(&b, " {")
+	s.root.writeFields(&b, "  ")
+	fmt.Fprintln(&b, "}")
+	return b.String()
+}
+
+// writeFields writes the children of g in the message format of parquet-mr.
+func (g *group) writeFields(w io.Writer, indent string) {
+	for _, child := range g.children {
+		var s *thrift.SchemaElement
+		switch c := child.(type) {
+		case *primitive:
+			s = c.schemaElement
+			typ := strings.ToLower(s.Type.String())
+			switch *s.Type {
+			case thrift.Type_BYTE_ARRAY:
+				typ = "binary"
+			case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+				typ = fmt.Sprintf("%s(%d)", typ, s.GetTypeLength())
+			}
+			fmt.Fprintf(w, "%s%s %s %s", indent, strings.ToLower(s.RepetitionType.String()), typ, s.Name)
+		case *group:
+			s = c.schemaElement
+			fmt.Fprintf(w, "%s%s group %s", indent, strings.ToLower(s.RepetitionType.String()), s.Name)
+		default:
+			panic("unexpected child type")
+		}
+		if s.ConvertedType != nil || s.LogicalType != nil {
+			fmt.Fprintf(w, " (%s)", logicalTypeAnnotation(s))
+		}
+		if s.FieldID != nil {
+			fmt.Fprintf(w, " = %d", *s.FieldID)
+		}
+		if c, ok := child.(*group); ok {
+			fmt.Fprintln(w, " {")
+			c.writeFields(w, indent+"  ")
+			fmt.Fprintf(w, "%s}\n", indent)
+		} else {
+			fmt.Fprintln(w, ";")
+		}
+	}
+}
+
+// logicalTypeAnnotation returns the annotation of parquet-mr of the logical
+// type of s, of its converted type if it has none.
+func logicalTypeAnnotation(s *thrift.SchemaElement) string {
+	switch lt := s.GetLogicalType(); {
+	case lt == nil:
+	case lt.IsSetTIMESTAMP():
+		return fmt.Sprintf("TIMESTAMP(%s,%t)", timeUnitName(lt.TIMESTAMP.GetUnit()), lt.TIMESTAMP.IsAdjustedToUTC)
+	case lt.IsSetTIME():
+		return fmt.Sprintf("TIME(%s,%t)", timeUnitName(lt.TIME.GetUnit()), lt.TIME.IsAdjustedToUTC)
+	case lt.IsSetDATE():
+		return "DATE"
+	case lt.IsSetUUID():
+		return "UUID"
+	case lt.IsSetJSON():
+		return "JSON"
+	case lt.IsSetBSON():
+		return "BSON"
+	case lt.IsSetENUM():
+		return "ENUM"
+	case lt.IsSetFLOAT16():
+		return "FLOAT16"
+	case lt.IsSetINTEGER():
+		return fmt.Sprintf("INTEGER(%d,%t)", lt.INTEGER.BitWidth, lt.INTEGER.IsSigned)
+	case lt.IsSetLIST():
+		return "LIST"
+	case lt.IsSetMAP():
+		return "MAP"
+	case lt.IsSetGEOMETRY():
+		if crs := lt.GEOMETRY.GetCrs(); crs != "" {
+			return fmt.Sprintf("GEOMETRY(%s)", crs)
+		}
+		return "GEOMETRY"
+	case lt.IsSetGEOGRAPHY():
+		crs := lt.GEOGRAPHY.GetCrs()
+		if lt.GEOGRAPHY.IsSetAlgorithm() {
+			if crs == "" {
+				crs = defaultCRS
+			}
+			return fmt.Sprintf("GEOGRAPHY(%s,%s)", crs, lt.GEOGRAPHY.GetAlgorithm())
+		}
+		if crs != "" {
+			return fmt.Sprintf("GEOGRAPHY(%s)", crs)
+		}
+		return "GEOGRAPHY"
+	case lt.IsSetVARIANT():
+		if lt.VARIANT.IsSetSpecificationVersion() {
+			return fmt.Sprintf("VARIANT(%d)", lt.VARIANT.GetSpecificationVersion())
+		}
+		return "VARIANT"
+	}
+	switch ct := s.GetConvertedType(); ct {
+	case thrift.ConvertedType_UTF8:
+		return "STRING"
+	case thrift.ConvertedType_DECIMAL:
+		return fmt.Sprintf("DECIMAL(%d,%d)", s.GetPrecision(), s.GetScale())
+	case thrift.ConvertedType_TIME_MILLIS, thrift.ConvertedType_TIME_MICROS:
+		return fmt.Sprintf("TIME(%s,true)", strings.TrimPrefix(ct.String(), "TIME_"))
+	case thrift.ConvertedType_TIMESTAMP_MILLIS, thrift.ConvertedType_TIMESTAMP_MICROS:
+		return fmt.Sprintf("TIMESTAMP(%s,true)", strings.TrimPrefix(ct.String(), "TIMESTAMP_"))
+	case thrift.ConvertedType_INT_8, thrift.ConvertedType_INT_16, thrift.ConvertedType_INT_32, thrift.ConvertedType_INT_64:
+		return fmt.Sprintf("INTEGER(%s,true)", strings.TrimPrefix(ct.String(), "INT_"))
+	case thrift.ConvertedType_UINT_8, thrift.ConvertedType_UINT_16, thrift.ConvertedType_UINT_32, thrift.ConvertedType_UINT_64:
+		return fmt.Sprintf("INTEGER(%s,false)", strings.TrimPrefix(ct.String(), "UINT_"))
+	default:
+		return ct.String()
+	}
+}
+
+// timeUnitName returns the name of the unit u in the annotations.
+func timeUnitName(u *thrift.TimeUnit) string {
+	switch {
+	case u.IsSetMILLIS():
+		return "MILLIS"
+	case u.IsSetMICROS():
+		return "MICROS"
+	case u.IsSetNANOS():
+		return "NANOS"
+	}
+	return "UNKNOWN"
+}
+
 type schemaElement interface {
 	create(schema []*thrift.SchemaElement, start int) (next int, err error)
 
@@ -430,6 +597,24 @@ func (g *group) calcMaxLevels() map[string]Levels {
 	return lvls
 }
 
+// columnNames returns the names of the leaves of g, depth first.
+func (g *group) columnNames() []string {
+	var names []string
+	for _, child := range g.children {
+		switch c := child.(type) {
+		case *primitive:
+			names = append(names, c.schemaElement.Name)
+		case *group:
+			for _, k := range c.columnNames() {
+				names = append(names, c.schemaElement.Name+"."+k)
+			}
+		default:
+			panic("unexpected child type")
+		}
+	}
+	return names
+}
+
 func (g *group) makeSchemaElements() map[string]*thrift.SchemaElement {
 	m := make(map[string]*thrift.SchemaElement)
 	for _, child := range g.children {
@@ -522,7 +707,7 @@ func (p *primitive) writeTo(w io.Writer, indent string) {
 		fmt.Fprint(w, " (")
 		fmt.Fprint(w, s.ConvertedType.String())
 		if *s.ConvertedType == thrift.ConvertedType_DECIMAL {
-			fmt.Fprintf(w, "(%d,%d)", s.Precision, s.Scale)
+			fmt.Fprintf(w, "(%d,%d)", s.GetPrecision(), s.GetScale())
 		}
 		fmt.Fprint(w, ")")
 	}
@@ -0,0 +1,455 @@
+package parquet
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	intervalType = reflect.TypeOf(Interval{})
+	float16Type  = reflect.TypeOf(Float16(0))
+	bytesType    = reflect.TypeOf([]byte(nil))
+	enumType     = reflect.TypeOf((*Enum)(nil)).Elem()
+)
+
+// Enum is implemented by the string types of the enums, the columns of their
+// fields are ENUM columns of the values returned by EnumValues.
+type Enum interface {
+	EnumValues() []string
+}
+
+// SchemaOf returns the schema of the values of v, a struct or a pointer to
+// a struct. Each exported field is a column, or a group of columns, named
+// after the field or the name of its "parquet" tag, "-" to skip the field.
+// The fields of the embedded structs without a tag are the fields of the
+// struct. The Go types are mapped to the parquet types:
+//
+//	bool                   BOOLEAN
+//	int8, int16, int32     INT32 (INT_8, INT_16)
+//	uint8, uint16, uint32  INT32 (UINT_8, UINT_16, UINT_32)
+//	int, int64             INT64
+//	uint, uint64           INT64 (UINT_64)
+//	float32                FLOAT
+//	float64                DOUBLE
+//	string                 BYTE_ARRAY (UTF8)
+//	[]byte                 BYTE_ARRAY
+//	[N]byte                FIXED_LEN_BYTE_ARRAY of N bytes
+//	UUID                   FIXED_LEN_BYTE_ARRAY of 16 bytes (UUID)
+//	time.Time              INT64 (TIMESTAMP_MICROS)
+//	Interval               FIXED_LEN_BYTE_ARRAY of 12 bytes (INTERVAL)
+//	Float16                FIXED_LEN_BYTE_ARRAY of 2 bytes (FLOAT16)
+//	string type of Enum    BYTE_ARRAY (ENUM)
+//	struct                 group of the fields
+//	[]T, [N]T              group (LIST) of the elements
+//	map[K]V                group (MAP) of the keys and the values
+//	interface{}            group (VARIANT(1)) of the metadata and the value
+//
+// The UUID types are the types named UUID of 16 bytes, e.g. the UUID of
+// github.com/google/uuid. The lists and the maps have the three levels of
+// the parquet spec: a repeated group "list" of an "element", and a repeated
+// group "key_value" of a "key" and a "value". The fields, the elements and
+// the values are required, or optional if they are pointers.
+//
+// The name of a tag is followed by options separated by commas, e.g.
+// `parquet:"name,optional,zstd,delta,fieldid=7,bloom"`:
+//
+//	optional     the field is optional
+//	enum         the string or []byte field is an ENUM column
+//	fieldid=N    the field_id of the field is N
+//	bloom        the column has a Bloom filter
+//	dict         the column is dictionary encoded
+//	delta        delta_binary_packed for the integers,
+//	             delta_length_byte_array for the byte arrays
+//	split        byte_stream_split
+//	<encoding>   an encoding of ColumnOptions.Encoding, e.g. "plain"
+//	<codec>      a codec of ColumnOptions.Compression, e.g. "snappy"
+//
+// The options of the columns apply to all the columns of the groups and
+// are returned by ColumnOptionsOf, with the EnumValues of the Enum types,
+// they are validated by SchemaOf. The structs are written by Writer.Write
+// and read by RowScanner.Decode.
+func SchemaOf(v interface{}) (*Schema, error) {
+	b, err := buildSchema(v)
+	if err != nil {
+		return nil, err
+	}
+	return schemaFromFileMetaData(&thrift.FileMetaData{Schema: b.elements})
+}
+
+// ColumnOptionsOf returns the options of the columns of SchemaOf(v) set by
+// the tags of the fields of v, by column name, e.g. for the Columns of the
+// WriterOptions. A column without options has no entry.
+func ColumnOptionsOf(v interface{}) (map[string]ColumnOptions, error) {
+	b, err := buildSchema(v)
+	if err != nil {
+		return nil, err
+	}
+	return b.columns, nil
+}
+
+// buildSchema returns the builder of the schema of v, see SchemaOf.
+func buildSchema(v interface{}) (*schemaBuilder, error) {
+	t := reflect.TypeOf(v)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("schema of %T: not a struct", v)
+	}
+
+	b := &schemaBuilder{visiting: make(map[reflect.Type]bool), columns: make(map[string]ColumnOptions)}
+	if err := b.addGroup(&thrift.SchemaElement{Name: "root"}, t, columnTag{}); err != nil {
+		return nil, fmt.Errorf("schema of %s: %s", t, err)
+	}
+	return b, nil
+}
+
+// schemaBuilder builds the schema elements of a struct, depth first, and
+// the options of its columns.
+type schemaBuilder struct {
+	elements []*thrift.SchemaElement
+	columns  map[string]ColumnOptions
+	path     []string              // the names of the groups of the element added
+	visiting map[reflect.Type]bool // the structs being added, for recursive types
+}
+
+// fieldTag is the "parquet" tag of a field.
+type fieldTag struct {
+	name     string
+	optional bool
+	fieldID  *int32
+	column   columnTag
+}
+
+// columnTag are the options of a tag for the columns of the field.
+type columnTag struct {
+	encoding    string // "delta" depends on the type of each column
+	compression string
+	bloom       bool
+	enum        bool
+}
+
+// parseFieldTag parses the tag of f, named after f if the tag has no name.
+func parseFieldTag(f reflect.StructField) (fieldTag, error) {
+	tag, ok := f.Tag.Lookup("parquet")
+	if !ok {
+		return fieldTag{name: f.Name}, nil
+	}
+	options := strings.Split(tag, ",")
+	ft := fieldTag{name: options[0]}
+	if ft.name == "" {
+		ft.name = f.Name
+	}
+	for _, option := range options[1:] {
+		var encoding, compression string
+		switch {
+		case option == "optional":
+			ft.optional = true
+		case option == "bloom":
+			ft.column.bloom = true
+		case option == "enum":
+			ft.column.enum = true
+		case strings.HasPrefix(option, "fieldid="):
+			id, err := strconv.ParseInt(strings.TrimPrefix(option, "fieldid="), 10, 32)
+			if err != nil {
+				return ft, fmt.Errorf("invalid field id %q", option)
+			}
+			fieldID := int32(id)
+			ft.fieldID = &fieldID
+		case option == "dict":
+			encoding = "dictionary"
+		case option == "split":
+			encoding = "byte_stream_split"
+		case option == "delta":
+			encoding = option
+		default:
+			if _, err := page.EncodingByName(option); err == nil {
+				encoding = option
+			} else if _, err := page.CodecByName(option); err == nil && option != "" {
+				if err := page.CheckCompression(page.EncodingPreferences{CompressionCodec: option}); err != nil {
+					return ft, err
+				}
+				compression = option
+			} else {
+				return ft, fmt.Errorf("unknown option %q", option)
+			}
+		}
+		if encoding != "" {
+			if ft.column.encoding != "" {
+				return ft, fmt.Errorf("encodings %s and %s", ft.column.encoding, option)
+			}
+			ft.column.encoding = encoding
+		}
+		if compression != "" {
+			if ft.column.compression != "" {
+				return ft, fmt.Errorf("codecs %s and %s", ft.column.compression, option)
+			}
+			ft.column.compression = compression
+		}
+	}
+	return ft, nil
+}
+
+// override returns the options of c overridden by the options set in o.
+func (c columnTag) override(o columnTag) columnTag {
+	if o.encoding != "" {
+		c.encoding = o.encoding
+	}
+	if o.compression != "" {
+		c.compression = o.compression
+	}
+	c.bloom = c.bloom || o.bloom
+	c.enum = c.enum || o.enum
+	return c
+}
+
+// columnOptions returns the options c of the column element, an error if
+// they are not valid for its type.
+func (c columnTag) columnOptions(element *thrift.SchemaElement) (ColumnOptions, error) {
+	t := element.GetType()
+	options := ColumnOptions{Encoding: c.encoding, Compression: c.compression, BloomFilter: c.bloom}
+	if c.encoding == "delta" {
+		switch t {
+		case thrift.Type_INT32, thrift.Type_INT64:
+			options.Encoding = "delta_binary_packed"
+		case thrift.Type_BYTE_ARRAY:
+			options.Encoding = "delta_length_byte_array"
+		default:
+			return options, fmt.Errorf("no delta encoding for %s", t)
+		}
+	}
+	if options.Encoding != "" {
+		enc, _ := page.EncodingByName(options.Encoding)
+		if err := page.CheckEncoding(enc, t); err != nil {
+			return options, err
+		}
+	}
+	if c.bloom && t == thrift.Type_BOOLEAN {
+		return options, fmt.Errorf("no bloom filter for %s", t)
+	}
+	if c.enum && !isEnum(element) {
+		return options, fmt.Errorf("no enum of type %s", t)
+	}
+	return options, nil
+}
+
+// addGroup adds the group element of the fields of the struct t, with the
+// options column.
+func (b *schemaBuilder) addGroup(element *thrift.SchemaElement, t reflect.Type, column columnTag) error {
+	if b.visiting[t] {
+		return fmt.Errorf("recursive type %s", t)
+	}
+	b.visiting[t] = true
+	defer delete(b.visiting, t)
+
+	b.elements = append(b.elements, element)
+	n, err := b.addFields(t, column)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("no fields in %s", t)
+	}
+	numChildren := int32(n)
+	element.NumChildren = &numChildren
+	return nil
+}
+
+// addFields adds the fields of the struct t and returns their number.
+func (b *schemaBuilder) addFields(t reflect.Type, column columnTag) (int, error) {
+	n := 0
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, err := parseFieldTag(f)
+		if err != nil {
+			return 0, fmt.Errorf("field %s: %s", f.Name, err)
+		}
+		_, tagged := f.Tag.Lookup("parquet")
+		switch {
+		case tag.name == "-":
+			continue
+		case f.Anonymous && f.Type.Kind() == reflect.Struct && !tagged:
+			k, err := b.addFields(f.Type, column)
+			if err != nil {
+				return 0, err
+			}
+			n += k
+			continue
+		case f.PkgPath != "":
+			// unexported
+			continue
+		}
+
+		repetition := thrift.FieldRepetitionType_REQUIRED
+		if tag.optional {
+			repetition = thrift.FieldRepetitionType_OPTIONAL
+		}
+		if err := b.addField(tag.name, f.Type, repetition, tag.fieldID, column.override(tag.column)); err != nil {
+			return 0, fmt.Errorf("field %s: %s", f.Name, err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// addField adds the element of the field name of type t, and its children,
+// with the options column.
+func (b *schemaBuilder) addField(name string, t reflect.Type, repetition thrift.FieldRepetitionType, fieldID *int32, column columnTag) error {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		repetition = thrift.FieldRepetitionType_OPTIONAL
+	}
+	element := &thrift.SchemaElement{Name: name, RepetitionType: &repetition, FieldID: fieldID}
+	b.path = append(b.path, name)
+	defer func() {
+		b.path = b.path[:len(b.path)-1]
+	}()
+
+	var enumValues []string
+	switch {
+	case t.Kind() == reflect.String && t.Implements(enumType):
+		element.Type, element.ConvertedType = thrift.TypePtr(thrift.Type_BYTE_ARRAY), thrift.ConvertedTypePtr(thrift.ConvertedType_ENUM)
+		enumValues = reflect.Zero(t).Interface().(Enum).EnumValues()
+	case t == timeType:
+		element.Type, element.ConvertedType = thrift.TypePtr(thrift.Type_INT64), thrift.ConvertedTypePtr(thrift.ConvertedType_TIMESTAMP_MICROS)
+	case t == float16Type:
+		length := int32(2)
+		element.Type, element.TypeLength = thrift.TypePtr(thrift.Type_FIXED_LEN_BYTE_ARRAY), &length
+		element.LogicalType = &thrift.LogicalType{FLOAT16: &thrift.Float16Type{}}
+	case t == intervalType:
+		length := int32(12)
+		element.Type, element.TypeLength = thrift.TypePtr(thrift.Type_FIXED_LEN_BYTE_ARRAY), &length
+		element.ConvertedType = thrift.ConvertedTypePtr(thrift.ConvertedType_INTERVAL)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		element.Type = thrift.TypePtr(thrift.Type_BYTE_ARRAY)
+	case t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8:
+		length := int32(t.Len())
+		element.Type, element.TypeLength = thrift.TypePtr(thrift.Type_FIXED_LEN_BYTE_ARRAY), &length
+		if t.Name() == "UUID" && length == 16 {
+			element.LogicalType = &thrift.LogicalType{UUID: &thrift.UUIDType{}}
+		}
+	case t.Kind() == reflect.Struct:
+		return b.addGroup(element, t, column)
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		return b.addList(element, t.Elem(), column)
+	case t.Kind() == reflect.Map:
+		return b.addMap(element, t.Key(), t.Elem(), column)
+	case t.Kind() == reflect.Interface && t.NumMethod() == 0:
+		return b.addVariant(element, column)
+	default:
+		physical, converted, ok := primitiveTypeOf(t.Kind())
+		if !ok {
+			return fmt.Errorf("unsupported type %s", t)
+		}
+		element.Type = &physical
+		if converted >= 0 {
+			element.ConvertedType = &converted
+		}
+	}
+	if column.enum && (t.Kind() == reflect.String || t.Kind() == reflect.Slice) {
+		element.ConvertedType = thrift.ConvertedTypePtr(thrift.ConvertedType_ENUM)
+	}
+	b.elements = append(b.elements, element)
+
+	if column != (columnTag{}) || enumValues != nil {
+		options, err := column.columnOptions(element)
+		if err != nil {
+			return err
+		}
+		options.EnumValues = enumValues
+		b.columns[strings.Join(b.path, ".")] = options
+	}
+	return nil
+}
+
+// addList adds the LIST group element of the elements of type elem.
+func (b *schemaBuilder) addList(element *thrift.SchemaElement, elem reflect.Type, column columnTag) error {
+	one := int32(1)
+	element.ConvertedType, element.NumChildren = thrift.ConvertedTypePtr(thrift.ConvertedType_LIST), &one
+	repeated := thrift.FieldRepetitionType_REPEATED
+	b.elements = append(b.elements, element, &thrift.SchemaElement{Name: "list", RepetitionType: &repeated, NumChildren: &one})
+	b.path = append(b.path, "list")
+	defer func() {
+		b.path = b.path[:len(b.path)-1]
+	}()
+	return b.addField("element", elem, thrift.FieldRepetitionType_REQUIRED, nil, column)
+}
+
+// addVariant adds the VARIANT group element of the values of a dynamic type,
+// unshredded.
+func (b *schemaBuilder) addVariant(element *thrift.SchemaElement, column columnTag) error {
+	two, version := int32(2), int8(1)
+	element.NumChildren = &two
+	element.LogicalType = &thrift.LogicalType{VARIANT: &thrift.VariantType{SpecificationVersion: &version}}
+	b.elements = append(b.elements, element)
+	for _, name := range []string{"metadata", "value"} {
+		if err := b.addField(name, bytesType, thrift.FieldRepetitionType_REQUIRED, nil, column); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addMap adds the MAP group element of the keys of type key and the values
+// of type value.
+func (b *schemaBuilder) addMap(element *thrift.SchemaElement, key, value reflect.Type, column columnTag) error {
+	one, two := int32(1), int32(2)
+	element.ConvertedType, element.NumChildren = thrift.ConvertedTypePtr(thrift.ConvertedType_MAP), &one
+	repeated := thrift.FieldRepetitionType_REPEATED
+	b.elements = append(b.elements, element, &thrift.SchemaElement{Name: "key_value", RepetitionType: &repeated, NumChildren: &two})
+	b.path = append(b.path, "key_value")
+	defer func() {
+		b.path = b.path[:len(b.path)-1]
+	}()
+
+	if err := b.addField("key", key, thrift.FieldRepetitionType_REQUIRED, nil, column); err != nil {
+		return fmt.Errorf("key: %s", err)
+	}
+	if k := b.elements[len(b.elements)-1]; k.Type == nil || k.GetRepetitionType() != thrift.FieldRepetitionType_REQUIRED {
+		return fmt.Errorf("key: unsupported type %s, the keys are required values", key)
+	}
+	if err := b.addField("value", value, thrift.FieldRepetitionType_REQUIRED, nil, column); err != nil {
+		return fmt.Errorf("value: %s", err)
+	}
+	return nil
+}
+
+// primitiveTypeOf returns the physical type and the converted type, -1 if
+// none, of the values of kind k.
+func primitiveTypeOf(k reflect.Kind) (thrift.Type, thrift.ConvertedType, bool) {
+	switch k {
+	case reflect.Bool:
+		return thrift.Type_BOOLEAN, -1, true
+	case reflect.Int8:
+		return thrift.Type_INT32, thrift.ConvertedType_INT_8, true
+	case reflect.Int16:
+		return thrift.Type_INT32, thrift.ConvertedType_INT_16, true
+	case reflect.Int32:
+		return thrift.Type_INT32, -1, true
+	case reflect.Uint8:
+		return thrift.Type_INT32, thrift.ConvertedType_UINT_8, true
+	case reflect.Uint16:
+		return thrift.Type_INT32, thrift.ConvertedType_UINT_16, true
+	case reflect.Uint32:
+		return thrift.Type_INT32, thrift.ConvertedType_UINT_32, true
+	case reflect.Int, reflect.Int64:
+		return thrift.Type_INT64, -1, true
+	case reflect.Uint, reflect.Uint64:
+		return thrift.Type_INT64, thrift.ConvertedType_UINT_64, true
+	case reflect.Float32:
+		return thrift.Type_FLOAT, -1, true
+	case reflect.Float64:
+		return thrift.Type_DOUBLE, -1, true
+	case reflect.String:
+		return thrift.Type_BYTE_ARRAY, thrift.ConvertedType_UTF8, true
+	}
+	return 0, -1, false
+}
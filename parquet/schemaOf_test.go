@@ -0,0 +1,255 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+type schemaOfAddress struct {
+	Street string
+	Zip    *int32 `parquet:"zip_code"`
+}
+
+type schemaOfBase struct {
+	ID int64 `parquet:"id"`
+}
+
+type schemaOfRecord struct {
+	schemaOfBase
+	Name     string
+	Nickname string `parquet:",optional"`
+	Age      uint8
+	Score    *float64
+	Ratio    float32
+	Valid    bool
+	Data     []byte
+	Hash     [16]byte
+	Created  time.Time
+	Address  schemaOfAddress
+	Previous *schemaOfAddress
+	Tags     []string
+	Scores   []*int16
+	Counts   map[string]int
+	Ignored  int `parquet:"-"`
+	private  int
+}
+
+func TestSchemaOf(t *testing.T) {
+	s, err := SchemaOf(&schemaOfRecord{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `message root {
+  required int64 id;
+  required byte_array Name (UTF8);
+  optional byte_array Nickname (UTF8);
+  required int32 Age (UINT_8);
+  optional double Score;
+  required float Ratio;
+  required boolean Valid;
+  required byte_array Data;
+  required fixed_len_byte_array(16) Hash;
+  required int64 Created (TIMESTAMP_MICROS);
+  required group Address {
+    required byte_array Street (UTF8);
+    optional int32 zip_code;
+  }
+  optional group Previous {
+    required byte_array Street (UTF8);
+    optional int32 zip_code;
+  }
+  required group Tags (LIST) {
+    repeated group list {
+      required byte_array element (UTF8);
+    }
+  }
+  required group Scores (LIST) {
+    repeated group list {
+      optional int32 element (INT_16);
+    }
+  }
+  required group Counts (MAP) {
+    repeated group key_value {
+      required byte_array key (UTF8);
+      required int64 value;
+    }
+  }
+}`
+	if got := s.DisplayString(); got != want {
+		t.Errorf("DisplayString: got\n%s\nwant\n%s", got, want)
+	}
+
+	levels := map[string]Levels{
+		"id":                     {},
+		"Nickname":               {D: 1},
+		"Previous.zip_code":      {D: 2},
+		"Tags.list.element":      {D: 1, R: 1},
+		"Scores.list.element":    {D: 2, R: 1},
+		"Counts.key_value.key":   {D: 1, R: 1},
+		"Counts.key_value.value": {D: 1, R: 1},
+		"Address.zip_code":       {D: 1},
+	}
+	for name, want := range levels {
+		col := s.ColumnByName(name)
+		if col == nil {
+			t.Errorf("no column %s", name)
+			continue
+		}
+		if col.MaxLevels != want {
+			t.Errorf("%s: got levels %+v, want %+v", name, col.MaxLevels, want)
+		}
+	}
+	if got := s.Columns(); len(got) != 18 || got[0] != "id" || got[17] != "Counts.key_value.value" {
+		t.Errorf("got columns %v", got)
+	}
+}
+
+type schemaOfNode struct {
+	Value    int32
+	Children []schemaOfNode
+}
+
+func TestSchemaOfErrors(t *testing.T) {
+	for _, v := range []interface{}{
+		nil,
+		42,
+		struct{}{},
+		struct{ C chan int }{},
+		struct{ M map[*string]int }{},
+		struct{ M map[schemaOfAddress]int }{},
+		struct {
+			A int `parquet:"a,unknown"`
+		}{},
+		struct {
+			A int `parquet:"a,fieldid=x"`
+		}{},
+		struct {
+			A int `parquet:"a,plain,dict"`
+		}{},
+		struct {
+			A int `parquet:"a,snappy,gzip"`
+		}{},
+		struct {
+			A int `parquet:"a,lz4"`
+		}{},
+		struct {
+			A float64 `parquet:"a,delta"`
+		}{},
+		struct {
+			A bool `parquet:"a,dict"`
+		}{},
+		struct {
+			A bool `parquet:"a,bloom"`
+		}{},
+		struct {
+			A string `parquet:"a,split"`
+		}{},
+		struct {
+			A struct{ B float32 } `parquet:"a,delta"`
+		}{},
+		schemaOfNode{},
+	} {
+		if _, err := SchemaOf(v); err == nil {
+			t.Errorf("no error for %T", v)
+		} else {
+			t.Log(err)
+		}
+	}
+
+	if _, err := SchemaOf(struct{ A, B schemaOfAddress }{}); err != nil {
+		t.Errorf("got %s for a struct used twice", err)
+	}
+}
+
+type schemaOfTagged struct {
+	ID      int64           `parquet:"id,delta,fieldid=1,bloom"`
+	Name    string          `parquet:"name,optional,dict,zstd,fieldid=2"`
+	Ratio   float64         `parquet:"ratio,split"`
+	Plain   int32           `parquet:"plain,plain,uncompressed"`
+	Address schemaOfAddress `parquet:"address,delta,snappy,fieldid=3"`
+	Tags    []string        `parquet:"tags,gzip"`
+	None    int32
+}
+
+func TestSchemaOfTagOptions(t *testing.T) {
+	s, err := SchemaOf(schemaOfTagged{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `message root {
+  required int64 id = 1;
+  optional byte_array name (UTF8) = 2;
+  required double ratio;
+  required int32 plain;
+  required group address = 3 {
+    required byte_array Street (UTF8);
+    optional int32 zip_code;
+  }
+  required group tags (LIST) {
+    repeated group list {
+      required byte_array element (UTF8);
+    }
+  }
+  required int32 None;
+}`
+	if got := s.DisplayString(); got != want {
+		t.Errorf("DisplayString: got\n%s\nwant\n%s", got, want)
+	}
+
+	columns, err := ColumnOptionsOf(&schemaOfTagged{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantColumns := map[string]ColumnOptions{
+		"id":                {Encoding: "delta_binary_packed", BloomFilter: true},
+		"name":              {Encoding: "dictionary", Compression: "zstd"},
+		"ratio":             {Encoding: "byte_stream_split"},
+		"plain":             {Encoding: "plain", Compression: "uncompressed"},
+		"address.Street":    {Encoding: "delta_length_byte_array", Compression: "snappy"},
+		"address.zip_code":  {Encoding: "delta_binary_packed", Compression: "snappy"},
+		"tags.list.element": {Compression: "gzip"},
+	}
+	if !reflect.DeepEqual(columns, wantColumns) {
+		t.Errorf("got column options %+v, want %+v", columns, wantColumns)
+	}
+
+	// the options are those of the Writer
+	type flat struct {
+		ID   int64  `parquet:"id,delta,bloom"`
+		Name string `parquet:"name,dict,zstd"`
+	}
+	s, err = SchemaOf(flat{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if columns, err = ColumnOptionsOf(flat{}); err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{Columns: columns})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(map[string]interface{}{"id": int64(1), "name": "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rg := f.RowGroups()[0]
+	if codec := rg.ColumnChunk("name").Metadata().GetCodec(); codec != thrift.CompressionCodec_ZSTD {
+		t.Errorf("got codec %s for name", codec)
+	}
+	if filter, err := rg.ColumnChunk("id").BloomFilter(); err != nil || filter == nil {
+		t.Errorf("got bloom filter %v, %v for id", filter, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/kostya-sh/parquet-go/parquet/thrift"
@@ -202,6 +203,57 @@ func TestCreateSchemaFromFileMetaDataAndMarshal(t *testing.T) {
 	}
 }
 
+func TestSchemaString(t *testing.T) {
+	s, err := ParseSchema(`message test.Message {
+  required byte_array s (UTF8) = 1;
+  optional fixed_len_byte_array(16) d (DECIMAL(38,2));
+  required int32 i8 (INT_8);
+  required int64 u64 (UINT_64);
+  required int32 day (DATE);
+  required int32 t (TIME_MILLIS);
+  required int64 ts (TIMESTAMP_MICROS);
+  optional int96 legacy;
+  optional group m (MAP) = 2 {
+    repeated group key_value (MAP_KEY_VALUE) {
+      required byte_array key (UTF8);
+      optional byte_array value (JSON);
+    }
+  }
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `message test.Message {
+  required binary s (STRING) = 1;
+  optional fixed_len_byte_array(16) d (DECIMAL(38,2));
+  required int32 i8 (INTEGER(8,true));
+  required int64 u64 (INTEGER(64,false));
+  required int32 day (DATE);
+  required int32 t (TIME(MILLIS,true));
+  required int64 ts (TIMESTAMP(MICROS,true));
+  optional int96 legacy;
+  optional group m (MAP) = 2 {
+    repeated group key_value (MAP_KEY_VALUE) {
+      required binary key (STRING);
+      optional binary value (JSON);
+    }
+  }
+}
+`
+	if got := s.String(); got != want {
+		t.Errorf("String: got \n%s\nwant\n%s", got, want)
+	}
+
+	parsed, err := ParseSchema(s.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := parsed.DisplayString(), s.DisplayString(); got != want {
+		t.Errorf("parsed String: got\n%s\nwant\n%s", got, want)
+	}
+}
+
 var dremelPaperExampleMeta = createFileMetaData(
 	&thrift.SchemaElement{
 		Name:        "Document",
@@ -341,6 +393,15 @@ func TestDremelPaperExampleDisplayString(t *testing.T) {
 	}
 }
 
+func TestDremelPaperExampleColumns(t *testing.T) {
+	s := mustCreateSchema(dremelPaperExampleMeta)
+
+	want := []string{"DocId", "Links.Backward", "Links.Forward", "Name.Language.Code", "Name.Language.Country", "Name.Url"}
+	if got := s.Columns(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Columns: got %v, want %v", got, want)
+	}
+}
+
 func TestReadFileMetaDataFromInvalidFiles(t *testing.T) {
 	invalidFiles := []string{
 		"NoMagicInHeader.parquet",
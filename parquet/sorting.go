@@ -0,0 +1,257 @@
+package parquet
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// SortingColumn is a column the rows of a row group are sorted by.
+type SortingColumn struct {
+	Column     string // name of the column
+	Descending bool
+	NullsFirst bool // whether the nulls are before the values
+}
+
+// sortingColumns returns the thrift sorting columns of the columns of s,
+// their index being the index of the column in the row groups.
+func sortingColumns(s *Schema, columns []SortingColumn) ([]*thrift.SortingColumn, error) {
+	sorting := make([]*thrift.SortingColumn, 0, len(columns))
+	for _, c := range columns {
+		index := -1
+		for i, name := range s.Columns() {
+			if name == c.Column {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("sorting column %s is not in the schema", c.Column)
+		}
+		sorting = append(sorting, &thrift.SortingColumn{
+			ColumnIdx:  int32(index),
+			Descending: c.Descending,
+			NullsFirst: c.NullsFirst,
+		})
+	}
+	return sorting, nil
+}
+
+// sortingVerifier checks that records are written in the order of the
+// sorting columns.
+type sortingVerifier struct {
+	columns []SortingColumn
+	orders  []page.SortOrder
+	last    map[string]interface{}
+	n       int // number of records verified
+}
+
+func newSortingVerifier(s *Schema, columns []SortingColumn) *sortingVerifier {
+	v := &sortingVerifier{columns: columns}
+	for _, c := range columns {
+		order := page.SortOrderSigned
+		if col := s.ColumnByName(c.Column); col != nil {
+			order = page.SortOrderOf(col.SchemaElement)
+		}
+		v.orders = append(v.orders, order)
+	}
+	return v
+}
+
+// reset forgets the records verified.
+func (v *sortingVerifier) reset() {
+	v.last, v.n = nil, 0
+}
+
+// verify returns an error if record is before the previous record.
+func (v *sortingVerifier) verify(record map[string]interface{}) error {
+	defer func() {
+		v.last = record
+		v.n++
+	}()
+	if v.last == nil {
+		return nil
+	}
+
+	for i, c := range v.columns {
+		cmp, err := compareValues(v.last[c.Column], record[c.Column], v.orders[i], c.NullsFirst)
+		if err != nil {
+			return fmt.Errorf("record %d: column %s: %s", v.n, c.Column, err)
+		}
+		if c.Descending {
+			cmp = -cmp
+		}
+		switch {
+		case cmp < 0:
+			return nil
+		case cmp > 0:
+			return fmt.Errorf("record %d is not sorted by column %s: %v after %v", v.n, c.Column, record[c.Column], v.last[c.Column])
+		}
+	}
+	return nil
+}
+
+// compare returns -1, 0 or 1 if the record a is before, equal to or after
+// the record b in the order of the sorting columns.
+func (v *sortingVerifier) compare(a, b map[string]interface{}) (int, error) {
+	for i, c := range v.columns {
+		cmp, err := compareValues(a[c.Column], b[c.Column], v.orders[i], c.NullsFirst)
+		if err != nil {
+			return 0, fmt.Errorf("column %s: %s", c.Column, err)
+		}
+		if c.Descending {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp, nil
+		}
+	}
+	return 0, nil
+}
+
+// compareValues compares the values a and b of a column written with
+// WriteRecords, nil being null, and returns -1, 0 or 1 if a is before, equal
+// to or after b.
+func compareValues(a, b interface{}, order page.SortOrder, nullsFirst bool) (int, error) {
+	switch {
+	case a == nil && b == nil:
+		return 0, nil
+	case a == nil || b == nil:
+		if (a == nil) == nullsFirst {
+			return -1, nil
+		}
+		return 1, nil
+	}
+
+	switch x := sortKey(a).(type) {
+	case int64:
+		y, ok := sortKey(b).(int64)
+		if !ok {
+			break
+		}
+		if order == page.SortOrderUnsigned {
+			return compareUint64(uint64(x), uint64(y)), nil
+		}
+		switch {
+		case x < y:
+			return -1, nil
+		case x > y:
+			return 1, nil
+		}
+		return 0, nil
+	case float64:
+		y, ok := sortKey(b).(float64)
+		if !ok {
+			break
+		}
+		switch {
+		case x < y:
+			return -1, nil
+		case x > y:
+			return 1, nil
+		}
+		return 0, nil
+	case []byte:
+		y, ok := sortKey(b).([]byte)
+		if !ok {
+			break
+		}
+		return bytes.Compare(x, y), nil
+	case *big.Rat:
+		y, ok := b.(*big.Rat)
+		if !ok {
+			break
+		}
+		return x.Cmp(y), nil
+	case time.Time:
+		y, ok := b.(time.Time)
+		if !ok {
+			break
+		}
+		switch {
+		case x.Before(y):
+			return -1, nil
+		case x.After(y):
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("cannot compare %T and %T", a, b)
+}
+
+// sortKey returns v as an int64, a float64 or a []byte, v itself if it
+// cannot be compared.
+func sortKey(v interface{}) interface{} {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return int64(1)
+		}
+		return int64(0)
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	case time.Duration:
+		return int64(v)
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	case string:
+		return []byte(v)
+	case []byte:
+		return v
+	case [16]byte:
+		return v[:]
+	default:
+		return v
+	}
+}
+
+func compareUint64(x, y uint64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// SortingColumns returns the columns the rows of the row group are sorted
+// by, nil if the writer did not declare any.
+func (rg *RowGroup) SortingColumns() ([]SortingColumn, error) {
+	if len(rg.meta.GetSortingColumns()) == 0 {
+		return nil, nil
+	}
+	chunks := rg.ColumnChunks()
+	columns := make([]SortingColumn, 0, len(rg.meta.GetSortingColumns()))
+	for _, c := range rg.meta.GetSortingColumns() {
+		if c.ColumnIdx < 0 || int(c.ColumnIdx) >= len(chunks) {
+			return nil, fmt.Errorf("sorting column %d of %d columns", c.ColumnIdx, len(chunks))
+		}
+		columns = append(columns, SortingColumn{
+			Column:     chunks[c.ColumnIdx].Name(),
+			Descending: c.Descending,
+			NullsFirst: c.NullsFirst,
+		})
+	}
+	return columns, nil
+}
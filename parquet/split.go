@@ -0,0 +1,59 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+)
+
+// SplitFile writes the row groups of f to several files, rowGroups row
+// groups per file, e.g. to process a large file in parallel. The writer of
+// the file i, from 0, is returned by create and closed once the file is
+// written. The column chunks are copied without decoding their pages, as by
+// MergeFiles, and every file has the schema and the metadata of f. The
+// encrypted files are not supported. It returns the number of files
+// written, none if f has no row groups.
+func SplitFile(f *File, rowGroups int, create func(i int) (io.WriteCloser, error)) (int, error) {
+	if rowGroups <= 0 {
+		return 0, fmt.Errorf("split: invalid number of row groups %d", rowGroups)
+	}
+	if f.Encrypted() {
+		return 0, fmt.Errorf("split: the file is encrypted")
+	}
+
+	all := f.RowGroups()
+	n := 0
+	for start := 0; start < len(all); start += rowGroups {
+		end := start + rowGroups
+		if end > len(all) {
+			end = len(all)
+		}
+		if err := splitFile(f, all[start:end], n, create); err != nil {
+			return n, fmt.Errorf("split: file %d: %s", n, err)
+		}
+		n++
+	}
+	return n, nil
+}
+
+// splitFile writes the file i of SplitFile, with the row groups of f.
+func splitFile(f *File, rowGroups []*RowGroup, i int, create func(i int) (io.WriteCloser, error)) error {
+	w, err := create(i)
+	if err != nil {
+		return err
+	}
+	c, err := newCopiedFile(w, *f.meta)
+	if err == nil {
+		for _, rg := range rowGroups {
+			if err = c.copyRowGroup(rg); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		err = c.close()
+	}
+	if cerr := w.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	return err
+}
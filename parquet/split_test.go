@@ -0,0 +1,99 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// closingBuffer is a bytes.Buffer closed by SplitFile.
+type closingBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closingBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestSplitFile(t *testing.T) {
+	rows := writerTestRows(250)
+	options := WriterOptions{
+		ColumnOptions:    ColumnOptions{BloomFilter: true},
+		KeyValueMetadata: map[string]string{"k": "v"},
+		RowGroupRows:     50,
+	}
+	f := writeMergeTestFile(t, rows, options)
+
+	var buffers []*closingBuffer
+	n, err := SplitFile(f, 2, func(i int) (io.WriteCloser, error) {
+		if i != len(buffers) {
+			t.Errorf("got file %d after %d files", i, len(buffers))
+		}
+		b := &closingBuffer{}
+		buffers = append(buffers, b)
+		return b, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 || len(buffers) != 3 {
+		t.Fatalf("got %d files, %d writers", n, len(buffers))
+	}
+
+	var got []map[string]interface{}
+	for i, b := range buffers {
+		if !b.closed {
+			t.Errorf("file %d is not closed", i)
+		}
+		part, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := []int{2, 2, 1}[i]; len(part.RowGroups()) != want {
+			t.Errorf("file %d: got %d row groups, want %d", i, len(part.RowGroups()), want)
+		}
+		if kv := part.KeyValueMetadata(); kv["k"] != "v" {
+			t.Errorf("file %d: got key/value metadata %v", i, kv)
+		}
+		for j, rg := range part.RowGroups() {
+			filter, err := rg.ColumnChunk("id").BloomFilter()
+			if err != nil || filter == nil || !filter.Check(int64(100*i+50*j)) {
+				t.Errorf("file %d: row group %d: got bloom filter %v, %v", i, j, filter, err)
+			}
+		}
+		got = append(got, readTestRows(t, part)...)
+	}
+	if len(got) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), len(rows))
+	}
+	for i, row := range got {
+		if row["id"] != int64(i) {
+			t.Fatalf("row %d: got id %v", i, row["id"])
+		}
+	}
+}
+
+func TestSplitFileErrors(t *testing.T) {
+	f := writeMergeTestFile(t, writerTestRows(100), WriterOptions{RowGroupRows: 50})
+
+	create := func(i int) (io.WriteCloser, error) {
+		return &closingBuffer{}, nil
+	}
+	if _, err := SplitFile(f, 0, create); err == nil {
+		t.Errorf("SplitFile succeeded with 0 row groups per file")
+	}
+
+	errCreate := errors.New("create")
+	n, err := SplitFile(f, 1, func(i int) (io.WriteCloser, error) {
+		if i == 1 {
+			return nil, errCreate
+		}
+		return &closingBuffer{}, nil
+	})
+	if n != 1 || err == nil {
+		t.Errorf("got %d files, %v", n, err)
+	}
+}
@@ -0,0 +1,238 @@
+package parquet
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// StreamPage is a page read by a StreamReader.
+type StreamPage struct {
+	Offset int64 // offset of the page header in the file
+	Header *thrift.PageHeader
+	Data   []byte // page data as stored in the file, possibly compressed
+}
+
+// StreamReader reads a parquet file sequentially from an io.Reader that
+// cannot seek, e.g. an HTTP body or a pipe. Only the current page is held in
+// memory.
+//
+// The footer is at the end of the file so, unlike File, a StreamReader does
+// not know the schema of the file while the pages are read and only knows the
+// column chunk a page belongs to if the writer stored the column chunk
+// metadata after the pages of each chunk, as impala does. Once Scan returns
+// false without error the footer was read and Metadata and ColumnChunk can be
+// used to place all the pages read. Callers that need to decode the values
+// while streaming must know the layout of the file in advance, e.g. a single
+// column file written with a known schema.
+type StreamReader struct {
+	r      replayReader
+	offset int64
+	page   *StreamPage
+	chunks []*thrift.ColumnChunk // column chunks stored after their pages
+	meta   *thrift.FileMetaData
+	err    error
+}
+
+// NewStreamReader checks the magic number at the start of r and returns a
+// StreamReader positioned on the first page.
+func NewStreamReader(r io.Reader) (*StreamReader, error) {
+	s := &StreamReader{r: replayReader{r: bufio.NewReader(r)}}
+
+	buf := make([]byte, magicSize)
+	if _, err := io.ReadFull(&s.r, buf); err != nil {
+		return nil, fmt.Errorf("stream: error reading header: %s", err)
+	}
+	if !bytes.Equal(buf, parquetMagic) {
+		return nil, ErrNotParquetFile
+	}
+	s.offset = s.r.mark()
+
+	return s, nil
+}
+
+// replayReader keeps the bytes read from r since the last mark so that they
+// can be read again.
+type replayReader struct {
+	r   *bufio.Reader
+	buf []byte
+	pos int
+}
+
+func (r *replayReader) Read(p []byte) (int, error) {
+	if r.pos < len(r.buf) {
+		n := copy(p, r.buf[r.pos:])
+		r.pos += n
+		return n, nil
+	}
+	n, err := r.r.Read(p)
+	r.buf = append(r.buf, p[:n]...)
+	r.pos += n
+	return n, err
+}
+
+// mark discards the bytes read so far and returns their number.
+func (r *replayReader) mark() int64 {
+	n := r.pos
+	r.buf = append(r.buf[:0], r.buf[n:]...)
+	r.pos = 0
+	return int64(n)
+}
+
+// rewind makes the bytes read since the last mark available again.
+func (r *replayReader) rewind() {
+	r.pos = 0
+}
+
+// Scan reads the next page. It returns false at the end of the pages or if
+// an error occurred.
+func (s *StreamReader) Scan() bool {
+	if s.err != nil || s.meta != nil {
+		return false
+	}
+	s.page = nil
+
+	for {
+		page, err := s.readPage()
+		if err == nil {
+			s.page = page
+			s.offset += s.r.mark()
+			return true
+		}
+
+		// the bytes that could not be read as a page might be the metadata
+		// of the column chunk just read or the footer
+		s.r.rewind()
+		var chunk thrift.ColumnChunk
+		if cerr := chunk.Read(&s.r); cerr == nil && chunk.GetFileOffset() == s.offset && chunk.IsSetMetaData() {
+			s.chunks = append(s.chunks, &chunk)
+			s.offset += s.r.mark()
+			continue
+		}
+
+		s.r.rewind()
+		meta, ferr := s.readFooter()
+		if ferr != nil {
+			s.err = fmt.Errorf("stream: could not read page at offset %d: %s", s.offset, err)
+			return false
+		}
+		s.meta = meta
+		return false
+	}
+}
+
+func (s *StreamReader) readPage() (*StreamPage, error) {
+	var header thrift.PageHeader
+	if err := header.Read(&s.r); err != nil {
+		return nil, err
+	}
+	if err := validatePageHeader(&header); err != nil {
+		return nil, err
+	}
+
+	// the size is not trusted to allocate the buffer
+	size := int64(header.GetCompressedPageSize())
+	data, err := ioutil.ReadAll(io.LimitReader(&s.r, size))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) != size {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return &StreamPage{Offset: s.offset, Header: &header, Data: data}, nil
+}
+
+// readFooter reads the rest of the stream and parses it as the footer.
+func (s *StreamReader) readFooter() (*thrift.FileMetaData, error) {
+	b, err := ioutil.ReadAll(&s.r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < footerSize || !bytes.Equal(b[len(b)-magicSize:], parquetMagic) {
+		return nil, fmt.Errorf("no footer")
+	}
+	footerLength := int64(int32(binary.LittleEndian.Uint32(b[len(b)-footerSize:])))
+	if footerLength != int64(len(b)-footerSize) {
+		return nil, fmt.Errorf("invalid footer length %d", footerLength)
+	}
+
+	var meta thrift.FileMetaData
+	if err := meta.Read(bytes.NewReader(b[:footerLength])); err != nil {
+		return nil, fmt.Errorf("error reading metadata: %s", err)
+	}
+	return &meta, nil
+}
+
+func validatePageHeader(header *thrift.PageHeader) error {
+	if header.GetCompressedPageSize() < 0 || header.GetUncompressedPageSize() < 0 {
+		return fmt.Errorf("invalid page size")
+	}
+
+	var ok bool
+	switch header.GetType() {
+	case thrift.PageType_DATA_PAGE:
+		ok = header.IsSetDataPageHeader()
+	case thrift.PageType_DATA_PAGE_V2:
+		ok = header.IsSetDataPageHeaderV2()
+	case thrift.PageType_DICTIONARY_PAGE:
+		ok = header.IsSetDictionaryPageHeader()
+	case thrift.PageType_INDEX_PAGE:
+		ok = true
+	}
+	if !ok {
+		return fmt.Errorf("invalid page header for type %s", header.GetType())
+	}
+
+	return nil
+}
+
+// Page returns the page read by the last call to Scan.
+func (s *StreamReader) Page() *StreamPage {
+	return s.page
+}
+
+// Err returns the first error encountered.
+func (s *StreamReader) Err() error {
+	return s.err
+}
+
+// Metadata returns the metadata stored in the footer, nil until Scan
+// returned false without error.
+func (s *StreamReader) Metadata() *thrift.FileMetaData {
+	return s.meta
+}
+
+// ColumnChunk returns the column chunk p belongs to or nil if it is not
+// known yet.
+func (s *StreamReader) ColumnChunk(p *StreamPage) *thrift.ColumnChunk {
+	chunks := s.chunks
+	if s.meta != nil {
+		chunks = nil
+		for _, rg := range s.meta.GetRowGroups() {
+			chunks = append(chunks, rg.GetColumns()...)
+		}
+	}
+
+	for _, col := range chunks {
+		meta := col.GetMetaData()
+		if meta == nil {
+			continue
+		}
+		start := meta.GetDataPageOffset()
+		if meta.GetDictionaryPageOffset() > 0 && meta.GetDictionaryPageOffset() < start {
+			start = meta.GetDictionaryPageOffset()
+		}
+		if p.Offset >= start && p.Offset < start+meta.GetTotalCompressedSize() {
+			return col
+		}
+	}
+
+	return nil
+}
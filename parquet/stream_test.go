@@ -0,0 +1,94 @@
+package parquet
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// onlyReader hides the methods of the underlying reader other than Read.
+type onlyReader struct {
+	io.Reader
+}
+
+func TestStreamReader(t *testing.T) {
+	files := []string{
+		"testdata/alltypes_plain.parquet",
+		"testdata/alltypes_plain.snappy.parquet",
+		"testdata/alltypes_dictionary.parquet",
+		"testdata/nation.impala.parquet",
+		"testdata/customer.impala.parquet",
+	}
+
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s, err := NewStreamReader(onlyReader{bytes.NewReader(b)})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", file, err)
+		}
+		var pages []*StreamPage
+		for s.Scan() {
+			if s.Metadata() != nil {
+				t.Fatalf("%s: metadata available before the end of the pages", file)
+			}
+			pages = append(pages, s.Page())
+		}
+		if err := s.Err(); err != nil {
+			t.Fatalf("%s: unexpected error: %s", file, err)
+		}
+
+		meta := s.Metadata()
+		if meta == nil {
+			t.Fatalf("%s: no metadata", file)
+		}
+		values := make(map[*thrift.ColumnChunk]int64)
+		for _, p := range pages {
+			col := s.ColumnChunk(p)
+			if col == nil {
+				t.Fatalf("%s: no column chunk for the page at offset %d", file, p.Offset)
+			}
+			if h := p.Header.DataPageHeader; h != nil {
+				values[col] += int64(h.NumValues)
+			}
+		}
+		for _, rg := range meta.RowGroups {
+			for _, col := range rg.Columns {
+				if n := values[col]; n != col.MetaData.NumValues {
+					t.Errorf("%s: %v: read %d values, expected %d", file, col.MetaData.PathInSchema, n, col.MetaData.NumValues)
+				}
+			}
+		}
+	}
+}
+
+func TestStreamReaderErrors(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/alltypes_plain.parquet")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewStreamReader(bytes.NewReader([]byte("PAR"))); err == nil {
+		t.Errorf("expected an error for a stream too small")
+	}
+	if _, err := NewStreamReader(bytes.NewReader([]byte("PAR2"))); err != ErrNotParquetFile {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// the footer is missing
+	s, err := NewStreamReader(bytes.NewReader(b[:len(b)-10]))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for s.Scan() {
+	}
+	if s.Err() == nil || s.Metadata() != nil {
+		t.Errorf("expected an error for a truncated stream")
+	}
+}
@@ -0,0 +1,296 @@
+package parquet
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// The structs are the rows of the fields of their schema, see SchemaOf: the
+// Writer splits the values of their fields into the values of the columns
+// with their levels and the RowScanner assembles them back, the nulls of the
+// optional fields as nil pointers.
+
+// structField is a field of a struct, see SchemaOf.
+type structField struct {
+	name  string // the name of the field in the schema
+	index []int  // the index of the field, for reflect.Value.FieldByIndex
+}
+
+// structFields returns the fields of the struct t in the schema, the fields
+// of the embedded structs without a tag included.
+func structFields(t reflect.Type) ([]structField, error) {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, err := parseFieldTag(f)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %s", f.Name, err)
+		}
+		_, tagged := f.Tag.Lookup("parquet")
+		switch {
+		case tag.name == "-":
+			continue
+		case f.Anonymous && f.Type.Kind() == reflect.Struct && !tagged:
+			embedded, err := structFields(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range embedded {
+				e.index = append([]int{i}, e.index...)
+				fields = append(fields, e)
+			}
+			continue
+		case f.PkgPath != "":
+			// unexported
+			continue
+		}
+		fields = append(fields, structField{name: tag.name, index: []int{i}})
+	}
+	return fields, nil
+}
+
+// isStructValue returns whether the struct type t is the type of the values of
+// a column rather than a group.
+func isStructValue(t reflect.Type) bool {
+	return t == timeType || t == intervalType
+}
+
+// rowValue returns the value of v in a row, see Writer.WriteRow: the structs
+// are maps of the values of their fields, the slices and the arrays
+// []interface{}, the maps map[interface{}]interface{}, nil for the nil
+// pointers, slices and maps, and the numbers have the types of their columns.
+func rowValue(v reflect.Value) (interface{}, error) {
+	t := v.Type()
+	switch {
+	case t == float16Type || isStructValue(t):
+		return v.Interface(), nil
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8:
+		if t.Kind() == reflect.Slice && v.IsNil() {
+			return nil, nil
+		}
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return b, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if t.Kind() == reflect.Interface && t.NumMethod() == 0 {
+			// a VARIANT value, of its own type
+			return v.Elem().Interface(), nil
+		}
+		return rowValue(v.Elem())
+	case reflect.Struct:
+		fields, err := structFields(t)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]interface{}, len(fields))
+		for _, f := range fields {
+			fv, err := rowValue(v.FieldByIndex(f.index))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", f.name, err)
+			}
+			m[f.name] = fv
+		}
+		return m, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && v.IsNil() {
+			return nil, nil
+		}
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			e, err := rowValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = e
+		}
+		return list, nil
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		m := make(map[interface{}]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			k, err := rowValue(iter.Key())
+			if err != nil {
+				return nil, err
+			}
+			if b, ok := k.([]byte); ok {
+				// the []byte are not comparable
+				k = string(b)
+			}
+			e, err := rowValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			m[k] = e
+		}
+		return m, nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return int32(v.Int()), nil
+	case reflect.Int, reflect.Int64:
+		if t == durationType {
+			return v.Interface(), nil
+		}
+		return v.Int(), nil
+	case reflect.Uint8:
+		return uint8(v.Uint()), nil
+	case reflect.Uint16:
+		return uint16(v.Uint()), nil
+	case reflect.Uint32:
+		return uint32(v.Uint()), nil
+	case reflect.Uint, reflect.Uint64:
+		return v.Uint(), nil
+	case reflect.Float32:
+		return float32(v.Float()), nil
+	case reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		return v.String(), nil
+	}
+	return v.Interface(), nil
+}
+
+// setValue sets dst to v, a value of a row, see RowScanner.Row: the structs
+// from the maps of the values of their fields, the slices and the arrays from
+// []interface{}, the maps from map[interface{}]interface{}, and the zero
+// value of dst from nil.
+func setValue(dst reflect.Value, v interface{}) error {
+	t := dst.Type()
+	if v == nil {
+		dst.Set(reflect.Zero(t))
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		p := reflect.New(t.Elem())
+		if err := setValue(p.Elem(), v); err != nil {
+			return err
+		}
+		dst.Set(p)
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch {
+	case rv.Type().AssignableTo(t):
+		dst.Set(rv)
+		return nil
+	case t == float16Type:
+		if f, ok := v.(float32); ok {
+			dst.Set(reflect.ValueOf(NewFloat16(f)))
+			return nil
+		}
+	case t.Kind() == reflect.Struct && !isStructValue(t):
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			break
+		}
+		fields, err := structFields(t)
+		if err != nil {
+			return err
+		}
+		for _, f := range fields {
+			fv, ok := m[f.name]
+			if !ok {
+				// not in the file
+				continue
+			}
+			if err := setValue(dst.FieldByIndex(f.index), fv); err != nil {
+				return fmt.Errorf("%s: %s", f.name, err)
+			}
+		}
+		return nil
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t.Elem().Kind() == reflect.Uint8:
+		b, ok := v.([]byte)
+		if !ok {
+			break
+		}
+		if t.Kind() == reflect.Slice {
+			dst.Set(reflect.ValueOf(append([]byte(nil), b...)).Convert(t))
+			return nil
+		}
+		if len(b) != t.Len() {
+			return fmt.Errorf("%d bytes for a %s", len(b), t)
+		}
+		reflect.Copy(dst, reflect.ValueOf(b))
+		return nil
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		list, ok := v.([]interface{})
+		if !ok {
+			break
+		}
+		if t.Kind() == reflect.Slice {
+			dst.Set(reflect.MakeSlice(t, len(list), len(list)))
+		} else if len(list) != t.Len() {
+			return fmt.Errorf("%d elements for a %s", len(list), t)
+		}
+		for i, e := range list {
+			if err := setValue(dst.Index(i), e); err != nil {
+				return err
+			}
+		}
+		return nil
+	case t.Kind() == reflect.Map:
+		m, ok := v.(map[interface{}]interface{})
+		if !ok {
+			break
+		}
+		dst.Set(reflect.MakeMapWithSize(t, len(m)))
+		for k, e := range m {
+			key := reflect.New(t.Key()).Elem()
+			if s, ok := k.(string); ok && t.Key().Kind() != reflect.String {
+				// the keys of the byte array columns
+				k = []byte(s)
+			}
+			if err := setValue(key, k); err != nil {
+				return err
+			}
+			value := reflect.New(t.Elem()).Elem()
+			if err := setValue(value, e); err != nil {
+				return err
+			}
+			dst.SetMapIndex(key, value)
+		}
+		return nil
+	case t.Kind() == reflect.String:
+		switch v := v.(type) {
+		case string:
+			dst.SetString(v)
+			return nil
+		case []byte:
+			dst.SetString(string(v))
+			return nil
+		}
+	case sameKind(rv.Kind(), t.Kind()):
+		dst.Set(rv.Convert(t))
+		return nil
+	}
+	return fmt.Errorf("value %v of type %T for a %s", v, v, t)
+}
+
+// sameKind returns whether the values of the kinds a and b are both booleans,
+// signed integers, unsigned integers or floating point numbers.
+func sameKind(a, b reflect.Kind) bool {
+	kind := func(k reflect.Kind) int {
+		switch k {
+		case reflect.Bool:
+			return 1
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return 2
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return 3
+		case reflect.Float32, reflect.Float64:
+			return 4
+		}
+		return 0
+	}
+	return kind(a) != 0 && kind(a) == kind(b)
+}
@@ -0,0 +1,156 @@
+package parquet
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type structsPoint struct {
+	X, Y int32
+	Tag  *string
+}
+
+type structsRecord struct {
+	schemaOfRecord
+	ID       UUID
+	Color    enumColor
+	Half     Float16
+	Points   []structsPoint
+	Paths    [][]*structsPoint
+	Named    map[string]*structsPoint
+	Nested   *structsNested
+	Matrix   [2][]uint16
+	Duration time.Duration
+}
+
+type structsNested struct {
+	Level  int8
+	Inner  *structsNested2
+	Labels map[int32][]string
+}
+
+type structsNested2 struct {
+	Value *uint64
+	List  []*int64
+}
+
+func TestStructsRoundTrip(t *testing.T) {
+	s, err := SchemaOf(structsRecord{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{ColumnOptions: ColumnOptions{PageValues: 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tag, zip, score, value, n := "t", int32(75001), 1.5, uint64(9), int64(-3)
+	var want []structsRecord
+	for i := 0; i < 20; i++ {
+		r := structsRecord{
+			ID:       UUID{byte(i)},
+			Color:    "green",
+			Half:     NewFloat16(float32(i)),
+			Duration: time.Duration(i) * time.Second,
+		}
+		r.schemaOfRecord.ID, r.Name, r.Age, r.Data, r.Hash[0] = int64(i), "name", uint8(i), []byte{byte(i)}, byte(i)
+		r.Created = time.Date(2020, 1, i+1, 0, 0, 0, 1000*i, time.UTC)
+		r.Address = schemaOfAddress{Street: "street"}
+		if i%2 == 0 {
+			r.Nickname, r.Score = "nick", &score
+			r.Address.Zip = &zip
+			r.Previous = &schemaOfAddress{Street: "previous", Zip: &zip}
+			r.Tags = []string{"a", "b"}
+			r.Scores = []*int16{nil, new(int16)}
+			r.Counts = map[string]int{"x": i}
+			r.Points = []structsPoint{{X: 1, Y: 2}, {X: 3, Y: 4, Tag: &tag}}
+			r.Paths = [][]*structsPoint{{{X: 5}, nil}, {}}
+			r.Named = map[string]*structsPoint{"p": {X: 6}, "nil": nil}
+			r.Matrix = [2][]uint16{{1, 2}, nil}
+		}
+		switch i % 3 {
+		case 1:
+			r.Nested = &structsNested{Level: -1}
+		case 2:
+			r.Nested = &structsNested{
+				Level:  int8(i),
+				Inner:  &structsNested2{Value: &value, List: []*int64{&n, nil}},
+				Labels: map[int32][]string{1: {"l"}, 2: {}},
+			}
+		}
+		if err := w.Write(&r); err != nil {
+			t.Fatal(err)
+		}
+		want = append(want, r)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []structsRecord
+	for _, rg := range f.RowGroups() {
+		rows := rg.Rows()
+		for rows.Scan() {
+			var r structsRecord
+			if err := rows.Decode(&r); err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, r)
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("read %d rows, want %d", len(got), len(want))
+	}
+	for i := range want {
+		// the empty lists and maps are read as empty, not nil
+		if want[i].Nested != nil && want[i].Nested.Inner == nil {
+			want[i].Nested.Labels = map[int32][]string{}
+		}
+		if want[i].Tags == nil {
+			want[i].Tags, want[i].Scores, want[i].Counts = []string{}, []*int16{}, map[string]int{}
+			want[i].Points, want[i].Paths, want[i].Named = []structsPoint{}, [][]*structsPoint{}, map[string]*structsPoint{}
+			want[i].Matrix = [2][]uint16{{}, {}}
+		}
+		want[i].Matrix[1] = []uint16{}
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("row %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestStructsErrors(t *testing.T) {
+	s, err := SchemaOf(schemaOfAddress{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewWriter(&bytes.Buffer{}, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []interface{}{nil, 1, (*schemaOfAddress)(nil), structsPoint{}} {
+		if err := w.Write(v); err == nil {
+			t.Errorf("no error writing %#v", v)
+		}
+	}
+	if err := w.Write(schemaOfAddress{Street: "s"}); err != nil {
+		t.Fatal(err)
+	}
+
+	rows := (&RowScanner{row: map[string]interface{}{"Street": []byte("s"), "zip_code": "x"}})
+	var a schemaOfAddress
+	for _, v := range []interface{}{a, &a, (*schemaOfAddress)(nil), new(int)} {
+		if err := rows.Decode(v); err == nil {
+			t.Errorf("no error decoding into %T", v)
+		}
+	}
+}
@@ -1,5 +1,7 @@
 package thrift
 
+import "sort"
+
 func contains(a []string, b string) bool {
 	for _, v := range a {
 		if v == b {
@@ -23,3 +25,36 @@ func (meta *FileMetaData) GetColumnChunks(colname string) ([]*ColumnChunk, error
 
 	return chunks, nil
 }
+
+// KeyValueMap returns the key value metadata kvs as a map, nil if there is
+// none. A key without value maps to "", the last value of a repeated key is
+// kept.
+func KeyValueMap(kvs []*KeyValue) map[string]string {
+	if len(kvs) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		m[kv.Key] = kv.GetValue()
+	}
+	return m
+}
+
+// KeyValues returns m as key value metadata sorted by key, nil if m is
+// empty.
+func KeyValues(m map[string]string) []*KeyValue {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	kvs := make([]*KeyValue, len(keys))
+	for i, k := range keys {
+		value := m[k]
+		kvs[i] = &KeyValue{Key: k, Value: &value}
+	}
+	return kvs
+}
@@ -18,11 +18,46 @@ func (meta *FileMetaData) Read(r io.Reader) error {
 	return meta.read(newProtocol(r))
 }
 
+// FileMetaData.ReadFrom reads the object from r and returns the number of
+// bytes read.
+func (meta *FileMetaData) ReadFrom(r io.Reader) (int64, error) {
+	rc := NewCountingReader(r)
+	err := meta.read(newProtocol(rc))
+	return rc.N, err
+}
+
 // PageHeader.Read reads the object from a io.Reader
 func (ph *PageHeader) Read(r io.Reader) error {
 	return ph.read(newProtocol(r))
 }
 
+// PageHeader.ReadFrom reads the object from r and returns the number of bytes
+// read, that is the offset of the page data from the start of the header.
+// The data following the header is not consumed.
+func (ph *PageHeader) ReadFrom(r io.Reader) (int64, error) {
+	rc := NewCountingReader(r)
+	err := ph.read(newProtocol(rc))
+	return rc.N, err
+}
+
+// ColumnMetaData.Read reads the object from a io.Reader
+func (cmd *ColumnMetaData) Read(r io.Reader) error {
+	return cmd.read(newProtocol(r))
+}
+
+// ColumnMetaData.ReadFrom reads the object from r and returns the number of
+// bytes read.
+func (cmd *ColumnMetaData) ReadFrom(r io.Reader) (int64, error) {
+	rc := NewCountingReader(r)
+	err := cmd.read(newProtocol(rc))
+	return rc.N, err
+}
+
+// ColumnChunk.Read reads the object from a io.Reader
+func (cc *ColumnChunk) Read(r io.Reader) error {
+	return cc.read(newProtocol(r))
+}
+
 // FileMetaData.Write writes the object to a io.Writer.
 func (meta *FileMetaData) Write(w io.Writer) (int64, error) {
 	wc := NewCountingWriter(w)
@@ -53,6 +88,82 @@ func (page *PageHeader) Write(w io.Writer) (int, error) {
 	return int(wc.N), err
 }
 
+// ColumnMetaData.Write writes the object to a io.Writer, parquet-mr writes
+// a copy of it after the column chunk data.
+func (cmd *ColumnMetaData) Write(w io.Writer) (int, error) {
+	wc := NewCountingWriter(w)
+	ttransport := &thrift.StreamTransport{Writer: wc}
+	proto := thrift.NewTCompactProtocol(ttransport)
+	err := cmd.write(proto)
+	return int(wc.N), err
+}
+
+// ColumnIndex.Read reads the object from a io.Reader
+func (ci *ColumnIndex) Read(r io.Reader) error {
+	return ci.read(newProtocol(r))
+}
+
+// ColumnIndex.Write writes the object to a io.Writer, after the row groups.
+func (ci *ColumnIndex) Write(w io.Writer) (int, error) {
+	wc := NewCountingWriter(w)
+	ttransport := &thrift.StreamTransport{Writer: wc}
+	proto := thrift.NewTCompactProtocol(ttransport)
+	err := ci.write(proto)
+	return int(wc.N), err
+}
+
+// OffsetIndex.Read reads the object from a io.Reader
+func (oi *OffsetIndex) Read(r io.Reader) error {
+	return oi.read(newProtocol(r))
+}
+
+// OffsetIndex.Write writes the object to a io.Writer, after the row groups.
+func (oi *OffsetIndex) Write(w io.Writer) (int, error) {
+	wc := NewCountingWriter(w)
+	ttransport := &thrift.StreamTransport{Writer: wc}
+	proto := thrift.NewTCompactProtocol(ttransport)
+	err := oi.write(proto)
+	return int(wc.N), err
+}
+
+// BloomFilterHeader.ReadFrom reads the object from r and returns the number
+// of bytes read, that is the offset of the bitset from the start of the
+// header. The bitset following the header is not consumed.
+func (h *BloomFilterHeader) ReadFrom(r io.Reader) (int64, error) {
+	rc := NewCountingReader(r)
+	err := h.read(newProtocol(rc))
+	return rc.N, err
+}
+
+// BloomFilterHeader.Write writes the object to a io.Writer, before the
+// bitset of the filter.
+func (h *BloomFilterHeader) Write(w io.Writer) (int, error) {
+	wc := NewCountingWriter(w)
+	ttransport := &thrift.StreamTransport{Writer: wc}
+	proto := thrift.NewTCompactProtocol(ttransport)
+	err := h.write(proto)
+	return int(wc.N), err
+}
+
+// FileCryptoMetaData.ReadFrom reads the object from r and returns the number
+// of bytes read, that is the offset of the encrypted footer from the start
+// of the footer. The encrypted footer is not consumed.
+func (m *FileCryptoMetaData) ReadFrom(r io.Reader) (int64, error) {
+	rc := NewCountingReader(r)
+	err := m.read(newProtocol(rc))
+	return rc.N, err
+}
+
+// FileCryptoMetaData.Write writes the object to a io.Writer, before the
+// encrypted footer.
+func (m *FileCryptoMetaData) Write(w io.Writer) (int, error) {
+	wc := NewCountingWriter(w)
+	ttransport := &thrift.StreamTransport{Writer: wc}
+	proto := thrift.NewTCompactProtocol(ttransport)
+	err := m.write(proto)
+	return int(wc.N), err
+}
+
 // CountingWriter counts the number of bytes written to it.
 type CountingWriter struct {
 	W io.Writer // underlying writer
@@ -70,3 +181,21 @@ func (wc *CountingWriter) Write(p []byte) (int, error) {
 	wc.N += int64(n)
 	return n, err
 }
+
+// CountingReader counts the number of bytes read from it.
+type CountingReader struct {
+	R io.Reader // underlying reader
+	N int64     // total # of bytes read
+}
+
+// NewCountingReader wraps an existing io.Reader
+func NewCountingReader(r io.Reader) *CountingReader {
+	return &CountingReader{R: r, N: 0}
+}
+
+// Read implements the io.Reader interface.
+func (rc *CountingReader) Read(p []byte) (int, error) {
+	n, err := rc.R.Read(p)
+	rc.N += int64(n)
+	return n, err
+}
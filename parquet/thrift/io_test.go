@@ -0,0 +1,111 @@
+package thrift
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestPageHeaderReadFrom(t *testing.T) {
+	header := NewPageHeader()
+	header.Type = PageType_DATA_PAGE
+	header.UncompressedPageSize = 4
+	header.CompressedPageSize = 4
+	header.DataPageHeader = NewDataPageHeader()
+	header.DataPageHeader.NumValues = 1
+	header.DataPageHeader.Encoding = Encoding_PLAIN
+
+	var b bytes.Buffer
+	size, err := header.Write(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Write([]byte{1, 2, 3, 4}) // page data
+
+	var got PageHeader
+	n, err := got.ReadFrom(&b)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != int64(size) {
+		t.Errorf("read %d bytes, want %d", n, size)
+	}
+	if !reflect.DeepEqual(&got, header) {
+		t.Errorf("got %+v, want %+v", got, header)
+	}
+	if !bytes.Equal(b.Bytes(), []byte{1, 2, 3, 4}) {
+		t.Errorf("page data was consumed: %v", b.Bytes())
+	}
+}
+
+func TestColumnMetaDataReadFrom(t *testing.T) {
+	meta := NewColumnMetaData()
+	meta.Type = Type_INT64
+	meta.Encodings = []Encoding{Encoding_PLAIN, Encoding_RLE}
+	meta.PathInSchema = []string{"a", "b"}
+	meta.Codec = CompressionCodec_SNAPPY
+	meta.NumValues = 10
+	meta.TotalUncompressedSize = 100
+	meta.TotalCompressedSize = 80
+	meta.DataPageOffset = 4
+	unencoded := int64(42)
+	meta.SizeStatistics = &SizeStatistics{
+		UnencodedByteArrayDataBytes: &unencoded,
+		RepetitionLevelHistogram:    []int64{6, 4},
+		DefinitionLevelHistogram:    []int64{1, 9},
+	}
+
+	var b bytes.Buffer
+	size, err := meta.Write(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got ColumnMetaData
+	n, err := got.ReadFrom(&b)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n != int64(size) {
+		t.Errorf("read %d bytes, want %d", n, size)
+	}
+	if !reflect.DeepEqual(&got, meta) {
+		t.Errorf("got %+v, want %+v", got, meta)
+	}
+}
+
+func TestFileMetaDataColumnOrders(t *testing.T) {
+	meta := NewFileMetaData()
+	meta.Schema = []*SchemaElement{{Name: "root"}}
+	meta.RowGroups = []*RowGroup{}
+	meta.ColumnOrders = []*ColumnOrder{{TYPE_ORDER: NewTypeDefinedOrder()}}
+
+	var b bytes.Buffer
+	if _, err := meta.Write(&b); err != nil {
+		t.Fatal(err)
+	}
+	var got FileMetaData
+	if err := got.Read(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&got, meta) {
+		t.Errorf("got %+v, want %+v", got, meta)
+	}
+}
+
+func TestKeyValues(t *testing.T) {
+	if KeyValues(nil) != nil || KeyValueMap(nil) != nil {
+		t.Errorf("got key values for no metadata")
+	}
+
+	value := "1"
+	kvs := []*KeyValue{{Key: "a", Value: &value}, {Key: "b"}}
+	m := KeyValueMap(kvs)
+	if want := map[string]string{"a": "1", "b": ""}; !reflect.DeepEqual(m, want) {
+		t.Errorf("got %v, want %v", m, want)
+	}
+	got := KeyValues(m)
+	if len(got) != 2 || got[0].Key != "a" || got[0].GetValue() != "1" || got[1].Key != "b" || !got[1].IsSetValue() {
+		t.Errorf("got %v", got)
+	}
+}
@@ -299,6 +299,7 @@ const (
 	Encoding_DELTA_LENGTH_BYTE_ARRAY Encoding = 6
 	Encoding_DELTA_BYTE_ARRAY        Encoding = 7
 	Encoding_RLE_DICTIONARY          Encoding = 8
+	Encoding_BYTE_STREAM_SPLIT       Encoding = 9
 )
 
 func (p Encoding) String() string {
@@ -319,6 +320,8 @@ func (p Encoding) String() string {
 		return "DELTA_BYTE_ARRAY"
 	case Encoding_RLE_DICTIONARY:
 		return "RLE_DICTIONARY"
+	case Encoding_BYTE_STREAM_SPLIT:
+		return "BYTE_STREAM_SPLIT"
 	}
 	return "<UNSET>"
 }
@@ -341,6 +344,8 @@ func EncodingFromString(s string) (Encoding, error) {
 		return Encoding_DELTA_BYTE_ARRAY, nil
 	case "RLE_DICTIONARY":
 		return Encoding_RLE_DICTIONARY, nil
+	case "BYTE_STREAM_SPLIT":
+		return Encoding_BYTE_STREAM_SPLIT, nil
 	}
 	return Encoding(0), fmt.Errorf("not a valid Encoding string")
 }
@@ -368,6 +373,10 @@ const (
 	CompressionCodec_SNAPPY       CompressionCodec = 1
 	CompressionCodec_GZIP         CompressionCodec = 2
 	CompressionCodec_LZO          CompressionCodec = 3
+	CompressionCodec_BROTLI       CompressionCodec = 4
+	CompressionCodec_LZ4          CompressionCodec = 5
+	CompressionCodec_ZSTD         CompressionCodec = 6
+	CompressionCodec_LZ4_RAW      CompressionCodec = 7
 )
 
 func (p CompressionCodec) String() string {
@@ -380,6 +389,14 @@ func (p CompressionCodec) String() string {
 		return "GZIP"
 	case CompressionCodec_LZO:
 		return "LZO"
+	case CompressionCodec_BROTLI:
+		return "BROTLI"
+	case CompressionCodec_LZ4:
+		return "LZ4"
+	case CompressionCodec_ZSTD:
+		return "ZSTD"
+	case CompressionCodec_LZ4_RAW:
+		return "LZ4_RAW"
 	}
 	return "<UNSET>"
 }
@@ -394,6 +411,14 @@ func CompressionCodecFromString(s string) (CompressionCodec, error) {
 		return CompressionCodec_GZIP, nil
 	case "LZO":
 		return CompressionCodec_LZO, nil
+	case "BROTLI":
+		return CompressionCodec_BROTLI, nil
+	case "LZ4":
+		return CompressionCodec_LZ4, nil
+	case "ZSTD":
+		return CompressionCodec_ZSTD, nil
+	case "LZ4_RAW":
+		return CompressionCodec_LZ4_RAW, nil
 	}
 	return CompressionCodec(0), fmt.Errorf("not a valid CompressionCodec string")
 }
@@ -465,6 +490,115 @@ func (p *PageType) UnmarshalText(text []byte) error {
 	return nil
 }
 
+//Enum to annotate whether lists of min/max elements inside ColumnIndex
+//are ordered and if so, in which direction.
+type BoundaryOrder int64
+
+const (
+	BoundaryOrder_UNORDERED  BoundaryOrder = 0
+	BoundaryOrder_ASCENDING  BoundaryOrder = 1
+	BoundaryOrder_DESCENDING BoundaryOrder = 2
+)
+
+func (p BoundaryOrder) String() string {
+	switch p {
+	case BoundaryOrder_UNORDERED:
+		return "UNORDERED"
+	case BoundaryOrder_ASCENDING:
+		return "ASCENDING"
+	case BoundaryOrder_DESCENDING:
+		return "DESCENDING"
+	}
+	return "<UNSET>"
+}
+
+func BoundaryOrderFromString(s string) (BoundaryOrder, error) {
+	switch s {
+	case "UNORDERED":
+		return BoundaryOrder_UNORDERED, nil
+	case "ASCENDING":
+		return BoundaryOrder_ASCENDING, nil
+	case "DESCENDING":
+		return BoundaryOrder_DESCENDING, nil
+	}
+	return BoundaryOrder(0), fmt.Errorf("not a valid BoundaryOrder string")
+}
+
+func BoundaryOrderPtr(v BoundaryOrder) *BoundaryOrder { return &v }
+
+func (p BoundaryOrder) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *BoundaryOrder) UnmarshalText(text []byte) error {
+	q, err := BoundaryOrderFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
+//Edge interpolation algorithm for Geography logical type
+type EdgeInterpolationAlgorithm int64
+
+const (
+	EdgeInterpolationAlgorithm_SPHERICAL EdgeInterpolationAlgorithm = 0
+	EdgeInterpolationAlgorithm_VINCENTY  EdgeInterpolationAlgorithm = 1
+	EdgeInterpolationAlgorithm_THOMAS    EdgeInterpolationAlgorithm = 2
+	EdgeInterpolationAlgorithm_ANDOYER   EdgeInterpolationAlgorithm = 3
+	EdgeInterpolationAlgorithm_KARNEY    EdgeInterpolationAlgorithm = 4
+)
+
+func (p EdgeInterpolationAlgorithm) String() string {
+	switch p {
+	case EdgeInterpolationAlgorithm_SPHERICAL:
+		return "SPHERICAL"
+	case EdgeInterpolationAlgorithm_VINCENTY:
+		return "VINCENTY"
+	case EdgeInterpolationAlgorithm_THOMAS:
+		return "THOMAS"
+	case EdgeInterpolationAlgorithm_ANDOYER:
+		return "ANDOYER"
+	case EdgeInterpolationAlgorithm_KARNEY:
+		return "KARNEY"
+	}
+	return "<UNSET>"
+}
+
+func EdgeInterpolationAlgorithmFromString(s string) (EdgeInterpolationAlgorithm, error) {
+	switch s {
+	case "SPHERICAL":
+		return EdgeInterpolationAlgorithm_SPHERICAL, nil
+	case "VINCENTY":
+		return EdgeInterpolationAlgorithm_VINCENTY, nil
+	case "THOMAS":
+		return EdgeInterpolationAlgorithm_THOMAS, nil
+	case "ANDOYER":
+		return EdgeInterpolationAlgorithm_ANDOYER, nil
+	case "KARNEY":
+		return EdgeInterpolationAlgorithm_KARNEY, nil
+	}
+	return EdgeInterpolationAlgorithm(0), fmt.Errorf("not a valid EdgeInterpolationAlgorithm string")
+}
+
+func EdgeInterpolationAlgorithmPtr(v EdgeInterpolationAlgorithm) *EdgeInterpolationAlgorithm {
+	return &v
+}
+
+func (p EdgeInterpolationAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *EdgeInterpolationAlgorithm) UnmarshalText(text []byte) error {
+	q, err := EdgeInterpolationAlgorithmFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*p = q
+	return nil
+}
+
 // Statistics per row group and per page
 // All fields are optional.
 //
@@ -473,11 +607,18 @@ func (p *PageType) UnmarshalText(text []byte) error {
 //  - Min
 //  - NullCount: count of null value in the column
 //  - DistinctCount: count of distinct values occurring
+//  - MaxValue: Min and max values for the column, determined by its ColumnOrder.
+//
+// Values are encoded using PLAIN encoding, except that variable-length byte
+// arrays do not include a length prefix.
+//  - MinValue
 type Statistics struct {
 	Max           []byte `thrift:"max,1" json:"max,omitempty"`
 	Min           []byte `thrift:"min,2" json:"min,omitempty"`
 	NullCount     *int64 `thrift:"null_count,3" json:"null_count,omitempty"`
 	DistinctCount *int64 `thrift:"distinct_count,4" json:"distinct_count,omitempty"`
+	MaxValue      []byte `thrift:"max_value,5" json:"max_value,omitempty"`
+	MinValue      []byte `thrift:"min_value,6" json:"min_value,omitempty"`
 }
 
 func NewStatistics() *Statistics {
@@ -513,6 +654,18 @@ func (p *Statistics) GetDistinctCount() int64 {
 	}
 	return *p.DistinctCount
 }
+
+var Statistics_MaxValue_DEFAULT []byte
+
+func (p *Statistics) GetMaxValue() []byte {
+	return p.MaxValue
+}
+
+var Statistics_MinValue_DEFAULT []byte
+
+func (p *Statistics) GetMinValue() []byte {
+	return p.MinValue
+}
 func (p *Statistics) IsSetMax() bool {
 	return p.Max != nil
 }
@@ -529,6 +682,14 @@ func (p *Statistics) IsSetDistinctCount() bool {
 	return p.DistinctCount != nil
 }
 
+func (p *Statistics) IsSetMaxValue() bool {
+	return p.MaxValue != nil
+}
+
+func (p *Statistics) IsSetMinValue() bool {
+	return p.MinValue != nil
+}
+
 func (p *Statistics) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -559,6 +720,14 @@ func (p *Statistics) read(iprot thrift.TProtocol) error {
 			if err := p.readField4(iprot); err != nil {
 				return err
 			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -610,6 +779,24 @@ func (p *Statistics) readField4(iprot thrift.TProtocol) error {
 	return nil
 }
 
+func (p *Statistics) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.MaxValue = v
+	}
+	return nil
+}
+
+func (p *Statistics) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.MinValue = v
+	}
+	return nil
+}
+
 func (p *Statistics) write(oprot thrift.TProtocol) error {
 	if err := oprot.WriteStructBegin("Statistics"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
@@ -626,6 +813,12 @@ func (p *Statistics) write(oprot thrift.TProtocol) error {
 	if err := p.writeField4(oprot); err != nil {
 		return err
 	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -695,6 +888,36 @@ func (p *Statistics) writeField4(oprot thrift.TProtocol) (err error) {
 	return err
 }
 
+func (p *Statistics) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMaxValue() {
+		if err := oprot.WriteFieldBegin("max_value", thrift.STRING, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:max_value: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.MaxValue); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.max_value (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:max_value: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *Statistics) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMinValue() {
+		if err := oprot.WriteFieldBegin("min_value", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:min_value: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.MinValue); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.min_value (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:min_value: ", p), err)
+		}
+	}
+	return err
+}
+
 func (p *Statistics) String() string {
 	if p == nil {
 		return "<nil>"
@@ -702,161 +925,178 @@ func (p *Statistics) String() string {
 	return fmt.Sprintf("Statistics(%+v)", *p)
 }
 
-// Represents a element inside a schema definition.
-//  - if it is a group (inner node) then type is undefined and num_children is defined
-//  - if it is a primitive type (leaf) then type is defined and num_children is undefined
-// the nodes are listed in depth first traversal order.
-//
-// Attributes:
-//  - Type: Data type for this field. Not set if the current element is a non-leaf node
-//  - TypeLength: If type is FIXED_LEN_BYTE_ARRAY, this is the byte length of the vales.
-// Otherwise, if specified, this is the maximum bit length to store any of the values.
-// (e.g. a low cardinality INT col could have this set to 3).  Note that this is
-// in the schema, and therefore fixed for the entire file.
-//  - RepetitionType: repetition of the field. The root of the schema does not have a repetition_type.
-// All other nodes must have one
-//  - Name: Name of the field in the schema
-//  - NumChildren: Nested fields.  Since thrift does not support nested fields,
-// the nesting is flattened to a single list by a depth-first traversal.
-// The children count is used to construct the nested relationship.
-// This field is not set when the element is a primitive type
-//  - ConvertedType: When the schema is the result of a conversion from another model
-// Used to record the original type to help with cross conversion.
-//  - Scale: Used when this column contains decimal data.
-// See the DECIMAL converted type for more details.
-//  - Precision
-//  - FieldID: When the original schema supports field ids, this will save the
-// original field id in the parquet schema
-type SchemaElement struct {
-	Type           *Type                `thrift:"type,1" json:"type,omitempty"`
-	TypeLength     *int32               `thrift:"type_length,2" json:"type_length,omitempty"`
-	RepetitionType *FieldRepetitionType `thrift:"repetition_type,3" json:"repetition_type,omitempty"`
-	Name           string               `thrift:"name,4,required" json:"name"`
-	NumChildren    *int32               `thrift:"num_children,5" json:"num_children,omitempty"`
-	ConvertedType  *ConvertedType       `thrift:"converted_type,6" json:"converted_type,omitempty"`
-	Scale          *int32               `thrift:"scale,7" json:"scale,omitempty"`
-	Precision      *int32               `thrift:"precision,8" json:"precision,omitempty"`
-	FieldID        *int32               `thrift:"field_id,9" json:"field_id,omitempty"`
+// Empty structs to use as logical type annotations
+type StringType struct {
 }
 
-func NewSchemaElement() *SchemaElement {
-	return &SchemaElement{}
+func NewStringType() *StringType {
+	return &StringType{}
 }
 
-var SchemaElement_Type_DEFAULT Type
+func (p *StringType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-func (p *SchemaElement) GetType() Type {
-	if !p.IsSetType() {
-		return SchemaElement_Type_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return *p.Type
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var SchemaElement_TypeLength_DEFAULT int32
-
-func (p *SchemaElement) GetTypeLength() int32 {
-	if !p.IsSetTypeLength() {
-		return SchemaElement_TypeLength_DEFAULT
+func (p *StringType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("StringType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return *p.TypeLength
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var SchemaElement_RepetitionType_DEFAULT FieldRepetitionType
-
-func (p *SchemaElement) GetRepetitionType() FieldRepetitionType {
-	if !p.IsSetRepetitionType() {
-		return SchemaElement_RepetitionType_DEFAULT
+func (p *StringType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.RepetitionType
+	return fmt.Sprintf("StringType(%+v)", *p)
 }
 
-func (p *SchemaElement) GetName() string {
-	return p.Name
+type UUIDType struct {
 }
 
-var SchemaElement_NumChildren_DEFAULT int32
-
-func (p *SchemaElement) GetNumChildren() int32 {
-	if !p.IsSetNumChildren() {
-		return SchemaElement_NumChildren_DEFAULT
-	}
-	return *p.NumChildren
+func NewUUIDType() *UUIDType {
+	return &UUIDType{}
 }
 
-var SchemaElement_ConvertedType_DEFAULT ConvertedType
+func (p *UUIDType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-func (p *SchemaElement) GetConvertedType() ConvertedType {
-	if !p.IsSetConvertedType() {
-		return SchemaElement_ConvertedType_DEFAULT
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return *p.ConvertedType
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-var SchemaElement_Scale_DEFAULT int32
-
-func (p *SchemaElement) GetScale() int32 {
-	if !p.IsSetScale() {
-		return SchemaElement_Scale_DEFAULT
+func (p *UUIDType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("UUIDType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return *p.Scale
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var SchemaElement_Precision_DEFAULT int32
-
-func (p *SchemaElement) GetPrecision() int32 {
-	if !p.IsSetPrecision() {
-		return SchemaElement_Precision_DEFAULT
+func (p *UUIDType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return *p.Precision
+	return fmt.Sprintf("UUIDType(%+v)", *p)
 }
 
-var SchemaElement_FieldID_DEFAULT int32
-
-func (p *SchemaElement) GetFieldID() int32 {
-	if !p.IsSetFieldID() {
-		return SchemaElement_FieldID_DEFAULT
-	}
-	return *p.FieldID
+type MapType struct {
 }
-func (p *SchemaElement) IsSetType() bool {
-	return p.Type != nil
+
+func NewMapType() *MapType {
+	return &MapType{}
 }
 
-func (p *SchemaElement) IsSetTypeLength() bool {
-	return p.TypeLength != nil
-}
-
-func (p *SchemaElement) IsSetRepetitionType() bool {
-	return p.RepetitionType != nil
-}
+func (p *MapType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
 
-func (p *SchemaElement) IsSetNumChildren() bool {
-	return p.NumChildren != nil
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *SchemaElement) IsSetConvertedType() bool {
-	return p.ConvertedType != nil
+func (p *MapType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("MapType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *SchemaElement) IsSetScale() bool {
-	return p.Scale != nil
+func (p *MapType) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MapType(%+v)", *p)
 }
 
-func (p *SchemaElement) IsSetPrecision() bool {
-	return p.Precision != nil
+type ListType struct {
 }
 
-func (p *SchemaElement) IsSetFieldID() bool {
-	return p.FieldID != nil
+func NewListType() *ListType {
+	return &ListType{}
 }
 
-func (p *SchemaElement) read(iprot thrift.TProtocol) error {
+func (p *ListType) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetName bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -865,48 +1105,8 @@ func (p *SchemaElement) read(iprot thrift.TProtocol) error {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		switch fieldId {
-		case 1:
-			if err := p.readField1(iprot); err != nil {
-				return err
-			}
-		case 2:
-			if err := p.readField2(iprot); err != nil {
-				return err
-			}
-		case 3:
-			if err := p.readField3(iprot); err != nil {
-				return err
-			}
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
-			issetName = true
-		case 5:
-			if err := p.readField5(iprot); err != nil {
-				return err
-			}
-		case 6:
-			if err := p.readField6(iprot); err != nil {
-				return err
-			}
-		case 7:
-			if err := p.readField7(iprot); err != nil {
-				return err
-			}
-		case 8:
-			if err := p.readField8(iprot); err != nil {
-				return err
-			}
-		case 9:
-			if err := p.readField9(iprot); err != nil {
-				return err
-			}
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
 		}
 		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
@@ -915,126 +1115,118 @@ func (p *SchemaElement) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetName {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Name is not set"))
-	}
 	return nil
 }
 
-func (p *SchemaElement) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		temp := Type(v)
-		p.Type = &temp
+func (p *ListType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ListType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *SchemaElement) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.TypeLength = &v
+func (p *ListType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
+	return fmt.Sprintf("ListType(%+v)", *p)
 }
 
-func (p *SchemaElement) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		temp := FieldRepetitionType(v)
-		p.RepetitionType = &temp
-	}
-	return nil
+type EnumType struct {
 }
 
-func (p *SchemaElement) readField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.Name = v
-	}
-	return nil
+func NewEnumType() *EnumType {
+	return &EnumType{}
 }
 
-func (p *SchemaElement) readField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.NumChildren = &v
+func (p *EnumType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	return nil
-}
 
-func (p *SchemaElement) readField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		temp := ConvertedType(v)
-		p.ConvertedType = &temp
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
 	return nil
 }
 
-func (p *SchemaElement) readField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
-	} else {
-		p.Scale = &v
+func (p *EnumType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("EnumType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *SchemaElement) readField8(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 8: ", err)
-	} else {
-		p.Precision = &v
+func (p *EnumType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return nil
+	return fmt.Sprintf("EnumType(%+v)", *p)
 }
 
-func (p *SchemaElement) readField9(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 9: ", err)
-	} else {
-		p.FieldID = &v
-	}
-	return nil
+type DateType struct {
 }
 
-func (p *SchemaElement) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SchemaElement"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
-	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField2(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField5(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField6(oprot); err != nil {
-		return err
+func NewDateType() *DateType {
+	return &DateType{}
+}
+
+func (p *DateType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	if err := p.writeField7(oprot); err != nil {
-		return err
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if err := p.writeField8(oprot); err != nil {
-		return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if err := p.writeField9(oprot); err != nil {
-		return err
+	return nil
+}
+
+func (p *DateType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DateType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
@@ -1045,204 +1237,207 @@ func (p *SchemaElement) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *SchemaElement) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetType() {
-		if err := oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
-		}
+func (p *DateType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return err
+	return fmt.Sprintf("DateType(%+v)", *p)
 }
 
-func (p *SchemaElement) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetTypeLength() {
-		if err := oprot.WriteFieldBegin("type_length", thrift.I32, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:type_length: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.TypeLength)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.type_length (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:type_length: ", p), err)
-		}
-	}
-	return err
+type Float16Type struct {
 }
 
-func (p *SchemaElement) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetRepetitionType() {
-		if err := oprot.WriteFieldBegin("repetition_type", thrift.I32, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:repetition_type: ", p), err)
+func NewFloat16Type() *Float16Type {
+	return &Float16Type{}
+}
+
+func (p *Float16Type) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := oprot.WriteI32(int32(*p.RepetitionType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.repetition_type (3) field write error: ", p), err)
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:repetition_type: ", p), err)
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *SchemaElement) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:name: ", p), err)
+func (p *Float16Type) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Float16Type"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := oprot.WriteString(string(p.Name)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.name (4) field write error: ", p), err)
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:name: ", p), err)
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *SchemaElement) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetNumChildren() {
-		if err := oprot.WriteFieldBegin("num_children", thrift.I32, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:num_children: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.NumChildren)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.num_children (5) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:num_children: ", p), err)
-		}
+func (p *Float16Type) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return err
+	return fmt.Sprintf("Float16Type(%+v)", *p)
 }
 
-func (p *SchemaElement) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetConvertedType() {
-		if err := oprot.WriteFieldBegin("converted_type", thrift.I32, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:converted_type: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.ConvertedType)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.converted_type (6) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:converted_type: ", p), err)
-		}
+// Embedded Variant logical type annotation
+//
+// Attributes:
+//  - SpecificationVersion
+type VariantType struct {
+	SpecificationVersion *int8 `thrift:"specification_version,1" json:"specification_version,omitempty"`
+}
+
+func NewVariantType() *VariantType {
+	return &VariantType{}
+}
+
+var VariantType_SpecificationVersion_DEFAULT int8
+
+func (p *VariantType) GetSpecificationVersion() int8 {
+	if !p.IsSetSpecificationVersion() {
+		return VariantType_SpecificationVersion_DEFAULT
 	}
-	return err
+	return *p.SpecificationVersion
 }
 
-func (p *SchemaElement) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetScale() {
-		if err := oprot.WriteFieldBegin("scale", thrift.I32, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:scale: ", p), err)
+func (p *VariantType) IsSetSpecificationVersion() bool {
+	return p.SpecificationVersion != nil
+}
+
+func (p *VariantType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := oprot.WriteI32(int32(*p.Scale)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.scale (7) field write error: ", p), err)
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:scale: ", p), err)
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *SchemaElement) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetPrecision() {
-		if err := oprot.WriteFieldBegin("precision", thrift.I32, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:precision: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.Precision)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.precision (8) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:precision: ", p), err)
-		}
+func (p *VariantType) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.SpecificationVersion = &v
 	}
-	return err
+	return nil
 }
 
-func (p *SchemaElement) writeField9(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFieldID() {
-		if err := oprot.WriteFieldBegin("field_id", thrift.I32, 9); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:field_id: ", p), err)
+func (p *VariantType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("VariantType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *VariantType) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSpecificationVersion() {
+		if err := oprot.WriteFieldBegin("specification_version", thrift.BYTE, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:specification_version: ", p), err)
 		}
-		if err := oprot.WriteI32(int32(*p.FieldID)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.field_id (9) field write error: ", p), err)
+		if err := oprot.WriteByte(int8(*p.SpecificationVersion)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.specification_version (1) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:field_id: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:specification_version: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *SchemaElement) String() string {
+func (p *VariantType) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("SchemaElement(%+v)", *p)
+	return fmt.Sprintf("VariantType(%+v)", *p)
 }
 
-// Data page header
+// Embedded Geometry logical type annotation
+//
+// Geospatial features in the Well-Known Binary (WKB) format and edges interpolation
+// is always linear/planar.
+//
+// A custom CRS can be set by the crs field. If unset, it defaults to "OGC:CRS84",
+// which means that the geometries must be stored in longitude, latitude based on
+// the WGS84 datum.
 //
 // Attributes:
-//  - NumValues: Number of values, including NULLs, in this data page. *
-//  - Encoding: Encoding used for this data page *
-//  - DefinitionLevelEncoding: Encoding used for definition levels *
-//  - RepetitionLevelEncoding: Encoding used for repetition levels *
-//  - Statistics: Optional statistics for the data in this page*
-type DataPageHeader struct {
-	NumValues               int32       `thrift:"num_values,1,required" json:"num_values"`
-	Encoding                Encoding    `thrift:"encoding,2,required" json:"encoding"`
-	DefinitionLevelEncoding Encoding    `thrift:"definition_level_encoding,3,required" json:"definition_level_encoding"`
-	RepetitionLevelEncoding Encoding    `thrift:"repetition_level_encoding,4,required" json:"repetition_level_encoding"`
-	Statistics              *Statistics `thrift:"statistics,5" json:"statistics,omitempty"`
-}
-
-func NewDataPageHeader() *DataPageHeader {
-	return &DataPageHeader{}
-}
-
-func (p *DataPageHeader) GetNumValues() int32 {
-	return p.NumValues
-}
-
-func (p *DataPageHeader) GetEncoding() Encoding {
-	return p.Encoding
-}
-
-func (p *DataPageHeader) GetDefinitionLevelEncoding() Encoding {
-	return p.DefinitionLevelEncoding
+//  - Crs
+type GeometryType struct {
+	Crs *string `thrift:"crs,1" json:"crs,omitempty"`
 }
 
-func (p *DataPageHeader) GetRepetitionLevelEncoding() Encoding {
-	return p.RepetitionLevelEncoding
+func NewGeometryType() *GeometryType {
+	return &GeometryType{}
 }
 
-var DataPageHeader_Statistics_DEFAULT *Statistics
+var GeometryType_Crs_DEFAULT string
 
-func (p *DataPageHeader) GetStatistics() *Statistics {
-	if !p.IsSetStatistics() {
-		return DataPageHeader_Statistics_DEFAULT
+func (p *GeometryType) GetCrs() string {
+	if !p.IsSetCrs() {
+		return GeometryType_Crs_DEFAULT
 	}
-	return p.Statistics
+	return *p.Crs
 }
-func (p *DataPageHeader) IsSetStatistics() bool {
-	return p.Statistics != nil
+func (p *GeometryType) IsSetCrs() bool {
+	return p.Crs != nil
 }
 
-func (p *DataPageHeader) read(iprot thrift.TProtocol) error {
+func (p *GeometryType) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNumValues bool = false
-	var issetEncoding bool = false
-	var issetDefinitionLevelEncoding bool = false
-	var issetRepetitionLevelEncoding bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -1256,26 +1451,6 @@ func (p *DataPageHeader) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetNumValues = true
-		case 2:
-			if err := p.readField2(iprot); err != nil {
-				return err
-			}
-			issetEncoding = true
-		case 3:
-			if err := p.readField3(iprot); err != nil {
-				return err
-			}
-			issetDefinitionLevelEncoding = true
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
-			issetRepetitionLevelEncoding = true
-		case 5:
-			if err := p.readField5(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -1288,70 +1463,163 @@ func (p *DataPageHeader) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNumValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
-	}
-	if !issetEncoding {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
-	}
-	if !issetDefinitionLevelEncoding {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DefinitionLevelEncoding is not set"))
-	}
-	if !issetRepetitionLevelEncoding {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RepetitionLevelEncoding is not set"))
-	}
 	return nil
 }
 
-func (p *DataPageHeader) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *GeometryType) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.NumValues = v
+		p.Crs = &v
 	}
 	return nil
 }
 
-func (p *DataPageHeader) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		temp := Encoding(v)
-		p.Encoding = temp
+func (p *GeometryType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GeometryType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *DataPageHeader) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		temp := Encoding(v)
-		p.DefinitionLevelEncoding = temp
+func (p *GeometryType) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCrs() {
+		if err := oprot.WriteFieldBegin("crs", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:crs: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Crs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.crs (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:crs: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *GeometryType) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GeometryType(%+v)", *p)
+}
+
+// Embedded Geography logical type annotation
+//
+// Geospatial features in the WKB format with an explicit (non-linear/non-planar)
+// edges interpolation algorithm.
+//
+// A custom geographic CRS can be set by the crs field, where longitudes are
+// bound by [-180, 180] and latitudes are bound by [-90, 90]. If unset, the CRS
+// defaults to "OGC:CRS84".
+//
+// An optional algorithm can be set to correctly interpret edges interpolation
+// of the geometries. If unset, the algorithm defaults to SPHERICAL.
+//
+// Attributes:
+//  - Crs
+//  - Algorithm
+type GeographyType struct {
+	Crs       *string                     `thrift:"crs,1" json:"crs,omitempty"`
+	Algorithm *EdgeInterpolationAlgorithm `thrift:"algorithm,2" json:"algorithm,omitempty"`
+}
+
+func NewGeographyType() *GeographyType {
+	return &GeographyType{}
+}
+
+var GeographyType_Crs_DEFAULT string
+
+func (p *GeographyType) GetCrs() string {
+	if !p.IsSetCrs() {
+		return GeographyType_Crs_DEFAULT
+	}
+	return *p.Crs
+}
+
+var GeographyType_Algorithm_DEFAULT EdgeInterpolationAlgorithm
+
+func (p *GeographyType) GetAlgorithm() EdgeInterpolationAlgorithm {
+	if !p.IsSetAlgorithm() {
+		return GeographyType_Algorithm_DEFAULT
+	}
+	return *p.Algorithm
+}
+func (p *GeographyType) IsSetCrs() bool {
+	return p.Crs != nil
+}
+
+func (p *GeographyType) IsSetAlgorithm() bool {
+	return p.Algorithm != nil
+}
+
+func (p *GeographyType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
 	return nil
 }
 
-func (p *DataPageHeader) readField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
+func (p *GeographyType) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		temp := Encoding(v)
-		p.RepetitionLevelEncoding = temp
+		p.Crs = &v
 	}
 	return nil
 }
 
-func (p *DataPageHeader) readField5(iprot thrift.TProtocol) error {
-	p.Statistics = &Statistics{}
-	if err := p.Statistics.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+func (p *GeographyType) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := EdgeInterpolationAlgorithm(v)
+		p.Algorithm = &temp
 	}
 	return nil
 }
 
-func (p *DataPageHeader) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DataPageHeader"); err != nil {
+func (p *GeographyType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GeographyType"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -1360,15 +1628,6 @@ func (p *DataPageHeader) write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField5(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -1378,88 +1637,56 @@ func (p *DataPageHeader) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DataPageHeader) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_values", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:num_values: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.NumValues)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_values (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:num_values: ", p), err)
-	}
-	return err
-}
-
-func (p *DataPageHeader) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
-	}
-	return err
-}
-
-func (p *DataPageHeader) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("definition_level_encoding", thrift.I32, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:definition_level_encoding: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.DefinitionLevelEncoding)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.definition_level_encoding (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:definition_level_encoding: ", p), err)
-	}
-	return err
-}
-
-func (p *DataPageHeader) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("repetition_level_encoding", thrift.I32, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:repetition_level_encoding: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.RepetitionLevelEncoding)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.repetition_level_encoding (4) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:repetition_level_encoding: ", p), err)
+func (p *GeographyType) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCrs() {
+		if err := oprot.WriteFieldBegin("crs", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:crs: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Crs)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.crs (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:crs: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *DataPageHeader) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatistics() {
-		if err := oprot.WriteFieldBegin("statistics", thrift.STRUCT, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:statistics: ", p), err)
+func (p *GeographyType) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAlgorithm() {
+		if err := oprot.WriteFieldBegin("algorithm", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:algorithm: ", p), err)
 		}
-		if err := p.Statistics.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
+		if err := oprot.WriteI32(int32(*p.Algorithm)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.algorithm (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:statistics: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:algorithm: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *DataPageHeader) String() string {
+func (p *GeographyType) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataPageHeader(%+v)", *p)
+	return fmt.Sprintf("GeographyType(%+v)", *p)
 }
 
-type IndexPageHeader struct {
+// Logical type to annotate a column that is always null.
+//
+// Sometimes when discovering the schema of existing data, values are always
+// null and the physical type can't be determined. This annotation signals
+// the case where the physical type was guessed from all null values.
+type NullType struct {
 }
 
-func NewIndexPageHeader() *IndexPageHeader {
-	return &IndexPageHeader{}
+func NewNullType() *NullType {
+	return &NullType{}
 }
 
-func (p *IndexPageHeader) read(iprot thrift.TProtocol) error {
+func (p *NullType) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
@@ -1485,8 +1712,8 @@ func (p *IndexPageHeader) read(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IndexPageHeader) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("IndexPageHeader"); err != nil {
+func (p *NullType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NullType"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := oprot.WriteFieldStop(); err != nil {
@@ -1498,56 +1725,47 @@ func (p *IndexPageHeader) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *IndexPageHeader) String() string {
+func (p *NullType) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("IndexPageHeader(%+v)", *p)
+	return fmt.Sprintf("NullType(%+v)", *p)
 }
 
-// TODO: *
+// Decimal logical type annotation
+//
+// Scale must be zero or a positive integer less than or equal to the precision.
+// Precision must be a non-zero positive integer.
+//
+// To maintain forward-compatibility in v1, implementations using this logical
+// type must also set scale and precision on the annotated SchemaElement.
 //
 // Attributes:
-//  - NumValues: Number of values in the dictionary *
-//  - Encoding: Encoding using this dictionary page *
-//  - IsSorted: If true, the entries in the dictionary are sorted in ascending order *
-type DictionaryPageHeader struct {
-	NumValues int32    `thrift:"num_values,1,required" json:"num_values"`
-	Encoding  Encoding `thrift:"encoding,2,required" json:"encoding"`
-	IsSorted  *bool    `thrift:"is_sorted,3" json:"is_sorted,omitempty"`
-}
-
-func NewDictionaryPageHeader() *DictionaryPageHeader {
-	return &DictionaryPageHeader{}
+//  - Scale
+//  - Precision
+type DecimalType struct {
+	Scale     int32 `thrift:"scale,1,required" json:"scale"`
+	Precision int32 `thrift:"precision,2,required" json:"precision"`
 }
 
-func (p *DictionaryPageHeader) GetNumValues() int32 {
-	return p.NumValues
+func NewDecimalType() *DecimalType {
+	return &DecimalType{}
 }
 
-func (p *DictionaryPageHeader) GetEncoding() Encoding {
-	return p.Encoding
+func (p *DecimalType) GetScale() int32 {
+	return p.Scale
 }
 
-var DictionaryPageHeader_IsSorted_DEFAULT bool
-
-func (p *DictionaryPageHeader) GetIsSorted() bool {
-	if !p.IsSetIsSorted() {
-		return DictionaryPageHeader_IsSorted_DEFAULT
-	}
-	return *p.IsSorted
+func (p *DecimalType) GetPrecision() int32 {
+	return p.Precision
 }
-func (p *DictionaryPageHeader) IsSetIsSorted() bool {
-	return p.IsSorted != nil
-}
-
-func (p *DictionaryPageHeader) read(iprot thrift.TProtocol) error {
+func (p *DecimalType) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNumValues bool = false
-	var issetEncoding bool = false
+	var issetScale bool = false
+	var issetPrecision bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -1562,16 +1780,12 @@ func (p *DictionaryPageHeader) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetNumValues = true
+			issetScale = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetEncoding = true
-		case 3:
-			if err := p.readField3(iprot); err != nil {
-				return err
-			}
+			issetPrecision = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -1584,45 +1798,35 @@ func (p *DictionaryPageHeader) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNumValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
+	if !issetScale {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Scale is not set"))
 	}
-	if !issetEncoding {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	if !issetPrecision {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Precision is not set"))
 	}
 	return nil
 }
 
-func (p *DictionaryPageHeader) readField1(iprot thrift.TProtocol) error {
+func (p *DecimalType) readField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.NumValues = v
+		p.Scale = v
 	}
 	return nil
 }
 
-func (p *DictionaryPageHeader) readField2(iprot thrift.TProtocol) error {
+func (p *DecimalType) readField2(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadI32(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		temp := Encoding(v)
-		p.Encoding = temp
-	}
-	return nil
-}
-
-func (p *DictionaryPageHeader) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.IsSorted = &v
+		p.Precision = v
 	}
 	return nil
 }
 
-func (p *DictionaryPageHeader) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DictionaryPageHeader"); err != nil {
+func (p *DecimalType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DecimalType"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -1631,9 +1835,6 @@ func (p *DictionaryPageHeader) write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -1643,148 +1844,271 @@ func (p *DictionaryPageHeader) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DictionaryPageHeader) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_values", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:num_values: ", p), err)
+func (p *DecimalType) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("scale", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:scale: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.NumValues)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_values (1) field write error: ", p), err)
+	if err := oprot.WriteI32(int32(p.Scale)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.scale (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:num_values: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:scale: ", p), err)
 	}
 	return err
 }
 
-func (p *DictionaryPageHeader) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
+func (p *DecimalType) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("precision", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:precision: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
+	if err := oprot.WriteI32(int32(p.Precision)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.precision (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:precision: ", p), err)
 	}
 	return err
 }
 
-func (p *DictionaryPageHeader) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsSorted() {
-		if err := oprot.WriteFieldBegin("is_sorted", thrift.BOOL, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:is_sorted: ", p), err)
+func (p *DecimalType) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DecimalType(%+v)", *p)
+}
+
+// Time units for logical types
+type MilliSeconds struct {
+}
+
+func NewMilliSeconds() *MilliSeconds {
+	return &MilliSeconds{}
+}
+
+func (p *MilliSeconds) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := oprot.WriteBool(bool(*p.IsSorted)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.is_sorted (3) field write error: ", p), err)
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:is_sorted: ", p), err)
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *DictionaryPageHeader) String() string {
+func (p *MilliSeconds) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("MilliSeconds"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *MilliSeconds) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DictionaryPageHeader(%+v)", *p)
+	return fmt.Sprintf("MilliSeconds(%+v)", *p)
 }
 
-// New page format alowing reading levels without decompressing the data
-// Repetition and definition levels are uncompressed
-// The remaining section containing the data is compressed if is_compressed is true
-//
-//
-// Attributes:
-//  - NumValues: Number of values, including NULLs, in this data page. *
-//  - NumNulls: Number of NULL values, in this data page.
-// Number of non-null = num_values - num_nulls which is also the number of values in the data section *
-//  - NumRows: Number of rows in this data page. which means pages change on record boundaries (r = 0) *
-//  - Encoding: Encoding used for data in this page *
-//  - DefinitionLevelsByteLength: length of the repetition levels
-//  - RepetitionLevelsByteLength: length of the definition levels
-//  - IsCompressed: whether the values are compressed.
-// Which means the section of the page between
-// definition_levels_byte_length + repetition_levels_byte_length + 1 and compressed_page_size (included)
-// is compressed with the compression_codec.
-// If missing it is considered compressed
-//  - Statistics: optional statistics for this column chunk
-type DataPageHeaderV2 struct {
-	NumValues                  int32       `thrift:"num_values,1,required" json:"num_values"`
-	NumNulls                   int32       `thrift:"num_nulls,2,required" json:"num_nulls"`
-	NumRows                    int32       `thrift:"num_rows,3,required" json:"num_rows"`
-	Encoding                   Encoding    `thrift:"encoding,4,required" json:"encoding"`
-	DefinitionLevelsByteLength int32       `thrift:"definition_levels_byte_length,5,required" json:"definition_levels_byte_length"`
-	RepetitionLevelsByteLength int32       `thrift:"repetition_levels_byte_length,6,required" json:"repetition_levels_byte_length"`
-	IsCompressed               bool        `thrift:"is_compressed,7" json:"is_compressed,omitempty"`
-	Statistics                 *Statistics `thrift:"statistics,8" json:"statistics,omitempty"`
+type MicroSeconds struct {
 }
 
-func NewDataPageHeaderV2() *DataPageHeaderV2 {
-	return &DataPageHeaderV2{
-		IsCompressed: true,
+func NewMicroSeconds() *MicroSeconds {
+	return &MicroSeconds{}
+}
+
+func (p *MicroSeconds) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
+	return nil
 }
 
-func (p *DataPageHeaderV2) GetNumValues() int32 {
-	return p.NumValues
+func (p *MicroSeconds) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("MicroSeconds"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *DataPageHeaderV2) GetNumNulls() int32 {
-	return p.NumNulls
+func (p *MicroSeconds) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("MicroSeconds(%+v)", *p)
 }
 
-func (p *DataPageHeaderV2) GetNumRows() int32 {
-	return p.NumRows
+type NanoSeconds struct {
 }
 
-func (p *DataPageHeaderV2) GetEncoding() Encoding {
-	return p.Encoding
+func NewNanoSeconds() *NanoSeconds {
+	return &NanoSeconds{}
 }
 
-func (p *DataPageHeaderV2) GetDefinitionLevelsByteLength() int32 {
-	return p.DefinitionLevelsByteLength
+func (p *NanoSeconds) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *DataPageHeaderV2) GetRepetitionLevelsByteLength() int32 {
-	return p.RepetitionLevelsByteLength
+func (p *NanoSeconds) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("NanoSeconds"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-var DataPageHeaderV2_IsCompressed_DEFAULT bool = true
+func (p *NanoSeconds) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("NanoSeconds(%+v)", *p)
+}
 
-func (p *DataPageHeaderV2) GetIsCompressed() bool {
-	return p.IsCompressed
+// Attributes:
+//  - MILLIS
+//  - MICROS
+//  - NANOS
+type TimeUnit struct {
+	MILLIS *MilliSeconds `thrift:"MILLIS,1" json:"MILLIS,omitempty"`
+	MICROS *MicroSeconds `thrift:"MICROS,2" json:"MICROS,omitempty"`
+	NANOS  *NanoSeconds  `thrift:"NANOS,3" json:"NANOS,omitempty"`
 }
 
-var DataPageHeaderV2_Statistics_DEFAULT *Statistics
+func NewTimeUnit() *TimeUnit {
+	return &TimeUnit{}
+}
 
-func (p *DataPageHeaderV2) GetStatistics() *Statistics {
-	if !p.IsSetStatistics() {
-		return DataPageHeaderV2_Statistics_DEFAULT
+var TimeUnit_MILLIS_DEFAULT *MilliSeconds
+
+func (p *TimeUnit) GetMILLIS() *MilliSeconds {
+	if !p.IsSetMILLIS() {
+		return TimeUnit_MILLIS_DEFAULT
 	}
-	return p.Statistics
+	return p.MILLIS
 }
-func (p *DataPageHeaderV2) IsSetIsCompressed() bool {
-	return p.IsCompressed != DataPageHeaderV2_IsCompressed_DEFAULT
+
+var TimeUnit_MICROS_DEFAULT *MicroSeconds
+
+func (p *TimeUnit) GetMICROS() *MicroSeconds {
+	if !p.IsSetMICROS() {
+		return TimeUnit_MICROS_DEFAULT
+	}
+	return p.MICROS
 }
 
-func (p *DataPageHeaderV2) IsSetStatistics() bool {
-	return p.Statistics != nil
+var TimeUnit_NANOS_DEFAULT *NanoSeconds
+
+func (p *TimeUnit) GetNANOS() *NanoSeconds {
+	if !p.IsSetNANOS() {
+		return TimeUnit_NANOS_DEFAULT
+	}
+	return p.NANOS
+}
+func (p *TimeUnit) CountSetFieldsTimeUnit() int {
+	count := 0
+	if p.IsSetMILLIS() {
+		count++
+	}
+	if p.IsSetMICROS() {
+		count++
+	}
+	if p.IsSetNANOS() {
+		count++
+	}
+	return count
+
 }
 
-func (p *DataPageHeaderV2) read(iprot thrift.TProtocol) error {
+func (p *TimeUnit) IsSetMILLIS() bool {
+	return p.MILLIS != nil
+}
+
+func (p *TimeUnit) IsSetMICROS() bool {
+	return p.MICROS != nil
+}
+
+func (p *TimeUnit) IsSetNANOS() bool {
+	return p.NANOS != nil
+}
+
+func (p *TimeUnit) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetNumValues bool = false
-	var issetNumNulls bool = false
-	var issetNumRows bool = false
-	var issetEncoding bool = false
-	var issetDefinitionLevelsByteLength bool = false
-	var issetRepetitionLevelsByteLength bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -1798,40 +2122,14 @@ func (p *DataPageHeaderV2) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetNumValues = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetNumNulls = true
 		case 3:
 			if err := p.readField3(iprot); err != nil {
 				return err
 			}
-			issetNumRows = true
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
-			issetEncoding = true
-		case 5:
-			if err := p.readField5(iprot); err != nil {
-				return err
-			}
-			issetDefinitionLevelsByteLength = true
-		case 6:
-			if err := p.readField6(iprot); err != nil {
-				return err
-			}
-			issetRepetitionLevelsByteLength = true
-		case 7:
-			if err := p.readField7(iprot); err != nil {
-				return err
-			}
-		case 8:
-			if err := p.readField8(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -1844,101 +2142,200 @@ func (p *DataPageHeaderV2) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetNumValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
-	}
-	if !issetNumNulls {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumNulls is not set"))
-	}
-	if !issetNumRows {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
-	}
-	if !issetEncoding {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
-	}
-	if !issetDefinitionLevelsByteLength {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DefinitionLevelsByteLength is not set"))
-	}
-	if !issetRepetitionLevelsByteLength {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RepetitionLevelsByteLength is not set"))
+	return nil
+}
+
+func (p *TimeUnit) readField1(iprot thrift.TProtocol) error {
+	p.MILLIS = &MilliSeconds{}
+	if err := p.MILLIS.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MILLIS), err)
 	}
 	return nil
 }
 
-func (p *DataPageHeaderV2) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.NumValues = v
+func (p *TimeUnit) readField2(iprot thrift.TProtocol) error {
+	p.MICROS = &MicroSeconds{}
+	if err := p.MICROS.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MICROS), err)
 	}
 	return nil
 }
 
-func (p *DataPageHeaderV2) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.NumNulls = v
+func (p *TimeUnit) readField3(iprot thrift.TProtocol) error {
+	p.NANOS = &NanoSeconds{}
+	if err := p.NANOS.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.NANOS), err)
 	}
 	return nil
 }
 
-func (p *DataPageHeaderV2) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.NumRows = v
+func (p *TimeUnit) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsTimeUnit(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("TimeUnit"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *DataPageHeaderV2) readField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		temp := Encoding(v)
-		p.Encoding = temp
+func (p *TimeUnit) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMILLIS() {
+		if err := oprot.WriteFieldBegin("MILLIS", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:MILLIS: ", p), err)
+		}
+		if err := p.MILLIS.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MILLIS), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:MILLIS: ", p), err)
+		}
 	}
-	return nil
+	return err
 }
 
-func (p *DataPageHeaderV2) readField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 5: ", err)
-	} else {
-		p.DefinitionLevelsByteLength = v
+func (p *TimeUnit) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMICROS() {
+		if err := oprot.WriteFieldBegin("MICROS", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:MICROS: ", p), err)
+		}
+		if err := p.MICROS.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MICROS), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:MICROS: ", p), err)
+		}
 	}
-	return nil
+	return err
 }
 
-func (p *DataPageHeaderV2) readField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
-	} else {
-		p.RepetitionLevelsByteLength = v
+func (p *TimeUnit) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNANOS() {
+		if err := oprot.WriteFieldBegin("NANOS", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:NANOS: ", p), err)
+		}
+		if err := p.NANOS.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.NANOS), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:NANOS: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *TimeUnit) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TimeUnit(%+v)", *p)
+}
+
+// Timestamp logical type annotation
+//
+// Allowed for physical types: INT64
+//
+// Attributes:
+//  - IsAdjustedToUTC
+//  - Unit
+type TimestampType struct {
+	IsAdjustedToUTC bool      `thrift:"isAdjustedToUTC,1,required" json:"isAdjustedToUTC"`
+	Unit            *TimeUnit `thrift:"unit,2,required" json:"unit"`
+}
+
+func NewTimestampType() *TimestampType {
+	return &TimestampType{}
+}
+
+func (p *TimestampType) GetIsAdjustedToUTC() bool {
+	return p.IsAdjustedToUTC
+}
+
+func (p *TimestampType) GetUnit() *TimeUnit {
+	return p.Unit
+}
+func (p *TimestampType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetIsAdjustedToUTC bool = false
+	var issetUnit bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetIsAdjustedToUTC = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetUnit = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetIsAdjustedToUTC {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field IsAdjustedToUTC is not set"))
+	}
+	if !issetUnit {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Unit is not set"))
 	}
 	return nil
 }
 
-func (p *DataPageHeaderV2) readField7(iprot thrift.TProtocol) error {
+func (p *TimestampType) readField1(iprot thrift.TProtocol) error {
 	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 7: ", err)
+		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.IsCompressed = v
+		p.IsAdjustedToUTC = v
 	}
 	return nil
 }
 
-func (p *DataPageHeaderV2) readField8(iprot thrift.TProtocol) error {
-	p.Statistics = &Statistics{}
-	if err := p.Statistics.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+func (p *TimestampType) readField2(iprot thrift.TProtocol) error {
+	p.Unit = &TimeUnit{}
+	if err := p.Unit.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Unit), err)
 	}
 	return nil
 }
 
-func (p *DataPageHeaderV2) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("DataPageHeaderV2"); err != nil {
+func (p *TimestampType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("TimestampType"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -1947,24 +2344,6 @@ func (p *DataPageHeaderV2) write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField5(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField6(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField7(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField8(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -1974,231 +2353,211 @@ func (p *DataPageHeaderV2) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *DataPageHeaderV2) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_values", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:num_values: ", p), err)
+func (p *TimestampType) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("isAdjustedToUTC", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:isAdjustedToUTC: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.NumValues)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_values (1) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.IsAdjustedToUTC)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.isAdjustedToUTC (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:num_values: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:isAdjustedToUTC: ", p), err)
 	}
 	return err
 }
 
-func (p *DataPageHeaderV2) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_nulls", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:num_nulls: ", p), err)
+func (p *TimestampType) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("unit", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:unit: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.NumNulls)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_nulls (2) field write error: ", p), err)
+	if err := p.Unit.write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Unit), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:num_nulls: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:unit: ", p), err)
 	}
 	return err
 }
 
-func (p *DataPageHeaderV2) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_rows", thrift.I32, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+func (p *TimestampType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteI32(int32(p.NumRows)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	return fmt.Sprintf("TimestampType(%+v)", *p)
+}
+
+// Time logical type annotation
+//
+// Allowed for physical types: INT32 (millis), INT64 (micros, nanos)
+//
+// Attributes:
+//  - IsAdjustedToUTC
+//  - Unit
+type TimeType struct {
+	IsAdjustedToUTC bool      `thrift:"isAdjustedToUTC,1,required" json:"isAdjustedToUTC"`
+	Unit            *TimeUnit `thrift:"unit,2,required" json:"unit"`
+}
+
+func NewTimeType() *TimeType {
+	return &TimeType{}
+}
+
+func (p *TimeType) GetIsAdjustedToUTC() bool {
+	return p.IsAdjustedToUTC
+}
+
+func (p *TimeType) GetUnit() *TimeUnit {
+	return p.Unit
+}
+func (p *TimeType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
+
+	var issetIsAdjustedToUTC bool = false
+	var issetUnit bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetIsAdjustedToUTC = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetUnit = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetIsAdjustedToUTC {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field IsAdjustedToUTC is not set"))
+	}
+	if !issetUnit {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Unit is not set"))
+	}
+	return nil
 }
 
-func (p *DataPageHeaderV2) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:encoding: ", p), err)
+func (p *TimeType) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.IsAdjustedToUTC = v
 	}
-	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.encoding (4) field write error: ", p), err)
+	return nil
+}
+
+func (p *TimeType) readField2(iprot thrift.TProtocol) error {
+	p.Unit = &TimeUnit{}
+	if err := p.Unit.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Unit), err)
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:encoding: ", p), err)
+	return nil
+}
+
+func (p *TimeType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("TimeType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return err
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *DataPageHeaderV2) writeField5(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("definition_levels_byte_length", thrift.I32, 5); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:definition_levels_byte_length: ", p), err)
+func (p *TimeType) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("isAdjustedToUTC", thrift.BOOL, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:isAdjustedToUTC: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.DefinitionLevelsByteLength)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.definition_levels_byte_length (5) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.IsAdjustedToUTC)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.isAdjustedToUTC (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:definition_levels_byte_length: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:isAdjustedToUTC: ", p), err)
 	}
 	return err
 }
 
-func (p *DataPageHeaderV2) writeField6(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("repetition_levels_byte_length", thrift.I32, 6); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:repetition_levels_byte_length: ", p), err)
+func (p *TimeType) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("unit", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:unit: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.RepetitionLevelsByteLength)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.repetition_levels_byte_length (6) field write error: ", p), err)
+	if err := p.Unit.write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Unit), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:repetition_levels_byte_length: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:unit: ", p), err)
 	}
 	return err
 }
 
-func (p *DataPageHeaderV2) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIsCompressed() {
-		if err := oprot.WriteFieldBegin("is_compressed", thrift.BOOL, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:is_compressed: ", p), err)
-		}
-		if err := oprot.WriteBool(bool(p.IsCompressed)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.is_compressed (7) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:is_compressed: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *DataPageHeaderV2) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatistics() {
-		if err := oprot.WriteFieldBegin("statistics", thrift.STRUCT, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:statistics: ", p), err)
-		}
-		if err := p.Statistics.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:statistics: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *DataPageHeaderV2) String() string {
+func (p *TimeType) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("DataPageHeaderV2(%+v)", *p)
+	return fmt.Sprintf("TimeType(%+v)", *p)
 }
 
-// Attributes:
-//  - Type: the type of the page: indicates which of the *_header fields is set *
-//  - UncompressedPageSize: Uncompressed page size in bytes (not including this header) *
-//  - CompressedPageSize: Compressed page size in bytes (not including this header) *
-//  - Crc: 32bit crc for the data below. This allows for disabling checksumming in HDFS
-// if only a few pages needs to be read
+// Integer logical type annotation
 //
-//  - DataPageHeader
-//  - IndexPageHeader
-//  - DictionaryPageHeader
-//  - DataPageHeaderV2
-type PageHeader struct {
-	Type                 PageType              `thrift:"type,1,required" json:"type"`
-	UncompressedPageSize int32                 `thrift:"uncompressed_page_size,2,required" json:"uncompressed_page_size"`
-	CompressedPageSize   int32                 `thrift:"compressed_page_size,3,required" json:"compressed_page_size"`
-	Crc                  *int32                `thrift:"crc,4" json:"crc,omitempty"`
-	DataPageHeader       *DataPageHeader       `thrift:"data_page_header,5" json:"data_page_header,omitempty"`
-	IndexPageHeader      *IndexPageHeader      `thrift:"index_page_header,6" json:"index_page_header,omitempty"`
-	DictionaryPageHeader *DictionaryPageHeader `thrift:"dictionary_page_header,7" json:"dictionary_page_header,omitempty"`
-	DataPageHeaderV2     *DataPageHeaderV2     `thrift:"data_page_header_v2,8" json:"data_page_header_v2,omitempty"`
-}
-
-func NewPageHeader() *PageHeader {
-	return &PageHeader{}
-}
-
-func (p *PageHeader) GetType() PageType {
-	return p.Type
-}
-
-func (p *PageHeader) GetUncompressedPageSize() int32 {
-	return p.UncompressedPageSize
-}
-
-func (p *PageHeader) GetCompressedPageSize() int32 {
-	return p.CompressedPageSize
-}
-
-var PageHeader_Crc_DEFAULT int32
-
-func (p *PageHeader) GetCrc() int32 {
-	if !p.IsSetCrc() {
-		return PageHeader_Crc_DEFAULT
-	}
-	return *p.Crc
-}
-
-var PageHeader_DataPageHeader_DEFAULT *DataPageHeader
-
-func (p *PageHeader) GetDataPageHeader() *DataPageHeader {
-	if !p.IsSetDataPageHeader() {
-		return PageHeader_DataPageHeader_DEFAULT
-	}
-	return p.DataPageHeader
-}
-
-var PageHeader_IndexPageHeader_DEFAULT *IndexPageHeader
-
-func (p *PageHeader) GetIndexPageHeader() *IndexPageHeader {
-	if !p.IsSetIndexPageHeader() {
-		return PageHeader_IndexPageHeader_DEFAULT
-	}
-	return p.IndexPageHeader
-}
-
-var PageHeader_DictionaryPageHeader_DEFAULT *DictionaryPageHeader
-
-func (p *PageHeader) GetDictionaryPageHeader() *DictionaryPageHeader {
-	if !p.IsSetDictionaryPageHeader() {
-		return PageHeader_DictionaryPageHeader_DEFAULT
-	}
-	return p.DictionaryPageHeader
-}
-
-var PageHeader_DataPageHeaderV2_DEFAULT *DataPageHeaderV2
-
-func (p *PageHeader) GetDataPageHeaderV2() *DataPageHeaderV2 {
-	if !p.IsSetDataPageHeaderV2() {
-		return PageHeader_DataPageHeaderV2_DEFAULT
-	}
-	return p.DataPageHeaderV2
-}
-func (p *PageHeader) IsSetCrc() bool {
-	return p.Crc != nil
-}
-
-func (p *PageHeader) IsSetDataPageHeader() bool {
-	return p.DataPageHeader != nil
+// bitWidth must be 8, 16, 32, or 64.
+//
+// Allowed for physical types: INT32, INT64
+//
+// Attributes:
+//  - BitWidth
+//  - IsSigned
+type IntType struct {
+	BitWidth int8 `thrift:"bitWidth,1,required" json:"bitWidth"`
+	IsSigned bool `thrift:"isSigned,2,required" json:"isSigned"`
 }
 
-func (p *PageHeader) IsSetIndexPageHeader() bool {
-	return p.IndexPageHeader != nil
+func NewIntType() *IntType {
+	return &IntType{}
 }
 
-func (p *PageHeader) IsSetDictionaryPageHeader() bool {
-	return p.DictionaryPageHeader != nil
+func (p *IntType) GetBitWidth() int8 {
+	return p.BitWidth
 }
 
-func (p *PageHeader) IsSetDataPageHeaderV2() bool {
-	return p.DataPageHeaderV2 != nil
+func (p *IntType) GetIsSigned() bool {
+	return p.IsSigned
 }
-
-func (p *PageHeader) read(iprot thrift.TProtocol) error {
+func (p *IntType) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetType bool = false
-	var issetUncompressedPageSize bool = false
-	var issetCompressedPageSize bool = false
+	var issetBitWidth bool = false
+	var issetIsSigned bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -2213,37 +2572,12 @@ func (p *PageHeader) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetType = true
+			issetBitWidth = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetUncompressedPageSize = true
-		case 3:
-			if err := p.readField3(iprot); err != nil {
-				return err
-			}
-			issetCompressedPageSize = true
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
-		case 5:
-			if err := p.readField5(iprot); err != nil {
-				return err
-			}
-		case 6:
-			if err := p.readField6(iprot); err != nil {
-				return err
-			}
-		case 7:
-			if err := p.readField7(iprot); err != nil {
-				return err
-			}
-		case 8:
-			if err := p.readField8(iprot); err != nil {
-				return err
-			}
+			issetIsSigned = true
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -2256,91 +2590,35 @@ func (p *PageHeader) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetType {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
-	}
-	if !issetUncompressedPageSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field UncompressedPageSize is not set"))
+	if !issetBitWidth {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field BitWidth is not set"))
 	}
-	if !issetCompressedPageSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
+	if !issetIsSigned {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field IsSigned is not set"))
 	}
 	return nil
 }
 
-func (p *PageHeader) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *IntType) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadByte(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		temp := PageType(v)
-		p.Type = temp
+		p.BitWidth = v
 	}
 	return nil
 }
 
-func (p *PageHeader) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *IntType) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.UncompressedPageSize = v
-	}
-	return nil
-}
-
-func (p *PageHeader) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.CompressedPageSize = v
-	}
-	return nil
-}
-
-func (p *PageHeader) readField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 4: ", err)
-	} else {
-		p.Crc = &v
-	}
-	return nil
-}
-
-func (p *PageHeader) readField5(iprot thrift.TProtocol) error {
-	p.DataPageHeader = &DataPageHeader{}
-	if err := p.DataPageHeader.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeader), err)
-	}
-	return nil
-}
-
-func (p *PageHeader) readField6(iprot thrift.TProtocol) error {
-	p.IndexPageHeader = &IndexPageHeader{}
-	if err := p.IndexPageHeader.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IndexPageHeader), err)
-	}
-	return nil
-}
-
-func (p *PageHeader) readField7(iprot thrift.TProtocol) error {
-	p.DictionaryPageHeader = &DictionaryPageHeader{}
-	if err := p.DictionaryPageHeader.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DictionaryPageHeader), err)
-	}
-	return nil
-}
-
-func (p *PageHeader) readField8(iprot thrift.TProtocol) error {
-	p.DataPageHeaderV2 = &DataPageHeaderV2{
-		IsCompressed: true,
-	}
-	if err := p.DataPageHeaderV2.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeaderV2), err)
+		p.IsSigned = v
 	}
 	return nil
 }
 
-func (p *PageHeader) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("PageHeader"); err != nil {
+func (p *IntType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("IntType"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -2349,24 +2627,6 @@ func (p *PageHeader) write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField5(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField6(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField7(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField8(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -2376,164 +2636,110 @@ func (p *PageHeader) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PageHeader) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
+func (p *IntType) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("bitWidth", thrift.BYTE, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:bitWidth: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.Type)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
+	if err := oprot.WriteByte(int8(p.BitWidth)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.bitWidth (1) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:bitWidth: ", p), err)
 	}
 	return err
 }
 
-func (p *PageHeader) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("uncompressed_page_size", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:uncompressed_page_size: ", p), err)
+func (p *IntType) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("isSigned", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:isSigned: ", p), err)
 	}
-	if err := oprot.WriteI32(int32(p.UncompressedPageSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.uncompressed_page_size (2) field write error: ", p), err)
+	if err := oprot.WriteBool(bool(p.IsSigned)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.isSigned (2) field write error: ", p), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:uncompressed_page_size: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:isSigned: ", p), err)
 	}
 	return err
 }
 
-func (p *PageHeader) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("compressed_page_size", thrift.I32, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:compressed_page_size: ", p), err)
+func (p *IntType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteI32(int32(p.CompressedPageSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:compressed_page_size: ", p), err)
-	}
-	return err
+	return fmt.Sprintf("IntType(%+v)", *p)
 }
 
-func (p *PageHeader) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCrc() {
-		if err := oprot.WriteFieldBegin("crc", thrift.I32, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:crc: ", p), err)
-		}
-		if err := oprot.WriteI32(int32(*p.Crc)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.crc (4) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:crc: ", p), err)
-		}
-	}
-	return err
+// Embedded JSON logical type annotation
+//
+// Allowed for physical types: BYTE_ARRAY
+type JsonType struct {
 }
 
-func (p *PageHeader) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataPageHeader() {
-		if err := oprot.WriteFieldBegin("data_page_header", thrift.STRUCT, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:data_page_header: ", p), err)
-		}
-		if err := p.DataPageHeader.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeader), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:data_page_header: ", p), err)
-		}
-	}
-	return err
+func NewJsonType() *JsonType {
+	return &JsonType{}
 }
 
-func (p *PageHeader) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIndexPageHeader() {
-		if err := oprot.WriteFieldBegin("index_page_header", thrift.STRUCT, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:index_page_header: ", p), err)
-		}
-		if err := p.IndexPageHeader.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IndexPageHeader), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:index_page_header: ", p), err)
-		}
+func (p *JsonType) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
-	return err
-}
 
-func (p *PageHeader) writeField7(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDictionaryPageHeader() {
-		if err := oprot.WriteFieldBegin("dictionary_page_header", thrift.STRUCT, 7); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:dictionary_page_header: ", p), err)
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := p.DictionaryPageHeader.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DictionaryPageHeader), err)
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:dictionary_page_header: ", p), err)
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *PageHeader) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDataPageHeaderV2() {
-		if err := oprot.WriteFieldBegin("data_page_header_v2", thrift.STRUCT, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:data_page_header_v2: ", p), err)
-		}
-		if err := p.DataPageHeaderV2.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeaderV2), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:data_page_header_v2: ", p), err)
-		}
+func (p *JsonType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("JsonType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	return err
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *PageHeader) String() string {
+func (p *JsonType) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PageHeader(%+v)", *p)
+	return fmt.Sprintf("JsonType(%+v)", *p)
 }
 
-// Wrapper struct to store key values
+// Embedded BSON logical type annotation
 //
-// Attributes:
-//  - Key
-//  - Value
-type KeyValue struct {
-	Key   string  `thrift:"key,1,required" json:"key"`
-	Value *string `thrift:"value,2" json:"value,omitempty"`
-}
-
-func NewKeyValue() *KeyValue {
-	return &KeyValue{}
-}
-
-func (p *KeyValue) GetKey() string {
-	return p.Key
+// Allowed for physical types: BYTE_ARRAY
+type BsonType struct {
 }
 
-var KeyValue_Value_DEFAULT string
-
-func (p *KeyValue) GetValue() string {
-	if !p.IsSetValue() {
-		return KeyValue_Value_DEFAULT
-	}
-	return *p.Value
-}
-func (p *KeyValue) IsSetValue() bool {
-	return p.Value != nil
+func NewBsonType() *BsonType {
+	return &BsonType{}
 }
 
-func (p *KeyValue) read(iprot thrift.TProtocol) error {
+func (p *BsonType) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetKey bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -2542,20 +2748,8 @@ func (p *KeyValue) read(iprot thrift.TProtocol) error {
 		if fieldTypeId == thrift.STOP {
 			break
 		}
-		switch fieldId {
-		case 1:
-			if err := p.readField1(iprot); err != nil {
-				return err
-			}
-			issetKey = true
-		case 2:
-			if err := p.readField2(iprot); err != nil {
-				return err
-			}
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
 		}
 		if err := iprot.ReadFieldEnd(); err != nil {
 			return err
@@ -2564,40 +2758,13 @@ func (p *KeyValue) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetKey {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Key is not set"))
-	}
-	return nil
-}
-
-func (p *KeyValue) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Key = v
-	}
-	return nil
-}
-
-func (p *KeyValue) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Value = &v
-	}
 	return nil
 }
 
-func (p *KeyValue) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("KeyValue"); err != nil {
+func (p *BsonType) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("BsonType"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField2(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -2607,257 +2774,343 @@ func (p *KeyValue) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *KeyValue) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:key: ", p), err)
+func (p *BsonType) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteString(string(p.Key)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.key (1) field write error: ", p), err)
+	return fmt.Sprintf("BsonType(%+v)", *p)
+}
+
+// LogicalType annotations to replace ConvertedType.
+//
+// To maintain compatibility, implementations using LogicalType for a
+// SchemaElement must also set the corresponding ConvertedType (if any)
+// from the following table.
+//
+// Attributes:
+//  - STRING
+//  - MAP
+//  - LIST
+//  - ENUM
+//  - DECIMAL
+//  - DATE
+//  - TIME
+//  - TIMESTAMP
+//  - INTEGER
+//  - UNKNOWN
+//  - JSON
+//  - BSON
+//  - UUID
+//  - FLOAT16
+//  - VARIANT
+//  - GEOMETRY
+//  - GEOGRAPHY
+type LogicalType struct {
+	STRING    *StringType    `thrift:"STRING,1" json:"STRING,omitempty"`
+	MAP       *MapType       `thrift:"MAP,2" json:"MAP,omitempty"`
+	LIST      *ListType      `thrift:"LIST,3" json:"LIST,omitempty"`
+	ENUM      *EnumType      `thrift:"ENUM,4" json:"ENUM,omitempty"`
+	DECIMAL   *DecimalType   `thrift:"DECIMAL,5" json:"DECIMAL,omitempty"`
+	DATE      *DateType      `thrift:"DATE,6" json:"DATE,omitempty"`
+	TIME      *TimeType      `thrift:"TIME,7" json:"TIME,omitempty"`
+	TIMESTAMP *TimestampType `thrift:"TIMESTAMP,8" json:"TIMESTAMP,omitempty"`
+	INTEGER   *IntType       `thrift:"INTEGER,10" json:"INTEGER,omitempty"`
+	UNKNOWN   *NullType      `thrift:"UNKNOWN,11" json:"UNKNOWN,omitempty"`
+	JSON      *JsonType      `thrift:"JSON,12" json:"JSON,omitempty"`
+	BSON      *BsonType      `thrift:"BSON,13" json:"BSON,omitempty"`
+	UUID      *UUIDType      `thrift:"UUID,14" json:"UUID,omitempty"`
+	FLOAT16   *Float16Type   `thrift:"FLOAT16,15" json:"FLOAT16,omitempty"`
+	VARIANT   *VariantType   `thrift:"VARIANT,16" json:"VARIANT,omitempty"`
+	GEOMETRY  *GeometryType  `thrift:"GEOMETRY,17" json:"GEOMETRY,omitempty"`
+	GEOGRAPHY *GeographyType `thrift:"GEOGRAPHY,18" json:"GEOGRAPHY,omitempty"`
+}
+
+func NewLogicalType() *LogicalType {
+	return &LogicalType{}
+}
+
+var LogicalType_STRING_DEFAULT *StringType
+
+func (p *LogicalType) GetSTRING() *StringType {
+	if !p.IsSetSTRING() {
+		return LogicalType_STRING_DEFAULT
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:key: ", p), err)
+	return p.STRING
+}
+
+var LogicalType_MAP_DEFAULT *MapType
+
+func (p *LogicalType) GetMAP() *MapType {
+	if !p.IsSetMAP() {
+		return LogicalType_MAP_DEFAULT
 	}
-	return err
+	return p.MAP
 }
 
-func (p *KeyValue) writeField2(oprot thrift.TProtocol) (err error) {
-	if p.IsSetValue() {
-		if err := oprot.WriteFieldBegin("value", thrift.STRING, 2); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:value: ", p), err)
-		}
-		if err := oprot.WriteString(string(*p.Value)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.value (2) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:value: ", p), err)
-		}
+var LogicalType_LIST_DEFAULT *ListType
+
+func (p *LogicalType) GetLIST() *ListType {
+	if !p.IsSetLIST() {
+		return LogicalType_LIST_DEFAULT
 	}
-	return err
+	return p.LIST
 }
 
-func (p *KeyValue) String() string {
-	if p == nil {
-		return "<nil>"
+var LogicalType_ENUM_DEFAULT *EnumType
+
+func (p *LogicalType) GetENUM() *EnumType {
+	if !p.IsSetENUM() {
+		return LogicalType_ENUM_DEFAULT
 	}
-	return fmt.Sprintf("KeyValue(%+v)", *p)
+	return p.ENUM
 }
 
-// Wrapper struct to specify sort order
-//
-// Attributes:
-//  - ColumnIdx: The column index (in this row group) *
-//  - Descending: If true, indicates this column is sorted in descending order. *
-//  - NullsFirst: If true, nulls will come before non-null values, otherwise,
-// nulls go at the end.
-type SortingColumn struct {
-	ColumnIdx  int32 `thrift:"column_idx,1,required" json:"column_idx"`
-	Descending bool  `thrift:"descending,2,required" json:"descending"`
-	NullsFirst bool  `thrift:"nulls_first,3,required" json:"nulls_first"`
+var LogicalType_DECIMAL_DEFAULT *DecimalType
+
+func (p *LogicalType) GetDECIMAL() *DecimalType {
+	if !p.IsSetDECIMAL() {
+		return LogicalType_DECIMAL_DEFAULT
+	}
+	return p.DECIMAL
 }
 
-func NewSortingColumn() *SortingColumn {
-	return &SortingColumn{}
+var LogicalType_DATE_DEFAULT *DateType
+
+func (p *LogicalType) GetDATE() *DateType {
+	if !p.IsSetDATE() {
+		return LogicalType_DATE_DEFAULT
+	}
+	return p.DATE
 }
 
-func (p *SortingColumn) GetColumnIdx() int32 {
-	return p.ColumnIdx
+var LogicalType_TIME_DEFAULT *TimeType
+
+func (p *LogicalType) GetTIME() *TimeType {
+	if !p.IsSetTIME() {
+		return LogicalType_TIME_DEFAULT
+	}
+	return p.TIME
 }
 
-func (p *SortingColumn) GetDescending() bool {
-	return p.Descending
+var LogicalType_TIMESTAMP_DEFAULT *TimestampType
+
+func (p *LogicalType) GetTIMESTAMP() *TimestampType {
+	if !p.IsSetTIMESTAMP() {
+		return LogicalType_TIMESTAMP_DEFAULT
+	}
+	return p.TIMESTAMP
 }
 
-func (p *SortingColumn) GetNullsFirst() bool {
-	return p.NullsFirst
+var LogicalType_INTEGER_DEFAULT *IntType
+
+func (p *LogicalType) GetINTEGER() *IntType {
+	if !p.IsSetINTEGER() {
+		return LogicalType_INTEGER_DEFAULT
+	}
+	return p.INTEGER
 }
-func (p *SortingColumn) read(iprot thrift.TProtocol) error {
-	if _, err := iprot.ReadStructBegin(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+
+var LogicalType_UNKNOWN_DEFAULT *NullType
+
+func (p *LogicalType) GetUNKNOWN() *NullType {
+	if !p.IsSetUNKNOWN() {
+		return LogicalType_UNKNOWN_DEFAULT
 	}
+	return p.UNKNOWN
+}
 
-	var issetColumnIdx bool = false
-	var issetDescending bool = false
-	var issetNullsFirst bool = false
+var LogicalType_JSON_DEFAULT *JsonType
 
-	for {
-		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-		if err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-		}
-		if fieldTypeId == thrift.STOP {
-			break
-		}
-		switch fieldId {
-		case 1:
-			if err := p.readField1(iprot); err != nil {
-				return err
-			}
-			issetColumnIdx = true
-		case 2:
-			if err := p.readField2(iprot); err != nil {
-				return err
-			}
-			issetDescending = true
-		case 3:
-			if err := p.readField3(iprot); err != nil {
-				return err
-			}
-			issetNullsFirst = true
-		default:
-			if err := iprot.Skip(fieldTypeId); err != nil {
-				return err
-			}
-		}
-		if err := iprot.ReadFieldEnd(); err != nil {
-			return err
-		}
-	}
-	if err := iprot.ReadStructEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-	}
-	if !issetColumnIdx {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ColumnIdx is not set"))
+func (p *LogicalType) GetJSON() *JsonType {
+	if !p.IsSetJSON() {
+		return LogicalType_JSON_DEFAULT
 	}
-	if !issetDescending {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Descending is not set"))
+	return p.JSON
+}
+
+var LogicalType_BSON_DEFAULT *BsonType
+
+func (p *LogicalType) GetBSON() *BsonType {
+	if !p.IsSetBSON() {
+		return LogicalType_BSON_DEFAULT
 	}
-	if !issetNullsFirst {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullsFirst is not set"))
+	return p.BSON
+}
+
+var LogicalType_UUID_DEFAULT *UUIDType
+
+func (p *LogicalType) GetUUID() *UUIDType {
+	if !p.IsSetUUID() {
+		return LogicalType_UUID_DEFAULT
 	}
-	return nil
+	return p.UUID
 }
 
-func (p *SortingColumn) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.ColumnIdx = v
+var LogicalType_FLOAT16_DEFAULT *Float16Type
+
+func (p *LogicalType) GetFLOAT16() *Float16Type {
+	if !p.IsSetFLOAT16() {
+		return LogicalType_FLOAT16_DEFAULT
 	}
-	return nil
+	return p.FLOAT16
 }
 
-func (p *SortingColumn) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		p.Descending = v
+var LogicalType_VARIANT_DEFAULT *VariantType
+
+func (p *LogicalType) GetVARIANT() *VariantType {
+	if !p.IsSetVARIANT() {
+		return LogicalType_VARIANT_DEFAULT
 	}
-	return nil
+	return p.VARIANT
 }
 
-func (p *SortingColumn) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadBool(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.NullsFirst = v
+var LogicalType_GEOMETRY_DEFAULT *GeometryType
+
+func (p *LogicalType) GetGEOMETRY() *GeometryType {
+	if !p.IsSetGEOMETRY() {
+		return LogicalType_GEOMETRY_DEFAULT
 	}
-	return nil
+	return p.GEOMETRY
 }
 
-func (p *SortingColumn) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("SortingColumn"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+var LogicalType_GEOGRAPHY_DEFAULT *GeographyType
+
+func (p *LogicalType) GetGEOGRAPHY() *GeographyType {
+	if !p.IsSetGEOGRAPHY() {
+		return LogicalType_GEOGRAPHY_DEFAULT
 	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
+	return p.GEOGRAPHY
+}
+func (p *LogicalType) CountSetFieldsLogicalType() int {
+	count := 0
+	if p.IsSetSTRING() {
+		count++
 	}
-	if err := p.writeField2(oprot); err != nil {
-		return err
+	if p.IsSetMAP() {
+		count++
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
+	if p.IsSetLIST() {
+		count++
 	}
-	if err := oprot.WriteFieldStop(); err != nil {
-		return thrift.PrependError("write field stop error: ", err)
+	if p.IsSetENUM() {
+		count++
 	}
-	if err := oprot.WriteStructEnd(); err != nil {
-		return thrift.PrependError("write struct stop error: ", err)
+	if p.IsSetDECIMAL() {
+		count++
 	}
-	return nil
-}
-
-func (p *SortingColumn) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("column_idx", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:column_idx: ", p), err)
+	if p.IsSetDATE() {
+		count++
 	}
-	if err := oprot.WriteI32(int32(p.ColumnIdx)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.column_idx (1) field write error: ", p), err)
+	if p.IsSetTIME() {
+		count++
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:column_idx: ", p), err)
+	if p.IsSetTIMESTAMP() {
+		count++
 	}
-	return err
-}
-
-func (p *SortingColumn) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("descending", thrift.BOOL, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:descending: ", p), err)
+	if p.IsSetINTEGER() {
+		count++
 	}
-	if err := oprot.WriteBool(bool(p.Descending)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.descending (2) field write error: ", p), err)
+	if p.IsSetUNKNOWN() {
+		count++
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:descending: ", p), err)
+	if p.IsSetJSON() {
+		count++
 	}
-	return err
-}
-
-func (p *SortingColumn) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("nulls_first", thrift.BOOL, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:nulls_first: ", p), err)
+	if p.IsSetBSON() {
+		count++
 	}
-	if err := oprot.WriteBool(bool(p.NullsFirst)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.nulls_first (3) field write error: ", p), err)
+	if p.IsSetUUID() {
+		count++
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:nulls_first: ", p), err)
+	if p.IsSetFLOAT16() {
+		count++
 	}
-	return err
+	if p.IsSetVARIANT() {
+		count++
+	}
+	if p.IsSetGEOMETRY() {
+		count++
+	}
+	if p.IsSetGEOGRAPHY() {
+		count++
+	}
+	return count
+
 }
 
-func (p *SortingColumn) String() string {
-	if p == nil {
-		return "<nil>"
-	}
-	return fmt.Sprintf("SortingColumn(%+v)", *p)
+func (p *LogicalType) IsSetSTRING() bool {
+	return p.STRING != nil
 }
 
-// statistics of a given page type and encoding
-//
-// Attributes:
-//  - PageType: the page type (data/dic/...) *
-//  - Encoding: encoding of the page *
-//  - Count: number of pages of this type with this encoding *
-type PageEncodingStats struct {
-	PageType PageType `thrift:"page_type,1,required" json:"page_type"`
-	Encoding Encoding `thrift:"encoding,2,required" json:"encoding"`
-	Count    int32    `thrift:"count,3,required" json:"count"`
+func (p *LogicalType) IsSetMAP() bool {
+	return p.MAP != nil
 }
 
-func NewPageEncodingStats() *PageEncodingStats {
-	return &PageEncodingStats{}
+func (p *LogicalType) IsSetLIST() bool {
+	return p.LIST != nil
 }
 
-func (p *PageEncodingStats) GetPageType() PageType {
-	return p.PageType
+func (p *LogicalType) IsSetENUM() bool {
+	return p.ENUM != nil
 }
 
-func (p *PageEncodingStats) GetEncoding() Encoding {
-	return p.Encoding
+func (p *LogicalType) IsSetDECIMAL() bool {
+	return p.DECIMAL != nil
 }
 
-func (p *PageEncodingStats) GetCount() int32 {
-	return p.Count
+func (p *LogicalType) IsSetDATE() bool {
+	return p.DATE != nil
 }
-func (p *PageEncodingStats) read(iprot thrift.TProtocol) error {
+
+func (p *LogicalType) IsSetTIME() bool {
+	return p.TIME != nil
+}
+
+func (p *LogicalType) IsSetTIMESTAMP() bool {
+	return p.TIMESTAMP != nil
+}
+
+func (p *LogicalType) IsSetINTEGER() bool {
+	return p.INTEGER != nil
+}
+
+func (p *LogicalType) IsSetUNKNOWN() bool {
+	return p.UNKNOWN != nil
+}
+
+func (p *LogicalType) IsSetJSON() bool {
+	return p.JSON != nil
+}
+
+func (p *LogicalType) IsSetBSON() bool {
+	return p.BSON != nil
+}
+
+func (p *LogicalType) IsSetUUID() bool {
+	return p.UUID != nil
+}
+
+func (p *LogicalType) IsSetFLOAT16() bool {
+	return p.FLOAT16 != nil
+}
+
+func (p *LogicalType) IsSetVARIANT() bool {
+	return p.VARIANT != nil
+}
+
+func (p *LogicalType) IsSetGEOMETRY() bool {
+	return p.GEOMETRY != nil
+}
+
+func (p *LogicalType) IsSetGEOGRAPHY() bool {
+	return p.GEOGRAPHY != nil
+}
+
+func (p *LogicalType) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetPageType bool = false
-	var issetEncoding bool = false
-	var issetCount bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -2871,17 +3124,70 @@ func (p *PageEncodingStats) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetPageType = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetEncoding = true
 		case 3:
 			if err := p.readField3(iprot); err != nil {
 				return err
 			}
-			issetCount = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 10:
+			if err := p.readField10(iprot); err != nil {
+				return err
+			}
+		case 11:
+			if err := p.readField11(iprot); err != nil {
+				return err
+			}
+		case 12:
+			if err := p.readField12(iprot); err != nil {
+				return err
+			}
+		case 13:
+			if err := p.readField13(iprot); err != nil {
+				return err
+			}
+		case 14:
+			if err := p.readField14(iprot); err != nil {
+				return err
+			}
+		case 15:
+			if err := p.readField15(iprot); err != nil {
+				return err
+			}
+		case 16:
+			if err := p.readField16(iprot); err != nil {
+				return err
+			}
+		case 17:
+			if err := p.readField17(iprot); err != nil {
+				return err
+			}
+		case 18:
+			if err := p.readField18(iprot); err != nil {
+				return err
+			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -2894,56 +3200,6686 @@ func (p *PageEncodingStats) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetPageType {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageType is not set"))
+	return nil
+}
+
+func (p *LogicalType) readField1(iprot thrift.TProtocol) error {
+	p.STRING = &StringType{}
+	if err := p.STRING.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.STRING), err)
 	}
-	if !issetEncoding {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	return nil
+}
+
+func (p *LogicalType) readField2(iprot thrift.TProtocol) error {
+	p.MAP = &MapType{}
+	if err := p.MAP.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MAP), err)
 	}
-	if !issetCount {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Count is not set"))
+	return nil
+}
+
+func (p *LogicalType) readField3(iprot thrift.TProtocol) error {
+	p.LIST = &ListType{}
+	if err := p.LIST.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.LIST), err)
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		temp := PageType(v)
-		p.PageType = temp
+func (p *LogicalType) readField4(iprot thrift.TProtocol) error {
+	p.ENUM = &EnumType{}
+	if err := p.ENUM.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ENUM), err)
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 2: ", err)
-	} else {
-		temp := Encoding(v)
-		p.Encoding = temp
+func (p *LogicalType) readField5(iprot thrift.TProtocol) error {
+	p.DECIMAL = &DecimalType{}
+	if err := p.DECIMAL.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DECIMAL), err)
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.Count = v
+func (p *LogicalType) readField6(iprot thrift.TProtocol) error {
+	p.DATE = &DateType{}
+	if err := p.DATE.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DATE), err)
 	}
 	return nil
 }
 
-func (p *PageEncodingStats) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("PageEncodingStats"); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+func (p *LogicalType) readField7(iprot thrift.TProtocol) error {
+	p.TIME = &TimeType{}
+	if err := p.TIME.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TIME), err)
 	}
-	if err := p.writeField1(oprot); err != nil {
-		return err
+	return nil
+}
+
+func (p *LogicalType) readField8(iprot thrift.TProtocol) error {
+	p.TIMESTAMP = &TimestampType{}
+	if err := p.TIMESTAMP.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TIMESTAMP), err)
 	}
-	if err := p.writeField2(oprot); err != nil {
-		return err
+	return nil
+}
+
+func (p *LogicalType) readField10(iprot thrift.TProtocol) error {
+	p.INTEGER = &IntType{}
+	if err := p.INTEGER.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.INTEGER), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField11(iprot thrift.TProtocol) error {
+	p.UNKNOWN = &NullType{}
+	if err := p.UNKNOWN.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UNKNOWN), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField12(iprot thrift.TProtocol) error {
+	p.JSON = &JsonType{}
+	if err := p.JSON.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.JSON), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField13(iprot thrift.TProtocol) error {
+	p.BSON = &BsonType{}
+	if err := p.BSON.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BSON), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField14(iprot thrift.TProtocol) error {
+	p.UUID = &UUIDType{}
+	if err := p.UUID.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UUID), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField15(iprot thrift.TProtocol) error {
+	p.FLOAT16 = &Float16Type{}
+	if err := p.FLOAT16.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FLOAT16), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField16(iprot thrift.TProtocol) error {
+	p.VARIANT = &VariantType{}
+	if err := p.VARIANT.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.VARIANT), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField17(iprot thrift.TProtocol) error {
+	p.GEOMETRY = &GeometryType{}
+	if err := p.GEOMETRY.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GEOMETRY), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) readField18(iprot thrift.TProtocol) error {
+	p.GEOGRAPHY = &GeographyType{}
+	if err := p.GEOGRAPHY.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GEOGRAPHY), err)
+	}
+	return nil
+}
+
+func (p *LogicalType) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsLogicalType(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("LogicalType"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField10(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField11(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField12(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField13(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField14(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField15(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField16(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField17(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField18(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *LogicalType) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSTRING() {
+		if err := oprot.WriteFieldBegin("STRING", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:STRING: ", p), err)
+		}
+		if err := p.STRING.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.STRING), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:STRING: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMAP() {
+		if err := oprot.WriteFieldBegin("MAP", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:MAP: ", p), err)
+		}
+		if err := p.MAP.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MAP), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:MAP: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLIST() {
+		if err := oprot.WriteFieldBegin("LIST", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:LIST: ", p), err)
+		}
+		if err := p.LIST.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.LIST), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:LIST: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetENUM() {
+		if err := oprot.WriteFieldBegin("ENUM", thrift.STRUCT, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ENUM: ", p), err)
+		}
+		if err := p.ENUM.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ENUM), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ENUM: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDECIMAL() {
+		if err := oprot.WriteFieldBegin("DECIMAL", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:DECIMAL: ", p), err)
+		}
+		if err := p.DECIMAL.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DECIMAL), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:DECIMAL: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDATE() {
+		if err := oprot.WriteFieldBegin("DATE", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:DATE: ", p), err)
+		}
+		if err := p.DATE.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DATE), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:DATE: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTIME() {
+		if err := oprot.WriteFieldBegin("TIME", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:TIME: ", p), err)
+		}
+		if err := p.TIME.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TIME), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:TIME: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTIMESTAMP() {
+		if err := oprot.WriteFieldBegin("TIMESTAMP", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:TIMESTAMP: ", p), err)
+		}
+		if err := p.TIMESTAMP.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TIMESTAMP), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:TIMESTAMP: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetINTEGER() {
+		if err := oprot.WriteFieldBegin("INTEGER", thrift.STRUCT, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:INTEGER: ", p), err)
+		}
+		if err := p.INTEGER.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.INTEGER), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:INTEGER: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUNKNOWN() {
+		if err := oprot.WriteFieldBegin("UNKNOWN", thrift.STRUCT, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:UNKNOWN: ", p), err)
+		}
+		if err := p.UNKNOWN.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UNKNOWN), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:UNKNOWN: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetJSON() {
+		if err := oprot.WriteFieldBegin("JSON", thrift.STRUCT, 12); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:JSON: ", p), err)
+		}
+		if err := p.JSON.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.JSON), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:JSON: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBSON() {
+		if err := oprot.WriteFieldBegin("BSON", thrift.STRUCT, 13); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:BSON: ", p), err)
+		}
+		if err := p.BSON.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BSON), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 13:BSON: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUUID() {
+		if err := oprot.WriteFieldBegin("UUID", thrift.STRUCT, 14); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 14:UUID: ", p), err)
+		}
+		if err := p.UUID.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UUID), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 14:UUID: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFLOAT16() {
+		if err := oprot.WriteFieldBegin("FLOAT16", thrift.STRUCT, 15); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 15:FLOAT16: ", p), err)
+		}
+		if err := p.FLOAT16.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FLOAT16), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 15:FLOAT16: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField16(oprot thrift.TProtocol) (err error) {
+	if p.IsSetVARIANT() {
+		if err := oprot.WriteFieldBegin("VARIANT", thrift.STRUCT, 16); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 16:VARIANT: ", p), err)
+		}
+		if err := p.VARIANT.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.VARIANT), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 16:VARIANT: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField17(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGEOMETRY() {
+		if err := oprot.WriteFieldBegin("GEOMETRY", thrift.STRUCT, 17); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 16:GEOMETRY: ", p), err)
+		}
+		if err := p.GEOMETRY.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GEOMETRY), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 16:GEOMETRY: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) writeField18(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGEOGRAPHY() {
+		if err := oprot.WriteFieldBegin("GEOGRAPHY", thrift.STRUCT, 18); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 16:GEOGRAPHY: ", p), err)
+		}
+		if err := p.GEOGRAPHY.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GEOGRAPHY), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 16:GEOGRAPHY: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *LogicalType) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("LogicalType(%+v)", *p)
+}
+
+// Represents a element inside a schema definition.
+//  - if it is a group (inner node) then type is undefined and num_children is defined
+//  - if it is a primitive type (leaf) then type is defined and num_children is undefined
+// the nodes are listed in depth first traversal order.
+//
+// Attributes:
+//  - Type: Data type for this field. Not set if the current element is a non-leaf node
+//  - TypeLength: If type is FIXED_LEN_BYTE_ARRAY, this is the byte length of the vales.
+// Otherwise, if specified, this is the maximum bit length to store any of the values.
+// (e.g. a low cardinality INT col could have this set to 3).  Note that this is
+// in the schema, and therefore fixed for the entire file.
+//  - RepetitionType: repetition of the field. The root of the schema does not have a repetition_type.
+// All other nodes must have one
+//  - Name: Name of the field in the schema
+//  - NumChildren: Nested fields.  Since thrift does not support nested fields,
+// the nesting is flattened to a single list by a depth-first traversal.
+// The children count is used to construct the nested relationship.
+// This field is not set when the element is a primitive type
+//  - ConvertedType: When the schema is the result of a conversion from another model
+// Used to record the original type to help with cross conversion.
+//  - Scale: Used when this column contains decimal data.
+// See the DECIMAL converted type for more details.
+//  - Precision
+//  - FieldID: When the original schema supports field ids, this will save the
+// original field id in the parquet schema
+//  - LogicalType: The logical type of this SchemaElement
+//
+// LogicalType replaces ConvertedType, but ConvertedType is still required
+// for some logical types to ensure forward-compatibility in format v1.
+type SchemaElement struct {
+	Type           *Type                `thrift:"type,1" json:"type,omitempty"`
+	TypeLength     *int32               `thrift:"type_length,2" json:"type_length,omitempty"`
+	RepetitionType *FieldRepetitionType `thrift:"repetition_type,3" json:"repetition_type,omitempty"`
+	Name           string               `thrift:"name,4,required" json:"name"`
+	NumChildren    *int32               `thrift:"num_children,5" json:"num_children,omitempty"`
+	ConvertedType  *ConvertedType       `thrift:"converted_type,6" json:"converted_type,omitempty"`
+	Scale          *int32               `thrift:"scale,7" json:"scale,omitempty"`
+	Precision      *int32               `thrift:"precision,8" json:"precision,omitempty"`
+	FieldID        *int32               `thrift:"field_id,9" json:"field_id,omitempty"`
+	LogicalType    *LogicalType         `thrift:"logicalType,10" json:"logicalType,omitempty"`
+}
+
+func NewSchemaElement() *SchemaElement {
+	return &SchemaElement{}
+}
+
+var SchemaElement_Type_DEFAULT Type
+
+func (p *SchemaElement) GetType() Type {
+	if !p.IsSetType() {
+		return SchemaElement_Type_DEFAULT
+	}
+	return *p.Type
+}
+
+var SchemaElement_TypeLength_DEFAULT int32
+
+func (p *SchemaElement) GetTypeLength() int32 {
+	if !p.IsSetTypeLength() {
+		return SchemaElement_TypeLength_DEFAULT
+	}
+	return *p.TypeLength
+}
+
+var SchemaElement_RepetitionType_DEFAULT FieldRepetitionType
+
+func (p *SchemaElement) GetRepetitionType() FieldRepetitionType {
+	if !p.IsSetRepetitionType() {
+		return SchemaElement_RepetitionType_DEFAULT
+	}
+	return *p.RepetitionType
+}
+
+func (p *SchemaElement) GetName() string {
+	return p.Name
+}
+
+var SchemaElement_NumChildren_DEFAULT int32
+
+func (p *SchemaElement) GetNumChildren() int32 {
+	if !p.IsSetNumChildren() {
+		return SchemaElement_NumChildren_DEFAULT
+	}
+	return *p.NumChildren
+}
+
+var SchemaElement_ConvertedType_DEFAULT ConvertedType
+
+func (p *SchemaElement) GetConvertedType() ConvertedType {
+	if !p.IsSetConvertedType() {
+		return SchemaElement_ConvertedType_DEFAULT
+	}
+	return *p.ConvertedType
+}
+
+var SchemaElement_Scale_DEFAULT int32
+
+func (p *SchemaElement) GetScale() int32 {
+	if !p.IsSetScale() {
+		return SchemaElement_Scale_DEFAULT
+	}
+	return *p.Scale
+}
+
+var SchemaElement_Precision_DEFAULT int32
+
+func (p *SchemaElement) GetPrecision() int32 {
+	if !p.IsSetPrecision() {
+		return SchemaElement_Precision_DEFAULT
+	}
+	return *p.Precision
+}
+
+var SchemaElement_FieldID_DEFAULT int32
+
+func (p *SchemaElement) GetFieldID() int32 {
+	if !p.IsSetFieldID() {
+		return SchemaElement_FieldID_DEFAULT
+	}
+	return *p.FieldID
+}
+
+var SchemaElement_LogicalType_DEFAULT *LogicalType
+
+func (p *SchemaElement) GetLogicalType() *LogicalType {
+	if !p.IsSetLogicalType() {
+		return SchemaElement_LogicalType_DEFAULT
+	}
+	return p.LogicalType
+}
+func (p *SchemaElement) IsSetType() bool {
+	return p.Type != nil
+}
+
+func (p *SchemaElement) IsSetTypeLength() bool {
+	return p.TypeLength != nil
+}
+
+func (p *SchemaElement) IsSetRepetitionType() bool {
+	return p.RepetitionType != nil
+}
+
+func (p *SchemaElement) IsSetNumChildren() bool {
+	return p.NumChildren != nil
+}
+
+func (p *SchemaElement) IsSetConvertedType() bool {
+	return p.ConvertedType != nil
+}
+
+func (p *SchemaElement) IsSetScale() bool {
+	return p.Scale != nil
+}
+
+func (p *SchemaElement) IsSetPrecision() bool {
+	return p.Precision != nil
+}
+
+func (p *SchemaElement) IsSetFieldID() bool {
+	return p.FieldID != nil
+}
+
+func (p *SchemaElement) IsSetLogicalType() bool {
+	return p.LogicalType != nil
+}
+
+func (p *SchemaElement) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetName bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+			issetName = true
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		case 10:
+			if err := p.readField10(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetName {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Name is not set"))
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := Type(v)
+		p.Type = &temp
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.TypeLength = &v
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := FieldRepetitionType(v)
+		p.RepetitionType = &temp
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Name = v
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.NumChildren = &v
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		temp := ConvertedType(v)
+		p.ConvertedType = &temp
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.Scale = &v
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.Precision = &v
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.FieldID = &v
+	}
+	return nil
+}
+
+func (p *SchemaElement) readField10(iprot thrift.TProtocol) error {
+	p.LogicalType = &LogicalType{}
+	if err := p.LogicalType.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.LogicalType), err)
+	}
+	return nil
+}
+
+func (p *SchemaElement) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SchemaElement"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField10(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SchemaElement) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetType() {
+		if err := oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Type)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTypeLength() {
+		if err := oprot.WriteFieldBegin("type_length", thrift.I32, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:type_length: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.TypeLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.type_length (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:type_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRepetitionType() {
+		if err := oprot.WriteFieldBegin("repetition_type", thrift.I32, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:repetition_type: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.RepetitionType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.repetition_type (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:repetition_type: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("name", thrift.STRING, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:name: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Name)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.name (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:name: ", p), err)
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNumChildren() {
+		if err := oprot.WriteFieldBegin("num_children", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:num_children: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.NumChildren)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.num_children (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:num_children: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetConvertedType() {
+		if err := oprot.WriteFieldBegin("converted_type", thrift.I32, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:converted_type: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.ConvertedType)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.converted_type (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:converted_type: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetScale() {
+		if err := oprot.WriteFieldBegin("scale", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:scale: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Scale)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.scale (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:scale: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetPrecision() {
+		if err := oprot.WriteFieldBegin("precision", thrift.I32, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:precision: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Precision)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.precision (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:precision: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFieldID() {
+		if err := oprot.WriteFieldBegin("field_id", thrift.I32, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:field_id: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.FieldID)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.field_id (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:field_id: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetLogicalType() {
+		if err := oprot.WriteFieldBegin("logicalType", thrift.STRUCT, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:logicalType: ", p), err)
+		}
+		if err := p.LogicalType.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.LogicalType), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:logicalType: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *SchemaElement) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SchemaElement(%+v)", *p)
+}
+
+// Data page header
+//
+// Attributes:
+//  - NumValues: Number of values, including NULLs, in this data page. *
+//  - Encoding: Encoding used for this data page *
+//  - DefinitionLevelEncoding: Encoding used for definition levels *
+//  - RepetitionLevelEncoding: Encoding used for repetition levels *
+//  - Statistics: Optional statistics for the data in this page*
+type DataPageHeader struct {
+	NumValues               int32       `thrift:"num_values,1,required" json:"num_values"`
+	Encoding                Encoding    `thrift:"encoding,2,required" json:"encoding"`
+	DefinitionLevelEncoding Encoding    `thrift:"definition_level_encoding,3,required" json:"definition_level_encoding"`
+	RepetitionLevelEncoding Encoding    `thrift:"repetition_level_encoding,4,required" json:"repetition_level_encoding"`
+	Statistics              *Statistics `thrift:"statistics,5" json:"statistics,omitempty"`
+}
+
+func NewDataPageHeader() *DataPageHeader {
+	return &DataPageHeader{}
+}
+
+func (p *DataPageHeader) GetNumValues() int32 {
+	return p.NumValues
+}
+
+func (p *DataPageHeader) GetEncoding() Encoding {
+	return p.Encoding
+}
+
+func (p *DataPageHeader) GetDefinitionLevelEncoding() Encoding {
+	return p.DefinitionLevelEncoding
+}
+
+func (p *DataPageHeader) GetRepetitionLevelEncoding() Encoding {
+	return p.RepetitionLevelEncoding
+}
+
+var DataPageHeader_Statistics_DEFAULT *Statistics
+
+func (p *DataPageHeader) GetStatistics() *Statistics {
+	if !p.IsSetStatistics() {
+		return DataPageHeader_Statistics_DEFAULT
+	}
+	return p.Statistics
+}
+func (p *DataPageHeader) IsSetStatistics() bool {
+	return p.Statistics != nil
+}
+
+func (p *DataPageHeader) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNumValues bool = false
+	var issetEncoding bool = false
+	var issetDefinitionLevelEncoding bool = false
+	var issetRepetitionLevelEncoding bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetNumValues = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetEncoding = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetDefinitionLevelEncoding = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+			issetRepetitionLevelEncoding = true
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNumValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
+	}
+	if !issetEncoding {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	}
+	if !issetDefinitionLevelEncoding {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DefinitionLevelEncoding is not set"))
+	}
+	if !issetRepetitionLevelEncoding {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RepetitionLevelEncoding is not set"))
+	}
+	return nil
+}
+
+func (p *DataPageHeader) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NumValues = v
+	}
+	return nil
+}
+
+func (p *DataPageHeader) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := Encoding(v)
+		p.Encoding = temp
+	}
+	return nil
+}
+
+func (p *DataPageHeader) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		temp := Encoding(v)
+		p.DefinitionLevelEncoding = temp
+	}
+	return nil
+}
+
+func (p *DataPageHeader) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := Encoding(v)
+		p.RepetitionLevelEncoding = temp
+	}
+	return nil
+}
+
+func (p *DataPageHeader) readField5(iprot thrift.TProtocol) error {
+	p.Statistics = &Statistics{}
+	if err := p.Statistics.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+	}
+	return nil
+}
+
+func (p *DataPageHeader) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DataPageHeader"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DataPageHeader) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_values", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:num_values: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.NumValues)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_values (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:num_values: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeader) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeader) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("definition_level_encoding", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:definition_level_encoding: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.DefinitionLevelEncoding)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.definition_level_encoding (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:definition_level_encoding: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeader) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("repetition_level_encoding", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:repetition_level_encoding: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.RepetitionLevelEncoding)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.repetition_level_encoding (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:repetition_level_encoding: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeader) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatistics() {
+		if err := oprot.WriteFieldBegin("statistics", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:statistics: ", p), err)
+		}
+		if err := p.Statistics.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:statistics: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DataPageHeader) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataPageHeader(%+v)", *p)
+}
+
+type IndexPageHeader struct {
+}
+
+func NewIndexPageHeader() *IndexPageHeader {
+	return &IndexPageHeader{}
+}
+
+func (p *IndexPageHeader) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *IndexPageHeader) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("IndexPageHeader"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *IndexPageHeader) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("IndexPageHeader(%+v)", *p)
+}
+
+// TODO: *
+//
+// Attributes:
+//  - NumValues: Number of values in the dictionary *
+//  - Encoding: Encoding using this dictionary page *
+//  - IsSorted: If true, the entries in the dictionary are sorted in ascending order *
+type DictionaryPageHeader struct {
+	NumValues int32    `thrift:"num_values,1,required" json:"num_values"`
+	Encoding  Encoding `thrift:"encoding,2,required" json:"encoding"`
+	IsSorted  *bool    `thrift:"is_sorted,3" json:"is_sorted,omitempty"`
+}
+
+func NewDictionaryPageHeader() *DictionaryPageHeader {
+	return &DictionaryPageHeader{}
+}
+
+func (p *DictionaryPageHeader) GetNumValues() int32 {
+	return p.NumValues
+}
+
+func (p *DictionaryPageHeader) GetEncoding() Encoding {
+	return p.Encoding
+}
+
+var DictionaryPageHeader_IsSorted_DEFAULT bool
+
+func (p *DictionaryPageHeader) GetIsSorted() bool {
+	if !p.IsSetIsSorted() {
+		return DictionaryPageHeader_IsSorted_DEFAULT
+	}
+	return *p.IsSorted
+}
+func (p *DictionaryPageHeader) IsSetIsSorted() bool {
+	return p.IsSorted != nil
+}
+
+func (p *DictionaryPageHeader) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNumValues bool = false
+	var issetEncoding bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetNumValues = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetEncoding = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNumValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
+	}
+	if !issetEncoding {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	}
+	return nil
+}
+
+func (p *DictionaryPageHeader) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NumValues = v
+	}
+	return nil
+}
+
+func (p *DictionaryPageHeader) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := Encoding(v)
+		p.Encoding = temp
+	}
+	return nil
+}
+
+func (p *DictionaryPageHeader) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.IsSorted = &v
+	}
+	return nil
+}
+
+func (p *DictionaryPageHeader) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DictionaryPageHeader"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DictionaryPageHeader) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_values", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:num_values: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.NumValues)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_values (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:num_values: ", p), err)
+	}
+	return err
+}
+
+func (p *DictionaryPageHeader) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
+	}
+	return err
+}
+
+func (p *DictionaryPageHeader) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsSorted() {
+		if err := oprot.WriteFieldBegin("is_sorted", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:is_sorted: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.IsSorted)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.is_sorted (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:is_sorted: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DictionaryPageHeader) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DictionaryPageHeader(%+v)", *p)
+}
+
+// New page format alowing reading levels without decompressing the data
+// Repetition and definition levels are uncompressed
+// The remaining section containing the data is compressed if is_compressed is true
+//
+//
+// Attributes:
+//  - NumValues: Number of values, including NULLs, in this data page. *
+//  - NumNulls: Number of NULL values, in this data page.
+// Number of non-null = num_values - num_nulls which is also the number of values in the data section *
+//  - NumRows: Number of rows in this data page. which means pages change on record boundaries (r = 0) *
+//  - Encoding: Encoding used for data in this page *
+//  - DefinitionLevelsByteLength: length of the repetition levels
+//  - RepetitionLevelsByteLength: length of the definition levels
+//  - IsCompressed: whether the values are compressed.
+// Which means the section of the page between
+// definition_levels_byte_length + repetition_levels_byte_length + 1 and compressed_page_size (included)
+// is compressed with the compression_codec.
+// If missing it is considered compressed
+//  - Statistics: optional statistics for this column chunk
+type DataPageHeaderV2 struct {
+	NumValues                  int32       `thrift:"num_values,1,required" json:"num_values"`
+	NumNulls                   int32       `thrift:"num_nulls,2,required" json:"num_nulls"`
+	NumRows                    int32       `thrift:"num_rows,3,required" json:"num_rows"`
+	Encoding                   Encoding    `thrift:"encoding,4,required" json:"encoding"`
+	DefinitionLevelsByteLength int32       `thrift:"definition_levels_byte_length,5,required" json:"definition_levels_byte_length"`
+	RepetitionLevelsByteLength int32       `thrift:"repetition_levels_byte_length,6,required" json:"repetition_levels_byte_length"`
+	IsCompressed               bool        `thrift:"is_compressed,7" json:"is_compressed,omitempty"`
+	Statistics                 *Statistics `thrift:"statistics,8" json:"statistics,omitempty"`
+}
+
+func NewDataPageHeaderV2() *DataPageHeaderV2 {
+	return &DataPageHeaderV2{
+		IsCompressed: true,
+	}
+}
+
+func (p *DataPageHeaderV2) GetNumValues() int32 {
+	return p.NumValues
+}
+
+func (p *DataPageHeaderV2) GetNumNulls() int32 {
+	return p.NumNulls
+}
+
+func (p *DataPageHeaderV2) GetNumRows() int32 {
+	return p.NumRows
+}
+
+func (p *DataPageHeaderV2) GetEncoding() Encoding {
+	return p.Encoding
+}
+
+func (p *DataPageHeaderV2) GetDefinitionLevelsByteLength() int32 {
+	return p.DefinitionLevelsByteLength
+}
+
+func (p *DataPageHeaderV2) GetRepetitionLevelsByteLength() int32 {
+	return p.RepetitionLevelsByteLength
+}
+
+var DataPageHeaderV2_IsCompressed_DEFAULT bool = true
+
+func (p *DataPageHeaderV2) GetIsCompressed() bool {
+	return p.IsCompressed
+}
+
+var DataPageHeaderV2_Statistics_DEFAULT *Statistics
+
+func (p *DataPageHeaderV2) GetStatistics() *Statistics {
+	if !p.IsSetStatistics() {
+		return DataPageHeaderV2_Statistics_DEFAULT
+	}
+	return p.Statistics
+}
+func (p *DataPageHeaderV2) IsSetIsCompressed() bool {
+	return p.IsCompressed != DataPageHeaderV2_IsCompressed_DEFAULT
+}
+
+func (p *DataPageHeaderV2) IsSetStatistics() bool {
+	return p.Statistics != nil
+}
+
+func (p *DataPageHeaderV2) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNumValues bool = false
+	var issetNumNulls bool = false
+	var issetNumRows bool = false
+	var issetEncoding bool = false
+	var issetDefinitionLevelsByteLength bool = false
+	var issetRepetitionLevelsByteLength bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetNumValues = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetNumNulls = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetNumRows = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+			issetEncoding = true
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+			issetDefinitionLevelsByteLength = true
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+			issetRepetitionLevelsByteLength = true
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNumValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
+	}
+	if !issetNumNulls {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumNulls is not set"))
+	}
+	if !issetNumRows {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
+	}
+	if !issetEncoding {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	}
+	if !issetDefinitionLevelsByteLength {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DefinitionLevelsByteLength is not set"))
+	}
+	if !issetRepetitionLevelsByteLength {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RepetitionLevelsByteLength is not set"))
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NumValues = v
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.NumNulls = v
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NumRows = v
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := Encoding(v)
+		p.Encoding = temp
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.DefinitionLevelsByteLength = v
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.RepetitionLevelsByteLength = v
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.IsCompressed = v
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) readField8(iprot thrift.TProtocol) error {
+	p.Statistics = &Statistics{}
+	if err := p.Statistics.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("DataPageHeaderV2"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *DataPageHeaderV2) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_values", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:num_values: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.NumValues)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_values (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:num_values: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_nulls", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:num_nulls: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.NumNulls)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_nulls (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:num_nulls: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_rows", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.NumRows)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:encoding: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.encoding (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:encoding: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) writeField5(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("definition_levels_byte_length", thrift.I32, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:definition_levels_byte_length: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.DefinitionLevelsByteLength)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.definition_levels_byte_length (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:definition_levels_byte_length: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) writeField6(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("repetition_levels_byte_length", thrift.I32, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:repetition_levels_byte_length: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.RepetitionLevelsByteLength)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.repetition_levels_byte_length (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:repetition_levels_byte_length: ", p), err)
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIsCompressed() {
+		if err := oprot.WriteFieldBegin("is_compressed", thrift.BOOL, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:is_compressed: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(p.IsCompressed)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.is_compressed (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:is_compressed: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatistics() {
+		if err := oprot.WriteFieldBegin("statistics", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:statistics: ", p), err)
+		}
+		if err := p.Statistics.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:statistics: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *DataPageHeaderV2) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("DataPageHeaderV2(%+v)", *p)
+}
+
+// Attributes:
+//  - Type: the type of the page: indicates which of the *_header fields is set *
+//  - UncompressedPageSize: Uncompressed page size in bytes (not including this header) *
+//  - CompressedPageSize: Compressed page size in bytes (not including this header) *
+//  - Crc: 32bit crc for the data below. This allows for disabling checksumming in HDFS
+// if only a few pages needs to be read
+//
+//  - DataPageHeader
+//  - IndexPageHeader
+//  - DictionaryPageHeader
+//  - DataPageHeaderV2
+type PageHeader struct {
+	Type                 PageType              `thrift:"type,1,required" json:"type"`
+	UncompressedPageSize int32                 `thrift:"uncompressed_page_size,2,required" json:"uncompressed_page_size"`
+	CompressedPageSize   int32                 `thrift:"compressed_page_size,3,required" json:"compressed_page_size"`
+	Crc                  *int32                `thrift:"crc,4" json:"crc,omitempty"`
+	DataPageHeader       *DataPageHeader       `thrift:"data_page_header,5" json:"data_page_header,omitempty"`
+	IndexPageHeader      *IndexPageHeader      `thrift:"index_page_header,6" json:"index_page_header,omitempty"`
+	DictionaryPageHeader *DictionaryPageHeader `thrift:"dictionary_page_header,7" json:"dictionary_page_header,omitempty"`
+	DataPageHeaderV2     *DataPageHeaderV2     `thrift:"data_page_header_v2,8" json:"data_page_header_v2,omitempty"`
+}
+
+func NewPageHeader() *PageHeader {
+	return &PageHeader{}
+}
+
+func (p *PageHeader) GetType() PageType {
+	return p.Type
+}
+
+func (p *PageHeader) GetUncompressedPageSize() int32 {
+	return p.UncompressedPageSize
+}
+
+func (p *PageHeader) GetCompressedPageSize() int32 {
+	return p.CompressedPageSize
+}
+
+var PageHeader_Crc_DEFAULT int32
+
+func (p *PageHeader) GetCrc() int32 {
+	if !p.IsSetCrc() {
+		return PageHeader_Crc_DEFAULT
+	}
+	return *p.Crc
+}
+
+var PageHeader_DataPageHeader_DEFAULT *DataPageHeader
+
+func (p *PageHeader) GetDataPageHeader() *DataPageHeader {
+	if !p.IsSetDataPageHeader() {
+		return PageHeader_DataPageHeader_DEFAULT
+	}
+	return p.DataPageHeader
+}
+
+var PageHeader_IndexPageHeader_DEFAULT *IndexPageHeader
+
+func (p *PageHeader) GetIndexPageHeader() *IndexPageHeader {
+	if !p.IsSetIndexPageHeader() {
+		return PageHeader_IndexPageHeader_DEFAULT
+	}
+	return p.IndexPageHeader
+}
+
+var PageHeader_DictionaryPageHeader_DEFAULT *DictionaryPageHeader
+
+func (p *PageHeader) GetDictionaryPageHeader() *DictionaryPageHeader {
+	if !p.IsSetDictionaryPageHeader() {
+		return PageHeader_DictionaryPageHeader_DEFAULT
+	}
+	return p.DictionaryPageHeader
+}
+
+var PageHeader_DataPageHeaderV2_DEFAULT *DataPageHeaderV2
+
+func (p *PageHeader) GetDataPageHeaderV2() *DataPageHeaderV2 {
+	if !p.IsSetDataPageHeaderV2() {
+		return PageHeader_DataPageHeaderV2_DEFAULT
+	}
+	return p.DataPageHeaderV2
+}
+func (p *PageHeader) IsSetCrc() bool {
+	return p.Crc != nil
+}
+
+func (p *PageHeader) IsSetDataPageHeader() bool {
+	return p.DataPageHeader != nil
+}
+
+func (p *PageHeader) IsSetIndexPageHeader() bool {
+	return p.IndexPageHeader != nil
+}
+
+func (p *PageHeader) IsSetDictionaryPageHeader() bool {
+	return p.DictionaryPageHeader != nil
+}
+
+func (p *PageHeader) IsSetDataPageHeaderV2() bool {
+	return p.DataPageHeaderV2 != nil
+}
+
+func (p *PageHeader) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetType bool = false
+	var issetUncompressedPageSize bool = false
+	var issetCompressedPageSize bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetType = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetUncompressedPageSize = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetCompressedPageSize = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetType {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
+	}
+	if !issetUncompressedPageSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field UncompressedPageSize is not set"))
+	}
+	if !issetCompressedPageSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
+	}
+	return nil
+}
+
+func (p *PageHeader) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := PageType(v)
+		p.Type = temp
+	}
+	return nil
+}
+
+func (p *PageHeader) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.UncompressedPageSize = v
+	}
+	return nil
+}
+
+func (p *PageHeader) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.CompressedPageSize = v
+	}
+	return nil
+}
+
+func (p *PageHeader) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.Crc = &v
+	}
+	return nil
+}
+
+func (p *PageHeader) readField5(iprot thrift.TProtocol) error {
+	p.DataPageHeader = &DataPageHeader{}
+	if err := p.DataPageHeader.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeader), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) readField6(iprot thrift.TProtocol) error {
+	p.IndexPageHeader = &IndexPageHeader{}
+	if err := p.IndexPageHeader.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.IndexPageHeader), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) readField7(iprot thrift.TProtocol) error {
+	p.DictionaryPageHeader = &DictionaryPageHeader{}
+	if err := p.DictionaryPageHeader.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DictionaryPageHeader), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) readField8(iprot thrift.TProtocol) error {
+	p.DataPageHeaderV2 = &DataPageHeaderV2{
+		IsCompressed: true,
+	}
+	if err := p.DataPageHeaderV2.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DataPageHeaderV2), err)
+	}
+	return nil
+}
+
+func (p *PageHeader) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("PageHeader"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PageHeader) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+	}
+	return err
+}
+
+func (p *PageHeader) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("uncompressed_page_size", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:uncompressed_page_size: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.UncompressedPageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.uncompressed_page_size (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:uncompressed_page_size: ", p), err)
+	}
+	return err
+}
+
+func (p *PageHeader) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("compressed_page_size", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:compressed_page_size: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.CompressedPageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:compressed_page_size: ", p), err)
+	}
+	return err
+}
+
+func (p *PageHeader) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCrc() {
+		if err := oprot.WriteFieldBegin("crc", thrift.I32, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:crc: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.Crc)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.crc (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:crc: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataPageHeader() {
+		if err := oprot.WriteFieldBegin("data_page_header", thrift.STRUCT, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:data_page_header: ", p), err)
+		}
+		if err := p.DataPageHeader.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeader), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:data_page_header: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIndexPageHeader() {
+		if err := oprot.WriteFieldBegin("index_page_header", thrift.STRUCT, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:index_page_header: ", p), err)
+		}
+		if err := p.IndexPageHeader.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.IndexPageHeader), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:index_page_header: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDictionaryPageHeader() {
+		if err := oprot.WriteFieldBegin("dictionary_page_header", thrift.STRUCT, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:dictionary_page_header: ", p), err)
+		}
+		if err := p.DictionaryPageHeader.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DictionaryPageHeader), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:dictionary_page_header: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDataPageHeaderV2() {
+		if err := oprot.WriteFieldBegin("data_page_header_v2", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:data_page_header_v2: ", p), err)
+		}
+		if err := p.DataPageHeaderV2.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DataPageHeaderV2), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:data_page_header_v2: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *PageHeader) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PageHeader(%+v)", *p)
+}
+
+// Wrapper struct to store key values
+//
+// Attributes:
+//  - Key
+//  - Value
+type KeyValue struct {
+	Key   string  `thrift:"key,1,required" json:"key"`
+	Value *string `thrift:"value,2" json:"value,omitempty"`
+}
+
+func NewKeyValue() *KeyValue {
+	return &KeyValue{}
+}
+
+func (p *KeyValue) GetKey() string {
+	return p.Key
+}
+
+var KeyValue_Value_DEFAULT string
+
+func (p *KeyValue) GetValue() string {
+	if !p.IsSetValue() {
+		return KeyValue_Value_DEFAULT
+	}
+	return *p.Value
+}
+func (p *KeyValue) IsSetValue() bool {
+	return p.Value != nil
+}
+
+func (p *KeyValue) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetKey bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetKey = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetKey {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Key is not set"))
+	}
+	return nil
+}
+
+func (p *KeyValue) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Key = v
+	}
+	return nil
+}
+
+func (p *KeyValue) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Value = &v
+	}
+	return nil
+}
+
+func (p *KeyValue) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("KeyValue"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *KeyValue) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("key", thrift.STRING, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:key: ", p), err)
+	}
+	if err := oprot.WriteString(string(p.Key)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.key (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:key: ", p), err)
+	}
+	return err
+}
+
+func (p *KeyValue) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetValue() {
+		if err := oprot.WriteFieldBegin("value", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:value: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.Value)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.value (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:value: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *KeyValue) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("KeyValue(%+v)", *p)
+}
+
+// Wrapper struct to specify sort order
+//
+// Attributes:
+//  - ColumnIdx: The column index (in this row group) *
+//  - Descending: If true, indicates this column is sorted in descending order. *
+//  - NullsFirst: If true, nulls will come before non-null values, otherwise,
+// nulls go at the end.
+type SortingColumn struct {
+	ColumnIdx  int32 `thrift:"column_idx,1,required" json:"column_idx"`
+	Descending bool  `thrift:"descending,2,required" json:"descending"`
+	NullsFirst bool  `thrift:"nulls_first,3,required" json:"nulls_first"`
+}
+
+func NewSortingColumn() *SortingColumn {
+	return &SortingColumn{}
+}
+
+func (p *SortingColumn) GetColumnIdx() int32 {
+	return p.ColumnIdx
+}
+
+func (p *SortingColumn) GetDescending() bool {
+	return p.Descending
+}
+
+func (p *SortingColumn) GetNullsFirst() bool {
+	return p.NullsFirst
+}
+func (p *SortingColumn) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetColumnIdx bool = false
+	var issetDescending bool = false
+	var issetNullsFirst bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetColumnIdx = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetDescending = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetNullsFirst = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetColumnIdx {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field ColumnIdx is not set"))
+	}
+	if !issetDescending {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Descending is not set"))
+	}
+	if !issetNullsFirst {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullsFirst is not set"))
+	}
+	return nil
+}
+
+func (p *SortingColumn) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.ColumnIdx = v
+	}
+	return nil
+}
+
+func (p *SortingColumn) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Descending = v
+	}
+	return nil
+}
+
+func (p *SortingColumn) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NullsFirst = v
+	}
+	return nil
+}
+
+func (p *SortingColumn) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SortingColumn"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SortingColumn) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("column_idx", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:column_idx: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.ColumnIdx)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.column_idx (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:column_idx: ", p), err)
+	}
+	return err
+}
+
+func (p *SortingColumn) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("descending", thrift.BOOL, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:descending: ", p), err)
+	}
+	if err := oprot.WriteBool(bool(p.Descending)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.descending (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:descending: ", p), err)
+	}
+	return err
+}
+
+func (p *SortingColumn) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("nulls_first", thrift.BOOL, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:nulls_first: ", p), err)
+	}
+	if err := oprot.WriteBool(bool(p.NullsFirst)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.nulls_first (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:nulls_first: ", p), err)
+	}
+	return err
+}
+
+func (p *SortingColumn) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SortingColumn(%+v)", *p)
+}
+
+// statistics of a given page type and encoding
+//
+// Attributes:
+//  - PageType: the page type (data/dic/...) *
+//  - Encoding: encoding of the page *
+//  - Count: number of pages of this type with this encoding *
+type PageEncodingStats struct {
+	PageType PageType `thrift:"page_type,1,required" json:"page_type"`
+	Encoding Encoding `thrift:"encoding,2,required" json:"encoding"`
+	Count    int32    `thrift:"count,3,required" json:"count"`
+}
+
+func NewPageEncodingStats() *PageEncodingStats {
+	return &PageEncodingStats{}
+}
+
+func (p *PageEncodingStats) GetPageType() PageType {
+	return p.PageType
+}
+
+func (p *PageEncodingStats) GetEncoding() Encoding {
+	return p.Encoding
+}
+
+func (p *PageEncodingStats) GetCount() int32 {
+	return p.Count
+}
+func (p *PageEncodingStats) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetPageType bool = false
+	var issetEncoding bool = false
+	var issetCount bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetPageType = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetEncoding = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetCount = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetPageType {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageType is not set"))
+	}
+	if !issetEncoding {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encoding is not set"))
+	}
+	if !issetCount {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Count is not set"))
+	}
+	return nil
+}
+
+func (p *PageEncodingStats) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := PageType(v)
+		p.PageType = temp
+	}
+	return nil
+}
+
+func (p *PageEncodingStats) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		temp := Encoding(v)
+		p.Encoding = temp
+	}
+	return nil
+}
+
+func (p *PageEncodingStats) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Count = v
+	}
+	return nil
+}
+
+func (p *PageEncodingStats) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("PageEncodingStats"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PageEncodingStats) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("page_type", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_type: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.PageType)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.page_type (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_type: ", p), err)
+	}
+	return err
+}
+
+func (p *PageEncodingStats) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
+	}
+	return err
+}
+
+func (p *PageEncodingStats) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("count", thrift.I32, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:count: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Count)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.count (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:count: ", p), err)
+	}
+	return err
+}
+
+func (p *PageEncodingStats) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PageEncodingStats(%+v)", *p)
+}
+
+// Description for column metadata
+//
+// Attributes:
+//  - Type: Type of this column *
+//  - Encodings: Set of all encodings used for this column. The purpose is to validate
+// whether we can decode those pages. *
+//  - PathInSchema: Path in schema *
+//  - Codec: Compression codec *
+//  - NumValues: Number of values in this column *
+//  - TotalUncompressedSize: total byte size of all uncompressed pages in this column chunk (including the headers) *
+//  - TotalCompressedSize: total byte size of all compressed pages in this column chunk (including the headers) *
+//  - KeyValueMetadata: Optional key/value metadata *
+//  - DataPageOffset: Byte offset from beginning of file to first data page *
+//  - IndexPageOffset: Byte offset from beginning of file to root index page *
+//  - DictionaryPageOffset: Byte offset from the beginning of file to first (only) dictionary page *
+//  - Statistics: optional statistics for this column chunk
+//  - EncodingStats: Set of all encodings used for pages in this column chunk.
+// This information can be used to determine if all data pages are
+// dictionary encoded for example *
+//  - BloomFilterOffset: Byte offset from beginning of file to Bloom filter data. *
+//  - BloomFilterLength: Size of Bloom filter data including the serialized header, in bytes.
+// Added in 2.10 so readers may not read this field from old files and
+// it can be obtained after the BloomFilterHeader has been deserialized.
+// Writers should write this field so readers can read the bloom filter
+// in a single I/O.
+//  - SizeStatistics: Optional statistics to help estimate total memory when converted to in-memory
+// representations. The histograms contained in these statistics can
+// also be useful in some cases for more fine-grained nullability/list length
+// filter pushdown.
+//  - GeospatialStatistics: Optional statistics specific for Geometry and Geography logical types
+type ColumnMetaData struct {
+	Type                  Type                  `thrift:"type,1,required" json:"type"`
+	Encodings             []Encoding            `thrift:"encodings,2,required" json:"encodings"`
+	PathInSchema          []string              `thrift:"path_in_schema,3,required" json:"path_in_schema"`
+	Codec                 CompressionCodec      `thrift:"codec,4,required" json:"codec"`
+	NumValues             int64                 `thrift:"num_values,5,required" json:"num_values"`
+	TotalUncompressedSize int64                 `thrift:"total_uncompressed_size,6,required" json:"total_uncompressed_size"`
+	TotalCompressedSize   int64                 `thrift:"total_compressed_size,7,required" json:"total_compressed_size"`
+	KeyValueMetadata      []*KeyValue           `thrift:"key_value_metadata,8" json:"key_value_metadata,omitempty"`
+	DataPageOffset        int64                 `thrift:"data_page_offset,9,required" json:"data_page_offset"`
+	IndexPageOffset       *int64                `thrift:"index_page_offset,10" json:"index_page_offset,omitempty"`
+	DictionaryPageOffset  *int64                `thrift:"dictionary_page_offset,11" json:"dictionary_page_offset,omitempty"`
+	Statistics            *Statistics           `thrift:"statistics,12" json:"statistics,omitempty"`
+	EncodingStats         []*PageEncodingStats  `thrift:"encoding_stats,13" json:"encoding_stats,omitempty"`
+	BloomFilterOffset     *int64                `thrift:"bloom_filter_offset,14" json:"bloom_filter_offset,omitempty"`
+	BloomFilterLength     *int32                `thrift:"bloom_filter_length,15" json:"bloom_filter_length,omitempty"`
+	SizeStatistics        *SizeStatistics       `thrift:"size_statistics,16" json:"size_statistics,omitempty"`
+	GeospatialStatistics  *GeospatialStatistics `thrift:"geospatial_statistics,17" json:"geospatial_statistics,omitempty"`
+}
+
+func NewColumnMetaData() *ColumnMetaData {
+	return &ColumnMetaData{}
+}
+
+func (p *ColumnMetaData) GetType() Type {
+	return p.Type
+}
+
+func (p *ColumnMetaData) GetEncodings() []Encoding {
+	return p.Encodings
+}
+
+func (p *ColumnMetaData) GetPathInSchema() []string {
+	return p.PathInSchema
+}
+
+func (p *ColumnMetaData) GetCodec() CompressionCodec {
+	return p.Codec
+}
+
+func (p *ColumnMetaData) GetNumValues() int64 {
+	return p.NumValues
+}
+
+func (p *ColumnMetaData) GetTotalUncompressedSize() int64 {
+	return p.TotalUncompressedSize
+}
+
+func (p *ColumnMetaData) GetTotalCompressedSize() int64 {
+	return p.TotalCompressedSize
+}
+
+var ColumnMetaData_KeyValueMetadata_DEFAULT []*KeyValue
+
+func (p *ColumnMetaData) GetKeyValueMetadata() []*KeyValue {
+	return p.KeyValueMetadata
+}
+
+func (p *ColumnMetaData) GetDataPageOffset() int64 {
+	return p.DataPageOffset
+}
+
+var ColumnMetaData_IndexPageOffset_DEFAULT int64
+
+func (p *ColumnMetaData) GetIndexPageOffset() int64 {
+	if !p.IsSetIndexPageOffset() {
+		return ColumnMetaData_IndexPageOffset_DEFAULT
+	}
+	return *p.IndexPageOffset
+}
+
+var ColumnMetaData_DictionaryPageOffset_DEFAULT int64
+
+func (p *ColumnMetaData) GetDictionaryPageOffset() int64 {
+	if !p.IsSetDictionaryPageOffset() {
+		return ColumnMetaData_DictionaryPageOffset_DEFAULT
+	}
+	return *p.DictionaryPageOffset
+}
+
+var ColumnMetaData_Statistics_DEFAULT *Statistics
+
+func (p *ColumnMetaData) GetStatistics() *Statistics {
+	if !p.IsSetStatistics() {
+		return ColumnMetaData_Statistics_DEFAULT
+	}
+	return p.Statistics
+}
+
+var ColumnMetaData_EncodingStats_DEFAULT []*PageEncodingStats
+
+func (p *ColumnMetaData) GetEncodingStats() []*PageEncodingStats {
+	return p.EncodingStats
+}
+
+var ColumnMetaData_BloomFilterOffset_DEFAULT int64
+
+func (p *ColumnMetaData) GetBloomFilterOffset() int64 {
+	if !p.IsSetBloomFilterOffset() {
+		return ColumnMetaData_BloomFilterOffset_DEFAULT
+	}
+	return *p.BloomFilterOffset
+}
+
+var ColumnMetaData_BloomFilterLength_DEFAULT int32
+
+func (p *ColumnMetaData) GetBloomFilterLength() int32 {
+	if !p.IsSetBloomFilterLength() {
+		return ColumnMetaData_BloomFilterLength_DEFAULT
+	}
+	return *p.BloomFilterLength
+}
+
+var ColumnMetaData_SizeStatistics_DEFAULT *SizeStatistics
+
+func (p *ColumnMetaData) GetSizeStatistics() *SizeStatistics {
+	if !p.IsSetSizeStatistics() {
+		return ColumnMetaData_SizeStatistics_DEFAULT
+	}
+	return p.SizeStatistics
+}
+
+var ColumnMetaData_GeospatialStatistics_DEFAULT *GeospatialStatistics
+
+func (p *ColumnMetaData) GetGeospatialStatistics() *GeospatialStatistics {
+	if !p.IsSetGeospatialStatistics() {
+		return ColumnMetaData_GeospatialStatistics_DEFAULT
+	}
+	return p.GeospatialStatistics
+}
+func (p *ColumnMetaData) IsSetKeyValueMetadata() bool {
+	return p.KeyValueMetadata != nil
+}
+
+func (p *ColumnMetaData) IsSetIndexPageOffset() bool {
+	return p.IndexPageOffset != nil
+}
+
+func (p *ColumnMetaData) IsSetDictionaryPageOffset() bool {
+	return p.DictionaryPageOffset != nil
+}
+
+func (p *ColumnMetaData) IsSetStatistics() bool {
+	return p.Statistics != nil
+}
+
+func (p *ColumnMetaData) IsSetEncodingStats() bool {
+	return p.EncodingStats != nil
+}
+
+func (p *ColumnMetaData) IsSetBloomFilterOffset() bool {
+	return p.BloomFilterOffset != nil
+}
+
+func (p *ColumnMetaData) IsSetBloomFilterLength() bool {
+	return p.BloomFilterLength != nil
+}
+
+func (p *ColumnMetaData) IsSetSizeStatistics() bool {
+	return p.SizeStatistics != nil
+}
+
+func (p *ColumnMetaData) IsSetGeospatialStatistics() bool {
+	return p.GeospatialStatistics != nil
+}
+
+func (p *ColumnMetaData) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetType bool = false
+	var issetEncodings bool = false
+	var issetPathInSchema bool = false
+	var issetCodec bool = false
+	var issetNumValues bool = false
+	var issetTotalUncompressedSize bool = false
+	var issetTotalCompressedSize bool = false
+	var issetDataPageOffset bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetType = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetEncodings = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetPathInSchema = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+			issetCodec = true
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+			issetNumValues = true
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+			issetTotalUncompressedSize = true
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+			issetTotalCompressedSize = true
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+			issetDataPageOffset = true
+		case 10:
+			if err := p.readField10(iprot); err != nil {
+				return err
+			}
+		case 11:
+			if err := p.readField11(iprot); err != nil {
+				return err
+			}
+		case 12:
+			if err := p.readField12(iprot); err != nil {
+				return err
+			}
+		case 13:
+			if err := p.readField13(iprot); err != nil {
+				return err
+			}
+		case 14:
+			if err := p.readField14(iprot); err != nil {
+				return err
+			}
+		case 15:
+			if err := p.readField15(iprot); err != nil {
+				return err
+			}
+		case 16:
+			if err := p.readField16(iprot); err != nil {
+				return err
+			}
+		case 17:
+			if err := p.readField17(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetType {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
+	}
+	if !issetEncodings {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encodings is not set"))
+	}
+	if !issetPathInSchema {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PathInSchema is not set"))
+	}
+	if !issetCodec {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Codec is not set"))
+	}
+	if !issetNumValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
+	}
+	if !issetTotalUncompressedSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalUncompressedSize is not set"))
+	}
+	if !issetTotalCompressedSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalCompressedSize is not set"))
+	}
+	if !issetDataPageOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DataPageOffset is not set"))
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		temp := Type(v)
+		p.Type = temp
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]Encoding, 0, size)
+	p.Encodings = tSlice
+	for i := 0; i < size; i++ {
+		var _elem0 Encoding
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			temp := Encoding(v)
+			_elem0 = temp
+		}
+		p.Encodings = append(p.Encodings, _elem0)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]string, 0, size)
+	p.PathInSchema = tSlice
+	for i := 0; i < size; i++ {
+		var _elem1 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem1 = v
+		}
+		p.PathInSchema = append(p.PathInSchema, _elem1)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := CompressionCodec(v)
+		p.Codec = temp
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.NumValues = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.TotalUncompressedSize = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.TotalCompressedSize = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField8(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*KeyValue, 0, size)
+	p.KeyValueMetadata = tSlice
+	for i := 0; i < size; i++ {
+		_elem2 := &KeyValue{}
+		if err := _elem2.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
+		}
+		p.KeyValueMetadata = append(p.KeyValueMetadata, _elem2)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.DataPageOffset = v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField10(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 10: ", err)
+	} else {
+		p.IndexPageOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField11(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 11: ", err)
+	} else {
+		p.DictionaryPageOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField12(iprot thrift.TProtocol) error {
+	p.Statistics = &Statistics{}
+	if err := p.Statistics.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField13(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*PageEncodingStats, 0, size)
+	p.EncodingStats = tSlice
+	for i := 0; i < size; i++ {
+		_elem3 := &PageEncodingStats{}
+		if err := _elem3.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
+		}
+		p.EncodingStats = append(p.EncodingStats, _elem3)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField14(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 14: ", err)
+	} else {
+		p.BloomFilterOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField15(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 15: ", err)
+	} else {
+		p.BloomFilterLength = &v
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField16(iprot thrift.TProtocol) error {
+	p.SizeStatistics = &SizeStatistics{}
+	if err := p.SizeStatistics.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SizeStatistics), err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) readField17(iprot thrift.TProtocol) error {
+	p.GeospatialStatistics = &GeospatialStatistics{}
+	if err := p.GeospatialStatistics.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GeospatialStatistics), err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ColumnMetaData"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField10(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField11(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField12(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField13(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField14(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField15(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField16(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField17(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnMetaData) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Type)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encodings", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encodings: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.I32, len(p.Encodings)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Encodings {
+		if err := oprot.WriteI32(int32(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encodings: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("path_in_schema", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:path_in_schema: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.PathInSchema)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.PathInSchema {
+		if err := oprot.WriteString(string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:path_in_schema: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("codec", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:codec: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Codec)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.codec (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:codec: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField5(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_values", thrift.I64, 5); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:num_values: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.NumValues)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_values (5) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:num_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField6(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("total_uncompressed_size", thrift.I64, 6); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:total_uncompressed_size: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.TotalUncompressedSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.total_uncompressed_size (6) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:total_uncompressed_size: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField7(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("total_compressed_size", thrift.I64, 7); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:total_compressed_size: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.TotalCompressedSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.total_compressed_size (7) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:total_compressed_size: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyValueMetadata() {
+		if err := oprot.WriteFieldBegin("key_value_metadata", thrift.LIST, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:key_value_metadata: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.KeyValueMetadata)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.KeyValueMetadata {
+			if err := v.write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:key_value_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField9(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("data_page_offset", thrift.I64, 9); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:data_page_offset: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.DataPageOffset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.data_page_offset (9) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:data_page_offset: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField10(oprot thrift.TProtocol) (err error) {
+	if p.IsSetIndexPageOffset() {
+		if err := oprot.WriteFieldBegin("index_page_offset", thrift.I64, 10); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:index_page_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.IndexPageOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.index_page_offset (10) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:index_page_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField11(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDictionaryPageOffset() {
+		if err := oprot.WriteFieldBegin("dictionary_page_offset", thrift.I64, 11); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:dictionary_page_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.DictionaryPageOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.dictionary_page_offset (11) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:dictionary_page_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField12(oprot thrift.TProtocol) (err error) {
+	if p.IsSetStatistics() {
+		if err := oprot.WriteFieldBegin("statistics", thrift.STRUCT, 12); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:statistics: ", p), err)
+		}
+		if err := p.Statistics.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:statistics: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField13(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncodingStats() {
+		if err := oprot.WriteFieldBegin("encoding_stats", thrift.LIST, 13); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:encoding_stats: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EncodingStats)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.EncodingStats {
+			if err := v.write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 13:encoding_stats: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField14(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBloomFilterOffset() {
+		if err := oprot.WriteFieldBegin("bloom_filter_offset", thrift.I64, 14); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 14:bloom_filter_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.BloomFilterOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.bloom_filter_offset (14) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 14:bloom_filter_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField15(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBloomFilterLength() {
+		if err := oprot.WriteFieldBegin("bloom_filter_length", thrift.I32, 15); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 15:bloom_filter_length: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.BloomFilterLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.bloom_filter_length (15) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 15:bloom_filter_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField16(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSizeStatistics() {
+		if err := oprot.WriteFieldBegin("size_statistics", thrift.STRUCT, 16); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 16:size_statistics: ", p), err)
+		}
+		if err := p.SizeStatistics.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SizeStatistics), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 16:size_statistics: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) writeField17(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGeospatialStatistics() {
+		if err := oprot.WriteFieldBegin("geospatial_statistics", thrift.STRUCT, 17); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 17:geospatial_statistics: ", p), err)
+		}
+		if err := p.GeospatialStatistics.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GeospatialStatistics), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 17:geospatial_statistics: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnMetaData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnMetaData(%+v)", *p)
+}
+
+// Attributes:
+//  - FilePath: File where column data is stored.  If not set, assumed to be same file as
+// metadata.  This path is relative to the current file.
+//
+//  - FileOffset: Byte offset in file_path to the ColumnMetaData *
+//  - MetaData: Column metadata for this chunk. This is the same content as what is at
+// file_path/file_offset.  Having it here has it replicated in the file
+// metadata.
+//
+//  - OffsetIndexOffset: File offset of ColumnChunk's OffsetIndex *
+//  - OffsetIndexLength: Size of ColumnChunk's OffsetIndex, in bytes *
+//  - ColumnIndexOffset: File offset of ColumnChunk's ColumnIndex *
+//  - ColumnIndexLength: Size of ColumnChunk's ColumnIndex, in bytes *
+//  - CryptoMetadata: Crypto metadata of encrypted columns *
+//  - EncryptedColumnMetadata: Encrypted column metadata for this chunk *
+type ColumnChunk struct {
+	FilePath                *string               `thrift:"file_path,1" json:"file_path,omitempty"`
+	FileOffset              int64                 `thrift:"file_offset,2,required" json:"file_offset"`
+	MetaData                *ColumnMetaData       `thrift:"meta_data,3" json:"meta_data,omitempty"`
+	OffsetIndexOffset       *int64                `thrift:"offset_index_offset,4" json:"offset_index_offset,omitempty"`
+	OffsetIndexLength       *int32                `thrift:"offset_index_length,5" json:"offset_index_length,omitempty"`
+	ColumnIndexOffset       *int64                `thrift:"column_index_offset,6" json:"column_index_offset,omitempty"`
+	ColumnIndexLength       *int32                `thrift:"column_index_length,7" json:"column_index_length,omitempty"`
+	CryptoMetadata          *ColumnCryptoMetaData `thrift:"crypto_metadata,8" json:"crypto_metadata,omitempty"`
+	EncryptedColumnMetadata []byte                `thrift:"encrypted_column_metadata,9" json:"encrypted_column_metadata,omitempty"`
+}
+
+func NewColumnChunk() *ColumnChunk {
+	return &ColumnChunk{}
+}
+
+var ColumnChunk_FilePath_DEFAULT string
+
+func (p *ColumnChunk) GetFilePath() string {
+	if !p.IsSetFilePath() {
+		return ColumnChunk_FilePath_DEFAULT
+	}
+	return *p.FilePath
+}
+
+func (p *ColumnChunk) GetFileOffset() int64 {
+	return p.FileOffset
+}
+
+var ColumnChunk_MetaData_DEFAULT *ColumnMetaData
+
+func (p *ColumnChunk) GetMetaData() *ColumnMetaData {
+	if !p.IsSetMetaData() {
+		return ColumnChunk_MetaData_DEFAULT
+	}
+	return p.MetaData
+}
+
+var ColumnChunk_OffsetIndexOffset_DEFAULT int64
+
+func (p *ColumnChunk) GetOffsetIndexOffset() int64 {
+	if !p.IsSetOffsetIndexOffset() {
+		return ColumnChunk_OffsetIndexOffset_DEFAULT
+	}
+	return *p.OffsetIndexOffset
+}
+
+var ColumnChunk_OffsetIndexLength_DEFAULT int32
+
+func (p *ColumnChunk) GetOffsetIndexLength() int32 {
+	if !p.IsSetOffsetIndexLength() {
+		return ColumnChunk_OffsetIndexLength_DEFAULT
+	}
+	return *p.OffsetIndexLength
+}
+
+var ColumnChunk_ColumnIndexOffset_DEFAULT int64
+
+func (p *ColumnChunk) GetColumnIndexOffset() int64 {
+	if !p.IsSetColumnIndexOffset() {
+		return ColumnChunk_ColumnIndexOffset_DEFAULT
+	}
+	return *p.ColumnIndexOffset
+}
+
+var ColumnChunk_ColumnIndexLength_DEFAULT int32
+
+func (p *ColumnChunk) GetColumnIndexLength() int32 {
+	if !p.IsSetColumnIndexLength() {
+		return ColumnChunk_ColumnIndexLength_DEFAULT
+	}
+	return *p.ColumnIndexLength
+}
+
+var ColumnChunk_CryptoMetadata_DEFAULT *ColumnCryptoMetaData
+
+func (p *ColumnChunk) GetCryptoMetadata() *ColumnCryptoMetaData {
+	if !p.IsSetCryptoMetadata() {
+		return ColumnChunk_CryptoMetadata_DEFAULT
+	}
+	return p.CryptoMetadata
+}
+
+var ColumnChunk_EncryptedColumnMetadata_DEFAULT []byte
+
+func (p *ColumnChunk) GetEncryptedColumnMetadata() []byte {
+	return p.EncryptedColumnMetadata
+}
+func (p *ColumnChunk) IsSetFilePath() bool {
+	return p.FilePath != nil
+}
+
+func (p *ColumnChunk) IsSetMetaData() bool {
+	return p.MetaData != nil
+}
+
+func (p *ColumnChunk) IsSetOffsetIndexOffset() bool {
+	return p.OffsetIndexOffset != nil
+}
+
+func (p *ColumnChunk) IsSetOffsetIndexLength() bool {
+	return p.OffsetIndexLength != nil
+}
+
+func (p *ColumnChunk) IsSetColumnIndexOffset() bool {
+	return p.ColumnIndexOffset != nil
+}
+
+func (p *ColumnChunk) IsSetColumnIndexLength() bool {
+	return p.ColumnIndexLength != nil
+}
+
+func (p *ColumnChunk) IsSetCryptoMetadata() bool {
+	return p.CryptoMetadata != nil
+}
+
+func (p *ColumnChunk) IsSetEncryptedColumnMetadata() bool {
+	return p.EncryptedColumnMetadata != nil
+}
+
+func (p *ColumnChunk) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetFileOffset bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetFileOffset = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetFileOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FileOffset is not set"))
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.FilePath = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.FileOffset = v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField3(iprot thrift.TProtocol) error {
+	p.MetaData = &ColumnMetaData{}
+	if err := p.MetaData.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MetaData), err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		p.OffsetIndexOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 5: ", err)
+	} else {
+		p.OffsetIndexLength = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.ColumnIndexOffset = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 7: ", err)
+	} else {
+		p.ColumnIndexLength = &v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField8(iprot thrift.TProtocol) error {
+	p.CryptoMetadata = &ColumnCryptoMetaData{}
+	if err := p.CryptoMetadata.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CryptoMetadata), err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) readField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.EncryptedColumnMetadata = v
+	}
+	return nil
+}
+
+func (p *ColumnChunk) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ColumnChunk"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnChunk) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFilePath() {
+		if err := oprot.WriteFieldBegin("file_path", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:file_path: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.FilePath)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.file_path (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:file_path: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("file_offset", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:file_offset: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.FileOffset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.file_offset (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:file_offset: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMetaData() {
+		if err := oprot.WriteFieldBegin("meta_data", thrift.STRUCT, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:meta_data: ", p), err)
+		}
+		if err := p.MetaData.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MetaData), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:meta_data: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffsetIndexOffset() {
+		if err := oprot.WriteFieldBegin("offset_index_offset", thrift.I64, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:offset_index_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.OffsetIndexOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offset_index_offset (4) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:offset_index_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetOffsetIndexLength() {
+		if err := oprot.WriteFieldBegin("offset_index_length", thrift.I32, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:offset_index_length: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.OffsetIndexLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.offset_index_length (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:offset_index_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnIndexOffset() {
+		if err := oprot.WriteFieldBegin("column_index_offset", thrift.I64, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:column_index_offset: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.ColumnIndexOffset)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.column_index_offset (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:column_index_offset: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnIndexLength() {
+		if err := oprot.WriteFieldBegin("column_index_length", thrift.I32, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:column_index_length: ", p), err)
+		}
+		if err := oprot.WriteI32(int32(*p.ColumnIndexLength)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.column_index_length (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:column_index_length: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCryptoMetadata() {
+		if err := oprot.WriteFieldBegin("crypto_metadata", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:crypto_metadata: ", p), err)
+		}
+		if err := p.CryptoMetadata.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CryptoMetadata), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:crypto_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncryptedColumnMetadata() {
+		if err := oprot.WriteFieldBegin("encrypted_column_metadata", thrift.STRING, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:encrypted_column_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.EncryptedColumnMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.encrypted_column_metadata (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:encrypted_column_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnChunk) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnChunk(%+v)", *p)
+}
+
+// Attributes:
+//  - Columns: Metadata for each column chunk in this row group.
+// This list must have the same order as the SchemaElement list in FileMetaData.
+//
+//  - TotalByteSize: Total byte size of all the uncompressed column data in this row group *
+//  - NumRows: Number of rows in this row group *
+//  - SortingColumns: If set, specifies a sort ordering of the rows in this RowGroup.
+// The sorting columns can be a subset of all the columns.
+type RowGroup struct {
+	Columns        []*ColumnChunk   `thrift:"columns,1,required" json:"columns"`
+	TotalByteSize  int64            `thrift:"total_byte_size,2,required" json:"total_byte_size"`
+	NumRows        int64            `thrift:"num_rows,3,required" json:"num_rows"`
+	SortingColumns []*SortingColumn `thrift:"sorting_columns,4" json:"sorting_columns,omitempty"`
+}
+
+func NewRowGroup() *RowGroup {
+	return &RowGroup{}
+}
+
+func (p *RowGroup) GetColumns() []*ColumnChunk {
+	return p.Columns
+}
+
+func (p *RowGroup) GetTotalByteSize() int64 {
+	return p.TotalByteSize
+}
+
+func (p *RowGroup) GetNumRows() int64 {
+	return p.NumRows
+}
+
+var RowGroup_SortingColumns_DEFAULT []*SortingColumn
+
+func (p *RowGroup) GetSortingColumns() []*SortingColumn {
+	return p.SortingColumns
+}
+func (p *RowGroup) IsSetSortingColumns() bool {
+	return p.SortingColumns != nil
+}
+
+func (p *RowGroup) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetColumns bool = false
+	var issetTotalByteSize bool = false
+	var issetNumRows bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetColumns = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetTotalByteSize = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetNumRows = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetColumns {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Columns is not set"))
+	}
+	if !issetTotalByteSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalByteSize is not set"))
+	}
+	if !issetNumRows {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
+	}
+	return nil
+}
+
+func (p *RowGroup) readField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ColumnChunk, 0, size)
+	p.Columns = tSlice
+	for i := 0; i < size; i++ {
+		_elem4 := &ColumnChunk{}
+		if err := _elem4.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		}
+		p.Columns = append(p.Columns, _elem4)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RowGroup) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.TotalByteSize = v
+	}
+	return nil
+}
+
+func (p *RowGroup) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NumRows = v
+	}
+	return nil
+}
+
+func (p *RowGroup) readField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*SortingColumn, 0, size)
+	p.SortingColumns = tSlice
+	for i := 0; i < size; i++ {
+		_elem5 := &SortingColumn{}
+		if err := _elem5.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem5), err)
+		}
+		p.SortingColumns = append(p.SortingColumns, _elem5)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *RowGroup) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("RowGroup"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *RowGroup) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("columns", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:columns: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Columns)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Columns {
+		if err := v.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:columns: ", p), err)
+	}
+	return err
+}
+
+func (p *RowGroup) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("total_byte_size", thrift.I64, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:total_byte_size: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.TotalByteSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.total_byte_size (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:total_byte_size: ", p), err)
+	}
+	return err
+}
+
+func (p *RowGroup) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_rows", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.NumRows)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
+	}
+	return err
+}
+
+func (p *RowGroup) writeField4(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSortingColumns() {
+		if err := oprot.WriteFieldBegin("sorting_columns", thrift.LIST, 4); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:sorting_columns: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SortingColumns)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.SortingColumns {
+			if err := v.write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:sorting_columns: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *RowGroup) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RowGroup(%+v)", *p)
+}
+
+// Description for file metadata
+//
+// Attributes:
+//  - Version: Version of this file *
+//  - Schema: Parquet schema for this file.  This schema contains metadata for all the columns.
+// The schema is represented as a tree with a single root.  The nodes of the tree
+// are flattened to a list by doing a depth-first traversal.
+// The column metadata contains the path in the schema for that column which can be
+// used to map columns to nodes in the schema.
+// The first element is the root *
+//  - NumRows: Number of rows in this file *
+//  - RowGroups: Row groups in this file *
+//  - KeyValueMetadata: Optional key/value metadata *
+//  - CreatedBy: String for application that wrote this file.  This should be in the format
+// <Application> version <App Version> (build <App Build Hash>).
+// e.g. impala version 1.0 (build 6cf94d29b2b7115df4de2c06e2ab4326d721eb55)
+//
+//  - ColumnOrders: Sort order used for the min_value and max_value fields of each column in
+// this file. Each sort order corresponds to one column, determined by its
+// position in the list, matching the position of the column in the schema.
+//
+//  - EncryptionAlgorithm: Encryption algorithm. This field is set only in encrypted files
+// with plaintext footer. Files with encrypted footer store algorithm id
+// in FileCryptoMetaData structure in this file.
+//  - FooterSigningKeyMetadata: Retrieval metadata of key used for signing the footer.
+// Used only in encrypted files with plaintext footer.
+type FileMetaData struct {
+	Version                  int32                `thrift:"version,1,required" json:"version"`
+	Schema                   []*SchemaElement     `thrift:"schema,2,required" json:"schema"`
+	NumRows                  int64                `thrift:"num_rows,3,required" json:"num_rows"`
+	RowGroups                []*RowGroup          `thrift:"row_groups,4,required" json:"row_groups"`
+	KeyValueMetadata         []*KeyValue          `thrift:"key_value_metadata,5" json:"key_value_metadata,omitempty"`
+	CreatedBy                *string              `thrift:"created_by,6" json:"created_by,omitempty"`
+	ColumnOrders             []*ColumnOrder       `thrift:"column_orders,7" json:"column_orders,omitempty"`
+	EncryptionAlgorithm      *EncryptionAlgorithm `thrift:"encryption_algorithm,8" json:"encryption_algorithm,omitempty"`
+	FooterSigningKeyMetadata []byte               `thrift:"footer_signing_key_metadata,9" json:"footer_signing_key_metadata,omitempty"`
+}
+
+func NewFileMetaData() *FileMetaData {
+	return &FileMetaData{}
+}
+
+func (p *FileMetaData) GetVersion() int32 {
+	return p.Version
+}
+
+func (p *FileMetaData) GetSchema() []*SchemaElement {
+	return p.Schema
+}
+
+func (p *FileMetaData) GetNumRows() int64 {
+	return p.NumRows
+}
+
+func (p *FileMetaData) GetRowGroups() []*RowGroup {
+	return p.RowGroups
+}
+
+var FileMetaData_KeyValueMetadata_DEFAULT []*KeyValue
+
+func (p *FileMetaData) GetKeyValueMetadata() []*KeyValue {
+	return p.KeyValueMetadata
+}
+
+var FileMetaData_CreatedBy_DEFAULT string
+
+func (p *FileMetaData) GetCreatedBy() string {
+	if !p.IsSetCreatedBy() {
+		return FileMetaData_CreatedBy_DEFAULT
+	}
+	return *p.CreatedBy
+}
+
+var FileMetaData_ColumnOrders_DEFAULT []*ColumnOrder
+
+func (p *FileMetaData) GetColumnOrders() []*ColumnOrder {
+	return p.ColumnOrders
+}
+
+var FileMetaData_EncryptionAlgorithm_DEFAULT *EncryptionAlgorithm
+
+func (p *FileMetaData) GetEncryptionAlgorithm() *EncryptionAlgorithm {
+	if !p.IsSetEncryptionAlgorithm() {
+		return FileMetaData_EncryptionAlgorithm_DEFAULT
+	}
+	return p.EncryptionAlgorithm
+}
+
+var FileMetaData_FooterSigningKeyMetadata_DEFAULT []byte
+
+func (p *FileMetaData) GetFooterSigningKeyMetadata() []byte {
+	return p.FooterSigningKeyMetadata
+}
+func (p *FileMetaData) IsSetKeyValueMetadata() bool {
+	return p.KeyValueMetadata != nil
+}
+
+func (p *FileMetaData) IsSetCreatedBy() bool {
+	return p.CreatedBy != nil
+}
+
+func (p *FileMetaData) IsSetColumnOrders() bool {
+	return p.ColumnOrders != nil
+}
+
+func (p *FileMetaData) IsSetEncryptionAlgorithm() bool {
+	return p.EncryptionAlgorithm != nil
+}
+
+func (p *FileMetaData) IsSetFooterSigningKeyMetadata() bool {
+	return p.FooterSigningKeyMetadata != nil
+}
+
+func (p *FileMetaData) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetVersion bool = false
+	var issetSchema bool = false
+	var issetNumRows bool = false
+	var issetRowGroups bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetVersion = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetSchema = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetNumRows = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+			issetRowGroups = true
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		case 6:
+			if err := p.readField6(iprot); err != nil {
+				return err
+			}
+		case 7:
+			if err := p.readField7(iprot); err != nil {
+				return err
+			}
+		case 8:
+			if err := p.readField8(iprot); err != nil {
+				return err
+			}
+		case 9:
+			if err := p.readField9(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetVersion {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Version is not set"))
+	}
+	if !issetSchema {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Schema is not set"))
+	}
+	if !issetNumRows {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
+	}
+	if !issetRowGroups {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RowGroups is not set"))
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Version = v
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*SchemaElement, 0, size)
+	p.Schema = tSlice
+	for i := 0; i < size; i++ {
+		_elem6 := &SchemaElement{}
+		if err := _elem6.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
+		}
+		p.Schema = append(p.Schema, _elem6)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.NumRows = v
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField4(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*RowGroup, 0, size)
+	p.RowGroups = tSlice
+	for i := 0; i < size; i++ {
+		_elem7 := &RowGroup{}
+		if err := _elem7.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem7), err)
+		}
+		p.RowGroups = append(p.RowGroups, _elem7)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*KeyValue, 0, size)
+	p.KeyValueMetadata = tSlice
+	for i := 0; i < size; i++ {
+		_elem8 := &KeyValue{}
+		if err := _elem8.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
+		}
+		p.KeyValueMetadata = append(p.KeyValueMetadata, _elem8)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadString(); err != nil {
+		return thrift.PrependError("error reading field 6: ", err)
+	} else {
+		p.CreatedBy = &v
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField7(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*ColumnOrder, 0, size)
+	p.ColumnOrders = tSlice
+	for i := 0; i < size; i++ {
+		_elem9 := &ColumnOrder{}
+		if err := _elem9.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem9), err)
+		}
+		p.ColumnOrders = append(p.ColumnOrders, _elem9)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField8(iprot thrift.TProtocol) error {
+	p.EncryptionAlgorithm = &EncryptionAlgorithm{}
+	if err := p.EncryptionAlgorithm.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EncryptionAlgorithm), err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) readField9(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 9: ", err)
+	} else {
+		p.FooterSigningKeyMetadata = v
+	}
+	return nil
+}
+
+func (p *FileMetaData) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FileMetaData"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField9(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *FileMetaData) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("version", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:version: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.Version)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.version (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:version: ", p), err)
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("schema", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:schema: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Schema)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.Schema {
+		if err := v.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:schema: ", p), err)
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("num_rows", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.NumRows)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("row_groups", thrift.LIST, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:row_groups: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RowGroups)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.RowGroups {
+		if err := v.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:row_groups: ", p), err)
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyValueMetadata() {
+		if err := oprot.WriteFieldBegin("key_value_metadata", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:key_value_metadata: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.KeyValueMetadata)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.KeyValueMetadata {
+			if err := v.write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:key_value_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetCreatedBy() {
+		if err := oprot.WriteFieldBegin("created_by", thrift.STRING, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:created_by: ", p), err)
+		}
+		if err := oprot.WriteString(string(*p.CreatedBy)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.created_by (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:created_by: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetColumnOrders() {
+		if err := oprot.WriteFieldBegin("column_orders", thrift.LIST, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:column_orders: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.ColumnOrders)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.ColumnOrders {
+			if err := v.write(oprot); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:column_orders: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetEncryptionAlgorithm() {
+		if err := oprot.WriteFieldBegin("encryption_algorithm", thrift.STRUCT, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:encryption_algorithm: ", p), err)
+		}
+		if err := p.EncryptionAlgorithm.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EncryptionAlgorithm), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:encryption_algorithm: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileMetaData) writeField9(oprot thrift.TProtocol) (err error) {
+	if p.IsSetFooterSigningKeyMetadata() {
+		if err := oprot.WriteFieldBegin("footer_signing_key_metadata", thrift.STRING, 9); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:footer_signing_key_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.FooterSigningKeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.footer_signing_key_metadata (9) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 9:footer_signing_key_metadata: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *FileMetaData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FileMetaData(%+v)", *p)
+}
+
+// Empty struct to signal the order defined by the physical or logical type
+type TypeDefinedOrder struct {
+}
+
+func NewTypeDefinedOrder() *TypeDefinedOrder {
+	return &TypeDefinedOrder{}
+}
+
+func (p *TypeDefinedOrder) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *TypeDefinedOrder) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("TypeDefinedOrder"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *TypeDefinedOrder) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TypeDefinedOrder(%+v)", *p)
+}
+
+// Union to specify the order used for the min_value and max_value fields for a
+// column. This union takes the role of an enhanced enum that allows rich
+// elements (which will be needed for a collation-based ordering in the future).
+//
+// Possible values are:
+// * TypeDefinedOrder - the column uses the order defined by its logical or
+//                      physical type (if there is no logical type).
+//
+// If the reader does not support the value of this union, min and max stats
+// for this column should be ignored.
+//
+// Attributes:
+//  - TYPE_ORDER
+type ColumnOrder struct {
+	TYPE_ORDER *TypeDefinedOrder `thrift:"TYPE_ORDER,1" json:"TYPE_ORDER,omitempty"`
+}
+
+func NewColumnOrder() *ColumnOrder {
+	return &ColumnOrder{}
+}
+
+var ColumnOrder_TYPE_ORDER_DEFAULT *TypeDefinedOrder
+
+func (p *ColumnOrder) GetTYPE_ORDER() *TypeDefinedOrder {
+	if !p.IsSetTYPE_ORDER() {
+		return ColumnOrder_TYPE_ORDER_DEFAULT
+	}
+	return p.TYPE_ORDER
+}
+func (p *ColumnOrder) CountSetFieldsColumnOrder() int {
+	count := 0
+	if p.IsSetTYPE_ORDER() {
+		count++
+	}
+	return count
+
+}
+
+func (p *ColumnOrder) IsSetTYPE_ORDER() bool {
+	return p.TYPE_ORDER != nil
+}
+
+func (p *ColumnOrder) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *ColumnOrder) readField1(iprot thrift.TProtocol) error {
+	p.TYPE_ORDER = &TypeDefinedOrder{}
+	if err := p.TYPE_ORDER.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TYPE_ORDER), err)
+	}
+	return nil
+}
+
+func (p *ColumnOrder) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsColumnOrder(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("ColumnOrder"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnOrder) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetTYPE_ORDER() {
+		if err := oprot.WriteFieldBegin("TYPE_ORDER", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:TYPE_ORDER: ", p), err)
+		}
+		if err := p.TYPE_ORDER.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TYPE_ORDER), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:TYPE_ORDER: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnOrder) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnOrder(%+v)", *p)
+}
+
+// Attributes:
+//  - Offset: Offset of the page in the file *
+//  - CompressedPageSize: Size of the page, including header. Sum of compressed_page_size and header
+// length
+//  - FirstRowIndex: Index within the RowGroup of the first row of the page; this means pages
+// change on record boundaries (r = 0).
+type PageLocation struct {
+	Offset             int64 `thrift:"offset,1,required" json:"offset"`
+	CompressedPageSize int32 `thrift:"compressed_page_size,2,required" json:"compressed_page_size"`
+	FirstRowIndex      int64 `thrift:"first_row_index,3,required" json:"first_row_index"`
+}
+
+func NewPageLocation() *PageLocation {
+	return &PageLocation{}
+}
+
+func (p *PageLocation) GetOffset() int64 {
+	return p.Offset
+}
+
+func (p *PageLocation) GetCompressedPageSize() int32 {
+	return p.CompressedPageSize
+}
+
+func (p *PageLocation) GetFirstRowIndex() int64 {
+	return p.FirstRowIndex
+}
+func (p *PageLocation) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetOffset bool = false
+	var issetCompressedPageSize bool = false
+	var issetFirstRowIndex bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetOffset = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetCompressedPageSize = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetFirstRowIndex = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetOffset {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Offset is not set"))
+	}
+	if !issetCompressedPageSize {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field CompressedPageSize is not set"))
+	}
+	if !issetFirstRowIndex {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FirstRowIndex is not set"))
+	}
+	return nil
+}
+
+func (p *PageLocation) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.Offset = v
+	}
+	return nil
+}
+
+func (p *PageLocation) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.CompressedPageSize = v
+	}
+	return nil
+}
+
+func (p *PageLocation) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.FirstRowIndex = v
+	}
+	return nil
+}
+
+func (p *PageLocation) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("PageLocation"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *PageLocation) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("offset", thrift.I64, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:offset: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.Offset)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.offset (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:offset: ", p), err)
+	}
+	return err
+}
+
+func (p *PageLocation) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("compressed_page_size", thrift.I32, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compressed_page_size: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.CompressedPageSize)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.compressed_page_size (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compressed_page_size: ", p), err)
+	}
+	return err
+}
+
+func (p *PageLocation) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("first_row_index", thrift.I64, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first_row_index: ", p), err)
+	}
+	if err := oprot.WriteI64(int64(p.FirstRowIndex)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.first_row_index (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first_row_index: ", p), err)
+	}
+	return err
+}
+
+func (p *PageLocation) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PageLocation(%+v)", *p)
+}
+
+// Attributes:
+//  - PageLocations: PageLocations, ordered by increasing PageLocation.offset. It is required
+// that page_locations[i].first_row_index < page_locations[i+1].first_row_index.
+type OffsetIndex struct {
+	PageLocations []*PageLocation `thrift:"page_locations,1,required" json:"page_locations"`
+}
+
+func NewOffsetIndex() *OffsetIndex {
+	return &OffsetIndex{}
+}
+
+func (p *OffsetIndex) GetPageLocations() []*PageLocation {
+	return p.PageLocations
+}
+func (p *OffsetIndex) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetPageLocations bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetPageLocations = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetPageLocations {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PageLocations is not set"))
+	}
+	return nil
+}
+
+func (p *OffsetIndex) readField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]*PageLocation, 0, size)
+	p.PageLocations = tSlice
+	for i := 0; i < size; i++ {
+		_elem10 := &PageLocation{}
+		if err := _elem10.read(iprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
+		}
+		p.PageLocations = append(p.PageLocations, _elem10)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *OffsetIndex) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("OffsetIndex"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *OffsetIndex) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("page_locations", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_locations: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.PageLocations)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.PageLocations {
+		if err := v.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_locations: ", p), err)
+	}
+	return err
+}
+
+func (p *OffsetIndex) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("OffsetIndex(%+v)", *p)
+}
+
+// Description for ColumnIndex.
+// Each <array-field>[i] refers to the page at OffsetIndex.page_locations[i]
+//
+// Attributes:
+//  - NullPages: A list of Boolean values to determine the validity of the corresponding
+// min and max values. If true, a page contains only null values, and writers
+// have to set the corresponding entries in min_values and max_values to
+// byte[0], so that all lists have the same length. If false, the
+// corresponding entries in min_values and max_values must be valid.
+//  - MinValues: Two lists containing lower and upper bounds for the values of each page
+// determined by the ColumnOrder of the column. These may be the actual
+// minimum and maximum values found on a page, but can also be (more compact)
+// values that do not exist on a page. For example, instead of storing ""Blart
+// Versenwald III", a writer may set min_values[i]="B", max_values[i]="C".
+// Such more compact values must still be valid values within the column's
+// logical type. Readers must make sure that list entries are populated before
+// using them by inspecting null_pages.
+//  - MaxValues
+//  - BoundaryOrder: Stores whether both min_values and max_values are orderd and if so, in
+// which direction. This allows readers to perform binary searches in both
+// lists. Readers cannot assume that max_values[i] <= min_values[i+1], even
+// if the lists are ordered.
+//  - NullCounts: A list containing the number of null values for each page *
+type ColumnIndex struct {
+	NullPages     []bool        `thrift:"null_pages,1,required" json:"null_pages"`
+	MinValues     [][]byte      `thrift:"min_values,2,required" json:"min_values"`
+	MaxValues     [][]byte      `thrift:"max_values,3,required" json:"max_values"`
+	BoundaryOrder BoundaryOrder `thrift:"boundary_order,4,required" json:"boundary_order"`
+	NullCounts    []int64       `thrift:"null_counts,5" json:"null_counts,omitempty"`
+}
+
+func NewColumnIndex() *ColumnIndex {
+	return &ColumnIndex{}
+}
+
+func (p *ColumnIndex) GetNullPages() []bool {
+	return p.NullPages
+}
+
+func (p *ColumnIndex) GetMinValues() [][]byte {
+	return p.MinValues
+}
+
+func (p *ColumnIndex) GetMaxValues() [][]byte {
+	return p.MaxValues
+}
+
+func (p *ColumnIndex) GetBoundaryOrder() BoundaryOrder {
+	return p.BoundaryOrder
+}
+
+var ColumnIndex_NullCounts_DEFAULT []int64
+
+func (p *ColumnIndex) GetNullCounts() []int64 {
+	return p.NullCounts
+}
+func (p *ColumnIndex) IsSetNullCounts() bool {
+	return p.NullCounts != nil
+}
+
+func (p *ColumnIndex) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNullPages bool = false
+	var issetMinValues bool = false
+	var issetMaxValues bool = false
+	var issetBoundaryOrder bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetNullPages = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetMinValues = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetMaxValues = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+			issetBoundaryOrder = true
+		case 5:
+			if err := p.readField5(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNullPages {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NullPages is not set"))
+	}
+	if !issetMinValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MinValues is not set"))
+	}
+	if !issetMaxValues {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field MaxValues is not set"))
+	}
+	if !issetBoundaryOrder {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field BoundaryOrder is not set"))
+	}
+	return nil
+}
+
+func (p *ColumnIndex) readField1(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]bool, 0, size)
+	p.NullPages = tSlice
+	for i := 0; i < size; i++ {
+		var _elem11 bool
+		if v, err := iprot.ReadBool(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem11 = v
+		}
+		p.NullPages = append(p.NullPages, _elem11)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) readField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.MinValues = tSlice
+	for i := 0; i < size; i++ {
+		var _elem12 []byte
+		if v, err := iprot.ReadBinary(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem12 = v
+		}
+		p.MinValues = append(p.MinValues, _elem12)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) readField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([][]byte, 0, size)
+	p.MaxValues = tSlice
+	for i := 0; i < size; i++ {
+		var _elem13 []byte
+		if v, err := iprot.ReadBinary(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem13 = v
+		}
+		p.MaxValues = append(p.MaxValues, _elem13)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 4: ", err)
+	} else {
+		temp := BoundaryOrder(v)
+		p.BoundaryOrder = temp
+	}
+	return nil
+}
+
+func (p *ColumnIndex) readField5(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int64, 0, size)
+	p.NullCounts = tSlice
+	for i := 0; i < size; i++ {
+		var _elem14 int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem14 = v
+		}
+		p.NullCounts = append(p.NullCounts, _elem14)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("ColumnIndex"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *ColumnIndex) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("null_pages", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:null_pages: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.BOOL, len(p.NullPages)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.NullPages {
+		if err := oprot.WriteBool(bool(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:null_pages: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("min_values", thrift.LIST, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:min_values: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.MinValues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MinValues {
+		if err := oprot.WriteBinary(v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:min_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("max_values", thrift.LIST, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:max_values: ", p), err)
+	}
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.MaxValues)); err != nil {
+		return thrift.PrependError("error writing list begin: ", err)
+	}
+	for _, v := range p.MaxValues {
+		if err := oprot.WriteBinary(v); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+		}
+	}
+	if err := oprot.WriteListEnd(); err != nil {
+		return thrift.PrependError("error writing list end: ", err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:max_values: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("boundary_order", thrift.I32, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:boundary_order: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.BoundaryOrder)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.boundary_order (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:boundary_order: ", p), err)
+	}
+	return err
+}
+
+func (p *ColumnIndex) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetNullCounts() {
+		if err := oprot.WriteFieldBegin("null_counts", thrift.LIST, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:null_counts: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.NullCounts)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.NullCounts {
+			if err := oprot.WriteI64(int64(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:null_counts: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *ColumnIndex) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnIndex(%+v)", *p)
+}
+
+// Block-based algorithm type annotation.
+type SplitBlockAlgorithm struct {
+}
+
+func NewSplitBlockAlgorithm() *SplitBlockAlgorithm {
+	return &SplitBlockAlgorithm{}
+}
+
+func (p *SplitBlockAlgorithm) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *SplitBlockAlgorithm) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SplitBlockAlgorithm"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *SplitBlockAlgorithm) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SplitBlockAlgorithm(%+v)", *p)
+}
+
+// The algorithm used in Bloom filter.
+type BloomFilterAlgorithm struct {
+	BLOCK *SplitBlockAlgorithm `thrift:"BLOCK,1" json:"BLOCK,omitempty"`
+}
+
+func NewBloomFilterAlgorithm() *BloomFilterAlgorithm {
+	return &BloomFilterAlgorithm{}
+}
+
+var BloomFilterAlgorithm_BLOCK_DEFAULT *SplitBlockAlgorithm
+
+func (p *BloomFilterAlgorithm) GetBLOCK() *SplitBlockAlgorithm {
+	if !p.IsSetBLOCK() {
+		return BloomFilterAlgorithm_BLOCK_DEFAULT
+	}
+	return p.BLOCK
+}
+func (p *BloomFilterAlgorithm) CountSetFieldsBloomFilterAlgorithm() int {
+	count := 0
+	if p.IsSetBLOCK() {
+		count++
+	}
+	return count
+
+}
+
+func (p *BloomFilterAlgorithm) IsSetBLOCK() bool {
+	return p.BLOCK != nil
+}
+
+func (p *BloomFilterAlgorithm) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterAlgorithm) readField1(iprot thrift.TProtocol) error {
+	p.BLOCK = &SplitBlockAlgorithm{}
+	if err := p.BLOCK.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BLOCK), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterAlgorithm) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsBloomFilterAlgorithm(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("BloomFilterAlgorithm"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *BloomFilterAlgorithm) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBLOCK() {
+		if err := oprot.WriteFieldBegin("BLOCK", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:BLOCK: ", p), err)
+		}
+		if err := p.BLOCK.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BLOCK), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:BLOCK: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BloomFilterAlgorithm) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BloomFilterAlgorithm(%+v)", *p)
+}
+
+// Hash strategy type annotation. xxHash is an extremely fast non-cryptographic hash
+// algorithm. It uses 64 bits version of xxHash.
+type XxHash struct {
+}
+
+func NewXxHash() *XxHash {
+	return &XxHash{}
+}
+
+func (p *XxHash) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *XxHash) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("XxHash"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *XxHash) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("XxHash(%+v)", *p)
+}
+
+// The hash function used in Bloom filter. This function takes the hash of a column value
+// using plain encoding.
+type BloomFilterHash struct {
+	XXHASH *XxHash `thrift:"XXHASH,1" json:"XXHASH,omitempty"`
+}
+
+func NewBloomFilterHash() *BloomFilterHash {
+	return &BloomFilterHash{}
+}
+
+var BloomFilterHash_XXHASH_DEFAULT *XxHash
+
+func (p *BloomFilterHash) GetXXHASH() *XxHash {
+	if !p.IsSetXXHASH() {
+		return BloomFilterHash_XXHASH_DEFAULT
+	}
+	return p.XXHASH
+}
+func (p *BloomFilterHash) CountSetFieldsBloomFilterHash() int {
+	count := 0
+	if p.IsSetXXHASH() {
+		count++
+	}
+	return count
+
+}
+
+func (p *BloomFilterHash) IsSetXXHASH() bool {
+	return p.XXHASH != nil
+}
+
+func (p *BloomFilterHash) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHash) readField1(iprot thrift.TProtocol) error {
+	p.XXHASH = &XxHash{}
+	if err := p.XXHASH.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.XXHASH), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHash) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsBloomFilterHash(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("BloomFilterHash"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHash) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetXXHASH() {
+		if err := oprot.WriteFieldBegin("XXHASH", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:XXHASH: ", p), err)
+		}
+		if err := p.XXHASH.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.XXHASH), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:XXHASH: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BloomFilterHash) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BloomFilterHash(%+v)", *p)
+}
+
+// The compression used in the Bloom filter.
+type Uncompressed struct {
+}
+
+func NewUncompressed() *Uncompressed {
+	return &Uncompressed{}
+}
+
+func (p *Uncompressed) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *Uncompressed) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("Uncompressed"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *Uncompressed) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Uncompressed(%+v)", *p)
+}
+
+type BloomFilterCompression struct {
+	UNCOMPRESSED *Uncompressed `thrift:"UNCOMPRESSED,1" json:"UNCOMPRESSED,omitempty"`
+}
+
+func NewBloomFilterCompression() *BloomFilterCompression {
+	return &BloomFilterCompression{}
+}
+
+var BloomFilterCompression_UNCOMPRESSED_DEFAULT *Uncompressed
+
+func (p *BloomFilterCompression) GetUNCOMPRESSED() *Uncompressed {
+	if !p.IsSetUNCOMPRESSED() {
+		return BloomFilterCompression_UNCOMPRESSED_DEFAULT
+	}
+	return p.UNCOMPRESSED
+}
+func (p *BloomFilterCompression) CountSetFieldsBloomFilterCompression() int {
+	count := 0
+	if p.IsSetUNCOMPRESSED() {
+		count++
+	}
+	return count
+
+}
+
+func (p *BloomFilterCompression) IsSetUNCOMPRESSED() bool {
+	return p.UNCOMPRESSED != nil
+}
+
+func (p *BloomFilterCompression) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterCompression) readField1(iprot thrift.TProtocol) error {
+	p.UNCOMPRESSED = &Uncompressed{}
+	if err := p.UNCOMPRESSED.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UNCOMPRESSED), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterCompression) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsBloomFilterCompression(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("BloomFilterCompression"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *BloomFilterCompression) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUNCOMPRESSED() {
+		if err := oprot.WriteFieldBegin("UNCOMPRESSED", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:UNCOMPRESSED: ", p), err)
+		}
+		if err := p.UNCOMPRESSED.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UNCOMPRESSED), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:UNCOMPRESSED: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BloomFilterCompression) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BloomFilterCompression(%+v)", *p)
+}
+
+// Bloom filter header is stored at beginning of Bloom filter data of each column
+// and followed by its bitset.
+//
+// Attributes:
+//  - NumBytes: The size of bitset in bytes *
+//  - Algorithm: The algorithm for setting bits. *
+//  - Hash: The hash function used for Bloom filter. *
+//  - Compression: The compression used in the Bloom filter *
+type BloomFilterHeader struct {
+	NumBytes    int32                   `thrift:"numBytes,1,required" json:"numBytes"`
+	Algorithm   *BloomFilterAlgorithm   `thrift:"algorithm,2,required" json:"algorithm"`
+	Hash        *BloomFilterHash        `thrift:"hash,3,required" json:"hash"`
+	Compression *BloomFilterCompression `thrift:"compression,4,required" json:"compression"`
+}
+
+func NewBloomFilterHeader() *BloomFilterHeader {
+	return &BloomFilterHeader{}
+}
+
+func (p *BloomFilterHeader) GetNumBytes() int32 {
+	return p.NumBytes
+}
+
+func (p *BloomFilterHeader) GetAlgorithm() *BloomFilterAlgorithm {
+	return p.Algorithm
+}
+
+func (p *BloomFilterHeader) GetHash() *BloomFilterHash {
+	return p.Hash
+}
+
+func (p *BloomFilterHeader) GetCompression() *BloomFilterCompression {
+	return p.Compression
+}
+func (p *BloomFilterHeader) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	var issetNumBytes bool = false
+	var issetAlgorithm bool = false
+	var issetHash bool = false
+	var issetCompression bool = false
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+			issetNumBytes = true
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+			issetAlgorithm = true
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+			issetHash = true
+		case 4:
+			if err := p.readField4(iprot); err != nil {
+				return err
+			}
+			issetCompression = true
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	if !issetNumBytes {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumBytes is not set"))
+	}
+	if !issetAlgorithm {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Algorithm is not set"))
+	}
+	if !issetHash {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Hash is not set"))
+	}
+	if !issetCompression {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Compression is not set"))
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI32(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.NumBytes = v
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) readField2(iprot thrift.TProtocol) error {
+	p.Algorithm = &BloomFilterAlgorithm{}
+	if err := p.Algorithm.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Algorithm), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) readField3(iprot thrift.TProtocol) error {
+	p.Hash = &BloomFilterHash{}
+	if err := p.Hash.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Hash), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) readField4(iprot thrift.TProtocol) error {
+	p.Compression = &BloomFilterCompression{}
+	if err := p.Compression.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Compression), err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("BloomFilterHeader"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *BloomFilterHeader) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("numBytes", thrift.I32, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numBytes: ", p), err)
+	}
+	if err := oprot.WriteI32(int32(p.NumBytes)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.numBytes (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numBytes: ", p), err)
+	}
+	return err
+}
+
+func (p *BloomFilterHeader) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("algorithm", thrift.STRUCT, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:algorithm: ", p), err)
+	}
+	if err := p.Algorithm.write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Algorithm), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:algorithm: ", p), err)
+	}
+	return err
+}
+
+func (p *BloomFilterHeader) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("hash", thrift.STRUCT, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:hash: ", p), err)
+	}
+	if err := p.Hash.write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Hash), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:hash: ", p), err)
+	}
+	return err
+}
+
+func (p *BloomFilterHeader) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("compression", thrift.STRUCT, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:compression: ", p), err)
+	}
+	if err := p.Compression.write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Compression), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:compression: ", p), err)
+	}
+	return err
+}
+
+func (p *BloomFilterHeader) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BloomFilterHeader(%+v)", *p)
+}
+
+// A structure for capturing metadata for estimating the unencoded,
+// uncompressed size of data written. This is useful for readers to estimate
+// how much memory is needed to reconstruct data in their memory model and for
+// fine grained filter pushdown on nested structures (the histograms contained
+// in this structure can help determine the number of nulls at a particular
+// nesting level and maximum length of lists).
+//
+// Attributes:
+//  - UnencodedByteArrayDataBytes: The number of physical bytes stored for BYTE_ARRAY data values assuming
+// no encoding. This is exclusive of the bytes needed to store the length of
+// each byte array. In other words, this field is equivalent to the `(size
+// of PLAIN-ENCODING the byte array values) - (4 bytes * number of values
+// written)`. To determine unencoded sizes of other types readers can use
+// schema information multiplied by the number of non-null and null values.
+// The number of null/non-null values can be inferred from the histograms
+// below.
+//
+// For example, if a column chunk is dictionary-encoded with dictionary
+// ["a", "bc", "cde"], and a data page contains the indices [0, 0, 1, 2],
+// then this value for that data page should be 7 (1 + 1 + 2 + 3).
+//
+// This field should only be set for types that use BYTE_ARRAY as their
+// physical type.
+//  - RepetitionLevelHistogram: When present, there is expected to be one element corresponding to each
+// repetition (i.e. size=max repetition_level+1) where each element
+// represents the number of times the repetition level was observed in the
+// data.
+//
+// This field may be omitted if max_repetition_level is 0 without loss
+// of information.
+//  - DefinitionLevelHistogram: Same as repetition_level_histogram except for definition levels.
+//
+// This field may be omitted if max_definition_level is 0 or 1 without
+// loss of information.
+type SizeStatistics struct {
+	UnencodedByteArrayDataBytes *int64  `thrift:"unencoded_byte_array_data_bytes,1" json:"unencoded_byte_array_data_bytes,omitempty"`
+	RepetitionLevelHistogram    []int64 `thrift:"repetition_level_histogram,2" json:"repetition_level_histogram,omitempty"`
+	DefinitionLevelHistogram    []int64 `thrift:"definition_level_histogram,3" json:"definition_level_histogram,omitempty"`
+}
+
+func NewSizeStatistics() *SizeStatistics {
+	return &SizeStatistics{}
+}
+
+var SizeStatistics_UnencodedByteArrayDataBytes_DEFAULT int64
+
+func (p *SizeStatistics) GetUnencodedByteArrayDataBytes() int64 {
+	if !p.IsSetUnencodedByteArrayDataBytes() {
+		return SizeStatistics_UnencodedByteArrayDataBytes_DEFAULT
+	}
+	return *p.UnencodedByteArrayDataBytes
+}
+
+var SizeStatistics_RepetitionLevelHistogram_DEFAULT []int64
+
+func (p *SizeStatistics) GetRepetitionLevelHistogram() []int64 {
+	return p.RepetitionLevelHistogram
+}
+
+var SizeStatistics_DefinitionLevelHistogram_DEFAULT []int64
+
+func (p *SizeStatistics) GetDefinitionLevelHistogram() []int64 {
+	return p.DefinitionLevelHistogram
+}
+func (p *SizeStatistics) IsSetUnencodedByteArrayDataBytes() bool {
+	return p.UnencodedByteArrayDataBytes != nil
+}
+
+func (p *SizeStatistics) IsSetRepetitionLevelHistogram() bool {
+	return p.RepetitionLevelHistogram != nil
+}
+
+func (p *SizeStatistics) IsSetDefinitionLevelHistogram() bool {
+	return p.DefinitionLevelHistogram != nil
+}
+
+func (p *SizeStatistics) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *SizeStatistics) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadI64(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.UnencodedByteArrayDataBytes = &v
+	}
+	return nil
+}
+
+func (p *SizeStatistics) readField2(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int64, 0, size)
+	p.RepetitionLevelHistogram = tSlice
+	for i := 0; i < size; i++ {
+		var _elem15 int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem15 = v
+		}
+		p.RepetitionLevelHistogram = append(p.RepetitionLevelHistogram, _elem15)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *SizeStatistics) readField3(iprot thrift.TProtocol) error {
+	_, size, err := iprot.ReadListBegin()
+	if err != nil {
+		return thrift.PrependError("error reading list begin: ", err)
+	}
+	tSlice := make([]int64, 0, size)
+	p.DefinitionLevelHistogram = tSlice
+	for i := 0; i < size; i++ {
+		var _elem16 int64
+		if v, err := iprot.ReadI64(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem16 = v
+		}
+		p.DefinitionLevelHistogram = append(p.DefinitionLevelHistogram, _elem16)
+	}
+	if err := iprot.ReadListEnd(); err != nil {
+		return thrift.PrependError("error reading list end: ", err)
+	}
+	return nil
+}
+
+func (p *SizeStatistics) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("SizeStatistics"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
 	}
 	if err := p.writeField3(oprot); err != nil {
 		return err
@@ -2957,194 +9893,177 @@ func (p *PageEncodingStats) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *PageEncodingStats) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("page_type", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:page_type: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.PageType)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.page_type (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:page_type: ", p), err)
+func (p *SizeStatistics) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetUnencodedByteArrayDataBytes() {
+		if err := oprot.WriteFieldBegin("unencoded_byte_array_data_bytes", thrift.I64, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:unencoded_byte_array_data_bytes: ", p), err)
+		}
+		if err := oprot.WriteI64(int64(*p.UnencodedByteArrayDataBytes)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.unencoded_byte_array_data_bytes (1) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:unencoded_byte_array_data_bytes: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PageEncodingStats) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("encoding", thrift.I32, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encoding: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.Encoding)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.encoding (2) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encoding: ", p), err)
+func (p *SizeStatistics) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetRepetitionLevelHistogram() {
+		if err := oprot.WriteFieldBegin("repetition_level_histogram", thrift.LIST, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:repetition_level_histogram: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.RepetitionLevelHistogram)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.RepetitionLevelHistogram {
+			if err := oprot.WriteI64(int64(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:repetition_level_histogram: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PageEncodingStats) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("count", thrift.I32, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:count: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.Count)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.count (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:count: ", p), err)
+func (p *SizeStatistics) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetDefinitionLevelHistogram() {
+		if err := oprot.WriteFieldBegin("definition_level_histogram", thrift.LIST, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:definition_level_histogram: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I64, len(p.DefinitionLevelHistogram)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.DefinitionLevelHistogram {
+			if err := oprot.WriteI64(int64(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:definition_level_histogram: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *PageEncodingStats) String() string {
+func (p *SizeStatistics) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("PageEncodingStats(%+v)", *p)
+	return fmt.Sprintf("SizeStatistics(%+v)", *p)
 }
 
-// Description for column metadata
+// Bounding box for GEOMETRY or GEOGRAPHY type in the representation of min/max
+// value pair of coordinates from each axis.
 //
 // Attributes:
-//  - Type: Type of this column *
-//  - Encodings: Set of all encodings used for this column. The purpose is to validate
-// whether we can decode those pages. *
-//  - PathInSchema: Path in schema *
-//  - Codec: Compression codec *
-//  - NumValues: Number of values in this column *
-//  - TotalUncompressedSize: total byte size of all uncompressed pages in this column chunk (including the headers) *
-//  - TotalCompressedSize: total byte size of all compressed pages in this column chunk (including the headers) *
-//  - KeyValueMetadata: Optional key/value metadata *
-//  - DataPageOffset: Byte offset from beginning of file to first data page *
-//  - IndexPageOffset: Byte offset from beginning of file to root index page *
-//  - DictionaryPageOffset: Byte offset from the beginning of file to first (only) dictionary page *
-//  - Statistics: optional statistics for this column chunk
-//  - EncodingStats: Set of all encodings used for pages in this column chunk.
-// This information can be used to determine if all data pages are
-// dictionary encoded for example *
-type ColumnMetaData struct {
-	Type                  Type                 `thrift:"type,1,required" json:"type"`
-	Encodings             []Encoding           `thrift:"encodings,2,required" json:"encodings"`
-	PathInSchema          []string             `thrift:"path_in_schema,3,required" json:"path_in_schema"`
-	Codec                 CompressionCodec     `thrift:"codec,4,required" json:"codec"`
-	NumValues             int64                `thrift:"num_values,5,required" json:"num_values"`
-	TotalUncompressedSize int64                `thrift:"total_uncompressed_size,6,required" json:"total_uncompressed_size"`
-	TotalCompressedSize   int64                `thrift:"total_compressed_size,7,required" json:"total_compressed_size"`
-	KeyValueMetadata      []*KeyValue          `thrift:"key_value_metadata,8" json:"key_value_metadata,omitempty"`
-	DataPageOffset        int64                `thrift:"data_page_offset,9,required" json:"data_page_offset"`
-	IndexPageOffset       *int64               `thrift:"index_page_offset,10" json:"index_page_offset,omitempty"`
-	DictionaryPageOffset  *int64               `thrift:"dictionary_page_offset,11" json:"dictionary_page_offset,omitempty"`
-	Statistics            *Statistics          `thrift:"statistics,12" json:"statistics,omitempty"`
-	EncodingStats         []*PageEncodingStats `thrift:"encoding_stats,13" json:"encoding_stats,omitempty"`
-}
-
-func NewColumnMetaData() *ColumnMetaData {
-	return &ColumnMetaData{}
+//  - Xmin
+//  - Xmax
+//  - Ymin
+//  - Ymax
+//  - Zmin
+//  - Zmax
+//  - Mmin
+//  - Mmax
+type BoundingBox struct {
+	Xmin float64  `thrift:"xmin,1,required" json:"xmin"`
+	Xmax float64  `thrift:"xmax,2,required" json:"xmax"`
+	Ymin float64  `thrift:"ymin,3,required" json:"ymin"`
+	Ymax float64  `thrift:"ymax,4,required" json:"ymax"`
+	Zmin *float64 `thrift:"zmin,5" json:"zmin,omitempty"`
+	Zmax *float64 `thrift:"zmax,6" json:"zmax,omitempty"`
+	Mmin *float64 `thrift:"mmin,7" json:"mmin,omitempty"`
+	Mmax *float64 `thrift:"mmax,8" json:"mmax,omitempty"`
 }
 
-func (p *ColumnMetaData) GetType() Type {
-	return p.Type
-}
-
-func (p *ColumnMetaData) GetEncodings() []Encoding {
-	return p.Encodings
-}
-
-func (p *ColumnMetaData) GetPathInSchema() []string {
-	return p.PathInSchema
-}
-
-func (p *ColumnMetaData) GetCodec() CompressionCodec {
-	return p.Codec
-}
-
-func (p *ColumnMetaData) GetNumValues() int64 {
-	return p.NumValues
+func NewBoundingBox() *BoundingBox {
+	return &BoundingBox{}
 }
 
-func (p *ColumnMetaData) GetTotalUncompressedSize() int64 {
-	return p.TotalUncompressedSize
+func (p *BoundingBox) GetXmin() float64 {
+	return p.Xmin
 }
 
-func (p *ColumnMetaData) GetTotalCompressedSize() int64 {
-	return p.TotalCompressedSize
+func (p *BoundingBox) GetXmax() float64 {
+	return p.Xmax
 }
 
-var ColumnMetaData_KeyValueMetadata_DEFAULT []*KeyValue
-
-func (p *ColumnMetaData) GetKeyValueMetadata() []*KeyValue {
-	return p.KeyValueMetadata
+func (p *BoundingBox) GetYmin() float64 {
+	return p.Ymin
 }
 
-func (p *ColumnMetaData) GetDataPageOffset() int64 {
-	return p.DataPageOffset
+func (p *BoundingBox) GetYmax() float64 {
+	return p.Ymax
 }
 
-var ColumnMetaData_IndexPageOffset_DEFAULT int64
+var BoundingBox_Zmin_DEFAULT float64
 
-func (p *ColumnMetaData) GetIndexPageOffset() int64 {
-	if !p.IsSetIndexPageOffset() {
-		return ColumnMetaData_IndexPageOffset_DEFAULT
+func (p *BoundingBox) GetZmin() float64 {
+	if !p.IsSetZmin() {
+		return BoundingBox_Zmin_DEFAULT
 	}
-	return *p.IndexPageOffset
+	return *p.Zmin
 }
 
-var ColumnMetaData_DictionaryPageOffset_DEFAULT int64
+var BoundingBox_Zmax_DEFAULT float64
 
-func (p *ColumnMetaData) GetDictionaryPageOffset() int64 {
-	if !p.IsSetDictionaryPageOffset() {
-		return ColumnMetaData_DictionaryPageOffset_DEFAULT
+func (p *BoundingBox) GetZmax() float64 {
+	if !p.IsSetZmax() {
+		return BoundingBox_Zmax_DEFAULT
 	}
-	return *p.DictionaryPageOffset
+	return *p.Zmax
 }
 
-var ColumnMetaData_Statistics_DEFAULT *Statistics
+var BoundingBox_Mmin_DEFAULT float64
 
-func (p *ColumnMetaData) GetStatistics() *Statistics {
-	if !p.IsSetStatistics() {
-		return ColumnMetaData_Statistics_DEFAULT
+func (p *BoundingBox) GetMmin() float64 {
+	if !p.IsSetMmin() {
+		return BoundingBox_Mmin_DEFAULT
 	}
-	return p.Statistics
+	return *p.Mmin
 }
 
-var ColumnMetaData_EncodingStats_DEFAULT []*PageEncodingStats
+var BoundingBox_Mmax_DEFAULT float64
 
-func (p *ColumnMetaData) GetEncodingStats() []*PageEncodingStats {
-	return p.EncodingStats
-}
-func (p *ColumnMetaData) IsSetKeyValueMetadata() bool {
-	return p.KeyValueMetadata != nil
+func (p *BoundingBox) GetMmax() float64 {
+	if !p.IsSetMmax() {
+		return BoundingBox_Mmax_DEFAULT
+	}
+	return *p.Mmax
 }
-
-func (p *ColumnMetaData) IsSetIndexPageOffset() bool {
-	return p.IndexPageOffset != nil
+func (p *BoundingBox) IsSetZmin() bool {
+	return p.Zmin != nil
 }
 
-func (p *ColumnMetaData) IsSetDictionaryPageOffset() bool {
-	return p.DictionaryPageOffset != nil
+func (p *BoundingBox) IsSetZmax() bool {
+	return p.Zmax != nil
 }
 
-func (p *ColumnMetaData) IsSetStatistics() bool {
-	return p.Statistics != nil
+func (p *BoundingBox) IsSetMmin() bool {
+	return p.Mmin != nil
 }
 
-func (p *ColumnMetaData) IsSetEncodingStats() bool {
-	return p.EncodingStats != nil
+func (p *BoundingBox) IsSetMmax() bool {
+	return p.Mmax != nil
 }
 
-func (p *ColumnMetaData) read(iprot thrift.TProtocol) error {
+func (p *BoundingBox) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetType bool = false
-	var issetEncodings bool = false
-	var issetPathInSchema bool = false
-	var issetCodec bool = false
-	var issetNumValues bool = false
-	var issetTotalUncompressedSize bool = false
-	var issetTotalCompressedSize bool = false
-	var issetDataPageOffset bool = false
+	var issetXmin bool = false
+	var issetXmax bool = false
+	var issetYmin bool = false
+	var issetYmax bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -3159,62 +10078,38 @@ func (p *ColumnMetaData) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetType = true
+			issetXmin = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetEncodings = true
+			issetXmax = true
 		case 3:
 			if err := p.readField3(iprot); err != nil {
 				return err
 			}
-			issetPathInSchema = true
+			issetYmin = true
 		case 4:
 			if err := p.readField4(iprot); err != nil {
 				return err
 			}
-			issetCodec = true
+			issetYmax = true
 		case 5:
 			if err := p.readField5(iprot); err != nil {
 				return err
 			}
-			issetNumValues = true
 		case 6:
 			if err := p.readField6(iprot); err != nil {
 				return err
 			}
-			issetTotalUncompressedSize = true
 		case 7:
 			if err := p.readField7(iprot); err != nil {
 				return err
 			}
-			issetTotalCompressedSize = true
 		case 8:
 			if err := p.readField8(iprot); err != nil {
 				return err
 			}
-		case 9:
-			if err := p.readField9(iprot); err != nil {
-				return err
-			}
-			issetDataPageOffset = true
-		case 10:
-			if err := p.readField10(iprot); err != nil {
-				return err
-			}
-		case 11:
-			if err := p.readField11(iprot); err != nil {
-				return err
-			}
-		case 12:
-			if err := p.readField12(iprot); err != nil {
-				return err
-			}
-		case 13:
-			if err := p.readField13(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -3227,193 +10122,345 @@ func (p *ColumnMetaData) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetType {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Type is not set"))
-	}
-	if !issetEncodings {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Encodings is not set"))
-	}
-	if !issetPathInSchema {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PathInSchema is not set"))
+	if !issetXmin {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Xmin is not set"))
 	}
-	if !issetCodec {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Codec is not set"))
-	}
-	if !issetNumValues {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumValues is not set"))
-	}
-	if !issetTotalUncompressedSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalUncompressedSize is not set"))
+	if !issetXmax {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Xmax is not set"))
 	}
-	if !issetTotalCompressedSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalCompressedSize is not set"))
+	if !issetYmin {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Ymin is not set"))
 	}
-	if !issetDataPageOffset {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field DataPageOffset is not set"))
+	if !issetYmax {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Ymax is not set"))
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *BoundingBox) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		temp := Type(v)
-		p.Type = temp
+		p.Xmin = v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]Encoding, 0, size)
-	p.Encodings = tSlice
-	for i := 0; i < size; i++ {
-		var _elem0 Encoding
-		if v, err := iprot.ReadI32(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			temp := Encoding(v)
-			_elem0 = temp
-		}
-		p.Encodings = append(p.Encodings, _elem0)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *BoundingBox) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.Xmax = v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField3(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]string, 0, size)
-	p.PathInSchema = tSlice
-	for i := 0; i < size; i++ {
-		var _elem1 string
-		if v, err := iprot.ReadString(); err != nil {
-			return thrift.PrependError("error reading field 0: ", err)
-		} else {
-			_elem1 = v
-		}
-		p.PathInSchema = append(p.PathInSchema, _elem1)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *BoundingBox) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.Ymin = v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField4(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
+func (p *BoundingBox) readField4(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return thrift.PrependError("error reading field 4: ", err)
 	} else {
-		temp := CompressionCodec(v)
-		p.Codec = temp
+		p.Ymax = v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField5(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *BoundingBox) readField5(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return thrift.PrependError("error reading field 5: ", err)
 	} else {
-		p.NumValues = v
+		p.Zmin = &v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *BoundingBox) readField6(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return thrift.PrependError("error reading field 6: ", err)
 	} else {
-		p.TotalUncompressedSize = v
+		p.Zmax = &v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField7(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *BoundingBox) readField7(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
 		return thrift.PrependError("error reading field 7: ", err)
 	} else {
-		p.TotalCompressedSize = v
+		p.Mmin = &v
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField8(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
+func (p *BoundingBox) readField8(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadDouble(); err != nil {
+		return thrift.PrependError("error reading field 8: ", err)
+	} else {
+		p.Mmax = &v
 	}
-	tSlice := make([]*KeyValue, 0, size)
-	p.KeyValueMetadata = tSlice
-	for i := 0; i < size; i++ {
-		_elem2 := &KeyValue{}
-		if err := _elem2.read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem2), err)
-		}
-		p.KeyValueMetadata = append(p.KeyValueMetadata, _elem2)
+	return nil
+}
+
+func (p *BoundingBox) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("BoundingBox"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField4(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField5(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField6(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField7(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField8(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField9(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 9: ", err)
-	} else {
-		p.DataPageOffset = v
+func (p *BoundingBox) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("xmin", thrift.DOUBLE, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:xmin: ", p), err)
 	}
-	return nil
+	if err := oprot.WriteDouble(float64(p.Xmin)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.xmin (1) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:xmin: ", p), err)
+	}
+	return err
 }
 
-func (p *ColumnMetaData) readField10(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 10: ", err)
-	} else {
-		p.IndexPageOffset = &v
+func (p *BoundingBox) writeField2(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("xmax", thrift.DOUBLE, 2); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:xmax: ", p), err)
 	}
-	return nil
+	if err := oprot.WriteDouble(float64(p.Xmax)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.xmax (2) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:xmax: ", p), err)
+	}
+	return err
 }
 
-func (p *ColumnMetaData) readField11(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 11: ", err)
-	} else {
-		p.DictionaryPageOffset = &v
+func (p *BoundingBox) writeField3(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("ymin", thrift.DOUBLE, 3); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ymin: ", p), err)
 	}
-	return nil
+	if err := oprot.WriteDouble(float64(p.Ymin)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ymin (3) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ymin: ", p), err)
+	}
+	return err
 }
 
-func (p *ColumnMetaData) readField12(iprot thrift.TProtocol) error {
-	p.Statistics = &Statistics{}
-	if err := p.Statistics.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Statistics), err)
+func (p *BoundingBox) writeField4(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("ymax", thrift.DOUBLE, 4); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ymax: ", p), err)
+	}
+	if err := oprot.WriteDouble(float64(p.Ymax)); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T.ymax (4) field write error: ", p), err)
+	}
+	if err := oprot.WriteFieldEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ymax: ", p), err)
+	}
+	return err
+}
+
+func (p *BoundingBox) writeField5(oprot thrift.TProtocol) (err error) {
+	if p.IsSetZmin() {
+		if err := oprot.WriteFieldBegin("zmin", thrift.DOUBLE, 5); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:zmin: ", p), err)
+		}
+		if err := oprot.WriteDouble(float64(*p.Zmin)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.zmin (5) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:zmin: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BoundingBox) writeField6(oprot thrift.TProtocol) (err error) {
+	if p.IsSetZmax() {
+		if err := oprot.WriteFieldBegin("zmax", thrift.DOUBLE, 6); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:zmax: ", p), err)
+		}
+		if err := oprot.WriteDouble(float64(*p.Zmax)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.zmax (6) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:zmax: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BoundingBox) writeField7(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMmin() {
+		if err := oprot.WriteFieldBegin("mmin", thrift.DOUBLE, 7); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:mmin: ", p), err)
+		}
+		if err := oprot.WriteDouble(float64(*p.Mmin)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.mmin (7) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 7:mmin: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BoundingBox) writeField8(oprot thrift.TProtocol) (err error) {
+	if p.IsSetMmax() {
+		if err := oprot.WriteFieldBegin("mmax", thrift.DOUBLE, 8); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:mmax: ", p), err)
+		}
+		if err := oprot.WriteDouble(float64(*p.Mmax)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.mmax (8) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:mmax: ", p), err)
+		}
+	}
+	return err
+}
+
+func (p *BoundingBox) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("BoundingBox(%+v)", *p)
+}
+
+// Statistics specific to Geometry and Geography logical types
+//
+// Attributes:
+//  - Bbox
+//  - GeospatialTypes
+type GeospatialStatistics struct {
+	Bbox            *BoundingBox `thrift:"bbox,1" json:"bbox,omitempty"`
+	GeospatialTypes []int32      `thrift:"geospatial_types,2" json:"geospatial_types,omitempty"`
+}
+
+func NewGeospatialStatistics() *GeospatialStatistics {
+	return &GeospatialStatistics{}
+}
+
+var GeospatialStatistics_Bbox_DEFAULT *BoundingBox
+
+func (p *GeospatialStatistics) GetBbox() *BoundingBox {
+	if !p.IsSetBbox() {
+		return GeospatialStatistics_Bbox_DEFAULT
+	}
+	return p.Bbox
+}
+
+var GeospatialStatistics_GeospatialTypes_DEFAULT []int32
+
+func (p *GeospatialStatistics) GetGeospatialTypes() []int32 {
+	return p.GeospatialTypes
+}
+func (p *GeospatialStatistics) IsSetBbox() bool {
+	return p.Bbox != nil
+}
+
+func (p *GeospatialStatistics) IsSetGeospatialTypes() bool {
+	return p.GeospatialTypes != nil
+}
+
+func (p *GeospatialStatistics) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *GeospatialStatistics) readField1(iprot thrift.TProtocol) error {
+	p.Bbox = &BoundingBox{}
+	if err := p.Bbox.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Bbox), err)
 	}
 	return nil
 }
 
-func (p *ColumnMetaData) readField13(iprot thrift.TProtocol) error {
+func (p *GeospatialStatistics) readField2(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*PageEncodingStats, 0, size)
-	p.EncodingStats = tSlice
+	tSlice := make([]int32, 0, size)
+	p.GeospatialTypes = tSlice
 	for i := 0; i < size; i++ {
-		_elem3 := &PageEncodingStats{}
-		if err := _elem3.read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem3), err)
+		var _elem18 int32
+		if v, err := iprot.ReadI32(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem18 = v
 		}
-		p.EncodingStats = append(p.EncodingStats, _elem3)
+		p.GeospatialTypes = append(p.GeospatialTypes, _elem18)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -3421,8 +10468,8 @@ func (p *ColumnMetaData) readField13(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnMetaData) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ColumnMetaData"); err != nil {
+func (p *GeospatialStatistics) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("GeospatialStatistics"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -3431,39 +10478,6 @@ func (p *ColumnMetaData) write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField5(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField6(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField7(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField8(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField9(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField10(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField11(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField12(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField13(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -3473,279 +10487,292 @@ func (p *ColumnMetaData) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnMetaData) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("type", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:type: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.Type)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.type (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:type: ", p), err)
+func (p *GeospatialStatistics) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetBbox() {
+		if err := oprot.WriteFieldBegin("bbox", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:bbox: ", p), err)
+		}
+		if err := p.Bbox.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Bbox), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:bbox: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("encodings", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:encodings: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.I32, len(p.Encodings)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Encodings {
-		if err := oprot.WriteI32(int32(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+func (p *GeospatialStatistics) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetGeospatialTypes() {
+		if err := oprot.WriteFieldBegin("geospatial_types", thrift.LIST, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:geospatial_types: ", p), err)
+		}
+		if err := oprot.WriteListBegin(thrift.I32, len(p.GeospatialTypes)); err != nil {
+			return thrift.PrependError("error writing list begin: ", err)
+		}
+		for _, v := range p.GeospatialTypes {
+			if err := oprot.WriteI32(int32(v)); err != nil {
+				return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
+			}
+		}
+		if err := oprot.WriteListEnd(); err != nil {
+			return thrift.PrependError("error writing list end: ", err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:geospatial_types: ", p), err)
 		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:encodings: ", p), err)
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("path_in_schema", thrift.LIST, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:path_in_schema: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRING, len(p.PathInSchema)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.PathInSchema {
-		if err := oprot.WriteString(string(v)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:path_in_schema: ", p), err)
+func (p *GeospatialStatistics) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return err
+	return fmt.Sprintf("GeospatialStatistics(%+v)", *p)
 }
 
-func (p *ColumnMetaData) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("codec", thrift.I32, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:codec: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.Codec)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.codec (4) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:codec: ", p), err)
-	}
-	return err
+// Attributes:
+//  - AadPrefix: AAD prefix *
+//  - AadFileUnique: Unique file identifier part of AAD suffix *
+//  - SupplyAadPrefix: In files encrypted with AAD prefix without storing it,
+// readers must supply the prefix *
+type AesGcmV1 struct {
+	AadPrefix       []byte `thrift:"aad_prefix,1" json:"aad_prefix,omitempty"`
+	AadFileUnique   []byte `thrift:"aad_file_unique,2" json:"aad_file_unique,omitempty"`
+	SupplyAadPrefix *bool  `thrift:"supply_aad_prefix,3" json:"supply_aad_prefix,omitempty"`
 }
 
-func (p *ColumnMetaData) writeField5(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_values", thrift.I64, 5); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:num_values: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.NumValues)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_values (5) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 5:num_values: ", p), err)
-	}
-	return err
+func NewAesGcmV1() *AesGcmV1 {
+	return &AesGcmV1{}
 }
 
-func (p *ColumnMetaData) writeField6(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("total_uncompressed_size", thrift.I64, 6); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:total_uncompressed_size: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.TotalUncompressedSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.total_uncompressed_size (6) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 6:total_uncompressed_size: ", p), err)
-	}
-	return err
+var AesGcmV1_AadPrefix_DEFAULT []byte
+
+func (p *AesGcmV1) GetAadPrefix() []byte {
+	return p.AadPrefix
 }
 
-func (p *ColumnMetaData) writeField7(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("total_compressed_size", thrift.I64, 7); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:total_compressed_size: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.TotalCompressedSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.total_compressed_size (7) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 7:total_compressed_size: ", p), err)
+var AesGcmV1_AadFileUnique_DEFAULT []byte
+
+func (p *AesGcmV1) GetAadFileUnique() []byte {
+	return p.AadFileUnique
+}
+
+var AesGcmV1_SupplyAadPrefix_DEFAULT bool
+
+func (p *AesGcmV1) GetSupplyAadPrefix() bool {
+	if !p.IsSetSupplyAadPrefix() {
+		return AesGcmV1_SupplyAadPrefix_DEFAULT
 	}
-	return err
+	return *p.SupplyAadPrefix
+}
+func (p *AesGcmV1) IsSetAadPrefix() bool {
+	return p.AadPrefix != nil
 }
 
-func (p *ColumnMetaData) writeField8(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyValueMetadata() {
-		if err := oprot.WriteFieldBegin("key_value_metadata", thrift.LIST, 8); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:key_value_metadata: ", p), err)
+func (p *AesGcmV1) IsSetAadFileUnique() bool {
+	return p.AadFileUnique != nil
+}
+
+func (p *AesGcmV1) IsSetSupplyAadPrefix() bool {
+	return p.SupplyAadPrefix != nil
+}
+
+func (p *AesGcmV1) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.KeyValueMetadata)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		for _, v := range p.KeyValueMetadata {
-			if err := v.write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		case 3:
+			if err := p.readField3(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
 			}
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 8:key_value_metadata: ", p), err)
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *ColumnMetaData) writeField9(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("data_page_offset", thrift.I64, 9); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:data_page_offset: ", p), err)
+func (p *AesGcmV1) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 1: ", err)
+	} else {
+		p.AadPrefix = v
 	}
-	if err := oprot.WriteI64(int64(p.DataPageOffset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.data_page_offset (9) field write error: ", p), err)
+	return nil
+}
+
+func (p *AesGcmV1) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
+	} else {
+		p.AadFileUnique = v
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 9:data_page_offset: ", p), err)
+	return nil
+}
+
+func (p *AesGcmV1) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.SupplyAadPrefix = &v
 	}
-	return err
+	return nil
 }
 
-func (p *ColumnMetaData) writeField10(oprot thrift.TProtocol) (err error) {
-	if p.IsSetIndexPageOffset() {
-		if err := oprot.WriteFieldBegin("index_page_offset", thrift.I64, 10); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:index_page_offset: ", p), err)
-		}
-		if err := oprot.WriteI64(int64(*p.IndexPageOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.index_page_offset (10) field write error: ", p), err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 10:index_page_offset: ", p), err)
-		}
+func (p *AesGcmV1) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AesGcmV1"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField3(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
 	}
-	return err
+	return nil
 }
 
-func (p *ColumnMetaData) writeField11(oprot thrift.TProtocol) (err error) {
-	if p.IsSetDictionaryPageOffset() {
-		if err := oprot.WriteFieldBegin("dictionary_page_offset", thrift.I64, 11); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:dictionary_page_offset: ", p), err)
+func (p *AesGcmV1) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadPrefix() {
+		if err := oprot.WriteFieldBegin("aad_prefix", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:aad_prefix: ", p), err)
 		}
-		if err := oprot.WriteI64(int64(*p.DictionaryPageOffset)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.dictionary_page_offset (11) field write error: ", p), err)
+		if err := oprot.WriteBinary(p.AadPrefix); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_prefix (1) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 11:dictionary_page_offset: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:aad_prefix: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField12(oprot thrift.TProtocol) (err error) {
-	if p.IsSetStatistics() {
-		if err := oprot.WriteFieldBegin("statistics", thrift.STRUCT, 12); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:statistics: ", p), err)
+func (p *AesGcmV1) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadFileUnique() {
+		if err := oprot.WriteFieldBegin("aad_file_unique", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:aad_file_unique: ", p), err)
 		}
-		if err := p.Statistics.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Statistics), err)
+		if err := oprot.WriteBinary(p.AadFileUnique); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_file_unique (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 12:statistics: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:aad_file_unique: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnMetaData) writeField13(oprot thrift.TProtocol) (err error) {
-	if p.IsSetEncodingStats() {
-		if err := oprot.WriteFieldBegin("encoding_stats", thrift.LIST, 13); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:encoding_stats: ", p), err)
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.EncodingStats)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.EncodingStats {
-			if err := v.write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
+func (p *AesGcmV1) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSupplyAadPrefix() {
+		if err := oprot.WriteFieldBegin("supply_aad_prefix", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:supply_aad_prefix: ", p), err)
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
+		if err := oprot.WriteBool(bool(*p.SupplyAadPrefix)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.supply_aad_prefix (3) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 13:encoding_stats: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:supply_aad_prefix: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnMetaData) String() string {
+func (p *AesGcmV1) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnMetaData(%+v)", *p)
+	return fmt.Sprintf("AesGcmV1(%+v)", *p)
 }
 
 // Attributes:
-//  - FilePath: File where column data is stored.  If not set, assumed to be same file as
-// metadata.  This path is relative to the current file.
-//
-//  - FileOffset: Byte offset in file_path to the ColumnMetaData *
-//  - MetaData: Column metadata for this chunk. This is the same content as what is at
-// file_path/file_offset.  Having it here has it replicated in the file
-// metadata.
-//
-type ColumnChunk struct {
-	FilePath   *string         `thrift:"file_path,1" json:"file_path,omitempty"`
-	FileOffset int64           `thrift:"file_offset,2,required" json:"file_offset"`
-	MetaData   *ColumnMetaData `thrift:"meta_data,3" json:"meta_data,omitempty"`
+//  - AadPrefix: AAD prefix *
+//  - AadFileUnique: Unique file identifier part of AAD suffix *
+//  - SupplyAadPrefix: In files encrypted with AAD prefix without storing it,
+// readers must supply the prefix *
+type AesGcmCtrV1 struct {
+	AadPrefix       []byte `thrift:"aad_prefix,1" json:"aad_prefix,omitempty"`
+	AadFileUnique   []byte `thrift:"aad_file_unique,2" json:"aad_file_unique,omitempty"`
+	SupplyAadPrefix *bool  `thrift:"supply_aad_prefix,3" json:"supply_aad_prefix,omitempty"`
 }
 
-func NewColumnChunk() *ColumnChunk {
-	return &ColumnChunk{}
+func NewAesGcmCtrV1() *AesGcmCtrV1 {
+	return &AesGcmCtrV1{}
 }
 
-var ColumnChunk_FilePath_DEFAULT string
+var AesGcmCtrV1_AadPrefix_DEFAULT []byte
 
-func (p *ColumnChunk) GetFilePath() string {
-	if !p.IsSetFilePath() {
-		return ColumnChunk_FilePath_DEFAULT
-	}
-	return *p.FilePath
+func (p *AesGcmCtrV1) GetAadPrefix() []byte {
+	return p.AadPrefix
 }
 
-func (p *ColumnChunk) GetFileOffset() int64 {
-	return p.FileOffset
+var AesGcmCtrV1_AadFileUnique_DEFAULT []byte
+
+func (p *AesGcmCtrV1) GetAadFileUnique() []byte {
+	return p.AadFileUnique
 }
 
-var ColumnChunk_MetaData_DEFAULT *ColumnMetaData
+var AesGcmCtrV1_SupplyAadPrefix_DEFAULT bool
 
-func (p *ColumnChunk) GetMetaData() *ColumnMetaData {
-	if !p.IsSetMetaData() {
-		return ColumnChunk_MetaData_DEFAULT
+func (p *AesGcmCtrV1) GetSupplyAadPrefix() bool {
+	if !p.IsSetSupplyAadPrefix() {
+		return AesGcmCtrV1_SupplyAadPrefix_DEFAULT
 	}
-	return p.MetaData
+	return *p.SupplyAadPrefix
 }
-func (p *ColumnChunk) IsSetFilePath() bool {
-	return p.FilePath != nil
+func (p *AesGcmCtrV1) IsSetAadPrefix() bool {
+	return p.AadPrefix != nil
 }
 
-func (p *ColumnChunk) IsSetMetaData() bool {
-	return p.MetaData != nil
+func (p *AesGcmCtrV1) IsSetAadFileUnique() bool {
+	return p.AadFileUnique != nil
 }
 
-func (p *ColumnChunk) read(iprot thrift.TProtocol) error {
+func (p *AesGcmCtrV1) IsSetSupplyAadPrefix() bool {
+	return p.SupplyAadPrefix != nil
+}
+
+func (p *AesGcmCtrV1) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetFileOffset bool = false
-
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
 		if err != nil {
@@ -3763,7 +10790,6 @@ func (p *ColumnChunk) read(iprot thrift.TProtocol) error {
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetFileOffset = true
 		case 3:
 			if err := p.readField3(iprot); err != nil {
 				return err
@@ -3780,40 +10806,38 @@ func (p *ColumnChunk) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetFileOffset {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field FileOffset is not set"))
-	}
 	return nil
 }
 
-func (p *ColumnChunk) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
+func (p *AesGcmCtrV1) readField1(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 1: ", err)
 	} else {
-		p.FilePath = &v
+		p.AadPrefix = v
 	}
 	return nil
 }
 
-func (p *ColumnChunk) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *AesGcmCtrV1) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.FileOffset = v
+		p.AadFileUnique = v
 	}
 	return nil
 }
 
-func (p *ColumnChunk) readField3(iprot thrift.TProtocol) error {
-	p.MetaData = &ColumnMetaData{}
-	if err := p.MetaData.read(iprot); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.MetaData), err)
+func (p *AesGcmCtrV1) readField3(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBool(); err != nil {
+		return thrift.PrependError("error reading field 3: ", err)
+	} else {
+		p.SupplyAadPrefix = &v
 	}
 	return nil
 }
 
-func (p *ColumnChunk) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("ColumnChunk"); err != nil {
+func (p *AesGcmCtrV1) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("AesGcmCtrV1"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -3834,104 +10858,303 @@ func (p *ColumnChunk) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *ColumnChunk) writeField1(oprot thrift.TProtocol) (err error) {
-	if p.IsSetFilePath() {
-		if err := oprot.WriteFieldBegin("file_path", thrift.STRING, 1); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:file_path: ", p), err)
+func (p *AesGcmCtrV1) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadPrefix() {
+		if err := oprot.WriteFieldBegin("aad_prefix", thrift.STRING, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:aad_prefix: ", p), err)
 		}
-		if err := oprot.WriteString(string(*p.FilePath)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.file_path (1) field write error: ", p), err)
+		if err := oprot.WriteBinary(p.AadPrefix); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_prefix (1) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:file_path: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:aad_prefix: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnChunk) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("file_offset", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:file_offset: ", p), err)
+func (p *AesGcmCtrV1) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAadFileUnique() {
+		if err := oprot.WriteFieldBegin("aad_file_unique", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:aad_file_unique: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.AadFileUnique); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.aad_file_unique (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:aad_file_unique: ", p), err)
+		}
 	}
-	if err := oprot.WriteI64(int64(p.FileOffset)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.file_offset (2) field write error: ", p), err)
+	return err
+}
+
+func (p *AesGcmCtrV1) writeField3(oprot thrift.TProtocol) (err error) {
+	if p.IsSetSupplyAadPrefix() {
+		if err := oprot.WriteFieldBegin("supply_aad_prefix", thrift.BOOL, 3); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:supply_aad_prefix: ", p), err)
+		}
+		if err := oprot.WriteBool(bool(*p.SupplyAadPrefix)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.supply_aad_prefix (3) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:supply_aad_prefix: ", p), err)
+		}
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:file_offset: ", p), err)
+	return err
+}
+
+func (p *AesGcmCtrV1) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AesGcmCtrV1(%+v)", *p)
+}
+
+// Attributes:
+//  - AES_GCM_V1
+//  - AES_GCM_CTR_V1
+type EncryptionAlgorithm struct {
+	AES_GCM_V1     *AesGcmV1    `thrift:"AES_GCM_V1,1" json:"AES_GCM_V1,omitempty"`
+	AES_GCM_CTR_V1 *AesGcmCtrV1 `thrift:"AES_GCM_CTR_V1,2" json:"AES_GCM_CTR_V1,omitempty"`
+}
+
+func NewEncryptionAlgorithm() *EncryptionAlgorithm {
+	return &EncryptionAlgorithm{}
+}
+
+var EncryptionAlgorithm_AES_GCM_V1_DEFAULT *AesGcmV1
+
+func (p *EncryptionAlgorithm) GetAES_GCM_V1() *AesGcmV1 {
+	if !p.IsSetAES_GCM_V1() {
+		return EncryptionAlgorithm_AES_GCM_V1_DEFAULT
+	}
+	return p.AES_GCM_V1
+}
+
+var EncryptionAlgorithm_AES_GCM_CTR_V1_DEFAULT *AesGcmCtrV1
+
+func (p *EncryptionAlgorithm) GetAES_GCM_CTR_V1() *AesGcmCtrV1 {
+	if !p.IsSetAES_GCM_CTR_V1() {
+		return EncryptionAlgorithm_AES_GCM_CTR_V1_DEFAULT
+	}
+	return p.AES_GCM_CTR_V1
+}
+func (p *EncryptionAlgorithm) CountSetFieldsEncryptionAlgorithm() int {
+	count := 0
+	if p.IsSetAES_GCM_V1() {
+		count++
+	}
+	if p.IsSetAES_GCM_CTR_V1() {
+		count++
+	}
+	return count
+
+}
+
+func (p *EncryptionAlgorithm) IsSetAES_GCM_V1() bool {
+	return p.AES_GCM_V1 != nil
+}
+
+func (p *EncryptionAlgorithm) IsSetAES_GCM_CTR_V1() bool {
+	return p.AES_GCM_CTR_V1 != nil
+}
+
+func (p *EncryptionAlgorithm) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
+			}
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
+}
+
+func (p *EncryptionAlgorithm) readField1(iprot thrift.TProtocol) error {
+	p.AES_GCM_V1 = &AesGcmV1{}
+	if err := p.AES_GCM_V1.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AES_GCM_V1), err)
+	}
+	return nil
+}
+
+func (p *EncryptionAlgorithm) readField2(iprot thrift.TProtocol) error {
+	p.AES_GCM_CTR_V1 = &AesGcmCtrV1{}
+	if err := p.AES_GCM_CTR_V1.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AES_GCM_CTR_V1), err)
+	}
+	return nil
+}
+
+func (p *EncryptionAlgorithm) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsEncryptionAlgorithm(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("EncryptionAlgorithm"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
+}
+
+func (p *EncryptionAlgorithm) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAES_GCM_V1() {
+		if err := oprot.WriteFieldBegin("AES_GCM_V1", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:AES_GCM_V1: ", p), err)
+		}
+		if err := p.AES_GCM_V1.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AES_GCM_V1), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:AES_GCM_V1: ", p), err)
+		}
 	}
 	return err
 }
 
-func (p *ColumnChunk) writeField3(oprot thrift.TProtocol) (err error) {
-	if p.IsSetMetaData() {
-		if err := oprot.WriteFieldBegin("meta_data", thrift.STRUCT, 3); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:meta_data: ", p), err)
+func (p *EncryptionAlgorithm) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetAES_GCM_CTR_V1() {
+		if err := oprot.WriteFieldBegin("AES_GCM_CTR_V1", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:AES_GCM_CTR_V1: ", p), err)
 		}
-		if err := p.MetaData.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.MetaData), err)
+		if err := p.AES_GCM_CTR_V1.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AES_GCM_CTR_V1), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 3:meta_data: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:AES_GCM_CTR_V1: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *ColumnChunk) String() string {
+func (p *EncryptionAlgorithm) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("ColumnChunk(%+v)", *p)
+	return fmt.Sprintf("EncryptionAlgorithm(%+v)", *p)
 }
 
-// Attributes:
-//  - Columns: Metadata for each column chunk in this row group.
-// This list must have the same order as the SchemaElement list in FileMetaData.
-//
-//  - TotalByteSize: Total byte size of all the uncompressed column data in this row group *
-//  - NumRows: Number of rows in this row group *
-//  - SortingColumns: If set, specifies a sort ordering of the rows in this RowGroup.
-// The sorting columns can be a subset of all the columns.
-type RowGroup struct {
-	Columns        []*ColumnChunk   `thrift:"columns,1,required" json:"columns"`
-	TotalByteSize  int64            `thrift:"total_byte_size,2,required" json:"total_byte_size"`
-	NumRows        int64            `thrift:"num_rows,3,required" json:"num_rows"`
-	SortingColumns []*SortingColumn `thrift:"sorting_columns,4" json:"sorting_columns,omitempty"`
+type EncryptionWithFooterKey struct {
+}
+
+func NewEncryptionWithFooterKey() *EncryptionWithFooterKey {
+	return &EncryptionWithFooterKey{}
+}
+
+func (p *EncryptionWithFooterKey) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+		}
+		if fieldTypeId == thrift.STOP {
+			break
+		}
+		if err := iprot.Skip(fieldTypeId); err != nil {
+			return err
+		}
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func NewRowGroup() *RowGroup {
-	return &RowGroup{}
+func (p *EncryptionWithFooterKey) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("EncryptionWithFooterKey"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *RowGroup) GetColumns() []*ColumnChunk {
-	return p.Columns
+func (p *EncryptionWithFooterKey) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("EncryptionWithFooterKey(%+v)", *p)
 }
 
-func (p *RowGroup) GetTotalByteSize() int64 {
-	return p.TotalByteSize
+// Attributes:
+//  - PathInSchema: Column path in schema *
+//  - KeyMetadata: Retrieval metadata of column encryption key *
+type EncryptionWithColumnKey struct {
+	PathInSchema []string `thrift:"path_in_schema,1,required" json:"path_in_schema"`
+	KeyMetadata  []byte   `thrift:"key_metadata,2" json:"key_metadata,omitempty"`
 }
 
-func (p *RowGroup) GetNumRows() int64 {
-	return p.NumRows
+func NewEncryptionWithColumnKey() *EncryptionWithColumnKey {
+	return &EncryptionWithColumnKey{}
 }
 
-var RowGroup_SortingColumns_DEFAULT []*SortingColumn
+func (p *EncryptionWithColumnKey) GetPathInSchema() []string {
+	return p.PathInSchema
+}
 
-func (p *RowGroup) GetSortingColumns() []*SortingColumn {
-	return p.SortingColumns
+var EncryptionWithColumnKey_KeyMetadata_DEFAULT []byte
+
+func (p *EncryptionWithColumnKey) GetKeyMetadata() []byte {
+	return p.KeyMetadata
 }
-func (p *RowGroup) IsSetSortingColumns() bool {
-	return p.SortingColumns != nil
+func (p *EncryptionWithColumnKey) IsSetKeyMetadata() bool {
+	return p.KeyMetadata != nil
 }
 
-func (p *RowGroup) read(iprot thrift.TProtocol) error {
+func (p *EncryptionWithColumnKey) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetColumns bool = false
-	var issetTotalByteSize bool = false
-	var issetNumRows bool = false
+	var issetPathInSchema bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -3946,21 +11169,11 @@ func (p *RowGroup) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetColumns = true
+			issetPathInSchema = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetTotalByteSize = true
-		case 3:
-			if err := p.readField3(iprot); err != nil {
-				return err
-			}
-			issetNumRows = true
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -3973,31 +11186,27 @@ func (p *RowGroup) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetColumns {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Columns is not set"))
-	}
-	if !issetTotalByteSize {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field TotalByteSize is not set"))
-	}
-	if !issetNumRows {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
+	if !issetPathInSchema {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field PathInSchema is not set"))
 	}
 	return nil
 }
 
-func (p *RowGroup) readField1(iprot thrift.TProtocol) error {
+func (p *EncryptionWithColumnKey) readField1(iprot thrift.TProtocol) error {
 	_, size, err := iprot.ReadListBegin()
 	if err != nil {
 		return thrift.PrependError("error reading list begin: ", err)
 	}
-	tSlice := make([]*ColumnChunk, 0, size)
-	p.Columns = tSlice
+	tSlice := make([]string, 0, size)
+	p.PathInSchema = tSlice
 	for i := 0; i < size; i++ {
-		_elem4 := &ColumnChunk{}
-		if err := _elem4.read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+		var _elem17 string
+		if v, err := iprot.ReadString(); err != nil {
+			return thrift.PrependError("error reading field 0: ", err)
+		} else {
+			_elem17 = v
 		}
-		p.Columns = append(p.Columns, _elem4)
+		p.PathInSchema = append(p.PathInSchema, _elem17)
 	}
 	if err := iprot.ReadListEnd(); err != nil {
 		return thrift.PrependError("error reading list end: ", err)
@@ -4005,46 +11214,17 @@ func (p *RowGroup) readField1(iprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *RowGroup) readField2(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
+func (p *EncryptionWithColumnKey) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
 		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.TotalByteSize = v
-	}
-	return nil
-}
-
-func (p *RowGroup) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.NumRows = v
-	}
-	return nil
-}
-
-func (p *RowGroup) readField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*SortingColumn, 0, size)
-	p.SortingColumns = tSlice
-	for i := 0; i < size; i++ {
-		_elem5 := &SortingColumn{}
-		if err := _elem5.read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem5), err)
-		}
-		p.SortingColumns = append(p.SortingColumns, _elem5)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+		p.KeyMetadata = v
 	}
 	return nil
 }
 
-func (p *RowGroup) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("RowGroup"); err != nil {
+func (p *EncryptionWithColumnKey) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("EncryptionWithColumnKey"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -4053,12 +11233,6 @@ func (p *RowGroup) write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -4068,160 +11242,246 @@ func (p *RowGroup) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *RowGroup) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("columns", thrift.LIST, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:columns: ", p), err)
+func (p *EncryptionWithColumnKey) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("path_in_schema", thrift.LIST, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path_in_schema: ", p), err)
 	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Columns)); err != nil {
+	if err := oprot.WriteListBegin(thrift.STRING, len(p.PathInSchema)); err != nil {
 		return thrift.PrependError("error writing list begin: ", err)
 	}
-	for _, v := range p.Columns {
-		if err := v.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+	for _, v := range p.PathInSchema {
+		if err := oprot.WriteString(string(v)); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err)
 		}
 	}
 	if err := oprot.WriteListEnd(); err != nil {
 		return thrift.PrependError("error writing list end: ", err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:columns: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path_in_schema: ", p), err)
 	}
 	return err
 }
 
-func (p *RowGroup) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("total_byte_size", thrift.I64, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:total_byte_size: ", p), err)
+func (p *EncryptionWithColumnKey) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyMetadata() {
+		if err := oprot.WriteFieldBegin("key_metadata", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:key_metadata: ", p), err)
+		}
+		if err := oprot.WriteBinary(p.KeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.key_metadata (2) field write error: ", p), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:key_metadata: ", p), err)
+		}
 	}
-	if err := oprot.WriteI64(int64(p.TotalByteSize)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.total_byte_size (2) field write error: ", p), err)
+	return err
+}
+
+func (p *EncryptionWithColumnKey) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:total_byte_size: ", p), err)
+	return fmt.Sprintf("EncryptionWithColumnKey(%+v)", *p)
+}
+
+// Attributes:
+//  - ENCRYPTION_WITH_FOOTER_KEY
+//  - ENCRYPTION_WITH_COLUMN_KEY
+type ColumnCryptoMetaData struct {
+	ENCRYPTION_WITH_FOOTER_KEY *EncryptionWithFooterKey `thrift:"ENCRYPTION_WITH_FOOTER_KEY,1" json:"ENCRYPTION_WITH_FOOTER_KEY,omitempty"`
+	ENCRYPTION_WITH_COLUMN_KEY *EncryptionWithColumnKey `thrift:"ENCRYPTION_WITH_COLUMN_KEY,2" json:"ENCRYPTION_WITH_COLUMN_KEY,omitempty"`
+}
+
+func NewColumnCryptoMetaData() *ColumnCryptoMetaData {
+	return &ColumnCryptoMetaData{}
+}
+
+var ColumnCryptoMetaData_ENCRYPTION_WITH_FOOTER_KEY_DEFAULT *EncryptionWithFooterKey
+
+func (p *ColumnCryptoMetaData) GetENCRYPTION_WITH_FOOTER_KEY() *EncryptionWithFooterKey {
+	if !p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		return ColumnCryptoMetaData_ENCRYPTION_WITH_FOOTER_KEY_DEFAULT
 	}
-	return err
+	return p.ENCRYPTION_WITH_FOOTER_KEY
 }
 
-func (p *RowGroup) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_rows", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
+var ColumnCryptoMetaData_ENCRYPTION_WITH_COLUMN_KEY_DEFAULT *EncryptionWithColumnKey
+
+func (p *ColumnCryptoMetaData) GetENCRYPTION_WITH_COLUMN_KEY() *EncryptionWithColumnKey {
+	if !p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		return ColumnCryptoMetaData_ENCRYPTION_WITH_COLUMN_KEY_DEFAULT
 	}
-	if err := oprot.WriteI64(int64(p.NumRows)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
+	return p.ENCRYPTION_WITH_COLUMN_KEY
+}
+func (p *ColumnCryptoMetaData) CountSetFieldsColumnCryptoMetaData() int {
+	count := 0
+	if p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		count++
 	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
+	if p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		count++
 	}
-	return err
+	return count
+
 }
 
-func (p *RowGroup) writeField4(oprot thrift.TProtocol) (err error) {
-	if p.IsSetSortingColumns() {
-		if err := oprot.WriteFieldBegin("sorting_columns", thrift.LIST, 4); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:sorting_columns: ", p), err)
+func (p *ColumnCryptoMetaData) IsSetENCRYPTION_WITH_FOOTER_KEY() bool {
+	return p.ENCRYPTION_WITH_FOOTER_KEY != nil
+}
+
+func (p *ColumnCryptoMetaData) IsSetENCRYPTION_WITH_COLUMN_KEY() bool {
+	return p.ENCRYPTION_WITH_COLUMN_KEY != nil
+}
+
+func (p *ColumnCryptoMetaData) read(iprot thrift.TProtocol) error {
+	if _, err := iprot.ReadStructBegin(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+	}
+
+	for {
+		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+		if err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
 		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.SortingColumns)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
+		if fieldTypeId == thrift.STOP {
+			break
 		}
-		for _, v := range p.SortingColumns {
-			if err := v.write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+		switch fieldId {
+		case 1:
+			if err := p.readField1(iprot); err != nil {
+				return err
+			}
+		case 2:
+			if err := p.readField2(iprot); err != nil {
+				return err
+			}
+		default:
+			if err := iprot.Skip(fieldTypeId); err != nil {
+				return err
 			}
 		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 4:sorting_columns: ", p), err)
+		if err := iprot.ReadFieldEnd(); err != nil {
+			return err
 		}
 	}
-	return err
+	if err := iprot.ReadStructEnd(); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+	}
+	return nil
 }
 
-func (p *RowGroup) String() string {
-	if p == nil {
-		return "<nil>"
+func (p *ColumnCryptoMetaData) readField1(iprot thrift.TProtocol) error {
+	p.ENCRYPTION_WITH_FOOTER_KEY = &EncryptionWithFooterKey{}
+	if err := p.ENCRYPTION_WITH_FOOTER_KEY.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ENCRYPTION_WITH_FOOTER_KEY), err)
 	}
-	return fmt.Sprintf("RowGroup(%+v)", *p)
+	return nil
 }
 
-// Description for file metadata
-//
-// Attributes:
-//  - Version: Version of this file *
-//  - Schema: Parquet schema for this file.  This schema contains metadata for all the columns.
-// The schema is represented as a tree with a single root.  The nodes of the tree
-// are flattened to a list by doing a depth-first traversal.
-// The column metadata contains the path in the schema for that column which can be
-// used to map columns to nodes in the schema.
-// The first element is the root *
-//  - NumRows: Number of rows in this file *
-//  - RowGroups: Row groups in this file *
-//  - KeyValueMetadata: Optional key/value metadata *
-//  - CreatedBy: String for application that wrote this file.  This should be in the format
-// <Application> version <App Version> (build <App Build Hash>).
-// e.g. impala version 1.0 (build 6cf94d29b2b7115df4de2c06e2ab4326d721eb55)
-//
-type FileMetaData struct {
-	Version          int32            `thrift:"version,1,required" json:"version"`
-	Schema           []*SchemaElement `thrift:"schema,2,required" json:"schema"`
-	NumRows          int64            `thrift:"num_rows,3,required" json:"num_rows"`
-	RowGroups        []*RowGroup      `thrift:"row_groups,4,required" json:"row_groups"`
-	KeyValueMetadata []*KeyValue      `thrift:"key_value_metadata,5" json:"key_value_metadata,omitempty"`
-	CreatedBy        *string          `thrift:"created_by,6" json:"created_by,omitempty"`
+func (p *ColumnCryptoMetaData) readField2(iprot thrift.TProtocol) error {
+	p.ENCRYPTION_WITH_COLUMN_KEY = &EncryptionWithColumnKey{}
+	if err := p.ENCRYPTION_WITH_COLUMN_KEY.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ENCRYPTION_WITH_COLUMN_KEY), err)
+	}
+	return nil
 }
 
-func NewFileMetaData() *FileMetaData {
-	return &FileMetaData{}
+func (p *ColumnCryptoMetaData) write(oprot thrift.TProtocol) error {
+	if c := p.CountSetFieldsColumnCryptoMetaData(); c != 1 {
+		return fmt.Errorf("%T write union: exactly one field must be set (%d set).", p, c)
+	}
+	if err := oprot.WriteStructBegin("ColumnCryptoMetaData"); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
+	}
+	if err := p.writeField1(oprot); err != nil {
+		return err
+	}
+	if err := p.writeField2(oprot); err != nil {
+		return err
+	}
+	if err := oprot.WriteFieldStop(); err != nil {
+		return thrift.PrependError("write field stop error: ", err)
+	}
+	if err := oprot.WriteStructEnd(); err != nil {
+		return thrift.PrependError("write struct stop error: ", err)
+	}
+	return nil
 }
 
-func (p *FileMetaData) GetVersion() int32 {
-	return p.Version
+func (p *ColumnCryptoMetaData) writeField1(oprot thrift.TProtocol) (err error) {
+	if p.IsSetENCRYPTION_WITH_FOOTER_KEY() {
+		if err := oprot.WriteFieldBegin("ENCRYPTION_WITH_FOOTER_KEY", thrift.STRUCT, 1); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ENCRYPTION_WITH_FOOTER_KEY: ", p), err)
+		}
+		if err := p.ENCRYPTION_WITH_FOOTER_KEY.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ENCRYPTION_WITH_FOOTER_KEY), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ENCRYPTION_WITH_FOOTER_KEY: ", p), err)
+		}
+	}
+	return err
 }
 
-func (p *FileMetaData) GetSchema() []*SchemaElement {
-	return p.Schema
+func (p *ColumnCryptoMetaData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetENCRYPTION_WITH_COLUMN_KEY() {
+		if err := oprot.WriteFieldBegin("ENCRYPTION_WITH_COLUMN_KEY", thrift.STRUCT, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ENCRYPTION_WITH_COLUMN_KEY: ", p), err)
+		}
+		if err := p.ENCRYPTION_WITH_COLUMN_KEY.write(oprot); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ENCRYPTION_WITH_COLUMN_KEY), err)
+		}
+		if err := oprot.WriteFieldEnd(); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ENCRYPTION_WITH_COLUMN_KEY: ", p), err)
+		}
+	}
+	return err
 }
 
-func (p *FileMetaData) GetNumRows() int64 {
-	return p.NumRows
+func (p *ColumnCryptoMetaData) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ColumnCryptoMetaData(%+v)", *p)
 }
 
-func (p *FileMetaData) GetRowGroups() []*RowGroup {
-	return p.RowGroups
+// Crypto metadata for files with encrypted footer *
+//
+// Attributes:
+//  - EncryptionAlgorithm: Encryption algorithm. This field is only used for files
+// with encrypted footer. Files with plaintext footer store algorithm id
+// inside footer (FileMetaData structure).
+//  - KeyMetadata: Retrieval metadata of key used for encryption of footer,
+// and (possibly) columns *
+type FileCryptoMetaData struct {
+	EncryptionAlgorithm *EncryptionAlgorithm `thrift:"encryption_algorithm,1,required" json:"encryption_algorithm"`
+	KeyMetadata         []byte               `thrift:"key_metadata,2" json:"key_metadata,omitempty"`
 }
 
-var FileMetaData_KeyValueMetadata_DEFAULT []*KeyValue
+func NewFileCryptoMetaData() *FileCryptoMetaData {
+	return &FileCryptoMetaData{}
+}
 
-func (p *FileMetaData) GetKeyValueMetadata() []*KeyValue {
-	return p.KeyValueMetadata
+func (p *FileCryptoMetaData) GetEncryptionAlgorithm() *EncryptionAlgorithm {
+	return p.EncryptionAlgorithm
 }
 
-var FileMetaData_CreatedBy_DEFAULT string
+var FileCryptoMetaData_KeyMetadata_DEFAULT []byte
 
-func (p *FileMetaData) GetCreatedBy() string {
-	if !p.IsSetCreatedBy() {
-		return FileMetaData_CreatedBy_DEFAULT
-	}
-	return *p.CreatedBy
-}
-func (p *FileMetaData) IsSetKeyValueMetadata() bool {
-	return p.KeyValueMetadata != nil
+func (p *FileCryptoMetaData) GetKeyMetadata() []byte {
+	return p.KeyMetadata
 }
-
-func (p *FileMetaData) IsSetCreatedBy() bool {
-	return p.CreatedBy != nil
+func (p *FileCryptoMetaData) IsSetKeyMetadata() bool {
+	return p.KeyMetadata != nil
 }
 
-func (p *FileMetaData) read(iprot thrift.TProtocol) error {
+func (p *FileCryptoMetaData) read(iprot thrift.TProtocol) error {
 	if _, err := iprot.ReadStructBegin(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
 	}
 
-	var issetVersion bool = false
-	var issetSchema bool = false
-	var issetNumRows bool = false
-	var issetRowGroups bool = false
+	var issetEncryptionAlgorithm bool = false
 
 	for {
 		_, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
@@ -4236,30 +11496,11 @@ func (p *FileMetaData) read(iprot thrift.TProtocol) error {
 			if err := p.readField1(iprot); err != nil {
 				return err
 			}
-			issetVersion = true
+			issetEncryptionAlgorithm = true
 		case 2:
 			if err := p.readField2(iprot); err != nil {
 				return err
 			}
-			issetSchema = true
-		case 3:
-			if err := p.readField3(iprot); err != nil {
-				return err
-			}
-			issetNumRows = true
-		case 4:
-			if err := p.readField4(iprot); err != nil {
-				return err
-			}
-			issetRowGroups = true
-		case 5:
-			if err := p.readField5(iprot); err != nil {
-				return err
-			}
-		case 6:
-			if err := p.readField6(iprot); err != nil {
-				return err
-			}
 		default:
 			if err := iprot.Skip(fieldTypeId); err != nil {
 				return err
@@ -4272,110 +11513,31 @@ func (p *FileMetaData) read(iprot thrift.TProtocol) error {
 	if err := iprot.ReadStructEnd(); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
 	}
-	if !issetVersion {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Version is not set"))
-	}
-	if !issetSchema {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field Schema is not set"))
-	}
-	if !issetNumRows {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field NumRows is not set"))
-	}
-	if !issetRowGroups {
-		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field RowGroups is not set"))
-	}
-	return nil
-}
-
-func (p *FileMetaData) readField1(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI32(); err != nil {
-		return thrift.PrependError("error reading field 1: ", err)
-	} else {
-		p.Version = v
-	}
-	return nil
-}
-
-func (p *FileMetaData) readField2(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*SchemaElement, 0, size)
-	p.Schema = tSlice
-	for i := 0; i < size; i++ {
-		_elem6 := &SchemaElement{}
-		if err := _elem6.read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
-		}
-		p.Schema = append(p.Schema, _elem6)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
-	}
-	return nil
-}
-
-func (p *FileMetaData) readField3(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadI64(); err != nil {
-		return thrift.PrependError("error reading field 3: ", err)
-	} else {
-		p.NumRows = v
-	}
-	return nil
-}
-
-func (p *FileMetaData) readField4(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*RowGroup, 0, size)
-	p.RowGroups = tSlice
-	for i := 0; i < size; i++ {
-		_elem7 := &RowGroup{}
-		if err := _elem7.read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem7), err)
-		}
-		p.RowGroups = append(p.RowGroups, _elem7)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+	if !issetEncryptionAlgorithm {
+		return thrift.NewTProtocolExceptionWithType(thrift.INVALID_DATA, fmt.Errorf("Required field EncryptionAlgorithm is not set"))
 	}
 	return nil
 }
 
-func (p *FileMetaData) readField5(iprot thrift.TProtocol) error {
-	_, size, err := iprot.ReadListBegin()
-	if err != nil {
-		return thrift.PrependError("error reading list begin: ", err)
-	}
-	tSlice := make([]*KeyValue, 0, size)
-	p.KeyValueMetadata = tSlice
-	for i := 0; i < size; i++ {
-		_elem8 := &KeyValue{}
-		if err := _elem8.read(iprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem8), err)
-		}
-		p.KeyValueMetadata = append(p.KeyValueMetadata, _elem8)
-	}
-	if err := iprot.ReadListEnd(); err != nil {
-		return thrift.PrependError("error reading list end: ", err)
+func (p *FileCryptoMetaData) readField1(iprot thrift.TProtocol) error {
+	p.EncryptionAlgorithm = &EncryptionAlgorithm{}
+	if err := p.EncryptionAlgorithm.read(iprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EncryptionAlgorithm), err)
 	}
 	return nil
 }
 
-func (p *FileMetaData) readField6(iprot thrift.TProtocol) error {
-	if v, err := iprot.ReadString(); err != nil {
-		return thrift.PrependError("error reading field 6: ", err)
+func (p *FileCryptoMetaData) readField2(iprot thrift.TProtocol) error {
+	if v, err := iprot.ReadBinary(); err != nil {
+		return thrift.PrependError("error reading field 2: ", err)
 	} else {
-		p.CreatedBy = &v
+		p.KeyMetadata = v
 	}
 	return nil
 }
 
-func (p *FileMetaData) write(oprot thrift.TProtocol) error {
-	if err := oprot.WriteStructBegin("FileMetaData"); err != nil {
+func (p *FileCryptoMetaData) write(oprot thrift.TProtocol) error {
+	if err := oprot.WriteStructBegin("FileCryptoMetaData"); err != nil {
 		return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err)
 	}
 	if err := p.writeField1(oprot); err != nil {
@@ -4384,18 +11546,6 @@ func (p *FileMetaData) write(oprot thrift.TProtocol) error {
 	if err := p.writeField2(oprot); err != nil {
 		return err
 	}
-	if err := p.writeField3(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField4(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField5(oprot); err != nil {
-		return err
-	}
-	if err := p.writeField6(oprot); err != nil {
-		return err
-	}
 	if err := oprot.WriteFieldStop(); err != nil {
 		return thrift.PrependError("write field stop error: ", err)
 	}
@@ -4405,115 +11555,37 @@ func (p *FileMetaData) write(oprot thrift.TProtocol) error {
 	return nil
 }
 
-func (p *FileMetaData) writeField1(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("version", thrift.I32, 1); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:version: ", p), err)
-	}
-	if err := oprot.WriteI32(int32(p.Version)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.version (1) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:version: ", p), err)
-	}
-	return err
-}
-
-func (p *FileMetaData) writeField2(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("schema", thrift.LIST, 2); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:schema: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Schema)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
-	}
-	for _, v := range p.Schema {
-		if err := v.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 2:schema: ", p), err)
-	}
-	return err
-}
-
-func (p *FileMetaData) writeField3(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("num_rows", thrift.I64, 3); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:num_rows: ", p), err)
-	}
-	if err := oprot.WriteI64(int64(p.NumRows)); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T.num_rows (3) field write error: ", p), err)
-	}
-	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 3:num_rows: ", p), err)
-	}
-	return err
-}
-
-func (p *FileMetaData) writeField4(oprot thrift.TProtocol) (err error) {
-	if err := oprot.WriteFieldBegin("row_groups", thrift.LIST, 4); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:row_groups: ", p), err)
-	}
-	if err := oprot.WriteListBegin(thrift.STRUCT, len(p.RowGroups)); err != nil {
-		return thrift.PrependError("error writing list begin: ", err)
+func (p *FileCryptoMetaData) writeField1(oprot thrift.TProtocol) (err error) {
+	if err := oprot.WriteFieldBegin("encryption_algorithm", thrift.STRUCT, 1); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:encryption_algorithm: ", p), err)
 	}
-	for _, v := range p.RowGroups {
-		if err := v.write(oprot); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-		}
-	}
-	if err := oprot.WriteListEnd(); err != nil {
-		return thrift.PrependError("error writing list end: ", err)
+	if err := p.EncryptionAlgorithm.write(oprot); err != nil {
+		return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EncryptionAlgorithm), err)
 	}
 	if err := oprot.WriteFieldEnd(); err != nil {
-		return thrift.PrependError(fmt.Sprintf("%T write field end error 4:row_groups: ", p), err)
+		return thrift.PrependError(fmt.Sprintf("%T write field end error 1:encryption_algorithm: ", p), err)
 	}
 	return err
 }
 
-func (p *FileMetaData) writeField5(oprot thrift.TProtocol) (err error) {
-	if p.IsSetKeyValueMetadata() {
-		if err := oprot.WriteFieldBegin("key_value_metadata", thrift.LIST, 5); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:key_value_metadata: ", p), err)
-		}
-		if err := oprot.WriteListBegin(thrift.STRUCT, len(p.KeyValueMetadata)); err != nil {
-			return thrift.PrependError("error writing list begin: ", err)
-		}
-		for _, v := range p.KeyValueMetadata {
-			if err := v.write(oprot); err != nil {
-				return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-			}
-		}
-		if err := oprot.WriteListEnd(); err != nil {
-			return thrift.PrependError("error writing list end: ", err)
-		}
-		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 5:key_value_metadata: ", p), err)
-		}
-	}
-	return err
-}
-
-func (p *FileMetaData) writeField6(oprot thrift.TProtocol) (err error) {
-	if p.IsSetCreatedBy() {
-		if err := oprot.WriteFieldBegin("created_by", thrift.STRING, 6); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:created_by: ", p), err)
+func (p *FileCryptoMetaData) writeField2(oprot thrift.TProtocol) (err error) {
+	if p.IsSetKeyMetadata() {
+		if err := oprot.WriteFieldBegin("key_metadata", thrift.STRING, 2); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:key_metadata: ", p), err)
 		}
-		if err := oprot.WriteString(string(*p.CreatedBy)); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T.created_by (6) field write error: ", p), err)
+		if err := oprot.WriteBinary(p.KeyMetadata); err != nil {
+			return thrift.PrependError(fmt.Sprintf("%T.key_metadata (2) field write error: ", p), err)
 		}
 		if err := oprot.WriteFieldEnd(); err != nil {
-			return thrift.PrependError(fmt.Sprintf("%T write field end error 6:created_by: ", p), err)
+			return thrift.PrependError(fmt.Sprintf("%T write field end error 2:key_metadata: ", p), err)
 		}
 	}
 	return err
 }
 
-func (p *FileMetaData) String() string {
+func (p *FileCryptoMetaData) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("FileMetaData(%+v)", *p)
+	return fmt.Sprintf("FileCryptoMetaData(%+v)", *p)
 }
@@ -0,0 +1,210 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The values of the TIMESTAMP columns are read as time.Time, the values of
+// the TIME columns as time.Duration, the time since midnight, and the values
+// of the DATE columns as time.Time, the midnight UTC of the date.
+
+// timestampOf returns the unit of the values of the TIMESTAMP column element
+// and whether they are adjusted to UTC, ok false if element is not an INT64
+// TIMESTAMP column. The TIMESTAMP_MILLIS and TIMESTAMP_MICROS converted types
+// are adjusted to UTC.
+func timestampOf(element *thrift.SchemaElement) (unit time.Duration, utc bool, ok bool) {
+	if element.GetType() != thrift.Type_INT64 {
+		return 0, false, false
+	}
+	if lt := element.GetLogicalType(); lt != nil && lt.IsSetTIMESTAMP() {
+		unit, ok := timeUnitOf(lt.TIMESTAMP.GetUnit())
+		return unit, lt.TIMESTAMP.IsAdjustedToUTC, ok
+	}
+	switch element.GetConvertedType() {
+	case thrift.ConvertedType_TIMESTAMP_MILLIS:
+		return time.Millisecond, true, true
+	case thrift.ConvertedType_TIMESTAMP_MICROS:
+		return time.Microsecond, true, true
+	}
+	return 0, false, false
+}
+
+// timeUnitOf returns the duration of the unit u, ok false if it is not set.
+func timeUnitOf(u *thrift.TimeUnit) (unit time.Duration, ok bool) {
+	switch {
+	case u == nil:
+		return 0, false
+	case u.IsSetMILLIS():
+		return time.Millisecond, true
+	case u.IsSetMICROS():
+		return time.Microsecond, true
+	case u.IsSetNANOS():
+		return time.Nanosecond, true
+	}
+	return 0, false
+}
+
+// timestampValue returns the time of the value v of a TIMESTAMP column, a
+// number of units since the epoch. A value adjusted to UTC is an instant,
+// returned in UTC. A value not adjusted to UTC is a wall clock time,
+// returned in loc, UTC if loc is nil.
+func timestampValue(v int64, unit time.Duration, utc bool, loc *time.Location) time.Time {
+	perSecond := int64(time.Second / unit)
+	sec, n := v/perSecond, v%perSecond
+	if n < 0 {
+		sec, n = sec-1, n+perSecond
+	}
+	t := time.Unix(sec, n*int64(unit)).UTC()
+	if utc {
+		return t
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// timestampTicks returns the value of t in a TIMESTAMP column, truncated to
+// unit: the instant t for a column adjusted to UTC, its wall clock in loc
+// otherwise, in its own location if loc is nil.
+func timestampTicks(t time.Time, unit time.Duration, utc bool, loc *time.Location) (int64, error) {
+	if !utc {
+		if loc != nil {
+			t = t.In(loc)
+		}
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	}
+	perSecond := int64(time.Second / unit)
+	sec, n := t.Unix(), int64(t.Nanosecond())/int64(unit)
+	if sec > math.MaxInt64/perSecond-1 || sec < math.MinInt64/perSecond+1 {
+		return 0, fmt.Errorf("time %s out of the range of a timestamp of unit %s", t, unit)
+	}
+	return sec*perSecond + n, nil
+}
+
+// timeOf returns the unit of the values of the TIME column element and
+// whether they are adjusted to UTC, ok false if element is not a TIME
+// column: an INT32 of unit MILLIS or an INT64 of unit MICROS or NANOS. The
+// TIME_MILLIS and TIME_MICROS converted types are adjusted to UTC.
+func timeOf(element *thrift.SchemaElement) (unit time.Duration, utc bool, ok bool) {
+	if lt := element.GetLogicalType(); lt != nil && lt.IsSetTIME() {
+		unit, ok = timeUnitOf(lt.TIME.GetUnit())
+		utc = lt.TIME.IsAdjustedToUTC
+	} else {
+		switch element.GetConvertedType() {
+		case thrift.ConvertedType_TIME_MILLIS:
+			unit, utc, ok = time.Millisecond, true, true
+		case thrift.ConvertedType_TIME_MICROS:
+			unit, utc, ok = time.Microsecond, true, true
+		}
+	}
+	if !ok || (element.GetType() == thrift.Type_INT32) != (unit == time.Millisecond) ||
+		(element.GetType() != thrift.Type_INT32 && element.GetType() != thrift.Type_INT64) {
+		return 0, false, false
+	}
+	return unit, utc, true
+}
+
+// timeValue returns the time since midnight of the value v of a TIME column,
+// an int32 or an int64 number of units, v itself otherwise.
+func timeValue(v interface{}, unit time.Duration) interface{} {
+	switch v := v.(type) {
+	case int32:
+		return time.Duration(v) * unit
+	case int64:
+		return time.Duration(v) * unit
+	}
+	return v
+}
+
+// timeTicks returns the value of the physical type typ of a TIME column for
+// the time since midnight d, truncated to unit, an error if d is not within
+// a day.
+func timeTicks(d time.Duration, unit time.Duration, typ thrift.Type) (interface{}, error) {
+	if d < 0 || d > 24*time.Hour {
+		return nil, fmt.Errorf("time %s is not within a day", d)
+	}
+	if typ == thrift.Type_INT32 {
+		return int32(d / unit), nil
+	}
+	return int64(d / unit), nil
+}
+
+// isDate returns whether element is a DATE column, an INT32 number of days
+// since the epoch.
+func isDate(element *thrift.SchemaElement) bool {
+	if element.GetType() != thrift.Type_INT32 {
+		return false
+	}
+	if lt := element.GetLogicalType(); lt != nil {
+		return lt.IsSetDATE()
+	}
+	return element.GetConvertedType() == thrift.ConvertedType_DATE
+}
+
+// dateValue returns the midnight UTC of the date of the value v of a DATE
+// column, v itself if it is not an int32.
+func dateValue(v interface{}) interface{} {
+	if days, ok := v.(int32); ok {
+		return time.Unix(int64(days)*secondsPerDay, 0).UTC()
+	}
+	return v
+}
+
+// dateDays returns the value of a DATE column for the date of t in its
+// location, an error if it is out of range.
+func dateDays(t time.Time) (int32, error) {
+	sec := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix()
+	days := sec / secondsPerDay
+	if sec%secondsPerDay < 0 {
+		days--
+	}
+	if days > math.MaxInt32 || days < math.MinInt32 {
+		return 0, fmt.Errorf("date %s out of range", t.Format("2006-01-02"))
+	}
+	return int32(days), nil
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// julianDayOfEpoch is the Julian day of 1970-01-01.
+const julianDayOfEpoch = 2440588
+
+// The values of the INT96 columns are the legacy timestamps of Impala and
+// Spark: the nanoseconds of the day, a little-endian int64, followed by the
+// Julian day, a little-endian int32. They are read as time.Time in UTC.
+
+// int96Value returns the time of the value v of an INT96 column, v itself
+// if it is not a datatypes.Int96.
+func int96Value(v interface{}) interface{} {
+	i, ok := v.(datatypes.Int96)
+	if !ok {
+		return v
+	}
+	days := int64(i.N2) - julianDayOfEpoch
+	return time.Unix(days*86400, 0).Add(time.Duration(i.N1)).UTC()
+}
+
+// int96Bytes returns the value of t in an INT96 column.
+func int96Bytes(t time.Time) []byte {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	b := make([]byte, 12)
+	binary.LittleEndian.PutUint64(b, uint64(t.Sub(day)))
+	binary.LittleEndian.PutUint32(b[8:], uint32(day.Unix()/86400+julianDayOfEpoch))
+	return b
+}
+
+// int96RawBytes returns the bytes of the value i of an INT96 column.
+func int96RawBytes(i datatypes.Int96) []byte {
+	b := make([]byte, 12)
+	binary.LittleEndian.PutUint64(b, uint64(i.N1))
+	binary.LittleEndian.PutUint32(b[8:], uint32(i.N2))
+	return b
+}
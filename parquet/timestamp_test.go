@@ -0,0 +1,323 @@
+package parquet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/datatypes"
+)
+
+func TestTimestampRoundTrip(t *testing.T) {
+	s, err := ParseSchema(`message timestamps {
+  required int64 millis (TIMESTAMP(MILLIS,true));
+  required int64 micros (TIMESTAMP(MICROS,true));
+  required int64 nanos (TIMESTAMP(NANOS,true));
+  optional int64 local_micros (TIMESTAMP(MICROS,false));
+  required int64 legacy (TIMESTAMP_MILLIS);
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+	times := []time.Time{
+		time.Date(2020, 6, 1, 12, 30, 15, 123456789, time.UTC),
+		time.Date(1960, 1, 1, 0, 0, 0, 999999999, paris),
+		time.Unix(0, 0),
+	}
+
+	for _, location := range []*time.Location{nil, paris} {
+		var b bytes.Buffer
+		w, err := NewWriter(&b, s, WriterOptions{Location: location})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tm := range times {
+			row := map[string]interface{}{"millis": tm, "micros": tm, "nanos": tm, "legacy": tm, "local_micros": tm}
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// the values of the physical type are written as they are
+		if err := w.WriteRow(map[string]interface{}{"millis": int64(-1), "micros": int64(1), "nanos": int64(1), "legacy": int64(1000)}); err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.options.Location = location
+		rows := readTestRows(t, f)
+		if len(rows) != len(times)+1 {
+			t.Fatalf("got %d rows", len(rows))
+		}
+		for i, tm := range times {
+			for name, unit := range map[string]time.Duration{"millis": time.Millisecond, "micros": time.Microsecond, "nanos": time.Nanosecond, "legacy": time.Millisecond} {
+				got, ok := rows[i][name].(time.Time)
+				if want := tm.Truncate(unit); !ok || !got.Equal(want) || got.Location() != time.UTC {
+					t.Errorf("row %d: got %v for %s, want %v in UTC", i, rows[i][name], name, want)
+				}
+			}
+			// the wall clock is kept
+			local := tm
+			if location != nil {
+				local = tm.In(location)
+			}
+			got, ok := rows[i]["local_micros"].(time.Time)
+			if !ok || got.Format(time.RFC3339Nano) != local.Truncate(time.Microsecond).Format("2006-01-02T15:04:05.999999999")+utcOffset(got) {
+				t.Errorf("location %v, row %d: got %v for local_micros, want the wall clock of %v", location, i, rows[i]["local_micros"], local)
+			}
+			if want := location; want != nil && got.Location() != want {
+				t.Errorf("row %d: got location %s", i, got.Location())
+			}
+		}
+		last := rows[len(times)]
+		for name, want := range map[string]time.Time{
+			"millis": time.Unix(0, -int64(time.Millisecond)),
+			"micros": time.Unix(0, int64(time.Microsecond)),
+			"nanos":  time.Unix(0, 1),
+			"legacy": time.Unix(1, 0),
+		} {
+			if got, ok := last[name].(time.Time); !ok || !got.Equal(want) {
+				t.Errorf("got %v for %s, want %v", last[name], name, want)
+			}
+		}
+		if last["local_micros"] != nil {
+			t.Errorf("got %v for a null", last["local_micros"])
+		}
+	}
+}
+
+// utcOffset returns the offset of t in RFC 3339.
+func utcOffset(t time.Time) string {
+	return t.Format("Z07:00")
+}
+
+func TestTimestampErrors(t *testing.T) {
+	s, err := ParseSchema("message m { required int64 nanos (TIMESTAMP(NANOS,true)); required int32 i; }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, invalid := range []map[string]interface{}{
+		{"nanos": time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC), "i": int32(1)},
+		{"nanos": time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC), "i": int32(1)},
+		{"nanos": int64(1), "i": time.Now()},
+	} {
+		if err := w.WriteRow(invalid); err == nil {
+			t.Errorf("no error for %v", invalid)
+		} else {
+			t.Log(err)
+		}
+	}
+}
+
+func TestTimestampSchemaString(t *testing.T) {
+	text := `message m {
+  required int64 a (TIMESTAMP(NANOS,true));
+  required int64 b (TIMESTAMP(MILLIS,false));
+  required int64 c (TIMESTAMP(MICROS,true));
+}
+`
+	s, err := ParseSchema(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.String(); got != text {
+		t.Errorf("got\n%s\nwant\n%s", got, text)
+	}
+	if c := s.ColumnByName("c"); c.SchemaElement.GetConvertedType().String() != "TIMESTAMP_MICROS" {
+		t.Errorf("got converted type %s", c.SchemaElement.GetConvertedType())
+	}
+	if a := s.ColumnByName("a"); a.SchemaElement.ConvertedType != nil {
+		t.Errorf("got converted type %s for NANOS", a.SchemaElement.GetConvertedType())
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	s, err := ParseSchema(`message times {
+  required int32 millis (TIME(MILLIS,true));
+  required int64 micros (TIME(MICROS,false));
+  optional int64 nanos (TIME(NANOS,true));
+  required int32 legacy (TIME_MILLIS);
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	durations := []time.Duration{0, 13*time.Hour + 5*time.Minute + 123456789, 24 * time.Hour}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{SortingColumns: []SortingColumn{{Column: "micros"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range durations {
+		if err := w.WriteRow(map[string]interface{}{"millis": d, "micros": d, "nanos": d, "legacy": d}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, invalid := range []map[string]interface{}{
+		{"millis": -time.Millisecond, "micros": 24 * time.Hour, "legacy": int32(0)},
+		{"millis": int32(0), "micros": 25 * time.Hour, "legacy": int32(0)},
+	} {
+		if err := w.WriteRow(invalid); err == nil {
+			t.Errorf("no error for %v", invalid)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readTestRows(t, f)
+	if len(rows) != len(durations) {
+		t.Fatalf("got %d rows", len(rows))
+	}
+	for i, d := range durations {
+		for name, unit := range map[string]time.Duration{"millis": time.Millisecond, "micros": time.Microsecond, "nanos": time.Nanosecond, "legacy": time.Millisecond} {
+			if got := rows[i][name]; got != d.Truncate(unit) {
+				t.Errorf("row %d: got %v (%T) for %s, want %s", i, got, got, name, d.Truncate(unit))
+			}
+		}
+	}
+
+	text := `message m {
+  required int32 a (TIME(MILLIS,true));
+  required int64 b (TIME(NANOS,false));
+}
+`
+	if s, err := ParseSchema(text); err != nil {
+		t.Error(err)
+	} else if got := s.String(); got != text {
+		t.Errorf("got\n%s\nwant\n%s", got, text)
+	}
+}
+
+func TestDateRoundTrip(t *testing.T) {
+	s, err := ParseSchema("message m { required int32 date (DATE); optional int32 days; }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokyo := time.FixedZone("JST", 9*60*60)
+	dates := []time.Time{
+		time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 3, 14, 23, 59, 0, 0, time.UTC),
+		time.Date(2021, 3, 15, 1, 0, 0, 0, tokyo), // 2021-03-14 in UTC
+		time.Date(1969, 12, 31, 12, 0, 0, 0, time.UTC),
+		time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range dates {
+		if err := w.WriteRow(map[string]interface{}{"date": d}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.WriteRow(map[string]interface{}{"date": int32(-1), "days": int32(2)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(map[string]interface{}{"date": int32(0), "days": dates[0]}); err == nil {
+		t.Error("no error for a time.Time in an INT32 column")
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readTestRows(t, f)
+	if len(rows) != len(dates)+1 {
+		t.Fatalf("got %d rows", len(rows))
+	}
+	for i, d := range dates {
+		want := time.Date(d.Year(), d.Month(), d.Day(), 0, 0, 0, 0, time.UTC)
+		if got := rows[i]["date"]; got != want {
+			t.Errorf("row %d: got %v, want %v", i, got, want)
+		}
+	}
+	if got, want := rows[len(dates)]["date"], time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := rows[len(dates)]["days"]; got != int32(2) {
+		t.Errorf("got %v (%T) for an INT32 column", got, got)
+	}
+}
+
+func TestInt96RoundTrip(t *testing.T) {
+	const schema = `message root {
+  required int96 ts;
+  optional int96 legacy;
+}`
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip(err)
+	}
+	times := []time.Time{
+		time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC),
+		time.Date(2021, 6, 30, 12, 0, 0, 1, paris),
+		time.Date(1582, 10, 15, 1, 2, 3, 4, time.UTC),
+	}
+	var rows []map[string]interface{}
+	for i, ts := range times {
+		row := map[string]interface{}{"ts": ts}
+		if i%2 == 0 {
+			row["legacy"] = datatypes.Int96{N1: int64(time.Hour), N2: 2451545}
+		}
+		rows = append(rows, row)
+	}
+
+	s, err := ParseSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&bytes.Buffer{}, s, WriterOptions{}); err == nil {
+		t.Errorf("no error for the INT96 columns without Int96Timestamps")
+	}
+	for _, options := range []WriterOptions{
+		{Int96Timestamps: true},
+		{Int96Timestamps: true, ColumnOptions: ColumnOptions{Encoding: "plain"}},
+	} {
+		f := writeListTestFile(t, schema, options, rows)
+		got := readTestRows(t, f)
+		for i, ts := range times {
+			if v, ok := got[i]["ts"].(time.Time); !ok || !v.Equal(ts) || v.Location() != time.UTC {
+				t.Errorf("row %d: got %v, want %v", i, got[i]["ts"], ts.UTC())
+			}
+			want := interface{}(nil)
+			if i%2 == 0 {
+				want = time.Date(2000, 1, 1, 1, 0, 0, 0, time.UTC)
+			}
+			if got[i]["legacy"] != want {
+				t.Errorf("row %d: got %v, want %v", i, got[i]["legacy"], want)
+			}
+		}
+
+		// 2000-01-01 is the Julian day 2451545
+		triples, err := f.RowGroups()[0].ColumnChunk("ts").Triples()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := triples[0].Value; v != (datatypes.Int96{N1: 0, N2: 2451545}) {
+			t.Errorf("got %v for 2000-01-01", v)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package parquet
+
+import (
+	"fmt"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The values of the unsigned integer columns, UINT_8, UINT_16 and UINT_32
+// stored in INT32 and UINT_64 stored in INT64, are read as uint8, uint16,
+// uint32 and uint64. The Writer takes the Go unsigned integers that fit in
+// the column.
+
+// unsignedWidth returns the bit width of the unsigned integer column
+// element, 0 if it is not an unsigned integer column.
+func unsignedWidth(element *thrift.SchemaElement) int {
+	var width int
+	if lt := element.GetLogicalType(); lt != nil && lt.IsSetINTEGER() {
+		if lt.INTEGER.IsSigned {
+			return 0
+		}
+		width = int(lt.INTEGER.BitWidth)
+	} else {
+		switch element.GetConvertedType() {
+		case thrift.ConvertedType_UINT_8:
+			width = 8
+		case thrift.ConvertedType_UINT_16:
+			width = 16
+		case thrift.ConvertedType_UINT_32:
+			width = 32
+		case thrift.ConvertedType_UINT_64:
+			width = 64
+		default:
+			return 0
+		}
+	}
+	switch t := element.GetType(); {
+	case t == thrift.Type_INT32 && width <= 32, t == thrift.Type_INT64 && width == 64:
+		return width
+	}
+	return 0
+}
+
+// checkUnsigned returns an error if the bit width of the unsigned integer
+// column element is not valid for its type.
+func checkUnsigned(element *thrift.SchemaElement) error {
+	if unsignedWidth(element) == 0 {
+		return fmt.Errorf("%s of type %s", logicalTypeAnnotation(element), element.GetType())
+	}
+	return nil
+}
+
+// isUnsigned returns whether element is annotated as an unsigned integer,
+// valid or not.
+func isUnsigned(element *thrift.SchemaElement) bool {
+	if lt := element.GetLogicalType(); lt != nil && lt.IsSetINTEGER() {
+		return !lt.INTEGER.IsSigned
+	}
+	switch element.GetConvertedType() {
+	case thrift.ConvertedType_UINT_8, thrift.ConvertedType_UINT_16, thrift.ConvertedType_UINT_32, thrift.ConvertedType_UINT_64:
+		return true
+	}
+	return false
+}
+
+// unsignedValue returns the unsigned integer of width bits of the value v of
+// an unsigned integer column, v itself if it is not an int32 or an int64.
+func unsignedValue(v interface{}, width int) interface{} {
+	switch v := v.(type) {
+	case int32:
+		switch width {
+		case 8:
+			return uint8(v)
+		case 16:
+			return uint16(v)
+		}
+		return uint32(v)
+	case int64:
+		return uint64(v)
+	}
+	return v
+}
+
+// unsignedPhysical returns the value of the physical type typ of an unsigned
+// integer column of width bits for the Go unsigned integer v, ok false if v
+// is not one, an error if it has more than width bits.
+func unsignedPhysical(v interface{}, width int, typ thrift.Type) (p interface{}, ok bool, err error) {
+	var u uint64
+	switch v := v.(type) {
+	case uint8:
+		u = uint64(v)
+	case uint16:
+		u = uint64(v)
+	case uint32:
+		u = uint64(v)
+	case uint64:
+		u = v
+	case uint:
+		u = uint64(v)
+	default:
+		return nil, false, nil
+	}
+	if width < 64 && u>>uint(width) != 0 {
+		return nil, true, fmt.Errorf("%d has more than %d bits", u, width)
+	}
+	if typ == thrift.Type_INT32 {
+		return int32(uint32(u)), true, nil
+	}
+	return int64(u), true, nil
+}
@@ -0,0 +1,99 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestUnsignedRoundTrip(t *testing.T) {
+	type record struct {
+		U8  uint8
+		U16 uint16
+		U32 uint32
+		U64 uint64
+		U   uint
+	}
+	s, err := SchemaOf(record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// an unsigned logical type without a converted type
+	s.ColumnByName("U16").SchemaElement.ConvertedType = nil
+	s.ColumnByName("U16").SchemaElement.LogicalType = &thrift.LogicalType{INTEGER: &thrift.IntType{BitWidth: 16, IsSigned: false}}
+
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := []map[string]interface{}{
+		{"U8": uint8(math.MaxUint8), "U16": uint16(math.MaxUint16), "U32": uint32(math.MaxUint32), "U64": uint64(math.MaxUint64), "U": uint(math.MaxUint64)},
+		{"U8": uint8(1), "U16": uint16(1), "U32": uint32(1), "U64": uint64(1), "U": uint(1)},
+		// the unsigned values of another size that fit
+		{"U8": uint(2), "U16": uint8(2), "U32": uint64(2), "U64": uint32(2), "U": uint16(2)},
+		// the physical values
+		{"U8": int32(3), "U16": int32(3), "U32": int32(-1), "U64": int64(3), "U": int64(-1)},
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, invalid := range []map[string]interface{}{
+		{"U8": uint16(256), "U16": uint16(1), "U32": uint32(1), "U64": uint64(1), "U": uint(1)},
+		{"U8": uint8(1), "U16": uint32(math.MaxUint16 + 1), "U32": uint32(1), "U64": uint64(1), "U": uint(1)},
+		{"U8": uint8(1), "U16": uint16(1), "U32": uint64(math.MaxUint32 + 1), "U64": uint64(1), "U": uint(1)},
+		{"U8": uint8(1), "U16": uint16(1), "U32": uint32(1), "U64": 1, "U": uint(1)},
+	} {
+		if err := w.WriteRow(invalid); err == nil {
+			t.Errorf("no error for %v", invalid)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readTestRows(t, f)
+	for i, want := range []map[string]interface{}{
+		{"U8": uint8(math.MaxUint8), "U16": uint16(math.MaxUint16), "U32": uint32(math.MaxUint32), "U64": uint64(math.MaxUint64), "U": uint64(math.MaxUint64)},
+		{"U8": uint8(1), "U16": uint16(1), "U32": uint32(1), "U64": uint64(1), "U": uint64(1)},
+		{"U8": uint8(2), "U16": uint16(2), "U32": uint32(2), "U64": uint64(2), "U": uint64(2)},
+		{"U8": uint8(3), "U16": uint16(3), "U32": uint32(math.MaxUint32), "U64": uint64(3), "U": uint64(math.MaxUint64)},
+	} {
+		for name, v := range want {
+			if got[i][name] != v {
+				t.Errorf("row %d: got %v (%T) for %s, want %v (%T)", i, got[i][name], got[i][name], name, v, v)
+			}
+		}
+	}
+
+	// the statistics are in the unsigned order
+	for _, cc := range f.RowGroups()[0].ColumnChunks() {
+		stats := cc.Statistics()
+		var min, max uint64
+		if len(stats.MinValue) == 4 {
+			min, max = uint64(binary.LittleEndian.Uint32(stats.MinValue)), uint64(binary.LittleEndian.Uint32(stats.MaxValue))
+		} else {
+			min, max = binary.LittleEndian.Uint64(stats.MinValue), binary.LittleEndian.Uint64(stats.MaxValue)
+		}
+		if min != 1 || max < math.MaxUint8 {
+			t.Errorf("column %s: got min %d and max %d", cc.Name(), min, max)
+		}
+	}
+
+	s, err = ParseSchema("message m { required int32 u (INTEGER(64,false)); }")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewWriter(&b, s, WriterOptions{}); err == nil {
+		t.Error("no error for a UINT_64 INT32 column")
+	}
+}
@@ -0,0 +1,67 @@
+package parquet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The values of the UUID columns, FIXED_LEN_BYTE_ARRAY of 16 bytes, are read
+// as [16]byte. The Writer takes [16]byte, the types of the uuid packages
+// defined as [16]byte, and the strings of the canonical textual form, e.g.
+// "f81d4fae-7dec-11d0-a765-00a0c91e6bf6".
+
+// isUUID returns whether element is a UUID column.
+func isUUID(element *thrift.SchemaElement) bool {
+	lt := element.GetLogicalType()
+	return lt != nil && lt.IsSetUUID()
+}
+
+// checkUUID returns an error if the UUID column element is not a
+// FIXED_LEN_BYTE_ARRAY of 16 bytes.
+func checkUUID(element *thrift.SchemaElement) error {
+	if element.GetType() != thrift.Type_FIXED_LEN_BYTE_ARRAY || element.GetTypeLength() != 16 {
+		return fmt.Errorf("UUID of type %s(%d)", element.GetType(), element.GetTypeLength())
+	}
+	return nil
+}
+
+// uuidValue returns the UUID of the value v of a UUID column, v itself if
+// it is not 16 bytes.
+func uuidValue(v interface{}) interface{} {
+	var u [16]byte
+	if b, ok := v.([]byte); ok && len(b) == len(u) {
+		copy(u[:], b)
+		return u
+	}
+	return v
+}
+
+// uuidBytes returns the 16 bytes of the UUID v, ok false if v is not a UUID
+// value, an error if it is a string that is not a canonical UUID. The
+// strings of 16 bytes are the bytes of the UUID.
+func uuidBytes(v interface{}) (b []byte, ok bool, err error) {
+	if s, isString := v.(string); isString {
+		if len(s) == 16 {
+			return []byte(s), true, nil
+		}
+		b = make([]byte, 16)
+		if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return nil, true, fmt.Errorf("invalid UUID %q", s)
+		}
+		hexa := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+		if _, err := hex.Decode(b, []byte(hexa)); err != nil {
+			return nil, true, fmt.Errorf("invalid UUID %q", s)
+		}
+		return b, true, nil
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Array || rv.Len() != 16 || rv.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false, nil
+	}
+	b = make([]byte, 16)
+	reflect.Copy(reflect.ValueOf(b), rv)
+	return b, true, nil
+}
@@ -0,0 +1,93 @@
+package parquet
+
+import (
+	"bytes"
+	"testing"
+)
+
+// UUID is defined as the UUID of the uuid packages.
+type UUID [16]byte
+
+type uuidRecord struct {
+	ID    UUID
+	Other *UUID
+	Hash  [16]byte
+}
+
+func TestUUIDRoundTrip(t *testing.T) {
+	s, err := SchemaOf(uuidRecord{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `message root {
+  required fixed_len_byte_array(16) ID (UUID);
+  optional fixed_len_byte_array(16) Other (UUID);
+  required fixed_len_byte_array(16) Hash;
+}
+`
+	if got := s.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	id := UUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []map[string]interface{}{
+		{"ID": id, "Other": [16]byte(id), "Hash": id[:]},
+		{"ID": "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", "Hash": string(id[:])},
+		{"ID": "F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", "Other": id[:], "Hash": id[:]},
+	} {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, invalid := range []map[string]interface{}{
+		{"ID": "f81d4fae7dec11d0a76500a0c91e6bf6", "Hash": id[:]},
+		{"ID": "f81d4fae-7dec-11d0-a765-00a0c91e6bfg", "Hash": id[:]},
+		{"ID": id[:8], "Hash": id[:]},
+		{"ID": id, "Hash": id},
+	} {
+		if err := w.WriteRow(invalid); err == nil {
+			t.Errorf("no error for %v", invalid)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := readTestRows(t, f)
+	if len(rows) != 3 {
+		t.Fatalf("got %d rows", len(rows))
+	}
+	for i, row := range rows {
+		if got := row["ID"]; got != [16]byte(id) {
+			t.Errorf("row %d: got ID %v", i, got)
+		}
+		if got, ok := row["Hash"].([]byte); !ok || !bytes.Equal(got, id[:]) {
+			t.Errorf("row %d: got Hash %v", i, row["Hash"])
+		}
+	}
+	if rows[1]["Other"] != nil || rows[2]["Other"] != [16]byte(id) {
+		t.Errorf("got Other %v and %v", rows[1]["Other"], rows[2]["Other"])
+	}
+
+	for _, text := range []string{
+		"message m { required fixed_len_byte_array(8) id (UUID); }",
+		"message m { required binary id (UUID); }",
+	} {
+		s, err := ParseSchema(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewWriter(&b, s, WriterOptions{}); err == nil {
+			t.Errorf("no error for %s", text)
+		}
+	}
+}
@@ -0,0 +1,837 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// The values of the VARIANT groups are the values of a dynamic type, encoded
+// in the binary format of the parquet spec: a "metadata", the dictionary of
+// the names of the fields of the objects, and a "value". They are read and
+// written as the Go values:
+//
+//	null                    nil
+//	boolean                 bool
+//	int8, int16, int32      int8, int16, int32
+//	int64                   int64, or int
+//	float, double           float32, float64
+//	decimal4, 8 and 16      *big.Rat
+//	date                    time.Time of its midnight UTC
+//	timestamp               time.Time, in UTC
+//	time                    time.Duration since midnight
+//	string                  string
+//	binary                  []byte
+//	uuid                    [16]byte
+//	object                  map[string]interface{}, or any map of strings
+//	array                   []interface{}, or any slice or array
+//
+// The Go unsigned integers are written as the smallest signed integers they
+// fit in, the times with a fraction of microsecond as timestamps of
+// nanoseconds. A VARIANT group can shred its values into the columns of a
+// "typed_value" field, read back into the same values:
+//
+//	optional group event (VARIANT(1)) {
+//	  required binary metadata;
+//	  optional binary value;
+//	  optional group typed_value {
+//	    required group id {
+//	      optional binary value;
+//	      optional int64 typed_value;
+//	    }
+//	  }
+//	}
+//
+// A value is written to the typed_value of its type, a primitive column, a
+// group of the fields of an object or a LIST of the elements of an array,
+// if there is one, and to the value otherwise. The fields of an object that
+// are not in the typed_value group are written to the value.
+
+// The basic types of the variant values, and the primitive types.
+const (
+	variantPrimitive   = 0
+	variantShortString = 1
+	variantObject      = 2
+	variantArray       = 3
+
+	variantNull           = 0
+	variantTrue           = 1
+	variantFalse          = 2
+	variantInt8           = 3
+	variantInt16          = 4
+	variantInt32          = 5
+	variantInt64          = 6
+	variantDouble         = 7
+	variantDecimal4       = 8
+	variantDecimal8       = 9
+	variantDecimal16      = 10
+	variantDate           = 11
+	variantTimestamp      = 12
+	variantTimestampNTZ   = 13
+	variantFloat          = 14
+	variantBinary         = 15
+	variantString         = 16
+	variantTimeNTZ        = 17
+	variantTimestampNanos = 18
+	variantTimestampNTZNs = 19
+	variantUUID           = 20
+)
+
+// isVariant returns whether element is a VARIANT group.
+func isVariant(element *thrift.SchemaElement) bool {
+	lt := element.GetLogicalType()
+	return lt != nil && lt.IsSetVARIANT()
+}
+
+// variantMetadata is the dictionary of the names of the fields of a variant.
+type variantMetadata struct {
+	names []string
+	ids   map[string]int // the ids of the names, when written
+}
+
+// newVariantMetadata returns the metadata of the names of the fields of the
+// objects of v, sorted.
+func newVariantMetadata(v interface{}) *variantMetadata {
+	ids := make(map[string]int)
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		for v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return
+			}
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Map:
+			iter := v.MapRange()
+			for iter.Next() {
+				if k, ok := variantKey(iter.Key()); ok {
+					ids[k] = 0
+				}
+				walk(iter.Value())
+			}
+		case reflect.Slice, reflect.Array:
+			if v.Type().Elem().Kind() == reflect.Uint8 {
+				return
+			}
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		}
+	}
+	if v != nil {
+		walk(reflect.ValueOf(v))
+	}
+	m := &variantMetadata{ids: ids}
+	for name := range ids {
+		m.names = append(m.names, name)
+	}
+	sort.Strings(m.names)
+	for i, name := range m.names {
+		ids[name] = i
+	}
+	return m
+}
+
+// variantKey returns the name of the key k of an object.
+func variantKey(k reflect.Value) (string, bool) {
+	if k.Kind() == reflect.Interface {
+		k = k.Elem()
+	}
+	if k.Kind() != reflect.String {
+		return "", false
+	}
+	return k.String(), true
+}
+
+// bytes returns the metadata in the binary format: the version 1 with the
+// sorted flag, the number of names, their offsets and the names.
+func (m *variantMetadata) bytes() []byte {
+	size := 0
+	for _, name := range m.names {
+		size += len(name)
+	}
+	width := uintWidth(size)
+	if len(m.names) > size {
+		width = uintWidth(len(m.names))
+	}
+	b := []byte{byte(1 | 1<<4 | (width-1)<<6)}
+	b = appendUint(b, len(m.names), width)
+	offset := 0
+	for _, name := range m.names {
+		b = appendUint(b, offset, width)
+		offset += len(name)
+	}
+	b = appendUint(b, offset, width)
+	for _, name := range m.names {
+		b = append(b, name...)
+	}
+	return b
+}
+
+// parseVariantMetadata parses the metadata b.
+func parseVariantMetadata(b []byte) (*variantMetadata, error) {
+	if len(b) == 0 || b[0]&0x0f != 1 {
+		return nil, fmt.Errorf("variant: unsupported metadata")
+	}
+	width := int(b[0]>>6) + 1
+	n, err := readUint(b, 1, width)
+	if err != nil {
+		return nil, err
+	}
+	start := 1 + width*(n+2)
+	if start > len(b) {
+		return nil, errVariantTruncated
+	}
+	m := &variantMetadata{names: make([]string, n)}
+	for i := range m.names {
+		from, _ := readUint(b, 1+width*(i+1), width)
+		to, _ := readUint(b, 1+width*(i+2), width)
+		if from > to || start+to > len(b) {
+			return nil, errVariantTruncated
+		}
+		m.names[i] = string(b[start+from : start+to])
+	}
+	return m, nil
+}
+
+var errVariantTruncated = fmt.Errorf("variant: truncated value")
+
+// uintWidth returns the number of bytes of the unsigned integer n, 1 to 4.
+func uintWidth(n int) int {
+	switch {
+	case n <= math.MaxUint8:
+		return 1
+	case n <= math.MaxUint16:
+		return 2
+	case n <= 1<<24-1:
+		return 3
+	}
+	return 4
+}
+
+// appendUint appends n as a little-endian unsigned integer of width bytes.
+func appendUint(b []byte, n, width int) []byte {
+	for i := 0; i < width; i++ {
+		b = append(b, byte(n>>(8*i)))
+	}
+	return b
+}
+
+// readUint reads the little-endian unsigned integer of width bytes at i.
+func readUint(b []byte, i, width int) (int, error) {
+	if i+width > len(b) {
+		return 0, errVariantTruncated
+	}
+	n := 0
+	for j := width - 1; j >= 0; j-- {
+		n = n<<8 | int(b[i+j])
+	}
+	return n, nil
+}
+
+// encodeVariant returns the value v in the binary format, with the names of
+// the fields of m.
+func encodeVariant(m *variantMetadata, v interface{}) ([]byte, error) {
+	if v == nil {
+		return []byte{variantNull << 2}, nil
+	}
+	primitive := func(t byte, size int, bits uint64) []byte {
+		b := make([]byte, 1+size)
+		b[0] = t << 2
+		for i := 0; i < size; i++ {
+			b[1+i] = byte(bits >> (8 * i))
+		}
+		return b
+	}
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return []byte{variantTrue << 2}, nil
+		}
+		return []byte{variantFalse << 2}, nil
+	case int8:
+		return primitive(variantInt8, 1, uint64(v)), nil
+	case int16:
+		return primitive(variantInt16, 2, uint64(v)), nil
+	case int32:
+		return primitive(variantInt32, 4, uint64(v)), nil
+	case int64:
+		return primitive(variantInt64, 8, uint64(v)), nil
+	case int:
+		return primitive(variantInt64, 8, uint64(v)), nil
+	case uint8:
+		return primitive(variantInt16, 2, uint64(v)), nil
+	case uint16:
+		return primitive(variantInt32, 4, uint64(v)), nil
+	case uint32:
+		return primitive(variantInt64, 8, uint64(v)), nil
+	case uint64, uint:
+		n := reflect.ValueOf(v).Uint()
+		if n > math.MaxInt64 {
+			return nil, fmt.Errorf("variant: %d overflows an int64", n)
+		}
+		return primitive(variantInt64, 8, n), nil
+	case float32:
+		return primitive(variantFloat, 4, uint64(math.Float32bits(v))), nil
+	case float64:
+		return primitive(variantDouble, 8, math.Float64bits(v)), nil
+	case *big.Rat:
+		return encodeVariantDecimal(v)
+	case time.Time:
+		if v.Nanosecond()%1000 != 0 {
+			return primitive(variantTimestampNanos, 8, uint64(v.UnixNano())), nil
+		}
+		return primitive(variantTimestamp, 8, uint64(v.Unix()*1e6+int64(v.Nanosecond()/1000))), nil
+	case time.Duration:
+		return primitive(variantTimeNTZ, 8, uint64(v/time.Microsecond)), nil
+	case string:
+		if len(v) < 64 {
+			return append([]byte{byte(len(v))<<2 | variantShortString}, v...), nil
+		}
+		return append(primitive(variantString, 4, uint64(len(v))), v...), nil
+	case []byte:
+		return append(primitive(variantBinary, 4, uint64(len(v))), v...), nil
+	case [16]byte:
+		return append([]byte{variantUUID << 2}, v[:]...), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if rv.IsNil() {
+			return encodeVariant(m, nil)
+		}
+		return encodeVariant(m, rv.Elem().Interface())
+	case reflect.Map:
+		return encodeVariantObject(m, rv)
+	case reflect.Slice, reflect.Array:
+		if rv.Kind() == reflect.Slice && rv.IsNil() {
+			return encodeVariant(m, nil)
+		}
+		values := make([][]byte, rv.Len())
+		for i := range values {
+			b, err := encodeVariant(m, rv.Index(i).Interface())
+			if err != nil {
+				return nil, err
+			}
+			values[i] = b
+		}
+		return encodeVariantList(variantArray, nil, values), nil
+	case reflect.String:
+		return encodeVariant(m, rv.String())
+	}
+	return nil, fmt.Errorf("variant: unsupported value of type %T", v)
+}
+
+// encodeVariantObject returns the object of the fields of the map rv.
+func encodeVariantObject(m *variantMetadata, rv reflect.Value) ([]byte, error) {
+	if rv.IsNil() {
+		return encodeVariant(m, nil)
+	}
+	type objectField struct {
+		name  string
+		value []byte
+	}
+	var fields []objectField
+	iter := rv.MapRange()
+	for iter.Next() {
+		name, ok := variantKey(iter.Key())
+		if !ok {
+			return nil, fmt.Errorf("variant: key %v of an object is not a string", iter.Key())
+		}
+		b, err := encodeVariant(m, iter.Value().Interface())
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, objectField{name, b})
+	}
+	// the fields are sorted by name
+	sort.Slice(fields, func(i, j int) bool { return fields[i].name < fields[j].name })
+	ids := make([]int, len(fields))
+	values := make([][]byte, len(fields))
+	for i, f := range fields {
+		id, ok := m.ids[f.name]
+		if !ok {
+			return nil, fmt.Errorf("variant: no field %s in the metadata", f.name)
+		}
+		ids[i], values[i] = id, f.value
+	}
+	return encodeVariantList(variantObject, ids, values), nil
+}
+
+// encodeVariantList returns the object or the array of values, with the ids
+// of the names of the fields of an object.
+func encodeVariantList(basicType byte, ids []int, values [][]byte) []byte {
+	size, maxID := 0, 0
+	for i, v := range values {
+		size += len(v)
+		if ids != nil && ids[i] > maxID {
+			maxID = ids[i]
+		}
+	}
+	offsetWidth, idWidth := uintWidth(size), uintWidth(maxID)
+	large, countWidth := 0, 1
+	if len(values) > math.MaxUint8 {
+		large, countWidth = 1, 4
+	}
+	header := byte(offsetWidth - 1)
+	if basicType == variantObject {
+		header |= byte(idWidth-1)<<2 | byte(large)<<4
+	} else {
+		header |= byte(large) << 2
+	}
+	b := []byte{header<<2 | basicType}
+	b = appendUint(b, len(values), countWidth)
+	for _, id := range ids {
+		b = appendUint(b, id, idWidth)
+	}
+	offset := 0
+	for _, v := range values {
+		b = appendUint(b, offset, offsetWidth)
+		offset += len(v)
+	}
+	b = appendUint(b, offset, offsetWidth)
+	for _, v := range values {
+		b = append(b, v...)
+	}
+	return b
+}
+
+// encodeVariantDecimal returns the decimal of r, of the smallest scale and
+// the smallest of the decimal4, 8 and 16 it fits in.
+func encodeVariantDecimal(r *big.Rat) ([]byte, error) {
+	unscaled := new(big.Rat).Set(r)
+	ten := big.NewRat(10, 1)
+	scale := 0
+	for !unscaled.IsInt() {
+		if scale++; scale > 38 {
+			return nil, fmt.Errorf("variant: %s is not a decimal of scale 38 or less", r.RatString())
+		}
+		unscaled.Mul(unscaled, ten)
+	}
+	n := unscaled.Num()
+	var t byte
+	var size int
+	switch digits := len(new(big.Int).Abs(n).String()); {
+	case digits <= 9:
+		t, size = variantDecimal4, 4
+	case digits <= 18:
+		t, size = variantDecimal8, 8
+	case digits <= 38:
+		t, size = variantDecimal16, 16
+	default:
+		return nil, fmt.Errorf("variant: %s has more than 38 digits", r.RatString())
+	}
+	b := make([]byte, 2+size)
+	b[0], b[1] = t<<2, byte(scale)
+	// the two's complement in little endian
+	twos := new(big.Int).Set(n)
+	if n.Sign() < 0 {
+		twos.Add(twos, new(big.Int).Lsh(big.NewInt(1), uint(8*size)))
+	}
+	be := twos.Bytes()
+	for i, c := range be {
+		b[2+len(be)-1-i] = c
+	}
+	return b, nil
+}
+
+// decodeVariant returns the Go value of the value b of a variant of the
+// metadata m.
+func decodeVariant(m *variantMetadata, b []byte) (interface{}, error) {
+	if len(b) == 0 {
+		return nil, errVariantTruncated
+	}
+	header := int(b[0] >> 2)
+	switch b[0] & 3 {
+	case variantShortString:
+		if 1+header > len(b) {
+			return nil, errVariantTruncated
+		}
+		return string(b[1 : 1+header]), nil
+	case variantObject, variantArray:
+		return decodeVariantList(m, b)
+	}
+
+	fixed := func(size int) ([]byte, error) {
+		if 1+size > len(b) {
+			return nil, errVariantTruncated
+		}
+		return b[1 : 1+size], nil
+	}
+	switch header {
+	case variantNull:
+		return nil, nil
+	case variantTrue:
+		return true, nil
+	case variantFalse:
+		return false, nil
+	case variantInt8:
+		p, err := fixed(1)
+		if err != nil {
+			return nil, err
+		}
+		return int8(p[0]), nil
+	case variantInt16:
+		p, err := fixed(2)
+		if err != nil {
+			return nil, err
+		}
+		return int16(binary.LittleEndian.Uint16(p)), nil
+	case variantInt32, variantDate, variantFloat:
+		p, err := fixed(4)
+		if err != nil {
+			return nil, err
+		}
+		n := binary.LittleEndian.Uint32(p)
+		switch header {
+		case variantDate:
+			return time.Unix(int64(int32(n))*86400, 0).UTC(), nil
+		case variantFloat:
+			return math.Float32frombits(n), nil
+		}
+		return int32(n), nil
+	case variantInt64, variantDouble, variantTimestamp, variantTimestampNTZ, variantTimeNTZ, variantTimestampNanos, variantTimestampNTZNs:
+		p, err := fixed(8)
+		if err != nil {
+			return nil, err
+		}
+		n := int64(binary.LittleEndian.Uint64(p))
+		switch header {
+		case variantDouble:
+			return math.Float64frombits(uint64(n)), nil
+		case variantTimestamp, variantTimestampNTZ:
+			return time.Unix(0, n*int64(time.Microsecond)).UTC(), nil
+		case variantTimestampNanos, variantTimestampNTZNs:
+			return time.Unix(0, n).UTC(), nil
+		case variantTimeNTZ:
+			return time.Duration(n) * time.Microsecond, nil
+		}
+		return n, nil
+	case variantDecimal4, variantDecimal8, variantDecimal16:
+		size := map[int]int{variantDecimal4: 4, variantDecimal8: 8, variantDecimal16: 16}[header]
+		p, err := fixed(1 + size)
+		if err != nil {
+			return nil, err
+		}
+		be := make([]byte, size)
+		for i := range be {
+			be[i] = p[size-i]
+		}
+		n := new(big.Int).SetBytes(be)
+		if be[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(8*size)))
+		}
+		scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(p[0])), nil)
+		return new(big.Rat).SetFrac(n, scale), nil
+	case variantBinary, variantString:
+		size, err := readUint(b, 1, 4)
+		if err != nil {
+			return nil, err
+		}
+		if 5+size > len(b) {
+			return nil, errVariantTruncated
+		}
+		if header == variantString {
+			return string(b[5 : 5+size]), nil
+		}
+		return append([]byte(nil), b[5:5+size]...), nil
+	case variantUUID:
+		p, err := fixed(16)
+		if err != nil {
+			return nil, err
+		}
+		var u [16]byte
+		copy(u[:], p)
+		return u, nil
+	}
+	return nil, fmt.Errorf("variant: unsupported primitive type %d", header)
+}
+
+// decodeVariantList returns the object or the array b.
+func decodeVariantList(m *variantMetadata, b []byte) (interface{}, error) {
+	header := int(b[0] >> 2)
+	object := b[0]&3 == variantObject
+	offsetWidth, idWidth, large := header&3+1, 0, header>>2&1
+	if object {
+		idWidth, large = header>>2&3+1, header>>4&1
+	}
+	countWidth := 1
+	if large == 1 {
+		countWidth = 4
+	}
+	n, err := readUint(b, 1, countWidth)
+	if err != nil {
+		return nil, err
+	}
+	ids := 1 + countWidth
+	offsets := ids + n*idWidth
+	start := offsets + (n+1)*offsetWidth
+	if start > len(b) {
+		return nil, errVariantTruncated
+	}
+	values := b[start:]
+	element := func(i int) (interface{}, error) {
+		offset, _ := readUint(b, offsets+i*offsetWidth, offsetWidth)
+		if offset >= len(values) {
+			return nil, errVariantTruncated
+		}
+		return decodeVariant(m, values[offset:])
+	}
+
+	if !object {
+		list := make([]interface{}, n)
+		for i := range list {
+			if list[i], err = element(i); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	}
+	fields := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		id, _ := readUint(b, ids+i*idWidth, idWidth)
+		if id >= len(m.names) {
+			return nil, fmt.Errorf("variant: no field %d in the metadata", id)
+		}
+		if fields[m.names[id]], err = element(i); err != nil {
+			return nil, err
+		}
+	}
+	return fields, nil
+}
+
+// variantGroup returns the values of the fields of the VARIANT group f for
+// the value v: its metadata, and its value or its typed_value.
+func variantGroup(f *field, v interface{}) (map[string]interface{}, error) {
+	metadata := newVariantMetadata(v)
+	m, err := shredVariant(metadata, f, v)
+	if err != nil {
+		return nil, err
+	}
+	m["metadata"] = metadata.bytes()
+	return m, nil
+}
+
+// shredVariant returns the value and the typed_value of v in the group f.
+func shredVariant(metadata *variantMetadata, f *field, v interface{}) (map[string]interface{}, error) {
+	m := make(map[string]interface{}, 2)
+	if typed := f.child("typed_value"); typed != nil && v != nil {
+		tv, rest, ok, err := shredTypedVariant(metadata, typed, v)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			m["typed_value"] = tv
+			if rest != nil {
+				m["value"] = rest
+			}
+			return m, nil
+		}
+	}
+	b, err := encodeVariant(metadata, v)
+	if err != nil {
+		return nil, err
+	}
+	m["value"] = b
+	return m, nil
+}
+
+// shredTypedVariant returns the typed_value of v in the field typed, and the
+// value of the fields of an object that are not in typed, ok false if v
+// does not have the type of typed.
+func shredTypedVariant(metadata *variantMetadata, typed *field, v interface{}) (tv interface{}, rest []byte, ok bool, err error) {
+	switch typed.kind {
+	case columnField:
+		tv, ok = variantTypedValue(typed.element, v)
+		return tv, nil, ok, nil
+	case groupField:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Map {
+			return nil, nil, false, nil
+		}
+		fields := make(map[string]interface{}, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			name, ok := variantKey(iter.Key())
+			if !ok {
+				return nil, nil, false, fmt.Errorf("variant: key %v of an object is not a string", iter.Key())
+			}
+			fields[name] = iter.Value().Interface()
+		}
+		object := make(map[string]interface{}, len(typed.children))
+		for _, c := range typed.children {
+			// the missing fields have neither a value nor a typed_value
+			fv, ok := fields[c.name]
+			if !ok {
+				object[c.name] = map[string]interface{}{}
+				continue
+			}
+			if object[c.name], err = shredVariant(metadata, c, fv); err != nil {
+				return nil, nil, false, err
+			}
+			delete(fields, c.name)
+		}
+		if len(fields) > 0 {
+			if rest, err = encodeVariant(metadata, fields); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		return object, rest, true, nil
+	case listField:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array || rv.Type().Elem().Kind() == reflect.Uint8 {
+			return nil, nil, false, nil
+		}
+		list := make([]interface{}, rv.Len())
+		for i := range list {
+			if list[i], err = shredVariant(metadata, typed.elem, rv.Index(i).Interface()); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		return list, nil, true, nil
+	}
+	return nil, nil, false, nil
+}
+
+// variantTypedValue returns the value of v in the typed_value column
+// element, ok false if v does not have its type.
+func variantTypedValue(element *thrift.SchemaElement, v interface{}) (interface{}, bool) {
+	ct := element.GetConvertedType()
+	if element.ConvertedType == nil {
+		ct = -1
+	}
+	switch element.GetType() {
+	case thrift.Type_BOOLEAN:
+		b, ok := v.(bool)
+		return b, ok
+	case thrift.Type_INT32:
+		switch v := v.(type) {
+		case int8:
+			return int32(v), ct == thrift.ConvertedType_INT_8
+		case int16:
+			return int32(v), ct == thrift.ConvertedType_INT_16
+		case int32:
+			return v, ct == -1 || ct == thrift.ConvertedType_INT_32
+		}
+	case thrift.Type_INT64:
+		if unit, _, ok := timestampOf(element); ok {
+			t, isTime := v.(time.Time)
+			return t, isTime && (unit == time.Nanosecond || t.Nanosecond()%int(unit) == 0)
+		}
+		switch v := v.(type) {
+		case int64:
+			return v, ct == -1 || ct == thrift.ConvertedType_INT_64
+		case int:
+			return int64(v), ct == -1 || ct == thrift.ConvertedType_INT_64
+		}
+	case thrift.Type_FLOAT:
+		f, ok := v.(float32)
+		return f, ok
+	case thrift.Type_DOUBLE:
+		f, ok := v.(float64)
+		return f, ok
+	case thrift.Type_BYTE_ARRAY:
+		switch v := v.(type) {
+		case string:
+			return v, ct == thrift.ConvertedType_UTF8
+		case []byte:
+			return v, ct == -1 && element.LogicalType == nil
+		}
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		u, ok := v.([16]byte)
+		return u, ok && isUUID(element)
+	}
+	return nil, false
+}
+
+// variantValue returns the value of the VARIANT group f of the values m of
+// its fields, nil if it has neither a value nor a typed_value.
+func variantValue(f *field, m map[string]interface{}) (interface{}, error) {
+	b, _ := m["metadata"].([]byte)
+	metadata, err := parseVariantMetadata(b)
+	if err != nil {
+		return nil, err
+	}
+	v, _, err := unshredVariant(metadata, f, m)
+	return v, err
+}
+
+// unshredVariant returns the value of the group f of a value and a
+// typed_value of the values m, ok false if it has neither.
+func unshredVariant(metadata *variantMetadata, f *field, m map[string]interface{}) (v interface{}, ok bool, err error) {
+	value, _ := m["value"].([]byte)
+	typed := f.child("typed_value")
+	if typed == nil || m["typed_value"] == nil {
+		if value == nil {
+			return nil, false, nil
+		}
+		v, err := decodeVariant(metadata, value)
+		return v, true, err
+	}
+
+	switch tv := m["typed_value"].(type) {
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(tv))
+		if value != nil {
+			rest, err := decodeVariant(metadata, value)
+			if err != nil {
+				return nil, false, err
+			}
+			fields, ok := rest.(map[string]interface{})
+			if !ok {
+				return nil, false, fmt.Errorf("variant: value %v with the fields of an object", rest)
+			}
+			for name, fv := range fields {
+				object[name] = fv
+			}
+		}
+		for _, c := range typed.children {
+			cm, _ := tv[c.name].(map[string]interface{})
+			fv, ok, err := unshredVariant(metadata, c, cm)
+			if err != nil {
+				return nil, false, err
+			}
+			if ok {
+				object[c.name] = fv
+			}
+		}
+		return object, true, nil
+	case []interface{}:
+		list := make([]interface{}, len(tv))
+		for i, e := range tv {
+			em, _ := e.(map[string]interface{})
+			if list[i], _, err = unshredVariant(metadata, typed.elem, em); err != nil {
+				return nil, false, err
+			}
+		}
+		return list, true, nil
+	}
+	return variantTypedColumnValue(typed.element, m["typed_value"]), true, nil
+}
+
+// variantTypedColumnValue returns the Go value of the value v of the
+// typed_value column element.
+func variantTypedColumnValue(element *thrift.SchemaElement, v interface{}) interface{} {
+	switch v := v.(type) {
+	case []byte:
+		if element.GetConvertedType() == thrift.ConvertedType_UTF8 {
+			return string(v)
+		}
+	case int32:
+		switch element.GetConvertedType() {
+		case thrift.ConvertedType_INT_8:
+			return int8(v)
+		case thrift.ConvertedType_INT_16:
+			return int16(v)
+		}
+	}
+	return v
+}
@@ -0,0 +1,236 @@
+package parquet
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVariantEncoding(t *testing.T) {
+	long := strings.Repeat("s", 100)
+	ts := time.Date(2024, 2, 3, 4, 5, 6, 7000, time.UTC)
+	for _, test := range []struct {
+		v, want interface{}
+	}{
+		{nil, nil},
+		{true, true},
+		{false, false},
+		{int8(-1), int8(-1)},
+		{int16(300), int16(300)},
+		{int32(-70000), int32(-70000)},
+		{int64(1) << 40, int64(1) << 40},
+		{7, int64(7)},
+		{uint8(200), int16(200)},
+		{uint64(1) << 40, int64(1) << 40},
+		{float32(1.5), float32(1.5)},
+		{2.5, 2.5},
+		{big.NewRat(-12345, 100), big.NewRat(-12345, 100)},
+		{ts, ts},
+		{ts.Add(1), ts.Add(1)},
+		{90 * time.Minute, 90 * time.Minute},
+		{"short", "short"},
+		{long, long},
+		{[]byte{1, 2}, []byte{1, 2}},
+		{[16]byte{3}, [16]byte{3}},
+		{[]int32{1, 2}, []interface{}{int32(1), int32(2)}},
+		{[]interface{}{}, []interface{}{}},
+		{
+			map[string]interface{}{"b": "x", "a": []interface{}{map[string]int8{"c": 1}, nil}},
+			map[string]interface{}{"b": "x", "a": []interface{}{map[string]interface{}{"c": int8(1)}, nil}},
+		},
+		{map[string]interface{}{}, map[string]interface{}{}},
+	} {
+		m := newVariantMetadata(test.v)
+		b, err := encodeVariant(m, test.v)
+		if err != nil {
+			t.Errorf("%#v: %s", test.v, err)
+			continue
+		}
+		metadata, err := parseVariantMetadata(m.bytes())
+		if err != nil {
+			t.Errorf("%#v: %s", test.v, err)
+			continue
+		}
+		got, err := decodeVariant(metadata, b)
+		if err != nil {
+			t.Errorf("%#v: %s", test.v, err)
+			continue
+		}
+		if r, ok := got.(*big.Rat); ok && r.Cmp(test.want.(*big.Rat)) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%#v: got %#v, want %#v", test.v, got, test.want)
+		}
+	}
+
+	m := newVariantMetadata(nil)
+	for _, v := range []interface{}{struct{}{}, map[int]int{1: 1}, make(chan int)} {
+		if _, err := encodeVariant(m, v); err == nil {
+			t.Errorf("no error encoding %#v", v)
+		}
+	}
+	for _, b := range [][]byte{{}, {variantInt32 << 2, 1}, {variantArray, 2, 0}} {
+		if _, err := decodeVariant(m, b); err == nil {
+			t.Errorf("no error decoding %v", b)
+		}
+	}
+}
+
+func TestVariantRoundTrip(t *testing.T) {
+	const schema = `message root {
+  required int64 id;
+  optional group v (VARIANT(1)) {
+    required binary metadata;
+    required binary value;
+  }
+  optional group shredded (VARIANT) {
+    required binary metadata;
+    optional binary value;
+    optional group typed_value {
+      required group name {
+        optional binary value;
+        optional binary typed_value (STRING);
+      }
+      required group tags {
+        optional binary value;
+        optional group typed_value (LIST) {
+          repeated group list {
+            required group element {
+              optional binary value;
+              optional int32 typed_value (INT_16);
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+	values := []interface{}{
+		nil,
+		"s",
+		int32(1),
+		[]interface{}{"a", nil, 2.5},
+		map[string]interface{}{"name": "n", "tags": []interface{}{int16(1), "x", nil}},
+		map[string]interface{}{"name": int8(2), "other": true},
+		map[string]interface{}{"tags": "t"},
+		map[string]interface{}{},
+	}
+	var rows, want []map[string]interface{}
+	for i := 0; i < 40; i++ {
+		v := values[i%len(values)]
+		rows = append(rows, map[string]interface{}{"id": int64(i), "v": v, "shredded": v})
+		want = append(want, map[string]interface{}{"id": int64(i), "v": v, "shredded": v})
+	}
+
+	for _, options := range []WriterOptions{
+		{},
+		{ColumnOptions: ColumnOptions{PageValues: 3, DataPageVersion: 2}},
+	} {
+		f := writeListTestFile(t, schema, options, rows)
+		if s := f.Schema().String(); !strings.Contains(s, "(VARIANT(1))") {
+			t.Errorf("schema %s", s)
+		}
+		got := readTestRows(t, f)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%+v: got %v, want %v", options, got, want)
+		}
+	}
+}
+
+func TestVariantStructs(t *testing.T) {
+	type record struct {
+		ID    int64
+		Value interface{}
+		Ptr   *interface{}
+	}
+	s, err := SchemaOf(record{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.String(); !strings.Contains(got, "required group Value (VARIANT(1))") ||
+		!strings.Contains(got, "optional group Ptr (VARIANT(1))") {
+		t.Errorf("schema %s", got)
+	}
+
+	var b bytes.Buffer
+	w, err := NewWriter(&b, s, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v interface{} = "p"
+	records := []record{
+		{ID: 1, Value: map[string]interface{}{"a": int64(1)}, Ptr: &v},
+		{ID: 2},
+	}
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := f.RowGroups()[0].Rows()
+	var got []record
+	for rows.Scan() {
+		var r record
+		if err := rows.Decode(&r); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, r)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, records) {
+		t.Errorf("got %+v, want %+v", got, records)
+	}
+}
+
+func TestVariantSchemaErrors(t *testing.T) {
+	for _, schema := range []string{
+		`message root {
+  optional group v (VARIANT) {
+    required binary value;
+  }
+}`,
+		`message root {
+  optional group v (VARIANT) {
+    optional binary metadata;
+    required binary value;
+  }
+}`,
+		`message root {
+  optional group v (VARIANT) {
+    required binary metadata;
+    required binary value;
+    required binary other;
+  }
+}`,
+	} {
+		s, err := ParseSchema(schema)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewWriter(&bytes.Buffer{}, s, WriterOptions{}); err == nil {
+			t.Errorf("no error for schema %s", schema)
+		}
+	}
+	if _, err := ParseSchema(`message root {
+  optional group v (VARIANT(x)) {
+    required binary metadata;
+    required binary value;
+  }
+}`); err == nil {
+		t.Error("no error for an invalid version")
+	}
+}
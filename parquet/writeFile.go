@@ -0,0 +1,206 @@
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// CreateFile returns a Writer of the file at path, created or truncated,
+// that writes its column chunks with NewWriterAt. Close closes the file.
+//
+// With options.Atomic the rows are written to a temporary file of the same
+// directory, with the permissions 0644, renamed to path once it is complete.
+// The temporary file is removed if Close fails or if the Writer failed.
+func CreateFile(path string, schema *Schema, options WriterOptions) (*Writer, error) {
+	var file *os.File
+	var err error
+	if options.Atomic {
+		file, err = ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+		if err == nil {
+			err = file.Chmod(0644)
+		}
+	} else {
+		file, err = os.Create(path)
+	}
+	if err != nil {
+		if file != nil {
+			file.Close()
+			os.Remove(file.Name())
+		}
+		return nil, fmt.Errorf("writer: %s", err)
+	}
+
+	w, err := NewWriterAt(file, schema, options)
+	if err != nil {
+		file.Close()
+		if options.Atomic {
+			os.Remove(file.Name())
+		}
+		return nil, err
+	}
+	w.file = file
+	if options.Atomic {
+		w.rename = path
+	}
+	return w, nil
+}
+
+// WriteFileAtomic writes the file at path with the rows written by write to
+// the Writer of CreateFile, with options.Atomic: the file at path, if any,
+// is only replaced if write and Close succeed.
+func WriteFileAtomic(path string, schema *Schema, options WriterOptions, write func(w *Writer) error) error {
+	options.Atomic = true
+	w, err := CreateFile(path, schema, options)
+	if err != nil {
+		return err
+	}
+	if err := write(w); err != nil {
+		// the Writer fails and Close removes the temporary file
+		w.err = err
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// AppendFile returns a Writer that appends row groups to the parquet file
+// at path, without copying its data: the rows are written over the footer of
+// the file, and Close writes the Bloom filters and the page indexes of the
+// new column chunks, then a footer with the row groups of the file and the
+// new ones, and closes the file. The file keeps its schema, its created_by
+// and its key/value metadata, updated with options.KeyValueMetadata.
+//
+// The footer is restored if the Writer fails or is reset, but the file is
+// corrupt if the process stops before Close: WriteFileAtomic replaces a file
+// safely. The encrypted files and options.Atomic are not supported.
+func AppendFile(path string, options WriterOptions) (*Writer, error) {
+	if options.Atomic {
+		return nil, fmt.Errorf("writer: cannot append to a file atomically")
+	}
+	if options.Encryption != nil {
+		return nil, fmt.Errorf("writer: cannot append to an encrypted file")
+	}
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("writer: %s", err)
+	}
+	w, err := newAppendWriter(file, options)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// newAppendWriter returns the Writer of AppendFile to file.
+func newAppendWriter(file *os.File, options WriterOptions) (*Writer, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("writer: %s", err)
+	}
+	size := info.Size()
+	meta, err := ReadMetadata(file, size)
+	if err != nil {
+		return nil, fmt.Errorf("writer: %s", err)
+	}
+	if meta.IsSetEncryptionAlgorithm() {
+		return nil, fmt.Errorf("writer: cannot append to an encrypted file")
+	}
+	schema, err := schemaFromFileMetaData(meta)
+	if err != nil {
+		return nil, fmt.Errorf("writer: could not read schema: %s", err)
+	}
+	w, err := newWriter(schema, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// ReadMetadata validated the length of the footer
+	var length [4]byte
+	if _, err := file.ReadAt(length[:], size-footerSize); err != nil {
+		return nil, fmt.Errorf("writer: error reading footer: %s", err)
+	}
+	offset := size - footerSize - int64(binary.LittleEndian.Uint32(length[:]))
+	footer := make([]byte, size-offset)
+	if _, err := file.ReadAt(footer, offset); err != nil {
+		return nil, fmt.Errorf("writer: error reading footer: %s", err)
+	}
+
+	if len(options.KeyValueMetadata) > 0 {
+		keyValues := make(map[string]string)
+		for k, v := range thrift.KeyValueMap(meta.KeyValueMetadata) {
+			keyValues[k] = v
+		}
+		for k, v := range options.KeyValueMetadata {
+			keyValues[k] = v
+		}
+		meta.KeyValueMetadata = thrift.KeyValues(keyValues)
+	}
+	if meta.ColumnOrders == nil {
+		// the statistics of the new row groups use the order of the type
+		meta.ColumnOrders = w.meta.ColumnOrders
+	}
+	w.meta = meta
+	// the row groups of the file are already written
+	for _, rg := range meta.RowGroups {
+		w.filters = append(w.filters, make([]*bloom.Filter, len(rg.Columns)))
+		w.indexes = append(w.indexes, make([]pageIndex, len(rg.Columns)))
+	}
+
+	w.at = &offsetWriter{w: file, offset: offset}
+	w.w = thrift.NewCountingWriter(w.at)
+	w.w.N = offset
+	w.file, w.footer, w.footerOffset = file, footer, offset
+	return w, nil
+}
+
+// closeFile closes the file of CreateFile or AppendFile after the Writer was
+// closed with err and renames it, if the Writer is atomic and did not fail.
+// It returns the first error.
+func (w *Writer) closeFile(err error) error {
+	if w.footer != nil {
+		err = w.closeAppend(err)
+	}
+	if err == nil && w.rename != "" {
+		if err = w.file.Sync(); err != nil {
+			err = fmt.Errorf("writer: %s", err)
+		}
+	}
+	if cerr := w.file.Close(); cerr != nil && err == nil {
+		err = fmt.Errorf("writer: %s", cerr)
+	}
+	if w.rename == "" {
+		return err
+	}
+	if err == nil {
+		if err = os.Rename(w.file.Name(), w.rename); err == nil {
+			return nil
+		}
+		err = fmt.Errorf("writer: %s", err)
+	}
+	os.Remove(w.file.Name())
+	return err
+}
+
+// closeAppend truncates the file of AppendFile after its new footer if the
+// Writer was closed without error, the new footer may be shorter, or
+// restores its footer otherwise. It returns the first error.
+func (w *Writer) closeAppend(err error) error {
+	size := w.w.N
+	if err != nil {
+		if _, werr := w.file.WriteAt(w.footer, w.footerOffset); werr != nil {
+			return err
+		}
+		size = w.footerOffset + int64(len(w.footer))
+	}
+	if terr := w.file.Truncate(size); terr != nil && err == nil {
+		err = fmt.Errorf("writer: %s", terr)
+	}
+	return err
+}
@@ -0,0 +1,314 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// dirFiles returns the names of the files of dir.
+func dirFiles(t *testing.T, dir string) []string {
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names
+}
+
+func TestCreateFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet-go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	rows := writerTestRows(100)
+	for _, atomic := range []bool{false, true} {
+		path := filepath.Join(dir, "rows.parquet")
+		if err := ioutil.WriteFile(path, []byte("previous"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		w, err := CreateFile(path, writerTestSchema(t), WriterOptions{Atomic: atomic})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if atomic != bytes.Equal(data, []byte("previous")) {
+			t.Errorf("atomic %t: got %d bytes before Close", atomic, len(data))
+		}
+
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if names := dirFiles(t, dir); len(names) != 1 {
+			t.Errorf("atomic %t: got files %v", atomic, names)
+		}
+		data, err = ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("atomic %t: %s", atomic, err)
+		}
+		if got := readTestRows(t, f); len(got) != len(rows) {
+			t.Errorf("atomic %t: got %d rows, want %d", atomic, len(got), len(rows))
+		}
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet-go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "rows.parquet")
+
+	rows := writerTestRows(10)
+	write := func(w *Writer) error {
+		for _, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := WriteFileAtomic(path, writerTestSchema(t), WriterOptions{}, write); err != nil {
+		t.Fatal(err)
+	}
+	written, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(written, parquetMagic) || !bytes.HasSuffix(written, parquetMagic) {
+		t.Errorf("got a file of %d bytes", len(written))
+	}
+
+	// a failed write leaves the previous file
+	failure := errors.New("failure")
+	err = WriteFileAtomic(path, writerTestSchema(t), WriterOptions{}, func(w *Writer) error {
+		write(w)
+		return failure
+	})
+	if err != failure {
+		t.Errorf("got error %v", err)
+	}
+	err = WriteFileAtomic(path, writerTestSchema(t), WriterOptions{}, func(w *Writer) error {
+		return w.WriteRow(map[string]interface{}{"id": "not an int64"})
+	})
+	if err == nil {
+		t.Errorf("no error for an invalid row")
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, written) {
+		t.Errorf("the file was replaced by a failed write")
+	}
+	if names := dirFiles(t, dir); len(names) != 1 {
+		t.Errorf("got files %v", names)
+	}
+
+	if err := WriteFileAtomic(filepath.Join(dir, "missing", "rows.parquet"), writerTestSchema(t), WriterOptions{}, write); err == nil {
+		t.Errorf("no error for a missing directory")
+	}
+}
+
+func TestCreateFileReset(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet-go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := CreateFile(filepath.Join(dir, "rows.parquet"), writerTestSchema(t), WriterOptions{Atomic: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := writerTestRows(10)
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the temporary file is removed
+	var b bytes.Buffer
+	if err := w.Reset(&b); err != nil {
+		t.Fatal(err)
+	}
+	if names := dirFiles(t, dir); len(names) != 0 {
+		t.Errorf("got files %v", names)
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := readTestRows(t, f); len(got) != len(rows) {
+		t.Errorf("got %d rows, want %d", len(got), len(rows))
+	}
+}
+
+func TestAppendFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet-go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rows.parquet")
+	rows := writerTestRows(150)
+	options := WriterOptions{ColumnOptions: ColumnOptions{BloomFilter: true}, KeyValueMetadata: map[string]string{"a": "1"}}
+	w, err := CreateFile(path, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows[:100] {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the second append writes no rows, only the footer
+	for _, batch := range [][]map[string]interface{}{rows[100:], nil} {
+		options.KeyValueMetadata = map[string]string{"b": "2"}
+		w, err := AppendFile(path, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range batch {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readTestRows(t, f)
+	if len(got) != len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), len(rows))
+	}
+	for i, row := range got {
+		if row["id"] != int64(i) {
+			t.Fatalf("row %d: got id %v", i, row["id"])
+		}
+	}
+	if kv := f.KeyValueMetadata(); len(kv) != 2 || kv["a"] != "1" || kv["b"] != "2" {
+		t.Errorf("got key/value metadata %v", kv)
+	}
+	if n := len(f.RowGroups()); n != 2 {
+		t.Fatalf("got %d row groups", n)
+	}
+	for i, rg := range f.RowGroups() {
+		id := rg.ColumnChunk("id")
+		filter, err := id.BloomFilter()
+		if err != nil || filter == nil || !filter.Check(int64(100*i)) {
+			t.Errorf("row group %d: got bloom filter %v, %v", i, filter, err)
+		}
+		offsetIndex, err := id.OffsetIndex()
+		if err != nil || len(offsetIndex.GetPageLocations()) == 0 {
+			t.Errorf("row group %d: got offset index %v, %v", i, offsetIndex, err)
+		}
+	}
+}
+
+func TestAppendFileReset(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquet-go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "rows.parquet")
+	rows := writerTestRows(10)
+	w, err := CreateFile(path, writerTestSchema(t), WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the row group written over the footer is discarded
+	w, err = AppendFile(path, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range writerTestRows(1000) {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Reset(ioutil.Discard); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %d bytes, want the %d bytes of the file", len(got), len(want))
+	}
+
+	if _, err := AppendFile(path, WriterOptions{Atomic: true}); err == nil {
+		t.Errorf("AppendFile succeeded with Atomic")
+	}
+	if err := ioutil.WriteFile(path, []byte("not a parquet file"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AppendFile(path, WriterOptions{}); err == nil {
+		t.Errorf("AppendFile succeeded with a file that is not a parquet file")
+	}
+}
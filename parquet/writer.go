@@ -0,0 +1,945 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kostya-sh/parquet-go/parquet/bloom"
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+const (
+	// createdBy is the created_by of the metadata of the files written.
+	createdBy = "parquet-go version 0.1"
+
+	// DefaultRowGroupSize is the default WriterOptions.RowGroupSize, as in
+	// parquet-mr.
+	DefaultRowGroupSize = 128 * 1024 * 1024
+)
+
+var (
+	errWriterClosed = errors.New("writer closed")
+	errWriterReset  = errors.New("writer reset")
+)
+
+// Writer writes rows to a parquet file. The file starts with the magic, then
+// come the row groups of the rows written, the Bloom filters and the page
+// indexes of their column chunks and the footer, written by Close.
+//
+// The values of the rows are encoded into pages in memory until they are
+// written as a row group: once they reach the row group size or number of
+// rows of the WriterOptions, on Flush and on Close. The values of the nested
+// fields are split into the values of their columns with their levels.
+type Writer struct {
+	w         *thrift.CountingWriter
+	columns   []*columnWriter
+	shredder  *shredder
+	meta      *thrift.FileMetaData
+	sorting   []*thrift.SortingColumn
+	verifier  *sortingVerifier          // nil unless the sorting is verified
+	encryptor *encryption.FileEncryptor // nil unless the file is encrypted
+	numRows   int64                     // number of rows of the current row group
+
+	encryption *encryption.FileEncryptionProperties // nil unless the files are encrypted
+
+	rowGroupSize int64
+	rowGroupRows int64 // 0 for no limit
+	concurrency  int
+	at           *offsetWriter // nil unless the chunks are written at their offsets
+
+	file   *os.File    // the file of CreateFile or AppendFile, closed by Close
+	rename string      // the path the file is renamed to by Close, if any
+	parts  *PartWriter // the parts of NewMultipartWriter, closed by Close
+
+	// footer is the footer of the file of AppendFile, from footerOffset to
+	// the end of the file, restored if the Writer fails.
+	footer       []byte
+	footerOffset int64
+
+	// filters and indexes are the Bloom filters and the page indexes of
+	// the column chunks of the row groups written, written on Close.
+	filters [][]*bloom.Filter
+	indexes [][]pageIndex
+
+	err error // the writer fails after its first error
+}
+
+// NewWriter returns a Writer of a file of the given schema to w, written
+// with options, and writes the magic of the file. It fails if the schema is
+// not supported, or a compression codec, a sorting column or an encrypted
+// column of options is not valid.
+func NewWriter(w io.Writer, schema *Schema, options WriterOptions) (*Writer, error) {
+	writer, err := newWriter(schema, options)
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.start(w); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// newWriter returns a Writer of a file of the given schema, written with
+// options, that has not started a file yet.
+func newWriter(schema *Schema, options WriterOptions) (*Writer, error) {
+	if len(schema.Columns()) == 0 {
+		return nil, fmt.Errorf("writer: no columns in the schema")
+	}
+	sorting, err := sortingColumns(schema, options.SortingColumns)
+	if err != nil {
+		return nil, fmt.Errorf("writer: %s", err)
+	}
+
+	fields, err := schema.fields()
+	if err != nil {
+		return nil, fmt.Errorf("writer: %s", err)
+	}
+
+	meta := &thrift.FileMetaData{
+		Version:          1,
+		Schema:           schemaElements(schema),
+		RowGroups:        []*thrift.RowGroup{},
+		KeyValueMetadata: thrift.KeyValues(options.KeyValueMetadata),
+		CreatedBy:        strptr(createdBy),
+	}
+	writer := &Writer{
+		meta:         meta,
+		shredder:     newShredder(fields, len(schema.Columns())),
+		sorting:      sorting,
+		rowGroupSize: options.RowGroupSize,
+		rowGroupRows: options.RowGroupRows,
+		concurrency:  options.Concurrency,
+	}
+	if writer.rowGroupSize <= 0 {
+		writer.rowGroupSize = DefaultRowGroupSize
+	}
+	for j, f := range columnFields(fields, len(schema.Columns())) {
+		if f.element.GetType() == thrift.Type_INT96 && !options.Int96Timestamps {
+			return nil, fmt.Errorf("writer: column %s: type INT96 is deprecated, see WriterOptions.Int96Timestamps", f)
+		}
+		c, err := newColumnWriter(f.path, f.element, f.levels, options.column(schema.Columns()[j]), writer.concurrency > 1)
+		if err != nil {
+			return nil, fmt.Errorf("writer: %s", err)
+		}
+		c.location = options.Location
+		writer.columns = append(writer.columns, c)
+		// the min and max statistics use the order of the type
+		meta.ColumnOrders = append(meta.ColumnOrders, &thrift.ColumnOrder{TYPE_ORDER: thrift.NewTypeDefinedOrder()})
+	}
+
+	if options.VerifySorting && len(options.SortingColumns) > 0 {
+		writer.verifier = newSortingVerifier(schema, options.SortingColumns)
+	}
+	if options.Encryption != nil {
+		for _, path := range options.Encryption.EncryptedColumns() {
+			if schema.ColumnByName(path) == nil {
+				return nil, fmt.Errorf("writer: encrypted column %s is not in the schema", path)
+			}
+		}
+		writer.encryption = options.Encryption
+	}
+	return writer, nil
+}
+
+// start starts a file to w and writes its magic.
+func (w *Writer) start(out io.Writer) error {
+	w.w = thrift.NewCountingWriter(out)
+	w.meta.RowGroups = []*thrift.RowGroup{}
+	w.meta.NumRows = 0
+	w.numRows = 0
+	w.filters, w.indexes = nil, nil
+	w.err = nil
+
+	magic := parquetMagic
+	w.encryptor = nil
+	if w.encryption != nil {
+		// every file has its own AAD
+		var err error
+		if w.encryptor, err = encryption.NewFileEncryptor(w.encryption); err != nil {
+			return fmt.Errorf("writer: %s", err)
+		}
+		if w.encryptor.FooterEncrypted() {
+			magic = encryptedMagic
+		}
+	}
+	return writeMagic(w.w, magic)
+}
+
+// Reset discards the rows not written yet and the file being written, and
+// starts a new file to out, with the same schema and options, by writing
+// its magic. It reuses the buffers and the dictionaries of the columns,
+// which saves allocations when writing many small files. The file of
+// CreateFile is closed, and removed if it is atomic, unless the Writer was
+// closed, the file of AppendFile is restored and the last part of
+// NewMultipartWriter is not uploaded.
+func (w *Writer) Reset(out io.Writer) error {
+	if w.file != nil {
+		w.closeFile(errWriterReset)
+		w.file = nil
+	}
+	w.at, w.rename, w.parts, w.footer = nil, "", nil, nil
+	for _, c := range w.columns {
+		c.reset()
+	}
+	if w.verifier != nil {
+		w.verifier.reset()
+	}
+	if err := w.start(out); err != nil {
+		w.err = err
+		return err
+	}
+	return nil
+}
+
+// Write writes the row of v, a struct or a pointer to a struct of the
+// schema of the Writer, see SchemaOf: the values of the fields of its
+// groups, lists and maps are split into the values of their columns, the nil
+// pointers are the nulls of the optional fields. The fields are named as in
+// SchemaOf, the structs, slices, arrays and maps are the groups, lists and
+// maps of WriteRow and the values of the columns are converted to the types
+// of their columns, e.g. an int16 to an int32.
+func (w *Writer) Write(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("writer: value of type %T is not a struct", v)
+	}
+	row, err := rowValue(rv)
+	if err != nil {
+		return fmt.Errorf("writer: %s", err)
+	}
+	return w.WriteRow(row.(map[string]interface{}))
+}
+
+// NewWriterAt is like NewWriter but returns a Writer to w, e.g. an
+// *os.File, that writes the column chunks of a row group concurrently at
+// their offsets, up to options.Concurrency chunks at a time, rather than
+// one after the other. The file starts at the offset 0 of w.
+func NewWriterAt(w io.WriterAt, schema *Schema, options WriterOptions) (*Writer, error) {
+	at := &offsetWriter{w: w}
+	writer, err := NewWriter(at, schema, options)
+	if err != nil {
+		return nil, err
+	}
+	writer.at = at
+	return writer, nil
+}
+
+// WriteRow writes a row, a map from the names of the fields of the top level
+// of the schema to their values, nil or missing for the nulls of the optional
+// fields and the empty repeated fields: the columns of a flat schema, the
+// groups, the lists and the maps of a nested schema, see RowScanner.Row. The
+// lists are slices of any type, the maps maps of any type, the variants of a
+// VARIANT group the Go values of variant.go. The values of the columns have
+// the types the rows are read with: bool, int32, int64, float32, float64 or
+// []byte, a string for a BYTE_ARRAY or a FIXED_LEN_BYTE_ARRAY column, a
+// *big.Rat for a DECIMAL column, a time.Time for a TIMESTAMP, a DATE or an
+// INT96 column, a time.Duration since midnight for a TIME column, a [16]byte
+// or a canonical string for a UUID column, an Interval for an INTERVAL
+// column, a float32 or a Float16 for a FLOAT16 column or a Go unsigned
+// integer for an unsigned integer column, which also take the values
+// of their physical type. Nothing is written for a row that is not valid.
+func (w *Writer) WriteRow(row map[string]interface{}) error {
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.shredder.shred(row); err != nil {
+		return fmt.Errorf("writer: row %d: %s", w.meta.NumRows+w.numRows, err)
+	}
+	for j, c := range w.columns {
+		for _, t := range w.shredder.triples[j] {
+			if t.Value == nil {
+				continue
+			}
+			if err := c.check(t.Value); err != nil {
+				return fmt.Errorf("writer: row %d: %s", w.meta.NumRows+w.numRows, err)
+			}
+		}
+	}
+	if w.verifier != nil {
+		if err := w.verifier.verify(row); err != nil {
+			return fmt.Errorf("writer: %s", err)
+		}
+	}
+
+	for j, c := range w.columns {
+		if err := c.writeTriples(w.shredder.triples[j]); err != nil {
+			w.err = fmt.Errorf("writer: row %d: %s", w.meta.NumRows+w.numRows, err)
+			return w.err
+		}
+	}
+	w.numRows++
+
+	if w.rowGroupRows > 0 && w.numRows >= w.rowGroupRows || w.bufferedSize() >= w.rowGroupSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// bufferedSize returns the size of the values of the current row group,
+// see page.PageEncoder.BufferedSize.
+func (w *Writer) bufferedSize() int64 {
+	var size int64
+	for _, c := range w.columns {
+		size += c.pages.BufferedSize()
+	}
+	return size
+}
+
+// Flush writes the rows buffered as a row group, e.g. for the row groups
+// to follow the batches of rows written. Nothing is written without rows.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	return w.flushRowGroup()
+}
+
+// WriteColumnChunks writes a row group of column chunks of other files,
+// one per column of the schema in its order, copied without decoding their
+// pages, e.g. to stitch the columns of files written separately. The chunks
+// must have the same number of rows and the type, the repetition and the
+// codec of their column. They keep their statistics, their page indexes and
+// their Bloom filters, but the row group has no sorting columns. The rows
+// buffered are written as a row group first. The chunks of encrypted files
+// cannot be copied, nor can an encrypted file be written.
+func (w *Writer) WriteColumnChunks(chunks ...*ColumnChunk) error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.encryptor != nil {
+		return fmt.Errorf("writer: cannot copy column chunks to an encrypted file")
+	}
+	if len(chunks) != len(w.columns) {
+		return fmt.Errorf("writer: %d column chunks for %d columns", len(chunks), len(w.columns))
+	}
+	for j, cc := range chunks {
+		c := w.columns[j]
+		if err := c.checkChunk(cc); err != nil {
+			return fmt.Errorf("writer: %s", err)
+		}
+		if codec := cc.Metadata().GetCodec(); codec != c.codec {
+			return fmt.Errorf("writer: column %s: column chunk compressed with %s, not %s", c.name, codec, c.codec)
+		}
+		if n := chunks[0].rowGroup.NumRows(); cc.rowGroup.NumRows() != n {
+			return fmt.Errorf("writer: column %s: %d rows, %d in column %s", cc.Name(), cc.rowGroup.NumRows(), n, chunks[0].Name())
+		}
+	}
+	return w.copyRowGroup(chunks[0].rowGroup.NumRows(), chunks, nil, nil)
+}
+
+// columnValues returns the value of a column of a flat schema in the row k
+// of a row group.
+type columnValues func(k int64) (interface{}, error)
+
+// copyRowGroup writes a row group of numRows rows, sorted by sorting, whose
+// column j is copied from chunks[j], or has the values values[j] if
+// chunks[j] is nil. The rows buffered are written as a row group first.
+// Nothing is written if a value is not valid, or without rows, like
+// flushRowGroup.
+func (w *Writer) copyRowGroup(numRows int64, chunks []*ColumnChunk, values []columnValues, sorting []*thrift.SortingColumn) error {
+	if err := w.flushRowGroup(); err != nil {
+		return err
+	}
+	if numRows == 0 {
+		return nil
+	}
+	for j, cc := range chunks {
+		if cc != nil {
+			continue
+		}
+		c := w.columns[j]
+		for k := int64(0); k < numRows; k++ {
+			v, err := values[j](k)
+			if err != nil {
+				err = fmt.Errorf("column %s: %s", c.name, err)
+			} else if err = c.check(v); err == nil {
+				err = c.write(v)
+			}
+			if err != nil {
+				for _, c := range w.columns {
+					c.reset()
+				}
+				return fmt.Errorf("writer: row %d: %s", w.meta.NumRows+k, err)
+			}
+		}
+	}
+
+	i := len(w.meta.RowGroups)
+	rowGroup := &thrift.RowGroup{NumRows: numRows, SortingColumns: sorting}
+	filters := make([]*bloom.Filter, len(chunks))
+	indexes := make([]pageIndex, len(chunks))
+	for j, cc := range chunks {
+		c := w.columns[j]
+		var chunk *thrift.ColumnChunk
+		var err error
+		if cc != nil {
+			var copied *rewrittenColumnChunk
+			if copied, err = copyColumnChunk(w.w, cc); err == nil {
+				chunk, filters[j], indexes[j] = copied.meta, copied.filter, copied.index
+			}
+		} else {
+			var pages []page.Page
+			if pages, err = c.pages.Pages(); err == nil {
+				chunk, indexes[j], err = c.writeChunk(w.w, w.encryptor, i, j, pages)
+			}
+			filters[j] = c.filter
+			c.reset()
+		}
+		if err != nil {
+			w.err = fmt.Errorf("writer: column %s: %s", c.name, err)
+			return w.err
+		}
+		rowGroup.Columns = append(rowGroup.Columns, chunk)
+		rowGroup.TotalByteSize += chunk.MetaData.TotalUncompressedSize
+	}
+
+	w.meta.RowGroups = append(w.meta.RowGroups, rowGroup)
+	w.meta.NumRows += rowGroup.NumRows
+	w.filters = append(w.filters, filters)
+	w.indexes = append(w.indexes, indexes)
+	return nil
+}
+
+// Close writes the row group of the rows buffered, then the Bloom filters
+// and the page indexes of the column chunks and the footer. It does not
+// close the underlying writer, but the file of CreateFile or AppendFile and
+// the parts of NewMultipartWriter.
+func (w *Writer) Close() error {
+	err := w.close()
+	if w.file != nil {
+		err = w.closeFile(err)
+		w.file = nil
+	}
+	if w.parts != nil {
+		if err == nil {
+			if err = w.parts.Close(); err != nil {
+				err = fmt.Errorf("writer: %s", err)
+			}
+		}
+		w.parts = nil
+	}
+	return err
+}
+
+func (w *Writer) close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = errWriterClosed
+
+	if err := w.flushRowGroup(); err != nil {
+		return err
+	}
+	if _, err := writeBloomFilters(w.w, w.w.N, w.meta.RowGroups, w.filters, w.encryptor); err != nil {
+		return fmt.Errorf("writer: %s", err)
+	}
+	if _, err := writePageIndexes(w.w, w.w.N, w.meta.RowGroups, w.indexes, w.encryptor); err != nil {
+		return fmt.Errorf("writer: %s", err)
+	}
+	if w.encryptor != nil {
+		return writeEncryptedFileMetadata(w.w, w.meta, w.encryptor)
+	}
+	return writeFileMetadata(w.w, w.meta)
+}
+
+// flushRowGroup writes the column chunks of the rows buffered as a row
+// group. Nothing is written without rows.
+func (w *Writer) flushRowGroup() error {
+	if w.numRows == 0 {
+		return nil
+	}
+
+	i := len(w.meta.RowGroups)
+	rowGroup := &thrift.RowGroup{
+		NumRows:        w.numRows,
+		SortingColumns: append([]*thrift.SortingColumn{}, w.sorting...),
+	}
+	pages := make([][]page.Page, len(w.columns))
+	err := w.parallel(func(j int) error {
+		var err error
+		if pages[j], err = w.columns[j].pages.Pages(); err != nil {
+			return fmt.Errorf("column %s: %s", w.columns[j].name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		w.err = fmt.Errorf("writer: %s", err)
+		return w.err
+	}
+	var chunks []*thrift.ColumnChunk
+	var indexes []pageIndex
+	if w.at != nil {
+		chunks, indexes, err = w.writeChunksAt(i, pages)
+	} else {
+		chunks, indexes, err = w.writeChunks(i, pages)
+	}
+	if err != nil {
+		w.err = fmt.Errorf("writer: %s", err)
+		return w.err
+	}
+	filters := make([]*bloom.Filter, len(w.columns))
+	for j, c := range w.columns {
+		rowGroup.Columns = append(rowGroup.Columns, chunks[j])
+		rowGroup.TotalByteSize += chunks[j].MetaData.TotalUncompressedSize
+		filters[j] = c.filter
+		c.reset()
+	}
+
+	w.meta.RowGroups = append(w.meta.RowGroups, rowGroup)
+	w.meta.NumRows += w.numRows
+	w.filters = append(w.filters, filters)
+	w.indexes = append(w.indexes, indexes)
+	w.numRows = 0
+	return nil
+}
+
+// parallel calls f for each column j, with up to w.concurrency calls at a
+// time. It returns the error of the first column that failed.
+func (w *Writer) parallel(f func(j int) error) error {
+	errs := make([]error, len(w.columns))
+	if w.concurrency <= 1 {
+		for j := range w.columns {
+			if errs[j] = f(j); errs[j] != nil {
+				return errs[j]
+			}
+		}
+		return nil
+	}
+
+	columns := make(chan int)
+	var wg sync.WaitGroup
+	for k := 0; k < w.concurrency && k < len(w.columns); k++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range columns {
+				errs[j] = f(j)
+			}
+		}()
+	}
+	for j := range w.columns {
+		columns <- j
+	}
+	close(columns)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeChunks writes pages, the pages of the column chunks of the row group
+// i, one chunk after the other.
+func (w *Writer) writeChunks(i int, pages [][]page.Page) ([]*thrift.ColumnChunk, []pageIndex, error) {
+	chunks := make([]*thrift.ColumnChunk, len(w.columns))
+	indexes := make([]pageIndex, len(w.columns))
+	for j, c := range w.columns {
+		chunk, index, err := c.writeChunk(w.w, w.encryptor, i, j, pages[j])
+		if err != nil {
+			return nil, nil, fmt.Errorf("column %s of row group %d: %s", c.name, i, err)
+		}
+		chunks[j], indexes[j] = chunk, index
+	}
+	return chunks, indexes, nil
+}
+
+// writeChunksAt is like writeChunks but writes the chunks concurrently at
+// their offsets in w.at: each chunk is written to a buffer as if it started
+// the file, then its offsets are moved to its place after the previous
+// chunks and it is written there.
+func (w *Writer) writeChunksAt(i int, pages [][]page.Page) ([]*thrift.ColumnChunk, []pageIndex, error) {
+	chunks := make([]*thrift.ColumnChunk, len(w.columns))
+	indexes := make([]pageIndex, len(w.columns))
+	buffers := make([]bytes.Buffer, len(w.columns))
+	err := w.parallel(func(j int) error {
+		c := w.columns[j]
+		chunk, index, err := c.writeChunk(thrift.NewCountingWriter(&buffers[j]), w.encryptor, i, j, pages[j])
+		if err != nil {
+			return fmt.Errorf("column %s of row group %d: %s", c.name, i, err)
+		}
+		chunks[j], indexes[j] = chunk, index
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	offsets := make([]int64, len(w.columns))
+	offset := w.w.N
+	for j := range chunks {
+		offsets[j] = offset
+		moveChunk(chunks[j], indexes[j].offsetIndex, offset)
+		offset += int64(buffers[j].Len())
+	}
+	err = w.parallel(func(j int) error {
+		if _, err := w.at.w.WriteAt(buffers[j].Bytes(), offsets[j]); err != nil {
+			return fmt.Errorf("column %s of row group %d: %s", w.columns[j].name, i, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	w.w.N, w.at.offset = offset, offset
+	return chunks, indexes, nil
+}
+
+// moveChunk adds offset to the offsets of the pages of chunk and of its
+// offset index.
+func moveChunk(chunk *thrift.ColumnChunk, index *thrift.OffsetIndex, offset int64) {
+	chunk.FileOffset += offset
+	chunk.MetaData.DataPageOffset += offset
+	if chunk.MetaData.DictionaryPageOffset != nil {
+		dictionary := *chunk.MetaData.DictionaryPageOffset + offset
+		chunk.MetaData.DictionaryPageOffset = &dictionary
+	}
+	for _, location := range index.PageLocations {
+		location.Offset += offset
+	}
+}
+
+// offsetWriter writes to an io.WriterAt from offset on.
+type offsetWriter struct {
+	w      io.WriterAt
+	offset int64
+}
+
+func (w *offsetWriter) Write(p []byte) (int, error) {
+	n, err := w.w.WriteAt(p, w.offset)
+	w.offset += int64(n)
+	return n, err
+}
+
+// columnWriter encodes the values of a column into the pages of its chunk
+// in the current row group.
+type columnWriter struct {
+	name          string
+	path          []string // the path of the column in the schema
+	element       *thrift.SchemaElement
+	options       ColumnOptions
+	codec         thrift.CompressionCodec
+	maxDefinition uint
+	maxRepetition uint
+	deferred      bool // whether the pages are compressed by Pages
+	pages         page.PageEncoder
+	filter        *bloom.Filter         // nil unless the chunk has a Bloom filter
+	geospatial    *geospatialStatistics // nil unless a GEOMETRY or GEOGRAPHY column
+	location      *time.Location        // see WriterOptions.Location
+}
+
+// newColumnWriter returns the writer of the column of the given path, of
+// the maximum levels levels.
+func newColumnWriter(path []string, element *thrift.SchemaElement, levels Levels, options ColumnOptions, deferred bool) (*columnWriter, error) {
+	name := strings.Join(path, ".")
+	if !element.IsSetType() {
+		return nil, fmt.Errorf("column %s has no type", name)
+	}
+	if err := checkLogicalType(element); err != nil {
+		return nil, fmt.Errorf("column %s: %s", name, err)
+	}
+	if options.Encoding != "" {
+		enc, err := page.EncodingByName(options.Encoding)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %s", name, err)
+		}
+		if err := page.CheckEncoding(enc, element.GetType()); err != nil {
+			return nil, fmt.Errorf("column %s: %s", name, err)
+		}
+	}
+	if v := options.DataPageVersion; v < 0 || v > 2 {
+		return nil, fmt.Errorf("column %s: unsupported data page version %d", name, v)
+	}
+	c := &columnWriter{
+		name:          name,
+		path:          path,
+		element:       element,
+		options:       options,
+		maxDefinition: uint(levels.D),
+		maxRepetition: uint(levels.R),
+		deferred:      deferred,
+	}
+	codec, err := page.CodecByName(options.Compression)
+	if err != nil {
+		return nil, fmt.Errorf("column %s: %s", name, err)
+	}
+	c.codec = codec
+	if err := page.CheckCompression(c.preferences()); err != nil {
+		return nil, fmt.Errorf("column %s: %s", name, err)
+	}
+	c.reset()
+	return c, nil
+}
+
+// preferences returns how the pages of the column are encoded.
+func (c *columnWriter) preferences() page.EncodingPreferences {
+	preferences := page.EncodingPreferences{
+		CompressionCodec:         c.options.Compression,
+		CompressionLevel:         c.options.CompressionLevel,
+		Strategy:                 "dictionary",
+		Type:                     c.element.Type,
+		DictionaryPageSize:       c.options.DictionaryPageSize,
+		PageSize:                 c.options.PageSize,
+		PageValues:               c.options.PageValues,
+		DataPageVersion:          c.options.DataPageVersion,
+		ZstdDictionarySize:       c.options.ZstdDictionarySize,
+		SortOrder:                page.SortOrderOf(c.element),
+		StatisticsTruncateLength: c.options.StatisticsTruncateLength,
+		MaxDefinitionLevel:       c.maxDefinition,
+		MaxRepetitionLevel:       c.maxRepetition,
+		DeferCompression:         c.deferred,
+	}
+	switch {
+	case c.options.Encoding == "" && c.options.DisableDictionary:
+		preferences.Strategy = "default"
+	case c.options.Encoding != "" && c.options.Encoding != "dictionary":
+		// the encoding was checked by newColumnWriter
+		preferences.Strategy = "default"
+		preferences.Encoding, _ = page.EncodingByName(c.options.Encoding)
+	}
+	return preferences
+}
+
+// reset starts the chunk of the next row group.
+func (c *columnWriter) reset() {
+	c.filter = nil
+	c.geospatial = newGeospatialStatistics(c.element)
+	if c.options.BloomFilter && c.element.GetType() != thrift.Type_BOOLEAN {
+		c.filter = bloom.New(bloom.OptimalNumBytes(c.options.BloomFilterNDV, c.options.BloomFilterFPP))
+	}
+	if c.pages != nil {
+		c.pages.Reset(c.filter)
+		return
+	}
+	preferences := c.preferences()
+	preferences.BloomFilter = c.filter
+	c.pages = page.NewPageEncoder(preferences)
+}
+
+// checkChunk returns an error if the column chunk cc cannot be copied to
+// the column: its name, type and repetition must be the same.
+func (c *columnWriter) checkChunk(cc *ColumnChunk) error {
+	if cc.Name() != c.name {
+		return fmt.Errorf("column chunk of column %s for column %s", cc.Name(), c.name)
+	}
+	if cc.Encrypted() {
+		return fmt.Errorf("column %s: cannot copy an encrypted column chunk", c.name)
+	}
+	col := cc.rowGroup.file.schema.ColumnByName(cc.Name())
+	if col == nil {
+		return fmt.Errorf("column %s is not in the schema of the column chunk", c.name)
+	}
+	e := col.SchemaElement
+	if e.GetType() != c.element.GetType() || e.GetTypeLength() != c.element.GetTypeLength() ||
+		e.GetRepetitionType() != c.element.GetRepetitionType() || e.GetConvertedType() != c.element.GetConvertedType() ||
+		e.GetScale() != c.element.GetScale() || e.GetPrecision() != c.element.GetPrecision() ||
+		!reflect.DeepEqual(e.GetLogicalType(), c.element.GetLogicalType()) {
+		return fmt.Errorf("column %s: the column chunk has another type", c.name)
+	}
+	return nil
+}
+
+// check returns an error if v cannot be written in the column.
+func (c *columnWriter) check(v interface{}) error {
+	if v == nil {
+		if c.maxDefinition == 0 {
+			return fmt.Errorf("column %s: null value in a required column", c.name)
+		}
+		return nil
+	}
+	v, err := c.physicalValue(v)
+	if err != nil {
+		return fmt.Errorf("column %s: %s", c.name, err)
+	}
+	ok := false
+	switch c.element.GetType() {
+	case thrift.Type_BOOLEAN:
+		_, ok = v.(bool)
+	case thrift.Type_INT32:
+		_, ok = v.(int32)
+	case thrift.Type_INT64:
+		_, ok = v.(int64)
+	case thrift.Type_FLOAT:
+		_, ok = v.(float32)
+	case thrift.Type_DOUBLE:
+		_, ok = v.(float64)
+	case thrift.Type_BYTE_ARRAY:
+		switch v.(type) {
+		case []byte, string:
+			ok = true
+		}
+	case thrift.Type_FIXED_LEN_BYTE_ARRAY:
+		switch v := v.(type) {
+		case []byte:
+			ok = len(v) == int(c.element.GetTypeLength())
+		case string:
+			ok = len(v) == int(c.element.GetTypeLength())
+		}
+	case thrift.Type_INT96:
+		b, isBytes := v.([]byte)
+		ok = isBytes && len(b) == 12
+	}
+	if !ok {
+		return fmt.Errorf("column %s: value %v of type %T for a column of type %s", c.name, v, v, c.element.GetType())
+	}
+	if len(c.options.EnumValues) > 0 && isEnum(c.element) {
+		if err := checkEnumValue(v, c.options.EnumValues); err != nil {
+			return fmt.Errorf("column %s: %s", c.name, err)
+		}
+	}
+	return nil
+}
+
+// writeTriples writes the values of a row with their levels, the values
+// checked with check. The levels of the nested columns are written first.
+func (c *columnWriter) writeTriples(triples []page.Triple) error {
+	if c.maxRepetition > 0 || c.maxDefinition > 1 {
+		repetition := make([]int32, len(triples))
+		definition := make([]int32, len(triples))
+		for i, t := range triples {
+			repetition[i], definition[i] = int32(t.R), int32(t.D)
+		}
+		if err := c.pages.WriteLevels(repetition, definition); err != nil {
+			return fmt.Errorf("column %s: %s", c.name, err)
+		}
+	}
+	for _, t := range triples {
+		if t.Value == nil && (c.maxRepetition > 0 || c.maxDefinition > 1) {
+			continue
+		}
+		if err := c.write(t.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes v, checked with check.
+func (c *columnWriter) write(v interface{}) error {
+	v, err := c.physicalValue(v)
+	if err != nil {
+		return fmt.Errorf("column %s: %s", c.name, err)
+	}
+	if t := c.element.GetType(); t == thrift.Type_FIXED_LEN_BYTE_ARRAY || t == thrift.Type_INT96 {
+		// the plain encoding of the INT96 values is their 12 bytes
+		switch v := v.(type) {
+		case []byte:
+			return c.pages.WriteFixedByteArray([][]byte{v})
+		case string:
+			return c.pages.WriteFixedByteArray([][]byte{[]byte(v)})
+		}
+	}
+	if c.geospatial != nil {
+		switch v := v.(type) {
+		case []byte:
+			c.geospatial.add(v)
+		case string:
+			c.geospatial.add([]byte(v))
+		}
+	}
+	switch v := v.(type) {
+	case nil:
+		return c.pages.WriteNulls(1)
+	case bool:
+		return c.pages.WriteBool([]bool{v})
+	case int32:
+		return c.pages.WriteInt32([]int32{v})
+	case int64:
+		return c.pages.WriteInt64([]int64{v})
+	case float32:
+		return c.pages.WriteFloat32([]float32{v})
+	case float64:
+		return c.pages.WriteFloat64([]float64{v})
+	case []byte:
+		return c.pages.WriteByteArray([][]byte{v})
+	case string:
+		return c.pages.WriteByteArray([][]byte{[]byte(v)})
+	default:
+		return fmt.Errorf("column %s: unsupported value of type %T", c.name, v)
+	}
+}
+
+// writeChunk writes pages, the pages of the column chunk, the chunk j of the
+// row group i, to w, encrypted with e unless e is nil. It returns the chunk
+// and its page index.
+func (c *columnWriter) writeChunk(w *thrift.CountingWriter, e *encryption.FileEncryptor, i, j int, pages []page.Page) (*thrift.ColumnChunk, pageIndex, error) {
+	encodingStats, err := page.EncodingStats(pages)
+	if err != nil {
+		return nil, pageIndex{}, err
+	}
+	compressed, uncompressed, err := page.PageSizes(pages)
+	if err != nil {
+		return nil, pageIndex{}, err
+	}
+
+	meta := &thrift.ColumnMetaData{
+		Type:             c.element.GetType(),
+		Encodings:        encodings(encodingStats, c.maxDefinition),
+		PathInSchema:     c.path,
+		Codec:            c.codec,
+		KeyValueMetadata: thrift.KeyValues(c.options.ChunkKeyValueMetadata),
+		Statistics:       c.pages.Statistics(),
+		EncodingStats:    encodingStats,
+		SizeStatistics:   c.pages.SizeStatistics(),
+		NumValues:        page.NumValues(pages),
+	}
+	meta.GeospatialStatistics = c.geospatial.thrift()
+	if dict := c.pages.ZstdDictionary(); dict != nil {
+		page.SetZstdDictionary(meta, dict)
+	}
+
+	offset := w.N
+	chunk := &thrift.ColumnChunk{FileOffset: offset, MetaData: meta}
+	ce, err := columnEncryptor(e, chunk, i, j)
+	if err != nil {
+		return nil, pageIndex{}, err
+	}
+	n, offsetIndex, err := page.WriteEncryptedPages(w, offset, pages, ce)
+	if err != nil {
+		return nil, pageIndex{}, err
+	}
+	if len(offsetIndex.PageLocations) == 0 {
+		return nil, pageIndex{}, fmt.Errorf("no data page")
+	}
+	meta.TotalCompressedSize = n
+	meta.TotalUncompressedSize = n - compressed + uncompressed
+	meta.DataPageOffset = offsetIndex.PageLocations[0].Offset
+	if meta.DataPageOffset != offset {
+		meta.DictionaryPageOffset = &offset
+	}
+	return chunk, pageIndex{columnIndex: c.pages.ColumnIndex(), offsetIndex: offsetIndex}, nil
+}
+
+// encodings returns the encodings of the pages of a column chunk, from their
+// encoding stats, and of the definition levels of its data pages.
+func encodings(stats []*thrift.PageEncodingStats, maxDefinition uint) []thrift.Encoding {
+	var encodings []thrift.Encoding
+	add := func(enc thrift.Encoding) {
+		for _, e := range encodings {
+			if e == enc {
+				return
+			}
+		}
+		encodings = append(encodings, enc)
+	}
+	for _, s := range stats {
+		add(s.Encoding)
+	}
+	if maxDefinition > 0 {
+		add(thrift.Encoding_RLE)
+	}
+	return encodings
+}
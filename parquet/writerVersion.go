@@ -0,0 +1,168 @@
+package parquet
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// WriterVersion is the application that wrote a file, parsed from the
+// created_by of its metadata, e.g.
+// "parquet-mr version 1.8.0 (build 0fda28af84b9746396014ad6a415b90592a98b3b)".
+type WriterVersion struct {
+	Application string
+	Version     string // empty if the writer did not record it
+	Build       string // empty if the writer did not record it
+}
+
+var createdByPattern = regexp.MustCompile(`^(.*?)\s+version\s*(?:([^(]*?)\s*(?:\(\s*build\s*([^)]*?)\s*\))?)?$`)
+
+// ParseWriterVersion parses the created_by of the metadata of a file, of the
+// form "<application> version <version> (build <hash>)", the version and the
+// build being optional.
+func ParseWriterVersion(createdBy string) (WriterVersion, error) {
+	m := createdByPattern.FindStringSubmatch(createdBy)
+	if m == nil || strings.TrimSpace(m[1]) == "" {
+		return WriterVersion{}, fmt.Errorf("could not parse created_by %q", createdBy)
+	}
+	return WriterVersion{Application: strings.TrimSpace(m[1]), Version: m[2], Build: m[3]}, nil
+}
+
+// semanticVersion is a version of parquet-mr. Unlike semver, it may have
+// unknown characters after the patch, e.g. "1.6.0rc3": the version is then
+// a pre-release. Only the pre-release after a "-" is compared.
+type semanticVersion struct {
+	major, minor, patch int
+	unknown             string
+	prerelease          string
+}
+
+var semanticVersionPattern = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)([^-+]*)?(?:-([^+]*))?(?:\+(.*))?$`)
+
+func parseSemanticVersion(s string) (semanticVersion, error) {
+	m := semanticVersionPattern.FindStringSubmatch(s)
+	if m == nil {
+		return semanticVersion{}, fmt.Errorf("could not parse version %q", s)
+	}
+	var v semanticVersion
+	var err error
+	if v.major, err = strconv.Atoi(m[1]); err != nil {
+		return v, fmt.Errorf("could not parse version %q: %s", s, err)
+	}
+	if v.minor, err = strconv.Atoi(m[2]); err != nil {
+		return v, fmt.Errorf("could not parse version %q: %s", s, err)
+	}
+	if v.patch, err = strconv.Atoi(m[3]); err != nil {
+		return v, fmt.Errorf("could not parse version %q: %s", s, err)
+	}
+	v.unknown, v.prerelease = m[4], m[5]
+	return v, nil
+}
+
+func (v semanticVersion) isPrerelease() bool {
+	return v.unknown != "" || v.prerelease != ""
+}
+
+// compare returns -1, 0 or 1 if v is before, equal to or after o. A
+// pre-release is before the release.
+func (v semanticVersion) compare(o semanticVersion) int {
+	for _, c := range [][2]int{{v.major, o.major}, {v.minor, o.minor}, {v.patch, o.patch}} {
+		if c[0] != c[1] {
+			return compareInt(c[0], c[1])
+		}
+	}
+	switch {
+	case v.isPrerelease() && !o.isPrerelease():
+		return -1
+	case !v.isPrerelease() && o.isPrerelease():
+		return 1
+	}
+	switch {
+	case v.prerelease != "" && o.prerelease != "":
+		return comparePrerelease(v.prerelease, o.prerelease)
+	case v.prerelease != "":
+		return -1
+	case o.prerelease != "":
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares the pre-releases a and b as semver does: the
+// identifiers separated by "." one by one, numerically if both are numbers,
+// a number being before a string.
+func comparePrerelease(a, b string) int {
+	x, y := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(x) && i < len(y); i++ {
+		xn, xerr := strconv.Atoi(x[i])
+		yn, yerr := strconv.Atoi(y[i])
+		switch {
+		case xerr == nil && yerr == nil:
+			if xn != yn {
+				return compareInt(xn, yn)
+			}
+		case xerr == nil:
+			return -1
+		case yerr == nil:
+			return 1
+		default:
+			if c := strings.Compare(x[i], y[i]); c != 0 {
+				return c
+			}
+		}
+	}
+	return compareInt(len(x), len(y))
+}
+
+func compareInt(x, y int) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+var (
+	// parquet251FixedVersion is the first version of parquet-mr with correct
+	// statistics for the byte arrays: the earlier versions could compute the
+	// min and max from buffers reused for the next values (PARQUET-251).
+	parquet251FixedVersion = semanticVersion{major: 1, minor: 8}
+	// the fix was backported to CDH 5.5.0, from 1.5.0-cdh5.5.0 to 1.5.0
+	cdh5Parquet251FixedStart = semanticVersion{major: 1, minor: 5, prerelease: "cdh5.5.0"}
+	cdh5Parquet251FixedEnd   = semanticVersion{major: 1, minor: 5}
+)
+
+// corruptStatistics returns whether the min and max of the statistics of
+// the column chunks of type t written by createdBy cannot be trusted, as
+// parquet-mr does. Only the byte arrays of parquet-mr before 1.8.0 are
+// affected, but the files without created_by or with a version that
+// cannot be parsed are assumed to have been written by these versions
+// (PARQUET-297).
+func corruptStatistics(createdBy string, t thrift.Type) bool {
+	if t != thrift.Type_BYTE_ARRAY && t != thrift.Type_FIXED_LEN_BYTE_ARRAY {
+		return false
+	}
+	if createdBy == "" {
+		return true
+	}
+	wv, err := ParseWriterVersion(createdBy)
+	if err != nil {
+		return true
+	}
+	if wv.Application != "parquet-mr" {
+		return false
+	}
+	v, err := parseSemanticVersion(wv.Version)
+	if err != nil {
+		return true
+	}
+	if v.compare(parquet251FixedVersion) >= 0 {
+		return false
+	}
+	return v.compare(cdh5Parquet251FixedStart) < 0 || v.compare(cdh5Parquet251FixedEnd) >= 0
+}
@@ -0,0 +1,100 @@
+package parquet
+
+import (
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+func TestParseWriterVersion(t *testing.T) {
+	for createdBy, want := range map[string]WriterVersion{
+		"parquet-mr version 1.8.0 (build 0fda28af84b9746396014ad6a415b90592a98b3b)":    {"parquet-mr", "1.8.0", "0fda28af84b9746396014ad6a415b90592a98b3b"},
+		"parquet-mr version 1.5.0-cdh5.5.0":                                            {"parquet-mr", "1.5.0-cdh5.5.0", ""},
+		"impala version 1.2-INTERNAL (build a462ec42e550c75fccbff98c720f37f3ee9d55a3)": {"impala", "1.2-INTERNAL", "a462ec42e550c75fccbff98c720f37f3ee9d55a3"},
+		"parquet-cpp-arrow version 14.0.1":                                             {"parquet-cpp-arrow", "14.0.1", ""},
+	} {
+		got, err := ParseWriterVersion(createdBy)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", createdBy, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("%q: got %+v, want %+v", createdBy, got, want)
+		}
+	}
+
+	for _, createdBy := range []string{"", "parquet-mr", "parquet-mr (build 6aa21f8776625b5fa6b18059cfebe7549f2e00cb)", " version 1.0"} {
+		if _, err := ParseWriterVersion(createdBy); err == nil {
+			t.Errorf("%q: no error", createdBy)
+		}
+	}
+}
+
+func TestSemanticVersionCompare(t *testing.T) {
+	ordered := []string{"1.4.9", "1.5.0-cdh5.4.9", "1.5.0-cdh5.5.0", "1.5.0-cdh5.5.1", "1.5.0-cdh5.10.0", "1.5.0", "1.6.0rc3", "1.6.0", "1.8.0-SNAPSHOT", "1.8.0", "1.10.0"}
+	for i := range ordered {
+		for j := range ordered {
+			a, err := parseSemanticVersion(ordered[i])
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := parseSemanticVersion(ordered[j])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, want := a.compare(b), compareInt(i, j); got != want {
+				t.Errorf("compare(%s, %s) = %d, want %d", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+}
+
+func TestCorruptStatistics(t *testing.T) {
+	for _, tt := range []struct {
+		createdBy string
+		typ       thrift.Type
+		corrupt   bool
+	}{
+		{"parquet-mr version 1.7.0 (build abc)", thrift.Type_BYTE_ARRAY, true},
+		{"parquet-mr version 1.7.0 (build abc)", thrift.Type_FIXED_LEN_BYTE_ARRAY, true},
+		{"parquet-mr version 1.7.0 (build abc)", thrift.Type_INT64, false},
+		{"parquet-mr version 1.8.0 (build abc)", thrift.Type_BYTE_ARRAY, false},
+		{"parquet-mr version 1.8.0-SNAPSHOT", thrift.Type_BYTE_ARRAY, true},
+		{"parquet-mr version 1.5.0-cdh5.4.9", thrift.Type_BYTE_ARRAY, true},
+		{"parquet-mr version 1.5.0-cdh5.5.0", thrift.Type_BYTE_ARRAY, false},
+		{"parquet-mr version 1.5.0-cdh5.7.1", thrift.Type_BYTE_ARRAY, false},
+		{"parquet-mr version 1.5.0", thrift.Type_BYTE_ARRAY, true},
+		{"parquet-mr version", thrift.Type_BYTE_ARRAY, true},
+		{"parquet-mr version unknown", thrift.Type_BYTE_ARRAY, true},
+		{"parquet-mr (build 6aa21f8776625b5fa6b18059cfebe7549f2e00cb)", thrift.Type_BYTE_ARRAY, true},
+		{"", thrift.Type_BYTE_ARRAY, true},
+		{"", thrift.Type_INT32, false},
+		{"impala version 1.2-INTERNAL (build a462ec42e550c75fccbff98c720f37f3ee9d55a3)", thrift.Type_BYTE_ARRAY, false},
+	} {
+		if got := corruptStatistics(tt.createdBy, tt.typ); got != tt.corrupt {
+			t.Errorf("%q, %s: got %v, want %v", tt.createdBy, tt.typ, got, tt.corrupt)
+		}
+	}
+}
+
+func TestColumnChunkStatistics(t *testing.T) {
+	f := openTestFile(t, "testdata/ByteArrays.parquet")
+	cc := f.RowGroups()[0].ColumnChunk("Required")
+	stats := cc.Statistics()
+	if string(stats.GetMin()) != "r1" || string(stats.GetMax()) != "r6" {
+		t.Errorf("got min %q and max %q", stats.GetMin(), stats.GetMax())
+	}
+
+	createdBy := "parquet-mr version 1.7.0 (build 9ab4c4e4ed2d76e16fd4bb2cd5a4b8d1c3cdbcc1)"
+	f.meta.CreatedBy = &createdBy
+	stats = cc.Statistics()
+	if stats.IsSetMin() || stats.IsSetMax() {
+		t.Errorf("got min %q and max %q written by %s", stats.GetMin(), stats.GetMax(), createdBy)
+	}
+	if !stats.IsSetNullCount() {
+		t.Errorf("null count was removed")
+	}
+	if cc.Metadata().GetStatistics().GetMin() == nil {
+		t.Errorf("min was removed from the metadata")
+	}
+}
@@ -0,0 +1,858 @@
+package parquet
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kostya-sh/parquet-go/parquet/encryption"
+	"github.com/kostya-sh/parquet-go/parquet/page"
+	"github.com/kostya-sh/parquet-go/parquet/thrift"
+)
+
+// writerTestSpecs are the columns of writerTestSchema.
+var writerTestSpecs = []string{
+	"id: INT64 REQUIRED",
+	"name: BYTE_ARRAY UTF8 OPTIONAL",
+	"score: DOUBLE OPTIONAL",
+	"ratio: FLOAT REQUIRED",
+	"count: INT32 OPTIONAL",
+	"valid: BOOLEAN REQUIRED",
+	"missing: INT32 OPTIONAL",
+}
+
+// writerTestSchema returns a schema of a column of each supported type.
+func writerTestSchema(t *testing.T) *Schema {
+	return writerTestSchemaOf(t, writerTestSpecs)
+}
+
+// writerTestSchemaOf returns the schema of the columns of specs.
+func writerTestSchemaOf(t *testing.T, specs []string) *Schema {
+	schema := NewSchema()
+	for _, spec := range specs {
+		if err := schema.AddColumnFromSpec(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return schema
+}
+
+// writerTestRows returns n rows of the schema of writerTestSchema.
+func writerTestRows(n int) []map[string]interface{} {
+	rows := make([]map[string]interface{}, n)
+	for i := range rows {
+		row := map[string]interface{}{
+			"id":    int64(i),
+			"ratio": float32(i) / 2,
+			"valid": i%2 == 0,
+		}
+		if i%5 != 0 {
+			row["name"] = []byte(fmt.Sprintf("name %d", i%7))
+		}
+		if i%3 != 0 {
+			row["score"] = float64(i) * 1.5
+			row["count"] = int32(i % 10)
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// readTestRows reads the rows of the row groups of f, with nil for the
+// null values.
+func readTestRows(t *testing.T, f *File) []map[string]interface{} {
+	var rows []map[string]interface{}
+	for _, rg := range f.RowGroups() {
+		scanner := rg.Rows()
+		for scanner.Scan() {
+			rows = append(rows, scanner.Row())
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return rows
+}
+
+func TestWriter(t *testing.T) {
+	rows := writerTestRows(500)
+	for _, options := range []WriterOptions{
+		{},
+		{ColumnOptions: ColumnOptions{Compression: "snappy", DataPageVersion: 2, BloomFilter: true}},
+		{ColumnOptions: ColumnOptions{Compression: "gzip"}, Columns: map[string]ColumnOptions{"name": {Compression: "zstd"}}},
+		{ColumnOptions: ColumnOptions{Encoding: "plain", PageValues: 64}, Columns: map[string]ColumnOptions{
+			"id":    {Encoding: "delta_binary_packed"},
+			"name":  {Encoding: "delta_byte_array"},
+			"score": {Encoding: "byte_stream_split"},
+			"ratio": {Encoding: "byte_stream_split"},
+			"count": {Encoding: "delta_binary_packed"},
+			"valid": {Encoding: "rle"},
+		}},
+		{ColumnOptions: ColumnOptions{DataPageVersion: 2}, Columns: map[string]ColumnOptions{"name": {Encoding: "delta_length_byte_array"}}},
+	} {
+		var b bytes.Buffer
+		w, err := NewWriter(&b, writerTestSchema(t), options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		data := b.Bytes()
+		f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatalf("%+v: %s", options, err)
+		}
+		if f.Metadata().NumRows != int64(len(rows)) {
+			t.Errorf("%+v: got %d rows, want %d", options, f.Metadata().NumRows, len(rows))
+		}
+		wv, err := ParseWriterVersion(f.Metadata().GetCreatedBy())
+		if err != nil || wv.Application != "parquet-go" {
+			t.Errorf("%+v: got writer version %+v, %v", options, wv, err)
+		}
+
+		got := readTestRows(t, f)
+		if len(got) != len(rows) {
+			t.Fatalf("%+v: read %d rows, want %d", options, len(got), len(rows))
+		}
+		for i, row := range got {
+			want := map[string]interface{}{"name": nil, "score": nil, "count": nil, "missing": nil}
+			for k, v := range rows[i] {
+				want[k] = v
+			}
+			if !reflect.DeepEqual(row, want) {
+				t.Fatalf("%+v: row %d: got %v, want %v", options, i, row, want)
+			}
+		}
+
+		rg := f.RowGroups()[0]
+		id := rg.ColumnChunk("id")
+		if stats := id.Statistics(); !bytes.Equal(stats.GetMinValue(), make([]byte, 8)) || stats.GetNullCount() != 0 {
+			t.Errorf("%+v: got statistics %v of id", options, stats)
+		}
+		name := rg.ColumnChunk("name")
+		if stats := name.Statistics(); stats.GetNullCount() != 100 || string(stats.GetMaxValue()) != "name 6" {
+			t.Errorf("%+v: got statistics %v of name", options, stats)
+		}
+		if enc := options.column("name").Encoding; enc == "" {
+			if encoded, ok := name.DictionaryEncoded(); !ok || !encoded {
+				t.Errorf("%+v: name is not dictionary encoded", options)
+			}
+		} else if want, _ := page.EncodingByName(enc); name.Metadata().Encodings[0] != want {
+			t.Errorf("%+v: got encodings %v of name", options, name.Metadata().Encodings)
+		}
+		if stats := rg.ColumnChunk("missing").Statistics(); stats.GetNullCount() != int64(len(rows)) {
+			t.Errorf("%+v: got statistics %v of missing", options, stats)
+		}
+		offsetIndex, err := id.OffsetIndex()
+		if err != nil || len(offsetIndex.GetPageLocations()) == 0 {
+			t.Errorf("%+v: got offset index %v, %v", options, offsetIndex, err)
+		}
+		filter, err := id.BloomFilter()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if options.BloomFilter != (filter != nil) {
+			t.Errorf("%+v: got bloom filter %v", options, filter)
+		} else if filter != nil && !filter.Check(int64(42)) {
+			t.Errorf("%+v: Check(42) = false", options)
+		}
+	}
+}
+
+func TestWriterRowGroups(t *testing.T) {
+	rows := writerTestRows(250)
+	for _, test := range []struct {
+		options WriterOptions
+		flush   int // rows written between the calls to Flush, 0 for none
+		want    []int64
+	}{
+		{WriterOptions{}, 0, []int64{250}},
+		{WriterOptions{RowGroupRows: 100}, 0, []int64{100, 100, 50}},
+		{WriterOptions{}, 60, []int64{60, 60, 60, 60, 10}},
+		{WriterOptions{RowGroupRows: 50}, 60, []int64{50, 10, 50, 10, 50, 10, 50, 10, 10}},
+		{WriterOptions{RowGroupSize: 2000}, 0, nil},
+	} {
+		var b bytes.Buffer
+		w, err := NewWriter(&b, writerTestSchema(t), test.options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+			if test.flush > 0 && (i+1)%test.flush == 0 {
+				if err := w.Flush(); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var numRows []int64
+		for _, rg := range f.RowGroups() {
+			numRows = append(numRows, rg.NumRows())
+		}
+		if test.want != nil && !reflect.DeepEqual(numRows, test.want) {
+			t.Errorf("%+v, flush %d: got row groups of %v rows, want %v", test.options, test.flush, numRows, test.want)
+		}
+		if test.want == nil && len(numRows) < 3 {
+			t.Errorf("%+v: got row groups of %v rows", test.options, numRows)
+		}
+		if got := readTestRows(t, f); len(got) != len(rows) || !reflect.DeepEqual(got[len(got)-1]["id"], rows[len(rows)-1]["id"]) {
+			t.Errorf("%+v, flush %d: read %d rows", test.options, test.flush, len(got))
+		}
+	}
+}
+
+func TestWriterPageValues(t *testing.T) {
+	options := WriterOptions{
+		ColumnOptions: ColumnOptions{PageValues: 50},
+		Columns:       map[string]ColumnOptions{"name": {PageValues: 200}, "ratio": {PageSize: 16, PageValues: 1000}},
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := writerTestRows(500)
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rg := f.RowGroups()[0]
+	for name, want := range map[string]int{"id": 10, "name": 3, "ratio": 0} {
+		index, err := rg.ColumnChunk(name).OffsetIndex()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := len(index.GetPageLocations())
+		if want > 0 && got != want || want == 0 && got <= 10 {
+			t.Errorf("%s: got %d pages, want %d", name, got, want)
+		}
+	}
+	if got := readTestRows(t, f); !reflect.DeepEqual(got[499]["ratio"], rows[499]["ratio"]) {
+		t.Errorf("got row %v", got[499])
+	}
+}
+
+func TestWriterNullPages(t *testing.T) {
+	// the second page of each column has only nulls
+	for _, test := range []struct {
+		column   string
+		encoding string
+		value    interface{}
+	}{
+		{"optional int32 c", "plain", int32(7)},
+		{"optional int32 c", "delta_binary_packed", int32(7)},
+		{"optional int64 c", "delta_binary_packed", int64(7)},
+		{"optional binary c", "delta_length_byte_array", []byte("abc")},
+		{"optional binary c", "delta_byte_array", []byte("abc")},
+		{"optional fixed_len_byte_array(3) c", "delta_byte_array", []byte("abc")},
+		{"optional boolean c", "rle", true},
+		{"optional float c", "byte_stream_split", float32(7)},
+	} {
+		schema, err := ParseSchema("message m { " + test.column + "; }")
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows := []map[string]interface{}{{"c": test.value}, {"c": test.value}, {}, {}}
+		want := []map[string]interface{}{{"c": test.value}, {"c": test.value}, {"c": nil}, {"c": nil}}
+		for _, version := range []int{1, 2} {
+			options := WriterOptions{ColumnOptions: ColumnOptions{Encoding: test.encoding, PageValues: 2, DataPageVersion: version}}
+			var b bytes.Buffer
+			w, err := NewWriter(&b, schema, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, row := range rows {
+				if err := w.WriteRow(row); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []map[string]interface{}
+			scanner := f.RowGroups()[0].Rows()
+			for scanner.Scan() {
+				got = append(got, scanner.Row())
+			}
+			if err := scanner.Err(); err != nil {
+				t.Errorf("%s, %s, data page v%d: %s", test.column, test.encoding, version, err)
+			} else if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s, %s, data page v%d: got %v, want %v", test.column, test.encoding, version, got, want)
+			}
+		}
+	}
+}
+
+func TestWriterDataPageVersion(t *testing.T) {
+	options := WriterOptions{
+		ColumnOptions: ColumnOptions{DataPageVersion: 2},
+		Columns:       map[string]ColumnOptions{"name": {DataPageVersion: 1}},
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := writerTestRows(100)
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cc := range f.RowGroups()[0].ColumnChunks() {
+		want := thrift.PageType_DATA_PAGE_V2
+		if cc.Name() == "name" {
+			want = thrift.PageType_DATA_PAGE
+		}
+		for _, s := range cc.EncodingStats() {
+			if s.PageType != thrift.PageType_DICTIONARY_PAGE && s.PageType != want {
+				t.Errorf("%s: got %s pages, want %s", cc.Name(), s.PageType, want)
+			}
+		}
+	}
+	if got := readTestRows(t, f); len(got) != len(rows) || !reflect.DeepEqual(got[99]["name"], rows[99]["name"]) {
+		t.Errorf("got %d rows, want %d", len(got), len(rows))
+	}
+}
+
+func TestWriterDictionary(t *testing.T) {
+	options := WriterOptions{
+		ColumnOptions: ColumnOptions{DisableDictionary: true, DictionaryPageSize: 256},
+		Columns:       map[string]ColumnOptions{"id": {Encoding: "dictionary", PageValues: 16}, "name": {Encoding: "dictionary"}},
+	}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := writerTestRows(500)
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rg := f.RowGroups()[0]
+	for _, test := range []struct {
+		name       string
+		dictionary bool // whether the chunk has a dictionary page
+		encoded    bool // whether all its data pages are dictionary encoded
+	}{
+		// the dictionary of the ids reaches 256 bytes after the first pages
+		{"id", true, false},
+		{"name", true, true},
+		{"count", false, false},
+	} {
+		cc := rg.ColumnChunk(test.name)
+		dictionary := false
+		for _, s := range cc.EncodingStats() {
+			if s.PageType == thrift.PageType_DICTIONARY_PAGE {
+				dictionary = true
+			}
+		}
+		encoded, _ := cc.DictionaryEncoded()
+		if dictionary != test.dictionary || encoded != test.encoded {
+			t.Errorf("%s: got dictionary page %t and dictionary encoded %t, want %t and %t", test.name, dictionary, encoded, test.dictionary, test.encoded)
+		}
+	}
+	if got := readTestRows(t, f); len(got) != len(rows) || !reflect.DeepEqual(got[499]["id"], rows[499]["id"]) {
+		t.Errorf("got %d rows, want %d", len(got), len(rows))
+	}
+}
+
+func TestWriterConcurrency(t *testing.T) {
+	rows := writerTestRows(500)
+	var files [][]byte
+	for _, concurrency := range []int{0, 3, 16} {
+		options := WriterOptions{
+			ColumnOptions: ColumnOptions{Compression: "snappy"},
+			Columns:       map[string]ColumnOptions{"name": {Compression: "gzip"}, "ratio": {Compression: "zstd"}},
+			RowGroupRows:  200,
+			Concurrency:   concurrency,
+		}
+		var b bytes.Buffer
+		w, err := NewWriter(&b, writerTestSchema(t), options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, b.Bytes())
+	}
+	// the chunks are written in order whatever the concurrency
+	for i := 1; i < len(files); i++ {
+		if !bytes.Equal(files[i], files[0]) {
+			t.Errorf("file %d differs from the file written without concurrency", i)
+		}
+	}
+
+	f, err := OpenFile(bytes.NewReader(files[1]), int64(len(files[1])))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(f.RowGroups()); n != 3 {
+		t.Errorf("got %d row groups, want 3", n)
+	}
+	if got := readTestRows(t, f); len(got) != len(rows) || !reflect.DeepEqual(got[499]["name"], rows[499]["name"]) {
+		t.Errorf("got %d rows, want %d", len(got), len(rows))
+	}
+}
+
+// failingCodec is a Codec that fails to compress.
+type failingCodec struct{}
+
+func (failingCodec) Encode(src []byte) ([]byte, error) {
+	return nil, fmt.Errorf("compression failed")
+}
+
+func (failingCodec) Decode(src []byte, uncompressedSize int) ([]byte, error) {
+	return nil, fmt.Errorf("decompression failed")
+}
+
+func TestWriterPagesError(t *testing.T) {
+	page.RegisterCodec(thrift.CompressionCodec_LZO, failingCodec{})
+	defer page.RegisterCodec(thrift.CompressionCodec_LZO, nil)
+
+	// the pages are compressed when the row group is written
+	options := WriterOptions{ColumnOptions: ColumnOptions{Compression: "lzo"}, Concurrency: 2}
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range writerTestRows(10) {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err == nil || !strings.Contains(err.Error(), "compression failed") {
+		t.Errorf("got error %v, want the compression error", err)
+	}
+}
+
+func TestWriterEncrypted(t *testing.T) {
+	footerKey, columnKey := []byte("0123456789012345"), []byte("abcdefghijklmnop")
+	column, err := encryption.NewColumnEncryptionPropertiesBuilder("name").WithKey(columnKey).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	properties, err := encryption.NewFileEncryptionPropertiesBuilder(footerKey).WithEncryptedColumns(column).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := writerTestRows(100)
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), WriterOptions{Encryption: properties})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data := b.Bytes()
+	if !bytes.HasPrefix(data, encryptedMagic) {
+		t.Errorf("file starts with %q", data[:4])
+	}
+	if _, err := OpenFile(bytes.NewReader(data), int64(len(data))); err == nil {
+		t.Errorf("no error without the keys")
+	}
+	options := ReaderOptions{Decryption: &encryption.DecryptionProperties{
+		FooterKey:  footerKey,
+		ColumnKeys: map[string][]byte{"name": columnKey},
+	}}
+	f, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := readTestRows(t, f)
+	if len(got) != len(rows) || got[1]["name"] == nil || !bytes.Equal(got[1]["name"].([]byte), rows[1]["name"].([]byte)) {
+		t.Errorf("got rows %v", got)
+	}
+}
+
+func TestWriterAt(t *testing.T) {
+	footerKey := []byte("0123456789012345")
+	properties, err := encryption.NewFileEncryptionPropertiesBuilder(footerKey).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := writerTestRows(500)
+	for _, encrypted := range []bool{false, true} {
+		options := WriterOptions{ColumnOptions: ColumnOptions{Compression: "snappy"}, RowGroupRows: 200, Concurrency: 4}
+		if encrypted {
+			options.Encryption = properties
+		}
+		file, err := ioutil.TempFile("", "parquet-go")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.Remove(file.Name())
+		defer file.Close()
+
+		w, err := NewWriterAt(file, writerTestSchema(t), options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b bytes.Buffer
+		sequential, err := NewWriter(&b, writerTestSchema(t), WriterOptions{ColumnOptions: options.ColumnOptions, RowGroupRows: 200})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+			if err := sequential.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := sequential.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := ioutil.ReadFile(file.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !encrypted && !bytes.Equal(data, b.Bytes()) {
+			t.Errorf("the file differs from the file written sequentially")
+		}
+		f, err := OpenFileWithOptions(bytes.NewReader(data), int64(len(data)), ReaderOptions{Decryption: &encryption.DecryptionProperties{FooterKey: footerKey}})
+		if err != nil {
+			t.Fatalf("encrypted %t: %s", encrypted, err)
+		}
+		for _, rg := range f.RowGroups() {
+			for _, cc := range rg.ColumnChunks() {
+				index, err := cc.OffsetIndex()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if offset := index.PageLocations[0].Offset; offset != cc.Metadata().DataPageOffset {
+					t.Errorf("encrypted %t: %s: the first page is at %d, not %d", encrypted, cc.Name(), offset, cc.Metadata().DataPageOffset)
+				}
+			}
+		}
+		if got := readTestRows(t, f); len(got) != len(rows) || !reflect.DeepEqual(got[499]["name"], rows[499]["name"]) {
+			t.Errorf("encrypted %t: got %d rows, want %d", encrypted, len(got), len(rows))
+		}
+	}
+}
+
+func TestWriterReset(t *testing.T) {
+	options := WriterOptions{
+		ColumnOptions:  ColumnOptions{Compression: "snappy", BloomFilter: true},
+		SortingColumns: []SortingColumn{{Column: "id"}},
+		VerifySorting:  true,
+		RowGroupRows:   40,
+	}
+	rows := writerTestRows(100)
+	writeRows := func(w *Writer, rows []map[string]interface{}) {
+		for _, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	var want bytes.Buffer
+	w, err := NewWriter(&want, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeRows(w, rows)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a closed file, then a file abandoned with buffered rows
+	var first, second, got bytes.Buffer
+	w, err = NewWriter(&first, writerTestSchema(t), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeRows(w, rows[:70])
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range []*bytes.Buffer{&second, &got} {
+		if err := w.Reset(b); err != nil {
+			t.Fatal(err)
+		}
+		writeRows(w, rows[:30])
+	}
+	writeRows(w, rows[30:])
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got.Bytes(), want.Bytes()) {
+		t.Errorf("the file written after Reset differs from the file of a new Writer")
+	}
+}
+
+func TestWriterErrors(t *testing.T) {
+	for _, test := range []struct {
+		specs   []string
+		options WriterOptions
+	}{
+		{nil, WriterOptions{}},
+		{[]string{"a.b: INT32 REQUIRED"}, WriterOptions{}},
+		{[]string{"a: INT96 REQUIRED"}, WriterOptions{}},
+		{[]string{"a: INT32 REQUIRED"}, WriterOptions{ColumnOptions: ColumnOptions{Compression: "unknown"}}},
+		{[]string{"a: INT32 REQUIRED"}, WriterOptions{Columns: map[string]ColumnOptions{"a": {DataPageVersion: 3}}}},
+		{[]string{"a: INT32 REQUIRED"}, WriterOptions{ColumnOptions: ColumnOptions{Encoding: "unknown"}}},
+		{[]string{"a: INT32 REQUIRED"}, WriterOptions{ColumnOptions: ColumnOptions{Encoding: "rle"}}},
+		{[]string{"a: BOOLEAN REQUIRED"}, WriterOptions{Columns: map[string]ColumnOptions{"a": {Encoding: "dictionary"}}}},
+		{[]string{"a: DOUBLE REQUIRED"}, WriterOptions{Columns: map[string]ColumnOptions{"a": {Encoding: "delta_binary_packed"}}}},
+		{[]string{"a: INT32 REQUIRED"}, WriterOptions{SortingColumns: []SortingColumn{{Column: "b"}}}},
+	} {
+		schema := NewSchema()
+		for _, spec := range test.specs {
+			if err := schema.AddColumnFromSpec(spec); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := NewWriter(&bytes.Buffer{}, schema, test.options); err == nil {
+			t.Errorf("%v %+v: no error", test.specs, test.options)
+		}
+	}
+
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range []map[string]interface{}{
+		{"ratio": float32(1), "valid": true},
+		{"id": int64(1), "ratio": float32(1), "valid": 1},
+		{"id": int32(1), "ratio": float32(1), "valid": true},
+		{"id": int64(1), "ratio": float32(1), "valid": true, "unknown": 1},
+	} {
+		if err := w.WriteRow(row); err == nil {
+			t.Errorf("no error for row %v", row)
+		}
+	}
+	// the invalid rows are not written
+	row := map[string]interface{}{"id": int64(1), "name": "a string", "ratio": float32(1), "valid": true}
+	if err := w.WriteRow(row); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(row); err == nil {
+		t.Errorf("no error after Close")
+	}
+
+	data := b.Bytes()
+	f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows := readTestRows(t, f); len(rows) != 1 || string(rows[0]["name"].([]byte)) != "a string" {
+		t.Errorf("got rows %v", rows)
+	}
+	if f.Schema().ColumnByName("name").SchemaElement.GetConvertedType() != thrift.ConvertedType_UTF8 {
+		t.Errorf("name is not UTF8")
+	}
+
+	// a file without rows has no row groups
+	b.Reset()
+	if w, err = NewWriter(&b, writerTestSchema(t), WriterOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if f, err = OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len())); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.RowGroups()) != 0 || len(f.Schema().Columns()) != 7 {
+		t.Errorf("got %d row groups and columns %v", len(f.RowGroups()), f.Schema().Columns())
+	}
+}
+
+func TestWriterEmptyRowGroup(t *testing.T) {
+	schema := writerTestSchemaOf(t, writerTestSpecs)
+	var b bytes.Buffer
+	w, err := NewWriter(&b, schema, WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := w.columns[0].writeChunk(w.w, nil, 0, 0, nil); err == nil {
+		t.Error("no error for a column chunk without data pages")
+	}
+	n := len(w.columns)
+	if err := w.copyRowGroup(0, make([]*ColumnChunk, n), make([]columnValues, n), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(f.RowGroups()); n != 0 {
+		t.Errorf("got %d row groups", n)
+	}
+}
+
+func TestWriterColumnChunks(t *testing.T) {
+	// the columns of writerTestSchema are written in two files
+	rows := writerTestRows(200)
+	var files []*File
+	for _, specs := range [][]string{writerTestSpecs[:3], writerTestSpecs[3:]} {
+		schema := writerTestSchemaOf(t, specs)
+		names := schema.Columns()
+		var b bytes.Buffer
+		w, err := NewWriter(&b, schema, WriterOptions{ColumnOptions: ColumnOptions{Compression: "snappy", BloomFilter: true}, RowGroupRows: 100})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, row := range rows {
+			part := make(map[string]interface{})
+			for _, name := range names {
+				if v, ok := row[name]; ok {
+					part[name] = v
+				}
+			}
+			if err := w.WriteRow(part); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+
+	var b bytes.Buffer
+	w, err := NewWriter(&b, writerTestSchema(t), WriterOptions{ColumnOptions: ColumnOptions{Compression: "snappy"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow(rows[0]); err != nil {
+		t.Fatal(err)
+	}
+	for i := range files[0].RowGroups() {
+		chunks := append(files[0].RowGroups()[i].ColumnChunks(), files[1].RowGroups()[i].ColumnChunks()...)
+		if err := w.WriteColumnChunks(chunks...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	other := files[0].RowGroups()
+	for _, chunks := range [][]*ColumnChunk{
+		other[0].ColumnChunks(),
+		append(files[1].RowGroups()[0].ColumnChunks(), other[0].ColumnChunks()...),
+		append(other[0].ColumnChunks(), files[1].RowGroups()[0].ColumnChunks()[:3]...),
+	} {
+		if err := w.WriteColumnChunks(chunks...); err == nil {
+			t.Errorf("no error for %d column chunks", len(chunks))
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := OpenFile(bytes.NewReader(b.Bytes()), int64(b.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(f.RowGroups()); n != 3 {
+		t.Fatalf("got %d row groups, want 3", n)
+	}
+	got := readTestRows(t, f)
+	if len(got) != 1+len(rows) {
+		t.Fatalf("got %d rows, want %d", len(got), 1+len(rows))
+	}
+	for i, row := range got[1:] {
+		if row["id"] != rows[i]["id"] || row["ratio"] != rows[i]["ratio"] || row["count"] != rows[i]["count"] {
+			t.Fatalf("row %d: got %v, want %v", i, row, rows[i])
+		}
+	}
+	filter, err := f.RowGroups()[2].ColumnChunk("ratio").BloomFilter()
+	if err != nil || filter == nil || !filter.Check(float32(150)/2) {
+		t.Errorf("got bloom filter %v, %v", filter, err)
+	}
+
+	// the codec of the chunks is the codec of the columns
+	w, err = NewWriter(&bytes.Buffer{}, writerTestSchema(t), WriterOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chunks := append(files[0].RowGroups()[0].ColumnChunks(), files[1].RowGroups()[0].ColumnChunks()...)
+	if err := w.WriteColumnChunks(chunks...); err == nil {
+		t.Errorf("no error for snappy chunks in an uncompressed file")
+	}
+}
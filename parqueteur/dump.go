@@ -35,7 +35,7 @@ func runDump(cmd *Command, args []string) error {
 		return fmt.Errorf("%s: no files", args[0])
 	}
 
-	fd, err := parquet.OpenFile(args[0])
+	fd, err := parquet.Open(args[0])
 	if err != nil {
 		return err
 	}
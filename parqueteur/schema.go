@@ -20,7 +20,7 @@ func runSchema(cmd *Command, args []string) error {
 		return fmt.Errorf("No files")
 	}
 
-	r, err := parquet.OpenFile(args[0])
+	r, err := parquet.Open(args[0])
 	if err != nil {
 		return err
 	}